@@ -14,6 +14,8 @@ var (
 	DialerMail            *gomail.Dialer
 	InviteBodyTemplate    *template.Template
 	BaseCallbackInviteURL string
+	EventBodyTemplate     *template.Template
+	BaseEventExternalURL  string
 )
 
 func SetupInviteMail() {
@@ -31,6 +33,33 @@ func SetupInviteMail() {
 
 }
 
+// SetupEventMail parses the mails sent to event attendees into one template set, each mail is executed by its file name.
+func SetupEventMail() {
+	eventBodyTemplate, err := template.ParseFiles(
+		"./Ticket_confirmation_email_template.html",
+		"./Waitlist_offer_email_template.html",
+		"./Event_announcement_email_template.html",
+		"./Event_reminder_email_template.html",
+		"./Survey_invitation_email_template.html",
+		"./Certificate_email_template.html",
+		"./CoHost_invitation_email_template.html",
+		"./Discussion_reply_email_template.html",
+		"./Report_outcome_email_template.html",
+		"./Moderation_warning_email_template.html",
+	)
+	if err != nil {
+		log.Fatalf("Error loading template: %v", err)
+	}
+	EventBodyTemplate = eventBodyTemplate
+	baseUrl := os.Getenv("BASE_EXTERNAL_URL")
+	if baseUrl == "" {
+		log.Fatal("BASE_EXTERNAL_URL is not set")
+	}
+	BaseEventExternalURL = baseUrl
+	logs.Info("Successfully Setup Event Mail")
+
+}
+
 func SetupMail() {
 	//SMTP_PASSWORD
 	//SMTP_MAIL
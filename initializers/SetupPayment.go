@@ -0,0 +1,37 @@
+package initializers
+
+import (
+	"log"
+	"os"
+
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/infrastructure/payment"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/logs"
+)
+
+var PaymentProvider payment.PaymentProvider
+
+// SetupPayment selects the payment provider from PAYMENT_PROVIDER, the fake provider is the default outside production.
+func SetupPayment() {
+	webhookSecret := os.Getenv("PAYMENT_WEBHOOK_SECRET")
+	if webhookSecret == "" {
+		log.Fatal("PAYMENT_WEBHOOK_SECRET is not set")
+	}
+
+	switch provider := os.Getenv("PAYMENT_PROVIDER"); provider {
+	case "promptpay":
+		promptPayID := os.Getenv("PROMPTPAY_ID")
+		if promptPayID == "" {
+			log.Fatal("PROMPTPAY_ID is not set")
+		}
+		PaymentProvider = payment.NewPromptPayProvider(promptPayID, webhookSecret)
+	case "", "fake":
+		if os.Getenv("ENVIRONMENT") == "production" {
+			log.Fatal("PAYMENT_PROVIDER must be set in production")
+		}
+		PaymentProvider = payment.NewFakeProvider(webhookSecret)
+	default:
+		log.Fatalf("Unknown PAYMENT_PROVIDER: %s", provider)
+	}
+
+	logs.Info("Successfully Setup Payment Provider: " + PaymentProvider.Name())
+}
@@ -16,6 +16,7 @@ import (
 
 	"github.com/DAF-Bridge/Talent-Atmos-Backend/initializers"
 	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/infrastructure/api"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/infrastructure/sync"
 	"github.com/DAF-Bridge/Talent-Atmos-Backend/logs"
 	"github.com/DAF-Bridge/Talent-Atmos-Backend/utils"
 	"github.com/gofiber/fiber/v2"
@@ -32,9 +33,15 @@ func init() {
 	initializers.ConnectToDB()
 	initializers.ConnectToS3()
 	initializers.ConnectToElasticSearch()
+	// Brings the search indices to the current version of their analyzers
+	if err := sync.EnsureSearchIndices(initializers.ESClient); err != nil {
+		logs.Error(err)
+	}
 	initializers.ConnectToCasbin()
 	initializers.SetupMail()
 	initializers.SetupInviteMail()
+	initializers.SetupEventMail()
+	initializers.SetupPayment()
 	// initializers.ConnectToRedis()
 	// initializers.SyncDB()
 	initializers.SetupGoth()
@@ -148,19 +155,52 @@ func Start() {
 	api.NewAuthRouter(app, initializers.DB, jwtSecret)
 
 	// Define routes for Users
-	api.NewUserRouter(app, initializers.DB, initializers.S3, jwtSecret)
+	api.NewUserRouter(app, initializers.DB, initializers.ESClient, initializers.S3, jwtSecret)
 
 	// Define routes for Roles
 	api.NewRoleRouter(app, initializers.DB, initializers.Enforcer, initializers.DialerMail, jwtSecret, initializers.InviteBodyTemplate, initializers.BaseCallbackInviteURL)
 
 	// Define routes for Organizations && Organization Open Jobs
 	api.NewOrganizationAdminRouter(app, initializers.DB, initializers.Enforcer, initializers.ESClient, initializers.S3, jwtSecret)
-	api.NewOrganizationRouter(app, initializers.DB, initializers.Enforcer, initializers.ESClient, initializers.S3)
+	api.NewOrganizationRouter(app, initializers.DB, initializers.Enforcer, initializers.ESClient, initializers.S3, jwtSecret)
+
+	// Define routes for Skills
+	api.NewSkillRouter(app, initializers.DB, initializers.Enforcer, initializers.ESClient, jwtSecret)
+
+	// Define routes for Public Profiles && Talent Search
+	api.NewTalentRouter(app, initializers.DB, initializers.Enforcer, initializers.ESClient, jwtSecret)
+
+	// Define routes for Resumes && Job Applications
+	api.NewResumeRouter(app, initializers.DB, initializers.Enforcer, initializers.ESClient, initializers.S3, jwtSecret)
 
 	// Define routes for Events
 	api.NewEventAdminRouter(app, initializers.DB, initializers.Enforcer, initializers.ESClient, initializers.S3, jwtSecret)
 	api.NewEventRouter(app, initializers.DB, initializers.Enforcer, initializers.ESClient, initializers.S3, jwtSecret)
 
+	// Define routes for Event Tickets && Registrations
+	api.NewTicketRouter(app, initializers.DB, initializers.Enforcer, initializers.DialerMail, jwtSecret, initializers.EventBodyTemplate, initializers.BaseEventExternalURL, initializers.PaymentProvider)
+
+	// Define routes for Event Surveys && Ratings
+	api.NewSurveyRouter(app, initializers.DB, initializers.Enforcer, initializers.DialerMail, jwtSecret, initializers.EventBodyTemplate, initializers.BaseEventExternalURL)
+
+	// Define routes for Event Certificates
+	api.NewCertificateRouter(app, initializers.DB, initializers.Enforcer, initializers.DialerMail, initializers.S3, jwtSecret, initializers.EventBodyTemplate, initializers.BaseEventExternalURL, os.Getenv("CERTIFICATE_FONT_PATH"))
+
+	// Define routes for Event Co-hosts
+	api.NewCoHostRouter(app, initializers.DB, initializers.Enforcer, initializers.ESClient, initializers.DialerMail, jwtSecret, initializers.EventBodyTemplate, initializers.BaseEventExternalURL)
+
+	// Define routes for Event and Job Duplicates && Templates
+	api.NewOrgTemplateRouter(app, initializers.DB, initializers.Enforcer, initializers.S3, jwtSecret)
+
+	// Define routes for Event and Job Discussions
+	api.NewDiscussionRouter(app, initializers.DB, initializers.Enforcer, initializers.DialerMail, jwtSecret, initializers.EventBodyTemplate, initializers.BaseEventExternalURL)
+
+	// Define routes for Content Reports && Moderation
+	api.NewReportRouter(app, initializers.DB, initializers.Enforcer, initializers.ESClient, initializers.DialerMail, jwtSecret, initializers.EventBodyTemplate, initializers.BaseEventExternalURL, os.Getenv("REPORT_AUTO_HIDE_THRESHOLD"))
+
+	// Define routes for Event Calendars && Saved Events
+	api.NewCalendarRouter(app, initializers.DB, jwtSecret, initializers.BaseEventExternalURL, os.Getenv("BASE_INTERNAL_URL"))
+
 	// Define routes for Locations
 	api.NewLocationMapRouter(app, initializers.DB)
 	// Swagger
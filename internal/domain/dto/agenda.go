@@ -0,0 +1,127 @@
+package dto
+
+import (
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/models"
+)
+
+type AgendaItemRequest struct {
+	StartTime   string `json:"startTime" example:"09:00:00" validate:"omitempty,datetime=15:04:05"`
+	EndTime     string `json:"endTime" example:"10:30:00" validate:"omitempty,datetime=15:04:05"`
+	Title       string `json:"title" example:"Keynote: Building for impact" validate:"required,max=255"`
+	Description string `json:"description" example:"How startups in Chiang Mai measure their social impact"`
+	SessionID   *uint  `json:"sessionId" example:"1"` // The session the item belongs to, empty for the event as a whole
+	SpeakerIDs  []uint `json:"speakerIds" example:"1,2"`
+}
+
+type AgendaRequest struct {
+	// Items in the order they are shown, they replace the whole agenda
+	Items []AgendaItemRequest `json:"items" validate:"max=100,dive"`
+}
+
+type EventSpeakerShortResponse struct {
+	ID     uint   `json:"id" example:"1"`
+	Name   string `json:"name" example:"Anda Raiwin"`
+	PicUrl string `json:"picUrl" example:"https://example.com/speaker.jpg"`
+}
+
+type AgendaItemResponse struct {
+	ID          uint                        `json:"id" example:"1"`
+	StartTime   string                      `json:"startTime" example:"09:00:00"`
+	EndTime     string                      `json:"endTime" example:"10:30:00"`
+	Title       string                      `json:"title" example:"Keynote: Building for impact"`
+	Description string                      `json:"description" example:"How startups in Chiang Mai measure their social impact"`
+	SessionID   *uint                       `json:"sessionId" example:"1"`
+	Speakers    []EventSpeakerShortResponse `json:"speakers"`
+}
+
+func BuildAgendaItemResponse(item models.AgendaItem) AgendaItemResponse {
+	res := AgendaItemResponse{
+		ID:          item.ID,
+		Title:       item.Title,
+		Description: item.Description,
+		SessionID:   item.SessionID,
+		Speakers:    make([]EventSpeakerShortResponse, 0, len(item.Speakers)),
+	}
+	if !item.StartTime.IsZero() || !item.EndTime.IsZero() {
+		res.StartTime = item.StartTime.Format("15:04:05")
+		res.EndTime = item.EndTime.Format("15:04:05")
+	}
+	for _, speaker := range item.Speakers {
+		res.Speakers = append(res.Speakers, EventSpeakerShortResponse{
+			ID:     speaker.ID,
+			Name:   speaker.Name,
+			PicUrl: speaker.PicUrl,
+		})
+	}
+
+	return res
+}
+
+type EventSpeakerRequest struct {
+	UserID      string `json:"userId" example:"48a18dd9-48c3-45a5-b4f3-e8d7a60e2910" validate:"omitempty,uuid"` // Links the speaker to a user of the platform
+	Name        string `json:"name" example:"Anda Raiwin" validate:"required_without=UserID,max=255"`           // Defaults to the name of the user
+	JobTitle    string `json:"jobTitle" example:"Founder" validate:"max=255"`
+	Company     string `json:"company" example:"DAF Bridge" validate:"max=255"`
+	Bio         string `json:"bio" example:"Anda builds tools for social enterprises"`
+	ProfileLink string `json:"profileLink" example:"https://linkedin.com/in/anda" validate:"omitempty,url,max=255"`
+	Position    int    `json:"position" example:"0" validate:"gte=0"`
+}
+
+type EventSpeakerResponse struct {
+	ID          uint   `json:"id" example:"1"`
+	EventID     uint   `json:"eventId" example:"1"`
+	UserID      string `json:"userId,omitempty" example:"48a18dd9-48c3-45a5-b4f3-e8d7a60e2910"`
+	Name        string `json:"name" example:"Anda Raiwin"`
+	JobTitle    string `json:"jobTitle" example:"Founder"`
+	Company     string `json:"company" example:"DAF Bridge"`
+	Bio         string `json:"bio" example:"Anda builds tools for social enterprises"`
+	PicUrl      string `json:"picUrl" example:"https://example.com/speaker.jpg"` // The picture of the user when none was uploaded
+	ProfileLink string `json:"profileLink" example:"https://linkedin.com/in/anda"`
+	Position    int    `json:"position" example:"0"`
+}
+
+func BuildEventSpeakerResponse(speaker models.EventSpeaker) EventSpeakerResponse {
+	res := EventSpeakerResponse{
+		ID:          speaker.ID,
+		EventID:     speaker.EventID,
+		Name:        speaker.Name,
+		JobTitle:    speaker.JobTitle,
+		Company:     speaker.Company,
+		Bio:         speaker.Bio,
+		PicUrl:      speaker.PicUrl,
+		ProfileLink: speaker.ProfileLink,
+		Position:    speaker.Position,
+	}
+	if speaker.UserID != nil {
+		res.UserID = speaker.UserID.String()
+	}
+	if res.PicUrl == "" && speaker.User != nil {
+		res.PicUrl = speaker.User.PicUrl
+	}
+
+	return res
+}
+
+type EventMaterialResponse struct {
+	ID          uint   `json:"id" example:"1"`
+	EventID     uint   `json:"eventId" example:"1"`
+	Title       string `json:"title" example:"Workshop slides"`
+	FileName    string `json:"fileName" example:"slides.pdf"`
+	ContentType string `json:"contentType" example:"application/pdf"`
+	Size        int64  `json:"size" example:"204800"`
+	FileUrl     string `json:"fileUrl" example:"https://bucket.s3.ap-southeast-1.amazonaws.com/organizations/1/events/1/materials/slides.pdf"`
+	CreatedAt   string `json:"createdAt" example:"2025-01-05 09:00:00"`
+}
+
+func BuildEventMaterialResponse(material models.EventMaterial) EventMaterialResponse {
+	return EventMaterialResponse{
+		ID:          material.ID,
+		EventID:     material.EventID,
+		Title:       material.Title,
+		FileName:    material.FileName,
+		ContentType: material.ContentType,
+		Size:        material.Size,
+		FileUrl:     material.FileUrl,
+		CreatedAt:   material.CreatedAt.Format("2006-01-02 15:04:05"),
+	}
+}
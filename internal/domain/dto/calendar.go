@@ -0,0 +1,6 @@
+package dto
+
+type CalendarFeedResponse struct {
+	FeedURL   string `json:"feedUrl" example:"https://api.example.com/calendar/users/4f3c2a9e-8d1b-4b6f-9a7e-2c5d8e1f0a3b.ics"`
+	WebcalURL string `json:"webcalUrl" example:"webcal://api.example.com/calendar/users/4f3c2a9e-8d1b-4b6f-9a7e-2c5d8e1f0a3b.ics"` // Opens the subscription in calendar apps
+}
@@ -1,8 +1,9 @@
 package dto
 
 type CategoryResponses struct {
-	Value uint   `json:"value" example:"1"`
-	Label string `json:"label" example:"forum"`
+	Value        uint              `json:"value" example:"1"`
+	Label        string            `json:"label" example:"forum"`
+	Translations map[string]string `json:"translations,omitempty"` // Labels keyed by locale
 }
 
 type CategoryRequest struct {
@@ -0,0 +1,102 @@
+package dto
+
+import (
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/models"
+)
+
+type CertificateFieldRequest struct {
+	Text     string  `json:"text" example:"{name}" validate:"required,max=500"` // {name}, {event}, {date}, {organization} and {code} are filled in
+	X        float64 `json:"x" example:"50" validate:"min=0,max=100"`           // Percent of the page width
+	Y        float64 `json:"y" example:"48" validate:"min=0,max=100"`           // Percent of the page height from the top, baseline of the text
+	FontSize float64 `json:"fontSize" example:"36" validate:"required,min=6,max=120"`
+	Color    string  `json:"color" example:"#1d1d39" validate:"omitempty,hexcolor,len=7"`
+	Bold     bool    `json:"bold" example:"true"`
+	Align    string  `json:"align" example:"center" validate:"omitempty,oneof=left center right"` // Center when empty
+}
+
+type CertificateTemplateRequest struct {
+	SignatureX     float64 `json:"signatureX" example:"50" validate:"min=0,max=100"`               // Percent of the page width, center of the signature
+	SignatureY     float64 `json:"signatureY" example:"82" validate:"min=0,max=100"`               // Percent of the page height from the top, bottom of the signature
+	SignatureWidth float64 `json:"signatureWidth" example:"20" validate:"omitempty,min=1,max=100"` // Percent of the page width, 20 when empty
+	// Lines of text in the order they are drawn, a standard layout when empty
+	Fields []CertificateFieldRequest `json:"fields" validate:"max=20,dive"`
+}
+
+type CertificateFieldResponse struct {
+	ID       uint    `json:"id" example:"1"`
+	Text     string  `json:"text" example:"{name}"`
+	X        float64 `json:"x" example:"50"`
+	Y        float64 `json:"y" example:"48"`
+	FontSize float64 `json:"fontSize" example:"36"`
+	Color    string  `json:"color" example:"#1d1d39"`
+	Bold     bool    `json:"bold" example:"true"`
+	Align    string  `json:"align" example:"center"`
+}
+
+type CertificateTemplateResponse struct {
+	ID             uint                       `json:"id" example:"1"`
+	EventID        uint                       `json:"eventId" example:"1"`
+	BackgroundUrl  string                     `json:"backgroundUrl" example:"https://example.com/background.png"`
+	SignatureUrl   string                     `json:"signatureUrl" example:"https://example.com/signature.png"`
+	SignatureX     float64                    `json:"signatureX" example:"50"`
+	SignatureY     float64                    `json:"signatureY" example:"82"`
+	SignatureWidth float64                    `json:"signatureWidth" example:"20"`
+	Fields         []CertificateFieldResponse `json:"fields"`
+}
+
+type CertificateIssueResponse struct {
+	Pending int `json:"pending" example:"42"` // Participants checked in whose certificates are being generated and mailed
+}
+
+type CertificateResponse struct {
+	ID               uint   `json:"id" example:"1"`
+	EventID          uint   `json:"eventId" example:"1"`
+	EventName        string `json:"eventName,omitempty" example:"Builds CMU 2025"`
+	OrganizationName string `json:"organizationName,omitempty" example:"DAF Bridge"`
+	UserID           string `json:"userId" example:"48a18dd9-48c3-45a5-b4f3-e8d7a60e2910"`
+	RecipientName    string `json:"recipientName" example:"Anda Raiwin"`
+	Code             string `json:"code" example:"K7QX-2M9P-VH4D"`
+	VerifyUrl        string `json:"verifyUrl" example:"https://talent-atmos.com/certificates/K7QX-2M9P-VH4D"`
+	FileUrl          string `json:"fileUrl" example:"https://example.com/certificate.pdf"` // Empty while the PDF is generated
+	IssuedAt         string `json:"issuedAt" example:"2025-01-26T03:00:00Z"`
+	EmailedAt        string `json:"emailedAt,omitempty" example:"2025-01-26T03:00:04Z"`
+}
+
+type CertificateVerificationResponse struct {
+	Code             string `json:"code" example:"K7QX-2M9P-VH4D"`
+	RecipientName    string `json:"recipientName" example:"Anda Raiwin"`
+	EventID          uint   `json:"eventId" example:"1"`
+	EventName        string `json:"eventName" example:"Builds CMU 2025"`
+	EventDate        string `json:"eventDate" example:"25 January 2025"`
+	OrganizationName string `json:"organizationName" example:"DAF Bridge"`
+	FileUrl          string `json:"fileUrl" example:"https://example.com/certificate.pdf"`
+	IssuedAt         string `json:"issuedAt" example:"2025-01-26T03:00:00Z"`
+}
+
+func BuildCertificateTemplateResponse(template models.CertificateTemplate) CertificateTemplateResponse {
+	res := CertificateTemplateResponse{
+		ID:             template.ID,
+		EventID:        template.EventID,
+		BackgroundUrl:  template.BackgroundUrl,
+		SignatureUrl:   template.SignatureUrl,
+		SignatureX:     template.SignatureX,
+		SignatureY:     template.SignatureY,
+		SignatureWidth: template.SignatureWidth,
+		Fields:         make([]CertificateFieldResponse, 0, len(template.Fields)),
+	}
+
+	for _, field := range template.Fields {
+		res.Fields = append(res.Fields, CertificateFieldResponse{
+			ID:       field.ID,
+			Text:     field.Text,
+			X:        field.X,
+			Y:        field.Y,
+			FontSize: field.FontSize,
+			Color:    field.Color,
+			Bold:     field.Bold,
+			Align:    field.Align,
+		})
+	}
+
+	return res
+}
@@ -0,0 +1,88 @@
+package dto
+
+import (
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/models"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/utils"
+)
+
+type CoHostInviteRequest struct {
+	OrganizationID uint `json:"organizationId" example:"2" validate:"required"`
+}
+
+type EventHostResponse struct {
+	ID     uint   `json:"id" example:"2"`
+	Name   string `json:"name" example:"CMU Makerspace"`
+	PicUrl string `json:"picUrl" example:"https://example.com/logo.png"`
+}
+
+type EventCoHostResponse struct {
+	ID           uint              `json:"id" example:"1"`
+	EventID      uint              `json:"eventId" example:"1"`
+	Organization EventHostResponse `json:"organization"`
+	Status       string            `json:"status" example:"pending"` // pending or accepted
+	InvitedAt    string            `json:"invitedAt" example:"2025-01-20T03:00:00Z"`
+	RespondedAt  string            `json:"respondedAt,omitempty" example:"2025-01-21T03:00:00Z"`
+}
+
+// CoHostInvitationResponse is an invitation received by an organization to co-host the event of another.
+type CoHostInvitationResponse struct {
+	ID             uint              `json:"id" example:"1"`
+	EventID        uint              `json:"eventId" example:"1"`
+	EventName      string            `json:"eventName" example:"Builds CMU 2025"`
+	EventStartDate string            `json:"eventStartDate" example:"2025-01-25"`
+	Organizer      EventHostResponse `json:"organizer"`
+	InvitedAt      string            `json:"invitedAt" example:"2025-01-20T03:00:00Z"`
+}
+
+func BuildEventHostResponse(org models.Organization) EventHostResponse {
+	return EventHostResponse{
+		ID:     org.ID,
+		Name:   org.Name,
+		PicUrl: org.PicUrl,
+	}
+}
+
+// BuildEventHostResponses lists the co-hosts of an event loaded with their organization.
+func BuildEventHostResponses(coHosts []models.EventCoHost) []EventHostResponse {
+	res := make([]EventHostResponse, 0, len(coHosts))
+	for _, coHost := range coHosts {
+		if coHost.Status != models.CoHostAccepted {
+			continue
+		}
+		res = append(res, BuildEventHostResponse(coHost.Organization))
+	}
+
+	return res
+}
+
+func BuildEventCoHostResponse(coHost models.EventCoHost) EventCoHostResponse {
+	return EventCoHostResponse{
+		ID:           coHost.ID,
+		EventID:      coHost.EventID,
+		Organization: BuildEventHostResponse(coHost.Organization),
+		Status:       string(coHost.Status),
+		InvitedAt:    utils.FormatUTC(&coHost.CreatedAt),
+		RespondedAt:  utils.FormatUTC(coHost.RespondedAt),
+	}
+}
+
+func BuildCoHostInvitationResponse(coHost models.EventCoHost) CoHostInvitationResponse {
+	return CoHostInvitationResponse{
+		ID:             coHost.ID,
+		EventID:        coHost.EventID,
+		EventName:      coHost.Event.Name,
+		EventStartDate: coHost.Event.StartDate.Format("2006-01-02"),
+		Organizer:      BuildEventHostResponse(coHost.Event.Organization),
+		InvitedAt:      utils.FormatUTC(&coHost.CreatedAt),
+	}
+}
+
+// BuildOrganizationShortDocuments lists the co-hosts of an event in its search document.
+func BuildOrganizationShortDocuments(coHosts []models.EventCoHost) []OrganizationShortDocument {
+	docs := make([]OrganizationShortDocument, 0, len(coHosts))
+	for _, host := range BuildEventHostResponses(coHosts) {
+		docs = append(docs, OrganizationShortDocument{ID: host.ID, Name: host.Name, PicUrl: host.PicUrl})
+	}
+
+	return docs
+}
@@ -0,0 +1,103 @@
+package dto
+
+import (
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/models"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/utils"
+	"github.com/google/uuid"
+)
+
+type DiscussionPostRequest struct {
+	Body string `json:"body" example:"Is parking available at the venue?" validate:"required,max=2000"`
+}
+
+// DiscussionQuestionModerationRequest changes the fields given, the others are left as they are.
+type DiscussionQuestionModerationRequest struct {
+	Hidden *bool `json:"hidden" example:"false"`
+	Locked *bool `json:"locked" example:"true"`
+}
+
+// DiscussionAnswerModerationRequest changes the fields given, the others are left as they are.
+type DiscussionAnswerModerationRequest struct {
+	Hidden *bool `json:"hidden" example:"false"`
+	Pinned *bool `json:"pinned" example:"true"` // Pinned answers are listed in the FAQ
+}
+
+type DiscussionAuthorResponse struct {
+	ID     uuid.UUID `json:"id" example:"e2b3c4d5-6f70-4a1b-9c2d-3e4f5a6b7c8d"`
+	Name   string    `json:"name" example:"Somchai"`
+	PicUrl string    `json:"picUrl" example:"https://example.com/avatar.png"`
+}
+
+type DiscussionAnswerResponse struct {
+	ID         uint                     `json:"id" example:"1"`
+	QuestionID uint                     `json:"questionId" example:"1"`
+	Author     DiscussionAuthorResponse `json:"author"`
+	Body       string                   `json:"body" example:"Yes, the parking of the faculty is open to attendees"`
+	Official   bool                     `json:"official" example:"true"` // Answered by the organization
+	Pinned     bool                     `json:"pinned" example:"true"`
+	Hidden     bool                     `json:"hidden" example:"false"`
+	CreatedAt  string                   `json:"createdAt" example:"2025-01-20T03:00:00Z"`
+}
+
+type DiscussionQuestionResponse struct {
+	ID        uint                       `json:"id" example:"1"`
+	Author    DiscussionAuthorResponse   `json:"author"`
+	Body      string                     `json:"body" example:"Is parking available at the venue?"`
+	Hidden    bool                       `json:"hidden" example:"false"`
+	Locked    bool                       `json:"locked" example:"false"`
+	Answers   []DiscussionAnswerResponse `json:"answers"`
+	CreatedAt string                     `json:"createdAt" example:"2025-01-20T03:00:00Z"`
+}
+
+// DiscussionFAQResponse is a pinned answer with the question it answers.
+type DiscussionFAQResponse struct {
+	QuestionID uint                     `json:"questionId" example:"1"`
+	Question   string                   `json:"question" example:"Is parking available at the venue?"`
+	Answer     DiscussionAnswerResponse `json:"answer"`
+}
+
+func BuildDiscussionAuthorResponse(user models.User) DiscussionAuthorResponse {
+	return DiscussionAuthorResponse{
+		ID:     user.ID,
+		Name:   user.Name,
+		PicUrl: user.PicUrl,
+	}
+}
+
+func BuildDiscussionAnswerResponse(answer models.DiscussionAnswer) DiscussionAnswerResponse {
+	return DiscussionAnswerResponse{
+		ID:         answer.ID,
+		QuestionID: answer.QuestionID,
+		Author:     BuildDiscussionAuthorResponse(answer.User),
+		Body:       answer.Body,
+		Official:   answer.Official,
+		Pinned:     answer.PinnedAt != nil,
+		Hidden:     answer.Hidden,
+		CreatedAt:  utils.FormatUTC(&answer.CreatedAt),
+	}
+}
+
+func BuildDiscussionQuestionResponse(question models.DiscussionQuestion) DiscussionQuestionResponse {
+	answers := make([]DiscussionAnswerResponse, 0, len(question.Answers))
+	for _, answer := range question.Answers {
+		answers = append(answers, BuildDiscussionAnswerResponse(answer))
+	}
+
+	return DiscussionQuestionResponse{
+		ID:        question.ID,
+		Author:    BuildDiscussionAuthorResponse(question.User),
+		Body:      question.Body,
+		Hidden:    question.Hidden,
+		Locked:    question.Locked,
+		Answers:   answers,
+		CreatedAt: utils.FormatUTC(&question.CreatedAt),
+	}
+}
+
+func BuildDiscussionFAQResponse(answer models.DiscussionAnswer) DiscussionFAQResponse {
+	return DiscussionFAQResponse{
+		QuestionID: answer.QuestionID,
+		Question:   answer.Question.Body,
+		Answer:     BuildDiscussionAnswerResponse(answer),
+	}
+}
@@ -6,9 +6,11 @@ type SearchQuery struct {
 	Categories string `json:"categories" form:"categories"`   // The category filter
 	Q          string `json:"q" form:"q" validate:"required"` // The search keyword
 	DateRange  string `json:"dateRange" form:"dateRange"`     // The date range (e.g., 'thisWeek', 'today', 'tomorrow', `thisMonth`, `nextMonth`)
+	Timezone   string `json:"tz" form:"tz"`                   // IANA zone of the caller for the date range, the X-Timezone header is used when empty
 	Location   string `json:"location" form:"location"`       // Location filter (e.g., 'online')
 	Audience   string `json:"audience" form:"audience"`       // Audience type (e.g., 'general')
 	Price      string `json:"price" form:"price"`             // Price type (e.g., 'free')
+	OrgID      uint   `json:"orgId" form:"orgId"`             // Events hosted or co-hosted by the organization
 }
 
 type SearchJobQuery struct {
@@ -22,30 +24,56 @@ type SearchJobQuery struct {
 	CareerStage      string  `json:"careerStage" form:"careerStage"`           // Career stage (e.g., 'entry-level')
 	SalaryLowerBound float64 `json:"salaryLowerBound" form:"salaryLowerBound"` // Salary range (e.g., '1000-2000')
 	SalaryUpperBound float64 `json:"salaryUpperBound" form:"salaryUpperBound"` // Salary upper bound
+	SalaryCurrency   string  `json:"salaryCurrency" form:"salaryCurrency"`     // Currency of the salary bounds (default 'THB')
+	SalaryPeriod     string  `json:"salaryPeriod" form:"salaryPeriod"`         // Period of the salary bounds (e.g., 'hourly', default 'monthly')
 }
 
 // Document for Elasticsearch/Opensearch
 
 type EventDocument struct {
-	ID           uint                      `json:"id"`
-	Name         string                    `json:"name"`
-	PicUrl       string                    `json:"picUrl"`
-	Content      string                    `json:"content"`
-	Latitude     float64                   `json:"latitude"`
-	Longitude    float64                   `json:"longitude"`
-	StartDate    string                    `json:"startDate"`
-	StartTime    string                    `json:"startTime"`
-	EndTime      string                    `json:"endTime"`
-	EndDate      string                    `json:"endDate"`
-	LocationName string                    `json:"locationName"`
-	Province     string                    `json:"province"`
-	Country      string                    `json:"country"`
-	LocationType string                    `json:"locationType"`
-	Organization OrganizationShortDocument `json:"organization"`
-	Categories   []CategoryRequest         `json:"categories"`
-	Audience     string                    `json:"audience"`
-	Price        string                    `json:"price"`
-	UpdateAt     string                    `json:"updatedAt"`
+	ID              uint                                `json:"id"`
+	Name            string                              `json:"name"`
+	PicUrl          string                              `json:"picUrl"`
+	Content         string                              `json:"content"`
+	Latitude        float64                             `json:"latitude"`
+	Longitude       float64                             `json:"longitude"`
+	StartDate       string                              `json:"startDate"`
+	StartTime       string                              `json:"startTime"`
+	EndTime         string                              `json:"endTime"`
+	EndDate         string                              `json:"endDate"`
+	Timezone        string                              `json:"timezone"`
+	StartAt         string                              `json:"startAt"` // UTC instant, used by the date range filters
+	EndAt           string                              `json:"endAt"`   // UTC instant
+	LocationName    string                              `json:"locationName"`
+	Province        string                              `json:"province"`
+	Country         string                              `json:"country"`
+	LocationType    string                              `json:"locationType"`
+	Organization    OrganizationShortDocument           `json:"organization"`
+	CoHosts         []OrganizationShortDocument         `json:"coHosts"`
+	OrganizationIDs []uint                              `json:"organizationIds"` // The organizer and the co-hosts, the event is listed under each of them
+	Categories      []CategoryResponses                 `json:"categories"`
+	Audience        string                              `json:"audience"`
+	Price           string                              `json:"price"`
+	Sessions        []EventSessionDocument              `json:"sessions"`               // Sessions that are not cancelled, any of them matches the date range filters
+	Translations    map[string]EventTranslationDocument `json:"translations,omitempty"` // Keyed by locale, each analyzed in its language
+	UpdateAt        string                              `json:"updatedAt"`
+}
+
+// The texts of a translation are indexed as plain text, like the authored ones
+
+type EventTranslationDocument struct {
+	Name    string `json:"name"`
+	Content string `json:"content"`
+}
+
+type JobTranslationDocument struct {
+	Title       string `json:"title"`
+	Description string `json:"description"`
+}
+
+type OrganizationTranslationDocument struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
 }
 
 type OrganizationShortDocument struct {
@@ -60,34 +88,45 @@ type PrerequisiteDocument struct {
 }
 
 type JobDocument struct {
-	ID            uint                      `json:"id"`
-	Title         string                    `json:"title"`
-	Prerequisites []PrerequisiteRequest     `json:"prerequisite"`
-	Description   string                    `json:"description"`
-	Location      string                    `json:"location"`
-	Workplace     string                    `json:"workplace"`
-	WorkType      string                    `json:"workType"`
-	CareerStage   string                    `json:"careerStage"`
-	Salary        float64                   `json:"salary"`
-	Categories    []CategoryRequest         `json:"categories"`
-	Organization  OrganizationShortDocument `json:"organization"`
-	Province      string                    `json:"province"`
-	Country       string                    `json:"country"`
-	UpdateAt      string                    `json:"updatedAt"`
+	ID                  uint                              `json:"id"`
+	Title               string                            `json:"title"`
+	Prerequisites       []PrerequisiteRequest             `json:"prerequisite"`
+	Description         string                            `json:"description"`
+	Location            string                            `json:"location"`
+	Workplace           string                            `json:"workplace"`
+	WorkType            string                            `json:"workType"`
+	CareerStage         string                            `json:"careerStage"`
+	SalaryMin           float64                           `json:"salaryMin,omitempty"`
+	SalaryMax           float64                           `json:"salaryMax,omitempty"`
+	SalaryCurrency      string                            `json:"salaryCurrency"`
+	SalaryPeriod        string                            `json:"salaryPeriod"`
+	SalaryNegotiable    bool                              `json:"salaryNegotiable"`
+	SalaryHidden        bool                              `json:"salaryHidden"`
+	SalaryMinMonthlyTHB *float64                          `json:"salaryMinMonthlyThb,omitempty"` // Normalized for range filtering
+	SalaryMaxMonthlyTHB *float64                          `json:"salaryMaxMonthlyThb,omitempty"`
+	Categories          []CategoryResponses               `json:"categories"`
+	Skills              []SkillShortResponse              `json:"skills"`
+	SkillMatch          *SkillMatchResponse               `json:"skillMatch,omitempty"` // Computed per request, not indexed
+	Organization        OrganizationShortDocument         `json:"organization"`
+	Province            string                            `json:"province"`
+	Country             string                            `json:"country"`
+	Translations        map[string]JobTranslationDocument `json:"translations,omitempty"`
+	UpdateAt            string                            `json:"updatedAt"`
 }
 
 type OrganizationDocument struct {
-	ID          uint    `json:"id"`
-	Name        string  `json:"name"`
-	PicUrl      string  `json:"picUrl"`
-	Description string  `json:"description"`
-	Latitude    float64 `json:"latitude"`
-	Longitude   float64 `json:"longitude"`
-	Province    string  `json:"province"`
-	Country     string  `json:"country"`
-	Email       string  `json:"email"`
-	Phone       string  `json:"phone"`
-	UpdateAt    string  `json:"updatedAt"`
+	ID           uint                                       `json:"id"`
+	Name         string                                     `json:"name"`
+	PicUrl       string                                     `json:"picUrl"`
+	Description  string                                     `json:"description"`
+	Latitude     float64                                    `json:"latitude"`
+	Longitude    float64                                    `json:"longitude"`
+	Province     string                                     `json:"province"`
+	Country      string                                     `json:"country"`
+	Email        string                                     `json:"email"`
+	Phone        string                                     `json:"phone"`
+	Translations map[string]OrganizationTranslationDocument `json:"translations,omitempty"`
+	UpdateAt     string                                     `json:"updatedAt"`
 }
 
 type SearchEventResponse struct {
@@ -106,39 +145,79 @@ type SearchOrganizationResponse struct {
 }
 
 type EventDocumentDTOResponse struct {
-	ID           uint                      `json:"id"`
-	Name         string                    `json:"name"`
-	PicUrl       string                    `json:"picUrl"`
-	Latitude     float64                   `json:"latitude"`
-	Longitude    float64                   `json:"longitude"`
-	StartDate    string                    `json:"startDate"`
-	StartTime    string                    `json:"startTime"`
-	EndTime      string                    `json:"endTime"`
-	EndDate      string                    `json:"endDate"`
-	LocationName string                    `json:"locationName"`
-	Province     string                    `json:"province"`
-	Country      string                    `json:"country"`
-	LocationType string                    `json:"locationType"`
-	Organization OrganizationShortDocument `json:"organization"`
-	Categories   []CategoryResponses       `json:"categories"`
-	Audience     string                    `json:"audience"`
-	Price        string                    `json:"price"`
-	UpdateAt     string                    `json:"updatedAt"`
+	ID           uint                                `json:"id"`
+	Name         string                              `json:"name"`
+	PicUrl       string                              `json:"picUrl"`
+	Latitude     float64                             `json:"latitude"`
+	Longitude    float64                             `json:"longitude"`
+	StartDate    string                              `json:"startDate"`
+	StartTime    string                              `json:"startTime"`
+	EndTime      string                              `json:"endTime"`
+	EndDate      string                              `json:"endDate"`
+	Timezone     string                              `json:"timezone"`
+	StartAt      string                              `json:"startAt"` // UTC instant, used by the date range filters
+	EndAt        string                              `json:"endAt"`   // UTC instant
+	LocationName string                              `json:"locationName"`
+	Province     string                              `json:"province"`
+	Country      string                              `json:"country"`
+	LocationType string                              `json:"locationType"`
+	Organization OrganizationShortDocument           `json:"organization"`
+	CoHosts      []OrganizationShortDocument         `json:"coHosts"`
+	Categories   []CategoryResponses                 `json:"categories"`
+	Audience     string                              `json:"audience"`
+	Price        string                              `json:"price"`
+	Sessions     []EventSessionDocument              `json:"sessions,omitempty"`
+	Translations map[string]EventTranslationDocument `json:"translations,omitempty"`
+	UpdateAt     string                              `json:"updatedAt"`
 }
 
 type JobDocumentDTOResponse struct {
-	ID            uint                      `json:"id"`
-	Title         string                    `json:"title"`
-	Prerequisites []PrerequisiteRequest     `json:"prerequisite"`
-	Description   string                    `json:"description"`
-	Location      string                    `json:"location"`
-	Workplace     string                    `json:"workplace"`
-	WorkType      string                    `json:"workType"`
-	CareerStage   string                    `json:"careerStage"`
-	Salary        float64                   `json:"salary"`
-	Categories    []CategoryResponses       `json:"categories"`
-	Organization  OrganizationShortDocument `json:"organization"`
-	Province      string                    `json:"province"`
-	Country       string                    `json:"country"`
-	UpdateAt      string                    `json:"updatedAt"`
+	ID               uint                              `json:"id"`
+	Title            string                            `json:"title"`
+	Prerequisites    []PrerequisiteRequest             `json:"prerequisite"`
+	Description      string                            `json:"description"`
+	Location         string                            `json:"location"`
+	Workplace        string                            `json:"workplace"`
+	WorkType         string                            `json:"workType"`
+	CareerStage      string                            `json:"careerStage"`
+	SalaryMin        float64                           `json:"salaryMin,omitempty"`
+	SalaryMax        float64                           `json:"salaryMax,omitempty"`
+	SalaryCurrency   string                            `json:"salaryCurrency"`
+	SalaryPeriod     string                            `json:"salaryPeriod"`
+	SalaryNegotiable bool                              `json:"salaryNegotiable"`
+	SalaryHidden     bool                              `json:"salaryHidden"`
+	Categories       []CategoryResponses               `json:"categories"`
+	Organization     OrganizationShortDocument         `json:"organization"`
+	Province         string                            `json:"province"`
+	Country          string                            `json:"country"`
+	Translations     map[string]JobTranslationDocument `json:"translations,omitempty"`
+	UpdateAt         string                            `json:"updatedAt"`
+}
+
+type SearchTalentQuery struct {
+	Page       int    `json:"page" form:"page"`             // The page number
+	Offset     int    `json:"offset" form:"offset"`         // The number of items per page
+	Q          string `json:"q" form:"q"`                   // The search keyword
+	Skills     string `json:"skills" form:"skills"`         // Comma separated skill slugs, every skill is required (e.g., 'go,docker')
+	Province   string `json:"province" form:"province"`     // Province filter (e.g., 'Chiang Mai')
+	FocusField string `json:"focusField" form:"focusField"` // Field of expertise filter
+}
+
+// TalentDocument only holds what a public profile shows whatever its visibility settings
+type TalentDocument struct {
+	ID         uint                 `json:"id"`
+	Slug       string               `json:"slug"`
+	FirstName  string               `json:"firstName"`
+	LastName   string               `json:"lastName"`
+	PicUrl     string               `json:"picUrl"`
+	HeadLine   string               `json:"headline"`
+	FocusField string               `json:"focusField"`
+	Province   string               `json:"province"`
+	Skills     []SkillShortResponse `json:"skills"`
+	UpdateAt   string               `json:"updatedAt"`
+}
+
+type SearchTalentResponse struct {
+	TotalTalent int              `json:"total_talents"`
+	Talents     []TalentDocument `json:"talents"`
 }
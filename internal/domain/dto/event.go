@@ -34,7 +34,9 @@ type NewEventRequest struct {
 	EndDate         string                           `json:"endDate" example:"2025-01-22"`
 	StartTime       string                           `json:"startTime" example:"08:00:00" validate:"required"`
 	EndTime         string                           `json:"endTime" example:"17:00:00" validate:"required"`
+	Timezone        string                           `json:"timezone" example:"Asia/Bangkok"` // IANA zone of the dates and times, Asia/Bangkok when empty
 	Content         string                           `json:"content" example:"{\"html\": \"<h1>Hello</h1>\"}" validate:"required"`
+	ContentFormat   string                           `json:"contentFormat" example:"html" validate:"omitempty,oneof=html markdown"` // html when empty
 	Latitude        float64                          `json:"latitude" example:"13.7563"`
 	Longitude       float64                          `json:"longitude" example:"100.5018"`
 	LocationName    string                           `json:"locationName" example:"Bangkok" validate:"required"`
@@ -47,6 +49,7 @@ type NewEventRequest struct {
 	Status          string                           `json:"status" example:"draft" validate:"required"`
 	Categories      []CategoryRequest                `json:"categories" validate:"required"`
 	ContactChannels []NewEventContactChannelsRequest `json:"contactChannels" validate:"required"`
+	Translations    []EventTranslationRequest        `json:"translations" validate:"omitempty,unique=Locale,dive"`
 }
 
 // "startDate": "2024-11-16T00:00:00.000Z",
@@ -55,29 +58,41 @@ type NewEventRequest struct {
 // "endTime": "0001-01-01T16:30:00.000Z",
 
 type EventResponses struct {
-	ID              int                             `json:"id" example:"1"`
-	OrganizationID  int                             `json:"organization_id" example:"1"`
-	Name            string                          `json:"name" example:"builds IDEA 2024"`
-	PicUrl          string                          `json:"picUrl" example:"https://example.com/image.jpg"`
-	StartDate       string                          `json:"startDate" example:"2024-11-29"`
-	EndDate         string                          `json:"endDate" example:"2024-11-29"`
-	StartTime       string                          `json:"startTime" example:"08:00:00"`
-	EndTime         string                          `json:"endTime" example:"17:00:00"`
-	Content         string                          `json:"content"`
-	Latitude        float64                         `json:"latitude" example:"13.7563"`
-	Longitude       float64                         `json:"longitude" example:"100.5018"`
-	LocationName    string                          `json:"locationName" example:"builds CMU"`
-	Province        string                          `json:"province" example:"Chiang Mai"`
-	Country         string                          `json:"country" example:"Thailand"`
-	LocationType    string                          `json:"locationType" example:"onsite"`
-	Audience        string                          `json:"audience" example:"general"`
-	PriceType       string                          `json:"priceType" example:"free"`
-	RegisterLink    string                          `json:"registerLink" example:"https://example.com/register"`
-	Status          string                          `json:"status" example:"published"`
-	Organization    OrganizationResponse            `json:"organization"`
-	Categories      []CategoryResponses             `json:"categories" example:"[{\"id\": 1, \"name\": \"all\"}]"`
-	ContactChannels []EventContactChannelsResponses `json:"contactChannels" example:"[{\"media\": \"facebook\", \"mediaLink\": \"https://facebook.com\"}]"`
-	UpdateAt        string                          `json:"updatedAt" example:"2025-01-24T13:22:10.532645Z"`
+	ID              int                                 `json:"id" example:"1"`
+	OrganizationID  int                                 `json:"organization_id" example:"1"`
+	Name            string                              `json:"name" example:"builds IDEA 2024"`
+	PicUrl          string                              `json:"picUrl" example:"https://example.com/image.jpg"`
+	StartDate       string                              `json:"startDate" example:"2024-11-29"`
+	EndDate         string                              `json:"endDate" example:"2024-11-29"`
+	StartTime       string                              `json:"startTime" example:"08:00:00"`
+	EndTime         string                              `json:"endTime" example:"17:00:00"`
+	Timezone        string                              `json:"timezone" example:"Asia/Bangkok"`        // Zone of the local dates and times above
+	StartAt         string                              `json:"startAt" example:"2024-11-29T01:00:00Z"` // UTC instant
+	EndAt           string                              `json:"endAt" example:"2024-11-29T10:00:00Z"`   // UTC instant
+	Content         string                              `json:"content"`                                // Source as authored
+	ContentFormat   string                              `json:"contentFormat" example:"markdown"`
+	ContentHTML     string                              `json:"contentHtml" example:"<h1>Hello</h1>"` // Sanitized HTML to show
+	Latitude        float64                             `json:"latitude" example:"13.7563"`
+	Longitude       float64                             `json:"longitude" example:"100.5018"`
+	LocationName    string                              `json:"locationName" example:"builds CMU"`
+	Province        string                              `json:"province" example:"Chiang Mai"`
+	Country         string                              `json:"country" example:"Thailand"`
+	LocationType    string                              `json:"locationType" example:"onsite"`
+	Audience        string                              `json:"audience" example:"general"`
+	PriceType       string                              `json:"priceType" example:"free"`
+	RegisterLink    string                              `json:"registerLink" example:"https://example.com/register"`
+	Status          string                              `json:"status" example:"published"`
+	Organization    OrganizationResponse                `json:"organization"`
+	CoHosts         []EventHostResponse                 `json:"coHosts"` // Partner organizations hosting the event with its organizer
+	Categories      []CategoryResponses                 `json:"categories" example:"[{\"id\": 1, \"name\": \"all\"}]"`
+	ContactChannels []EventContactChannelsResponses     `json:"contactChannels" example:"[{\"media\": \"facebook\", \"mediaLink\": \"https://facebook.com\"}]"`
+	RecurrenceRule  string                              `json:"recurrenceRule" example:"FREQ=WEEKLY;BYDAY=TU,TH;COUNT=8"`
+	Sessions        []EventSessionResponse              `json:"sessions"`
+	Agenda          []AgendaItemResponse                `json:"agenda"`
+	Speakers        []EventSpeakerResponse              `json:"speakers"`
+	Materials       []EventMaterialResponse             `json:"materials"`
+	Translations    map[string]EventTranslationResponse `json:"translations"`
+	UpdateAt        string                              `json:"updatedAt" example:"2025-01-24T13:22:10.532645Z"`
 }
 
 type EventCardResponses struct {
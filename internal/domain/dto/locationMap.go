@@ -1,6 +1,8 @@
 package dto
 
 import (
+	"time"
+
 	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/models"
 )
 
@@ -67,6 +69,7 @@ type EventMapResponses struct {
 	Category     []CategoryResponses             `json:"categories"`
 	Price        string                          `json:"price"`
 	Organization OrganizationInEventMapResponses `json:"organization"`
+	Sessions     []EventSessionMapResponses      `json:"sessions"` // Upcoming sessions, each with its own pin
 }
 
 func BuildEventMapResponses(event models.Event) EventMapResponses {
@@ -79,6 +82,17 @@ func BuildEventMapResponses(event models.Event) EventMapResponses {
 	}
 
 	organization := BuildOrganizationInEventMapResponses(event.Organization)
+	sessions := make([]EventSessionMapResponses, 0)
+	for _, session := range event.Sessions {
+		if session.IsCancelled || session.LocationType == string(models.Online) {
+			continue
+		}
+		if session.EndAt != nil && session.EndAt.Before(time.Now()) {
+			continue
+		}
+		sessions = append(sessions, BuildEventSessionMapResponses(session))
+	}
+
 	return EventMapResponses{
 		ID:           int(event.ID),
 		Name:         event.Name,
@@ -93,6 +107,7 @@ func BuildEventMapResponses(event models.Event) EventMapResponses {
 		Category:     categories,
 		Price:        event.PriceType,
 		Organization: organization,
+		Sessions:     sessions,
 	}
 }
 
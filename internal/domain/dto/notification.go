@@ -0,0 +1,13 @@
+package dto
+
+type NotificationPreferenceRequest struct {
+	EventReminders     *bool `json:"eventReminders" example:"true" validate:"required"`     // Mails 24 hours and 1 hour before registered events
+	EventAnnouncements *bool `json:"eventAnnouncements" example:"true" validate:"required"` // Mails from the organizers of registered events
+	DiscussionReplies  *bool `json:"discussionReplies" example:"true"`                      // Mails on replies to the questions asked, unchanged when omitted
+}
+
+type NotificationPreferenceResponse struct {
+	EventReminders     bool `json:"eventReminders" example:"true"`
+	EventAnnouncements bool `json:"eventAnnouncements" example:"true"`
+	DiscussionReplies  bool `json:"discussionReplies" example:"true"`
+}
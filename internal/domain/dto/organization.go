@@ -2,6 +2,7 @@ package dto
 
 import (
 	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/models"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/pkg/richtext"
 )
 
 func BuildListDTO[T, F interface{}](t []T, fn func(T) F) []F {
@@ -13,13 +14,14 @@ func BuildListDTO[T, F interface{}](t []T, fn func(T) F) []F {
 }
 
 type JobShortResponseDTO struct {
-	ID        int    `json:"id" example:"1"`
-	Title     string `json:"title" example:"Software Engineer"`
-	WorkPlace string `json:"workplace" example:"remote"`
-	WorkType  string `json:"workType" example:"fulltime"`
-	Quantity  int    `json:"quantity" example:"1"`
-	Salary    string `json:"salary" example:"30000"`
-	Status    string `json:"status" example:"published"`
+	ID        int     `json:"id" example:"1"`
+	Title     string  `json:"title" example:"Software Engineer"`
+	WorkPlace string  `json:"workplace" example:"remote"`
+	WorkType  string  `json:"workType" example:"fulltime"`
+	Quantity  int     `json:"quantity" example:"1"`
+	SalaryMin float64 `json:"salaryMin" example:"25000"`
+	SalaryMax float64 `json:"salaryMax" example:"35000"`
+	Status    string  `json:"status" example:"published"`
 }
 
 type OrganizationShortResponse struct {
@@ -113,45 +115,64 @@ type PrerequisiteResponses struct {
 }
 
 type JobRequest struct {
-	JobTitle       string                `json:"title" example:"Software Engineer" validate:"required,min=3,max=255"`
-	Scope          string                `json:"scope" example:"This is a scope" validate:"required"`
-	Prerequisite   []PrerequisiteRequest `json:"prerequisite"`
-	Workplace      models.Workplace      `json:"workplace" example:"remote" validate:"required"`
-	WorkType       models.WorkType       `json:"workType" example:"fulltime" validate:"required"`
-	CareerStage    models.CareerStage    `json:"careerStage" example:"entrylevel" validate:"required"`
-	Period         string                `json:"period" example:"1 year"`
-	Description    string                `json:"description" example:"This is a description" validate:"required"`
-	Qualifications string                `json:"qualifications" example:"Bachelor's degree in Computer Science" validate:"required"`
-	Quantity       int                   `json:"quantity" example:"1" validate:"required"`
-	Salary         float64               `json:"salary" example:"30000"`
-	Province       string                `json:"province" example:"Chiang Mai"`
-	Country        string                `json:"country" example:"TH"`
-	RegisterLink   string                `json:"registerLink" example:"https://example.com/register" validate:"required"`
-	Status         string                `json:"status" example:"draft" validate:"required"`
-	Categories     []CategoryRequest     `json:"categories" validate:"required"`
+	JobTitle         string                  `json:"title" example:"Software Engineer" validate:"required,min=3,max=255"`
+	Scope            string                  `json:"scope" example:"This is a scope" validate:"required"`
+	Prerequisite     []PrerequisiteRequest   `json:"prerequisite"`
+	Workplace        models.Workplace        `json:"workplace" example:"remote" validate:"required"`
+	WorkType         models.WorkType         `json:"workType" example:"fulltime" validate:"required"`
+	CareerStage      models.CareerStage      `json:"careerStage" example:"entrylevel" validate:"required"`
+	Period           string                  `json:"period" example:"1 year"`
+	Description      string                  `json:"description" example:"This is a description" validate:"required"`
+	Qualifications   string                  `json:"qualifications" example:"Bachelor's degree in Computer Science" validate:"required"`
+	TextFormat       string                  `json:"textFormat" example:"markdown" validate:"omitempty,oneof=html markdown"` // Format of the description and qualifications, html when empty
+	Quantity         int                     `json:"quantity" example:"1" validate:"required"`
+	SalaryMin        float64                 `json:"salaryMin" example:"25000" validate:"gte=0"`
+	SalaryMax        float64                 `json:"salaryMax" example:"35000" validate:"omitempty,gtefield=SalaryMin"`
+	SalaryCurrency   string                  `json:"salaryCurrency" example:"THB" validate:"omitempty,salary_currency"`
+	SalaryPeriod     models.SalaryPeriod     `json:"salaryPeriod" example:"monthly" validate:"omitempty,oneof=hourly monthly yearly"`
+	SalaryNegotiable bool                    `json:"salaryNegotiable" example:"false"`
+	SalaryHidden     bool                    `json:"salaryHidden" example:"false"`
+	Province         string                  `json:"province" example:"Chiang Mai"`
+	Country          string                  `json:"country" example:"TH"`
+	RegisterLink     string                  `json:"registerLink" example:"https://example.com/register" validate:"required"`
+	Status           string                  `json:"status" example:"draft" validate:"required"`
+	Categories       []CategoryRequest       `json:"categories" validate:"required"`
+	SkillIDs         []uint                  `json:"skills" example:"1,2,3"` // Required skills
+	Translations     []JobTranslationRequest `json:"translations" validate:"omitempty,unique=Locale,dive"`
 }
 
 type JobResponses struct {
-	ID             uint                               `json:"id" example:"1"`
-	JobTitle       string                             `json:"title" example:"Software Engineer"`
-	Description    string                             `json:"description" example:"This is a description"`
-	PicUrl         string                             `json:"orgPicUrl" example:"https://example.com/image.jpg"`
-	Scope          string                             `json:"scope" example:"This is a scope"`
-	Prerequisite   []PrerequisiteResponses            `json:"prerequisite"`
-	Workplace      models.Workplace                   `json:"workplace" example:"remote"`
-	WorkType       models.WorkType                    `json:"workType" example:"fulltime"`
-	CareerStage    models.CareerStage                 `json:"careerStage" example:"entrylevel"`
-	Period         string                             `json:"period" example:"1 year"`
-	Qualifications string                             `json:"qualifications" example:"Bachelor's degree in Computer Science"`
-	Quantity       int                                `json:"quantity" example:"1"`
-	Salary         float64                            `json:"salary" example:"30000"`
-	Province       string                             `json:"province" example:"Chiang Mai"`
-	Country        string                             `json:"country" example:"TH"`
-	Status         string                             `json:"status" example:"draft"`
-	RegisterLink   string                             `json:"registerLink" example:"https://example.com/register"`
-	Organization   OrganizationShortResponseWithinJob `json:"organization"`
-	Categories     []CategoryResponses                `json:"categories"`
-	UpdatedAt      string                             `json:"updatedAt" example:"2024-11-29 08:00:00"`
+	ID                 uint                               `json:"id" example:"1"`
+	JobTitle           string                             `json:"title" example:"Software Engineer"`
+	Description        string                             `json:"description" example:"This is a description"` // Source as authored
+	DescriptionHTML    string                             `json:"descriptionHtml" example:"<p>This is a description</p>"`
+	PicUrl             string                             `json:"orgPicUrl" example:"https://example.com/image.jpg"`
+	Scope              string                             `json:"scope" example:"This is a scope"`
+	Prerequisite       []PrerequisiteResponses            `json:"prerequisite"`
+	Workplace          models.Workplace                   `json:"workplace" example:"remote"`
+	WorkType           models.WorkType                    `json:"workType" example:"fulltime"`
+	CareerStage        models.CareerStage                 `json:"careerStage" example:"entrylevel"`
+	Period             string                             `json:"period" example:"1 year"`
+	Qualifications     string                             `json:"qualifications" example:"Bachelor's degree in Computer Science"` // Source as authored
+	QualificationsHTML string                             `json:"qualificationsHtml" example:"<p>Bachelor's degree in Computer Science</p>"`
+	TextFormat         string                             `json:"textFormat" example:"markdown"`
+	Quantity           int                                `json:"quantity" example:"1"`
+	SalaryMin          float64                            `json:"salaryMin" example:"25000"`
+	SalaryMax          float64                            `json:"salaryMax" example:"35000"`
+	SalaryCurrency     string                             `json:"salaryCurrency" example:"THB"`
+	SalaryPeriod       models.SalaryPeriod                `json:"salaryPeriod" example:"monthly"`
+	SalaryNegotiable   bool                               `json:"salaryNegotiable" example:"false"`
+	SalaryHidden       bool                               `json:"salaryHidden" example:"false"`
+	Province           string                             `json:"province" example:"Chiang Mai"`
+	Country            string                             `json:"country" example:"TH"`
+	Status             string                             `json:"status" example:"draft"`
+	RegisterLink       string                             `json:"registerLink" example:"https://example.com/register"`
+	Organization       OrganizationShortResponseWithinJob `json:"organization"`
+	Categories         []CategoryResponses                `json:"categories"`
+	Skills             []SkillShortResponse               `json:"skills"`
+	SkillMatch         *SkillMatchResponse                `json:"skillMatch,omitempty"` // Only for a logged-in candidate
+	Translations       map[string]JobTranslationResponse  `json:"translations"`
+	UpdatedAt          string                             `json:"updatedAt" example:"2024-11-29 08:00:00"`
 }
 
 type PaginatedJobsResponse struct {
@@ -189,14 +210,24 @@ func BuildListOrganizationContactResponses(contact []models.OrganizationContact)
 // }
 
 type IndustryResponses struct {
-	ID   uint   `json:"id" example:"1"`
-	Name string `json:"name" example:"Software"`
+	ID           uint              `json:"id" example:"1"`
+	Name         string            `json:"name" example:"Software"`
+	Translations map[string]string `json:"translations,omitempty"` // Names keyed by locale
 }
 
 func BuildIndustryResponses(industry models.Industry) IndustryResponses {
+	var translations map[string]string
+	for _, t := range industry.Translations {
+		if translations == nil {
+			translations = make(map[string]string, len(industry.Translations))
+		}
+		translations[t.Locale] = t.Name
+	}
+
 	return IndustryResponses{
-		ID:   industry.ID,
-		Name: industry.Industry,
+		ID:           industry.ID,
+		Name:         industry.Industry,
+		Translations: translations,
 	}
 }
 
@@ -213,39 +244,44 @@ type IndustryListResponse struct {
 }
 
 type OrganizationRequest struct {
-	Name                 string                       `json:"name" example:"builds CMU" validate:"required,min=3,max=255"`
-	Email                string                       `json:"email" example:"andaraiwin@gmail.com" validate:"required"`
-	Phone                string                       `json:"phone" example:"0812345678"`
-	HeadLine             string                       `json:"headline" example:"This is a headline" validate:"required"`
-	Specialty            string                       `json:"specialty" example:"This is an specialty"`
-	Description          string                       `json:"description" example:"This is a description" validate:"required"`
-	Address              string                       `json:"address" example:"Chiang Mai postal code: 50200"`
-	Province             string                       `json:"province" example:"Chiang Mai"`
-	Country              string                       `json:"country" example:"Thailand"`
-	Latitude             float64                      `json:"latitude" example:"18.7876"`
-	Longitude            float64                      `json:"longitude" example:"98.9937"`
-	OrganizationContacts []OrganizationContactRequest `json:"organizationContacts" validate:"required"`
-	IndustryIDs          []uint                       `json:"industries" example:"1,2,3" validate:"required"`
+	Name                 string                           `json:"name" example:"builds CMU" validate:"required,min=3,max=255"`
+	Email                string                           `json:"email" example:"andaraiwin@gmail.com" validate:"required"`
+	Phone                string                           `json:"phone" example:"0812345678"`
+	HeadLine             string                           `json:"headline" example:"This is a headline" validate:"required"`
+	Specialty            string                           `json:"specialty" example:"This is an specialty"`
+	Description          string                           `json:"description" example:"This is a description" validate:"required"`
+	DescriptionFormat    string                           `json:"descriptionFormat" example:"markdown" validate:"omitempty,oneof=html markdown"` // html when empty
+	Address              string                           `json:"address" example:"Chiang Mai postal code: 50200"`
+	Province             string                           `json:"province" example:"Chiang Mai"`
+	Country              string                           `json:"country" example:"Thailand"`
+	Latitude             float64                          `json:"latitude" example:"18.7876"`
+	Longitude            float64                          `json:"longitude" example:"98.9937"`
+	OrganizationContacts []OrganizationContactRequest     `json:"organizationContacts" validate:"required"`
+	IndustryIDs          []uint                           `json:"industries" example:"1,2,3" validate:"required"`
+	Translations         []OrganizationTranslationRequest `json:"translations" validate:"omitempty,unique=Locale,dive"`
 }
 
 type OrganizationResponse struct {
-	ID                  uint                           `json:"id" example:"1"`
-	Name                string                         `json:"name" example:"builds CMU"`
-	Email               string                         `json:"email" example:"daf_bridge@egat.co.th"`
-	Phone               string                         `json:"phone" example:"0812345678"`
-	PicUrl              string                         `json:"picUrl" example:"https://example.com/image.jpg"`
-	BgUrl               string                         `json:"bgUrl" example:"https://example.com/image.jpg"`
-	HeadLine            string                         `json:"headline" example:"This is a headline"`
-	Specialty           string                         `json:"specialty" example:"This is an specialty"`
-	Description         string                         `json:"description" example:"This is a description"`
-	Address             string                         `json:"address" example:"Chiang Mai 50200"`
-	Province            string                         `json:"province" example:"Chiang Mai"`
-	Country             string                         `json:"country" example:"Thailand"`
-	Latitude            float64                        `json:"latitude" example:"18.7876"`
-	Longitude           float64                        `json:"longitude" example:"98.9937"`
-	OrganizationContact []OrganizationContactResponses `json:"organizationContacts"`
-	Industries          []IndustryResponses            `json:"industries"`
-	UpdatedAt           string                         `json:"updatedAt" example:"2024-11-29 08:00:00"`
+	ID                  uint                                       `json:"id" example:"1"`
+	Name                string                                     `json:"name" example:"builds CMU"`
+	Email               string                                     `json:"email" example:"daf_bridge@egat.co.th"`
+	Phone               string                                     `json:"phone" example:"0812345678"`
+	PicUrl              string                                     `json:"picUrl" example:"https://example.com/image.jpg"`
+	BgUrl               string                                     `json:"bgUrl" example:"https://example.com/image.jpg"`
+	HeadLine            string                                     `json:"headline" example:"This is a headline"`
+	Specialty           string                                     `json:"specialty" example:"This is an specialty"`
+	Description         string                                     `json:"description" example:"This is a description"` // Source as authored
+	DescriptionFormat   string                                     `json:"descriptionFormat" example:"markdown"`
+	DescriptionHTML     string                                     `json:"descriptionHtml" example:"<p>This is a description</p>"` // Sanitized HTML to show
+	Address             string                                     `json:"address" example:"Chiang Mai 50200"`
+	Province            string                                     `json:"province" example:"Chiang Mai"`
+	Country             string                                     `json:"country" example:"Thailand"`
+	Latitude            float64                                    `json:"latitude" example:"18.7876"`
+	Longitude           float64                                    `json:"longitude" example:"98.9937"`
+	OrganizationContact []OrganizationContactResponses             `json:"organizationContacts"`
+	Industries          []IndustryResponses                        `json:"industries"`
+	Translations        map[string]OrganizationTranslationResponse `json:"translations"`
+	UpdatedAt           string                                     `json:"updatedAt" example:"2024-11-29 08:00:00"`
 }
 
 func BuildOrganizationResponse(org models.Organization) OrganizationResponse {
@@ -260,6 +296,8 @@ func BuildOrganizationResponse(org models.Organization) OrganizationResponse {
 		HeadLine:            org.HeadLine,
 		Specialty:           org.Specialty,
 		Description:         org.Description,
+		DescriptionFormat:   string(richtext.ParseFormat(string(org.DescriptionFormat))),
+		DescriptionHTML:     richtext.Rendered(org.Description, org.DescriptionHTML, org.DescriptionFormat),
 		Address:             org.Address,
 		Province:            org.Province,
 		Country:             org.Country,
@@ -267,6 +305,7 @@ func BuildOrganizationResponse(org models.Organization) OrganizationResponse {
 		Longitude:           org.Longitude,
 		OrganizationContact: BuildListOrganizationContactResponses(org.OrganizationContacts),
 		Industries:          BuildListIndustryResponses(org.Industries),
+		Translations:        BuildOrganizationTranslationResponses(org),
 		UpdatedAt:           org.UpdatedAt.Format("2006-01-02 15:04:05"),
 	}
 
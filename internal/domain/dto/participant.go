@@ -0,0 +1,66 @@
+package dto
+
+import (
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/models"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/utils"
+)
+
+type ParticipantQuery struct {
+	TicketID  uint   `json:"ticketId" form:"ticketId"`                                                    // Only registrations of the ticket type
+	CheckedIn string `json:"checkedIn" form:"checkedIn" validate:"omitempty,oneof=true false"`            // Only attendees checked in, or not yet
+	Status    string `json:"status" form:"status" validate:"omitempty,oneof=pending confirmed cancelled"` // Every registration but cancelled ones when empty
+	Format    string `json:"format" form:"format" validate:"omitempty,oneof=csv xlsx"`                    // Export file, csv when empty
+}
+
+type EventAnnouncementRequest struct {
+	Subject     string `json:"subject" example:"Venue change for Builds CMU 2025" validate:"required,max=255"`
+	Message     string `json:"message" example:"The workshop moves to room 301, see you there!" validate:"required,max=5000"`
+	ScheduledAt string `json:"scheduledAt" example:"2025-01-24T09:00:00+07:00" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00"` // Sent right away when empty
+}
+
+type EventAnnouncementResponse struct {
+	ID          uint   `json:"id" example:"1"`
+	EventID     uint   `json:"eventId" example:"1"`
+	Subject     string `json:"subject" example:"Venue change for Builds CMU 2025"`
+	Message     string `json:"message" example:"The workshop moves to room 301, see you there!"`
+	Status      string `json:"status" example:"scheduled"`
+	ScheduledAt string `json:"scheduledAt" example:"2025-01-24T02:00:00Z"`
+	SentAt      string `json:"sentAt,omitempty" example:"2025-01-24T02:00:12Z"`
+	Recipients  int    `json:"recipients" example:"120"` // Registrants the announcement is mailed to, known once it is sending
+}
+
+type ParticipantVisibilityRequest struct {
+	IsVisible *bool `json:"isVisible" example:"true" validate:"required"`
+}
+
+type PublicParticipantResponse struct {
+	UserID string `json:"userId" example:"48a18dd9-48c3-45a5-b4f3-e8d7a60e2910"`
+	Name   string `json:"name" example:"Anda Raiwin"`
+	PicUrl string `json:"picUrl" example:"https://example.com/user.jpg"`
+}
+
+type PublicParticipantsResponse struct {
+	Total        int                         `json:"total" example:"1"` // Visible participants only
+	Participants []PublicParticipantResponse `json:"participants"`
+}
+
+func BuildEventAnnouncementResponse(announcement models.EventAnnouncement) EventAnnouncementResponse {
+	return EventAnnouncementResponse{
+		ID:          announcement.ID,
+		EventID:     announcement.EventID,
+		Subject:     announcement.Subject,
+		Message:     announcement.Message,
+		Status:      string(announcement.Status),
+		ScheduledAt: utils.FormatUTC(&announcement.ScheduledAt),
+		SentAt:      utils.FormatUTC(announcement.SentAt),
+		Recipients:  announcement.Recipients,
+	}
+}
+
+func BuildPublicParticipantResponse(participant models.EventParticipant) PublicParticipantResponse {
+	return PublicParticipantResponse{
+		UserID: participant.UserId.String(),
+		Name:   participant.User.Name,
+		PicUrl: participant.User.PicUrl,
+	}
+}
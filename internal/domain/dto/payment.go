@@ -0,0 +1,75 @@
+package dto
+
+import (
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/models"
+)
+
+type PaymentResponse struct {
+	ID             uint    `json:"id" example:"1"`
+	Provider       string  `json:"provider" example:"promptpay"`
+	ProviderRef    string  `json:"providerRef" example:"promptpay_ticket-1-1732867200"`
+	Amount         float64 `json:"amount" example:"500"`
+	Status         string  `json:"status" example:"pending"`
+	QRPayload      string  `json:"qrPayload,omitempty" example:"00020101021229370016A000000677010111..."`
+	RefundedAmount float64 `json:"refundedAmount,omitempty" example:"500"`
+	ManualRefund   bool    `json:"manualRefund,omitempty" example:"true"`
+	CreatedAt      string  `json:"createdAt" example:"2024-11-29 08:00:00"`
+}
+
+type OrderResponse struct {
+	ID                uint             `json:"id" example:"1"`
+	EventID           uint             `json:"eventId" example:"1"`
+	EventName         string           `json:"eventName" example:"Builds CMU 2025"`
+	TicketPurchasedID uint             `json:"ticketPurchasedId" example:"1"`
+	TicketTitle       string           `json:"ticketTitle" example:"Early Bird"`
+	UserID            string           `json:"userId" example:"48a18dd9-48c3-45a5-b4f3-e8d7a60e2910"`
+	Amount            float64          `json:"amount" example:"500"`
+	Currency          string           `json:"currency" example:"THB"`
+	Status            string           `json:"status" example:"pending"`
+	ExpiresAt         string           `json:"expiresAt" example:"2024-11-29 08:30:00"`
+	PaidAt            string           `json:"paidAt,omitempty" example:"2024-11-29 08:05:00"`
+	Payment           *PaymentResponse `json:"payment,omitempty"` // Latest payment attempt
+	CreatedAt         string           `json:"createdAt" example:"2024-11-29 08:00:00"`
+}
+
+func BuildPaymentResponse(payment models.Payment) PaymentResponse {
+	return PaymentResponse{
+		ID:             payment.ID,
+		Provider:       payment.Provider,
+		ProviderRef:    payment.ProviderRef,
+		Amount:         payment.Amount,
+		Status:         string(payment.Status),
+		QRPayload:      payment.QRPayload,
+		RefundedAmount: payment.RefundedAmount,
+		ManualRefund:   payment.ManualRefund,
+		CreatedAt:      payment.CreatedAt.Format("2006-01-02 15:04:05"),
+	}
+}
+
+func BuildOrderResponse(order models.Order) OrderResponse {
+	res := OrderResponse{
+		ID:                order.ID,
+		EventID:           order.EventID,
+		EventName:         order.Event.Name,
+		TicketPurchasedID: order.TicketPurchasedID,
+		TicketTitle:       order.TicketPurchased.TicketTitle,
+		UserID:            order.UserID.String(),
+		Amount:            order.Amount,
+		Currency:          order.Currency,
+		Status:            string(order.Status),
+		ExpiresAt:         order.ExpiresAt.Format("2006-01-02 15:04:05"),
+		CreatedAt:         order.CreatedAt.Format("2006-01-02 15:04:05"),
+	}
+
+	if order.PaidAt != nil {
+		res.PaidAt = order.PaidAt.Format("2006-01-02 15:04:05")
+	}
+
+	// Payments are loaded latest first
+	if len(order.Payments) > 0 {
+		payment := BuildPaymentResponse(order.Payments[0])
+		res.Payment = &payment
+	}
+
+	return res
+}
@@ -0,0 +1,158 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/models"
+)
+
+type PromoCodeRequest struct {
+	Code           string              `json:"code" example:"EARLYBIRD" validate:"required,min=3,max=50,alphanum"`
+	DiscountType   models.DiscountType `json:"discountType" example:"percent" validate:"required,oneof=percent fixed"`
+	DiscountValue  float64             `json:"discountValue" example:"20" validate:"gt=0"`
+	TicketIDs      []uint              `json:"ticketIds"` // Empty applies to every ticket type of the event
+	MaxUses        *int                `json:"maxUses" example:"100" validate:"omitempty,gte=1"`
+	MaxUsesPerUser int                 `json:"maxUsesPerUser" example:"1" validate:"gte=0"`
+	StartsAt       string              `json:"startsAt" example:"2025-01-01T00:00:00+07:00" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
+	ExpiresAt      string              `json:"expiresAt" example:"2025-01-20T23:59:59+07:00" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
+	IsActive       *bool               `json:"isActive" example:"true"`
+}
+
+type PromoCodeResponse struct {
+	ID             uint    `json:"id" example:"1"`
+	EventID        uint    `json:"eventId" example:"1"`
+	Code           string  `json:"code" example:"EARLYBIRD"`
+	DiscountType   string  `json:"discountType" example:"percent"`
+	DiscountValue  float64 `json:"discountValue" example:"20"`
+	TicketIDs      []uint  `json:"ticketIds"`
+	MaxUses        *int    `json:"maxUses" example:"100"`
+	MaxUsesPerUser int     `json:"maxUsesPerUser" example:"1"`
+	UsedCount      int     `json:"usedCount" example:"12"`
+	StartsAt       string  `json:"startsAt,omitempty" example:"2025-01-01T00:00:00+07:00"`
+	ExpiresAt      string  `json:"expiresAt,omitempty" example:"2025-01-20T23:59:59+07:00"`
+	IsActive       bool    `json:"isActive" example:"true"`
+}
+
+type PromoCodeQuoteRequest struct {
+	Code string `json:"code" example:"EARLYBIRD" validate:"required,max=50"`
+}
+
+type PromoCodeQuoteResponse struct {
+	Code           string  `json:"code" example:"EARLYBIRD"`
+	OriginalPrice  float64 `json:"originalPrice" example:"500"`
+	DiscountAmount float64 `json:"discountAmount" example:"100"`
+	FinalPrice     float64 `json:"finalPrice" example:"400"`
+}
+
+type PromoRedemptionResponse struct {
+	ID                uint    `json:"id" example:"1"`
+	PromoCodeID       uint    `json:"promoCodeId" example:"1"`
+	Code              string  `json:"code" example:"EARLYBIRD"`
+	TicketPurchasedID uint    `json:"ticketPurchasedId" example:"1"`
+	TicketTitle       string  `json:"ticketTitle" example:"Early Bird"`
+	TicketStatus      string  `json:"ticketStatus" example:"confirmed"`
+	UserID            string  `json:"userId" example:"48a18dd9-48c3-45a5-b4f3-e8d7a60e2910"`
+	UserName          string  `json:"userName" example:"Anda Raiwin"`
+	UserEmail         string  `json:"userEmail" example:"anda@example.com"`
+	OriginalPrice     float64 `json:"originalPrice" example:"500"`
+	DiscountAmount    float64 `json:"discountAmount" example:"100"`
+	ReleasedAt        string  `json:"releasedAt,omitempty" example:"2025-01-10 09:00:00"` // The ticket was cancelled and the use given back
+	CreatedAt         string  `json:"createdAt" example:"2025-01-05 09:00:00"`
+}
+
+type PromoCodeUsageResponse struct {
+	PromoCodeID   uint    `json:"promoCodeId" example:"1"`
+	Code          string  `json:"code" example:"EARLYBIRD"`
+	Redemptions   int64   `json:"redemptions" example:"12"`
+	Released      int64   `json:"released" example:"1"`
+	TotalDiscount float64 `json:"totalDiscount" example:"1200"`
+	Revenue       float64 `json:"revenue" example:"4800"`
+}
+
+type PromoCodeReportResponse struct {
+	Codes       []PromoCodeUsageResponse  `json:"codes"`
+	Redemptions []PromoRedemptionResponse `json:"redemptions"`
+}
+
+func BuildPromoCodeResponse(promo models.PromoCode) PromoCodeResponse {
+	res := PromoCodeResponse{
+		ID:             promo.ID,
+		EventID:        promo.EventID,
+		Code:           promo.Code,
+		DiscountType:   string(promo.DiscountType),
+		DiscountValue:  promo.DiscountValue,
+		TicketIDs:      []uint{},
+		MaxUses:        promo.MaxUses,
+		MaxUsesPerUser: promo.MaxUsesPerUser,
+		UsedCount:      promo.UsedCount,
+		IsActive:       promo.IsActive,
+	}
+
+	for _, ticket := range promo.Tickets {
+		res.TicketIDs = append(res.TicketIDs, ticket.ID)
+	}
+
+	if promo.StartsAt != nil {
+		res.StartsAt = promo.StartsAt.Format(time.RFC3339)
+	}
+
+	if promo.ExpiresAt != nil {
+		res.ExpiresAt = promo.ExpiresAt.Format(time.RFC3339)
+	}
+
+	return res
+}
+
+func BuildPromoRedemptionResponse(redemption models.PromoRedemption) PromoRedemptionResponse {
+	res := PromoRedemptionResponse{
+		ID:                redemption.ID,
+		PromoCodeID:       redemption.PromoCodeID,
+		Code:              redemption.PromoCode.Code,
+		TicketPurchasedID: redemption.TicketPurchasedID,
+		TicketTitle:       redemption.TicketPurchased.TicketTitle,
+		TicketStatus:      string(redemption.TicketPurchased.Status),
+		UserID:            redemption.UserID.String(),
+		UserName:          redemption.User.Name,
+		UserEmail:         redemption.User.Email,
+		OriginalPrice:     redemption.OriginalPrice,
+		DiscountAmount:    redemption.DiscountAmount,
+		CreatedAt:         redemption.CreatedAt.Format("2006-01-02 15:04:05"),
+	}
+
+	if redemption.ReleasedAt != nil {
+		res.ReleasedAt = redemption.ReleasedAt.Format("2006-01-02 15:04:05")
+	}
+
+	return res
+}
+
+// BuildPromoCodeReportResponse lists every code of the event, including the ones never redeemed.
+func BuildPromoCodeReportResponse(promos []models.PromoCode, usages []models.PromoCodeUsage, redemptions []models.PromoRedemption) PromoCodeReportResponse {
+	usageByCode := make(map[uint]models.PromoCodeUsage, len(usages))
+	for _, usage := range usages {
+		usageByCode[usage.PromoCodeID] = usage
+	}
+
+	res := PromoCodeReportResponse{
+		Codes:       []PromoCodeUsageResponse{},
+		Redemptions: []PromoRedemptionResponse{},
+	}
+
+	for _, promo := range promos {
+		usage := usageByCode[promo.ID]
+		res.Codes = append(res.Codes, PromoCodeUsageResponse{
+			PromoCodeID:   promo.ID,
+			Code:          promo.Code,
+			Redemptions:   usage.Redemptions,
+			Released:      usage.Released,
+			TotalDiscount: usage.TotalDiscount,
+			Revenue:       usage.Revenue,
+		})
+	}
+
+	for _, redemption := range redemptions {
+		res.Redemptions = append(res.Redemptions, BuildPromoRedemptionResponse(redemption))
+	}
+
+	return res
+}
@@ -0,0 +1,78 @@
+package dto
+
+import (
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/models"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/utils"
+	"github.com/google/uuid"
+)
+
+type ContentReportRequest struct {
+	TargetType string `json:"targetType" example:"event" validate:"required,oneof=event job organization question answer"`
+	TargetID   uint   `json:"targetId" example:"1" validate:"required"`
+	Reason     string `json:"reason" example:"scam" validate:"required,oneof=spam scam inappropriate harassment misleading other"`
+	Details    string `json:"details" example:"The event asks for a transfer to a personal account" validate:"max=1000"`
+}
+
+type ModerationActionRequest struct {
+	Action string `json:"action" example:"hide" validate:"required,oneof=dismiss hide suspend warn"`
+	Note   string `json:"note" example:"Misleading ticket prices" validate:"max=1000"` // Sent to the owner with a warning
+}
+
+type ContentReportResponse struct {
+	ID         uint   `json:"id" example:"1"`
+	TargetType string `json:"targetType" example:"event"`
+	TargetID   uint   `json:"targetId" example:"1"`
+	Reason     string `json:"reason" example:"scam"`
+	Details    string `json:"details" example:"The event asks for a transfer to a personal account"`
+	Status     string `json:"status" example:"pending"`
+	CreatedAt  string `json:"createdAt" example:"2025-01-20T03:00:00Z"`
+}
+
+type ModerationLogResponse struct {
+	ID          uint       `json:"id" example:"1"`
+	Action      string     `json:"action" example:"hide"`
+	ModeratorID *uuid.UUID `json:"moderatorId"` // Empty for automatic actions
+	Note        string     `json:"note" example:"Misleading ticket prices"`
+	Reports     int        `json:"reports" example:"3"` // Number of reports the action resolved
+	CreatedAt   string     `json:"createdAt" example:"2025-01-20T03:00:00Z"`
+}
+
+// ReportedContentResponse is a content of the moderation queue.
+type ReportedContentResponse struct {
+	TargetType     string `json:"targetType" example:"event"`
+	TargetID       uint   `json:"targetId" example:"1"`
+	Title          string `json:"title" example:"Builds CMU 2025"`
+	Path           string `json:"path" example:"/events/1"`
+	Hidden         bool   `json:"hidden" example:"false"` // Hidden content, suspended organization
+	Reports        int64  `json:"reports" example:"3"`    // Number of pending reports
+	LastReportedAt string `json:"lastReportedAt" example:"2025-01-20T03:00:00Z"`
+}
+
+type ReportedContentDetailResponse struct {
+	ReportedContentResponse
+	PendingReports []ContentReportResponse `json:"pendingReports"`
+	Logs           []ModerationLogResponse `json:"logs"` // Actions taken on the content, newest first
+}
+
+func BuildContentReportResponse(report models.ContentReport) ContentReportResponse {
+	return ContentReportResponse{
+		ID:         report.ID,
+		TargetType: string(report.TargetType),
+		TargetID:   report.TargetID,
+		Reason:     string(report.Reason),
+		Details:    report.Details,
+		Status:     string(report.Status),
+		CreatedAt:  utils.FormatUTC(&report.CreatedAt),
+	}
+}
+
+func BuildModerationLogResponse(log models.ModerationLog) ModerationLogResponse {
+	return ModerationLogResponse{
+		ID:          log.ID,
+		Action:      string(log.Action),
+		ModeratorID: log.ModeratorID,
+		Note:        log.Note,
+		Reports:     log.Reports,
+		CreatedAt:   utils.FormatUTC(&log.CreatedAt),
+	}
+}
@@ -0,0 +1,95 @@
+package dto
+
+import (
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/models"
+)
+
+type ResumeResponse struct {
+	ID          uint   `json:"id" example:"1"`
+	Version     int    `json:"version" example:"2"`
+	FileName    string `json:"fileName" example:"resume.pdf"`
+	ContentType string `json:"contentType" example:"application/pdf"`
+	Size        int64  `json:"size" example:"102400"`
+	CreatedAt   string `json:"createdAt" example:"2024-11-29 08:00:00"`
+}
+
+type ResumeURLResponse struct {
+	URL       string `json:"url" example:"https://bucket.s3.ap-southeast-1.amazonaws.com/users/resumes/..."`
+	ExpiresAt string `json:"expiresAt" example:"2024-11-29 08:05:00"`
+}
+
+// ResumeSuggestionResponse is what could be extracted from a resume, the user confirms it before it is saved to the profile.
+type ResumeSuggestionResponse struct {
+	Education   string                 `json:"education" example:"B.Eng. Computer Engineering, Chiang Mai University"`
+	Skill       string                 `json:"skill" example:"Go, PostgreSQL, Docker"`
+	Skills      []SkillShortResponse   `json:"skills"` // Extracted skills found in the skills catalogue
+	Experiences []ExperienceSuggestion `json:"experiences"`
+}
+
+type ExperienceSuggestion struct {
+	Title       string `json:"title" example:"Software Engineer"`
+	Description string `json:"description" example:"Built the job board backend"`
+	StartDate   string `json:"startDate" example:"2022-06-01"`
+	EndDate     string `json:"endDate" example:"2024-05-01"`
+	Currently   bool   `json:"currently" example:"false"`
+}
+
+type ResumeUploadResponse struct {
+	Resume      ResumeResponse           `json:"resume"`
+	Suggestions ResumeSuggestionResponse `json:"suggestions"`
+}
+
+type ResumeConfirmRequest struct {
+	Education   string              `json:"education" example:"B.Eng. Computer Engineering, Chiang Mai University" validate:"max=255"`
+	Skill       string              `json:"skill" example:"Go, PostgreSQL, Docker" validate:"max=255"`
+	SkillIDs    []uint              `json:"skills" example:"1,2,3"`
+	Experiences []ExperienceRequest `json:"experiences" validate:"dive"`
+}
+
+type JobApplicationRequest struct {
+	ResumeID    *uint  `json:"resumeId" example:"1"`
+	CoverLetter string `json:"coverLetter" example:"I would love to join your team"`
+}
+
+type JobApplicationResponse struct {
+	ID          uint            `json:"id" example:"1"`
+	JobID       uint            `json:"jobId" example:"1"`
+	JobTitle    string          `json:"jobTitle" example:"Software Engineer"`
+	UserID      string          `json:"userId" example:"48a18dd9-48c3-45a5-b4f3-e8d7a60e2910"`
+	Applicant   string          `json:"applicant" example:"Anda Raiwin"`
+	Resume      *ResumeResponse `json:"resume,omitempty"`
+	CoverLetter string          `json:"coverLetter" example:"I would love to join your team"`
+	Status      string          `json:"status" example:"submitted"`
+	CreatedAt   string          `json:"createdAt" example:"2024-11-29 08:00:00"`
+}
+
+func BuildResumeResponse(resume models.Resume) ResumeResponse {
+	return ResumeResponse{
+		ID:          resume.ID,
+		Version:     resume.Version,
+		FileName:    resume.FileName,
+		ContentType: resume.ContentType,
+		Size:        resume.Size,
+		CreatedAt:   resume.CreatedAt.Format("2006-01-02 15:04:05"),
+	}
+}
+
+func BuildJobApplicationResponse(application models.JobApplication) JobApplicationResponse {
+	res := JobApplicationResponse{
+		ID:          application.ID,
+		JobID:       application.JobID,
+		JobTitle:    application.Job.Title,
+		UserID:      application.UserID.String(),
+		Applicant:   application.User.Name,
+		CoverLetter: application.CoverLetter,
+		Status:      string(application.Status),
+		CreatedAt:   application.CreatedAt.Format("2006-01-02 15:04:05"),
+	}
+
+	if application.Resume != nil {
+		resume := BuildResumeResponse(*application.Resume)
+		res.Resume = &resume
+	}
+
+	return res
+}
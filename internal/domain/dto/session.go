@@ -0,0 +1,118 @@
+package dto
+
+import (
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/models"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/utils"
+)
+
+// Scopes of an edit or a removal of a session
+const (
+	SessionScopeThis = "this"
+	SessionScopeAll  = "all"
+)
+
+type EventSessionRequest struct {
+	Title        string  `json:"title" example:"Week 1: Customer discovery" validate:"max=255"`
+	StartDate    string  `json:"startDate" example:"2025-01-25" validate:"required,datetime=2006-01-02"`
+	EndDate      string  `json:"endDate" example:"2025-01-25" validate:"omitempty,datetime=2006-01-02"`
+	StartTime    string  `json:"startTime" example:"09:00:00" validate:"required,datetime=15:04:05"`
+	EndTime      string  `json:"endTime" example:"12:00:00" validate:"required,datetime=15:04:05"`
+	LocationName string  `json:"locationName" example:"builds CMU"` // Empty uses the location of the event
+	Latitude     float64 `json:"latitude" example:"18.7883"`
+	Longitude    float64 `json:"longitude" example:"98.9853"`
+	Province     string  `json:"province" example:"Chiang Mai"`
+	LocationType string  `json:"locationType" example:"onsite" validate:"omitempty,oneof=online onsite"`
+}
+
+type EventRecurrenceRequest struct {
+	// RFC 5545 RRULE with COUNT or UNTIL, the first session is on the start date of the event, empty removes the rule
+	Rule string `json:"rule" example:"FREQ=WEEKLY;BYDAY=TU,TH;COUNT=8" validate:"max=255"`
+}
+
+type EventSessionResponse struct {
+	ID           uint    `json:"id" example:"1"`
+	EventID      uint    `json:"eventId" example:"1"`
+	Title        string  `json:"title" example:"Week 1: Customer discovery"`
+	StartDate    string  `json:"startDate" example:"2025-01-25"`
+	EndDate      string  `json:"endDate" example:"2025-01-25"`
+	StartTime    string  `json:"startTime" example:"09:00:00"`
+	EndTime      string  `json:"endTime" example:"12:00:00"`
+	StartAt      string  `json:"startAt" example:"2025-01-25T02:00:00Z"` // UTC instant
+	EndAt        string  `json:"endAt" example:"2025-01-25T05:00:00Z"`   // UTC instant
+	LocationName string  `json:"locationName" example:"builds CMU"`
+	Latitude     float64 `json:"latitude" example:"18.7883"`
+	Longitude    float64 `json:"longitude" example:"98.9853"`
+	Province     string  `json:"province" example:"Chiang Mai"`
+	LocationType string  `json:"locationType" example:"onsite"`
+	IsRecurring  bool    `json:"isRecurring" example:"true"` // Created from the recurrence rule of the event
+	IsDetached   bool    `json:"isDetached" example:"false"` // Edited on its own
+	IsCancelled  bool    `json:"isCancelled" example:"false"`
+}
+
+func BuildEventSessionResponse(session models.EventSession) EventSessionResponse {
+	endDate := ""
+	if !session.EndDate.IsZero() {
+		endDate = session.EndDate.Format("2006-01-02")
+	}
+
+	return EventSessionResponse{
+		ID:           session.ID,
+		EventID:      session.EventID,
+		Title:        session.Title,
+		StartDate:    session.StartDate.Format("2006-01-02"),
+		EndDate:      endDate,
+		StartTime:    session.StartTime.Format("15:04:05"),
+		EndTime:      session.EndTime.Format("15:04:05"),
+		StartAt:      utils.FormatUTC(session.StartAt),
+		EndAt:        utils.FormatUTC(session.EndAt),
+		LocationName: session.LocationName,
+		Latitude:     session.Latitude,
+		Longitude:    session.Longitude,
+		Province:     session.Province,
+		LocationType: session.LocationType,
+		IsRecurring:  session.RecurrenceID != nil,
+		IsDetached:   session.IsDetached,
+		IsCancelled:  session.IsCancelled,
+	}
+}
+
+type EventSessionsResponse struct {
+	RecurrenceRule string                 `json:"recurrenceRule" example:"FREQ=WEEKLY;BYDAY=TU,TH;COUNT=8"`
+	Sessions       []EventSessionResponse `json:"sessions"`
+}
+
+// EventSessionDocument is a session in the event document, each of them matches the date range filters
+type EventSessionDocument struct {
+	ID           uint    `json:"id"`
+	Title        string  `json:"title"`
+	StartDate    string  `json:"startDate"`
+	StartTime    string  `json:"startTime"`
+	StartAt      string  `json:"startAt"`
+	EndAt        string  `json:"endAt"`
+	LocationName string  `json:"locationName"`
+	Latitude     float64 `json:"latitude"`
+	Longitude    float64 `json:"longitude"`
+	Province     string  `json:"province"`
+}
+
+type EventSessionMapResponses struct {
+	ID        uint    `json:"id"`
+	StartDate string  `json:"startDate"`
+	StartTime string  `json:"startTime"`
+	EndTime   string  `json:"endTime"`
+	Location  string  `json:"locationName"`
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+}
+
+func BuildEventSessionMapResponses(session models.EventSession) EventSessionMapResponses {
+	return EventSessionMapResponses{
+		ID:        session.ID,
+		StartDate: session.StartDate.Format("2006-01-02"),
+		StartTime: session.StartTime.Format("15:04:05"),
+		EndTime:   session.EndTime.Format("15:04:05"),
+		Location:  session.LocationName,
+		Latitude:  session.Latitude,
+		Longitude: session.Longitude,
+	}
+}
@@ -0,0 +1,69 @@
+package dto
+
+import (
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/models"
+)
+
+type SkillRequest struct {
+	Name     string   `json:"name" example:"Go" validate:"required,min=1,max=255"`
+	Slug     string   `json:"slug" example:"go" validate:"omitempty,max=255"`
+	ParentID *uint    `json:"parentId" example:"1"`
+	Synonyms []string `json:"synonyms" example:"golang"`
+	IsActive *bool    `json:"isActive" example:"true"`
+}
+
+type SkillShortResponse struct {
+	ID   uint   `json:"id" example:"1"`
+	Name string `json:"name" example:"Go"`
+	Slug string `json:"slug" example:"go"`
+}
+
+type SkillResponse struct {
+	ID        uint     `json:"id" example:"1"`
+	Name      string   `json:"name" example:"Go"`
+	Slug      string   `json:"slug" example:"go"`
+	ParentID  *uint    `json:"parentId" example:"1"`
+	Synonyms  []string `json:"synonyms" example:"golang"`
+	IsActive  bool     `json:"isActive" example:"true"`
+	UpdatedAt string   `json:"updatedAt" example:"2024-11-29 08:00:00"`
+}
+
+type SkillListResponse struct {
+	Skills []SkillResponse `json:"skills"`
+}
+
+type UserSkillsRequest struct {
+	SkillIDs []uint `json:"skills" example:"1,2,3" validate:"required"`
+}
+
+// SkillMatchResponse compares the skills of the logged-in candidate with the skills required by a job.
+type SkillMatchResponse struct {
+	Score         int                  `json:"score" example:"67"` // Percentage of the required skills the candidate has
+	MatchedSkills []SkillShortResponse `json:"matchedSkills"`
+	MissingSkills []SkillShortResponse `json:"missingSkills"`
+}
+
+func BuildSkillShortResponse(skill models.Skill) SkillShortResponse {
+	return SkillShortResponse{
+		ID:   skill.ID,
+		Name: skill.Name,
+		Slug: skill.Slug,
+	}
+}
+
+func BuildSkillResponse(skill models.Skill) SkillResponse {
+	synonyms := make([]string, 0, len(skill.Synonyms))
+	for _, synonym := range skill.Synonyms {
+		synonyms = append(synonyms, synonym.Name)
+	}
+
+	return SkillResponse{
+		ID:        skill.ID,
+		Name:      skill.Name,
+		Slug:      skill.Slug,
+		ParentID:  skill.ParentID,
+		Synonyms:  synonyms,
+		IsActive:  skill.IsActive,
+		UpdatedAt: skill.UpdatedAt.Format("2006-01-02 15:04:05"),
+	}
+}
@@ -0,0 +1,132 @@
+package dto
+
+import (
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/models"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/utils"
+)
+
+type SurveyQuestionRequest struct {
+	Type     string   `json:"type" example:"choice" validate:"required,oneof=rating choice text"`
+	Prompt   string   `json:"prompt" example:"Which session did you enjoy the most?" validate:"required,max=500"`
+	Required bool     `json:"required" example:"true"`
+	Options  []string `json:"options" example:"Keynote,Workshop,Networking" validate:"max=20,dive,required,max=255"` // Choice questions only, at least two
+}
+
+type EventSurveyRequest struct {
+	Title              string `json:"title" example:"How was Builds CMU 2025?" validate:"required,max=255"`
+	Description        string `json:"description" example:"Help us make the next edition better"`
+	ShowRatingPublicly bool   `json:"showRatingPublicly" example:"true"`
+	// Questions in the order they are asked, they replace the current ones. Leave them out to keep the
+	// current questions, they cannot change once the survey is answered
+	Questions []SurveyQuestionRequest `json:"questions" validate:"omitempty,max=30,dive"`
+}
+
+type SurveyOptionResponse struct {
+	ID    uint   `json:"id" example:"1"`
+	Label string `json:"label" example:"Workshop"`
+}
+
+type SurveyQuestionResponse struct {
+	ID       uint                   `json:"id" example:"1"`
+	Type     string                 `json:"type" example:"choice"`
+	Prompt   string                 `json:"prompt" example:"Which session did you enjoy the most?"`
+	Required bool                   `json:"required" example:"true"`
+	Options  []SurveyOptionResponse `json:"options"`
+}
+
+type EventSurveyResponse struct {
+	ID                 uint                     `json:"id" example:"1"`
+	EventID            uint                     `json:"eventId" example:"1"`
+	Title              string                   `json:"title" example:"How was Builds CMU 2025?"`
+	Description        string                   `json:"description" example:"Help us make the next edition better"`
+	ShowRatingPublicly bool                     `json:"showRatingPublicly" example:"true"`
+	SentAt             string                   `json:"sentAt,omitempty" example:"2025-01-25T10:01:00Z"` // When participants were invited, the survey is open from then on
+	Questions          []SurveyQuestionResponse `json:"questions"`
+}
+
+type ParticipantSurveyResponse struct {
+	EventSurveyResponse
+	Answered bool `json:"answered" example:"false"`
+}
+
+type SurveyAnswerRequest struct {
+	QuestionID uint   `json:"questionId" example:"1" validate:"required"`
+	Rating     *int   `json:"rating" example:"5" validate:"omitempty,min=1,max=5"` // Rating questions
+	OptionID   *uint  `json:"optionId" example:"2"`                                // Choice questions
+	Text       string `json:"text" example:"Great speakers!" validate:"max=5000"`  // Text questions
+}
+
+type SurveySubmitRequest struct {
+	Answers []SurveyAnswerRequest `json:"answers" validate:"required,max=30,dive"`
+}
+
+type SurveyOptionResultResponse struct {
+	ID    uint   `json:"id" example:"2"`
+	Label string `json:"label" example:"Workshop"`
+	Count int    `json:"count" example:"14"`
+}
+
+type SurveyQuestionResultResponse struct {
+	ID       uint   `json:"id" example:"1"`
+	Type     string `json:"type" example:"rating"`
+	Prompt   string `json:"prompt" example:"How would you rate the event?"`
+	Answered int    `json:"answered" example:"42"`
+	// Rating questions
+	Average      float64 `json:"average,omitempty" example:"4.36"`
+	RatingCounts []int   `json:"ratingCounts,omitempty" example:"0,1,3,18,20"` // Answers per rating, from 1 to 5
+	// Choice questions
+	Options []SurveyOptionResultResponse `json:"options,omitempty"`
+	// Text questions
+	Texts []string `json:"texts,omitempty" example:"Great speakers!"`
+}
+
+type SurveyResultsResponse struct {
+	SurveyID  uint                           `json:"surveyId" example:"1"`
+	EventID   uint                           `json:"eventId" example:"1"`
+	Responses int                            `json:"responses" example:"42"`
+	Questions []SurveyQuestionResultResponse `json:"questions"`
+}
+
+type EventRatingResponse struct {
+	EventID   uint    `json:"eventId" example:"1"`
+	EventName string  `json:"eventName,omitempty" example:"Builds CMU 2025"`
+	Average   float64 `json:"average" example:"4.36"` // Average of every rating answer, 0 without any
+	Ratings   int64   `json:"ratings" example:"84"`
+	Responses int64   `json:"responses" example:"42"`
+}
+
+type OrganizationRatingResponse struct {
+	OrganizationID uint                  `json:"organizationId" example:"1"`
+	Average        float64               `json:"average" example:"4.2"` // Weighted by the number of ratings of each event
+	Ratings        int64                 `json:"ratings" example:"230"`
+	Responses      int64                 `json:"responses" example:"120"`
+	Events         []EventRatingResponse `json:"events"`
+}
+
+func BuildEventSurveyResponse(survey models.EventSurvey) EventSurveyResponse {
+	res := EventSurveyResponse{
+		ID:                 survey.ID,
+		EventID:            survey.EventID,
+		Title:              survey.Title,
+		Description:        survey.Description,
+		ShowRatingPublicly: survey.ShowRatingPublicly,
+		SentAt:             utils.FormatUTC(survey.SentAt),
+		Questions:          make([]SurveyQuestionResponse, 0, len(survey.Questions)),
+	}
+
+	for _, question := range survey.Questions {
+		questionRes := SurveyQuestionResponse{
+			ID:       question.ID,
+			Type:     string(question.Type),
+			Prompt:   question.Prompt,
+			Required: question.Required,
+			Options:  make([]SurveyOptionResponse, 0, len(question.Options)),
+		}
+		for _, option := range question.Options {
+			questionRes.Options = append(questionRes.Options, SurveyOptionResponse{ID: option.ID, Label: option.Label})
+		}
+		res.Questions = append(res.Questions, questionRes)
+	}
+
+	return res
+}
@@ -0,0 +1,122 @@
+package dto
+
+import (
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/models"
+)
+
+type ProfileVisibilityRequest struct {
+	IsPublic        bool   `json:"isPublic" example:"true"`
+	Slug            string `json:"slug" example:"anda-raiwin" validate:"omitempty,min=3,max=100"`
+	HideEmail       bool   `json:"hideEmail" example:"true"`
+	HidePhone       bool   `json:"hidePhone" example:"true"`
+	HideExperiences bool   `json:"hideExperiences" example:"false"`
+}
+
+type ProfileVisibilityResponse struct {
+	IsPublic        bool   `json:"isPublic" example:"true"`
+	Slug            string `json:"slug" example:"anda-raiwin"`
+	HideEmail       bool   `json:"hideEmail" example:"true"`
+	HidePhone       bool   `json:"hidePhone" example:"true"`
+	HideExperiences bool   `json:"hideExperiences" example:"false"`
+}
+
+// PublicProfileResponse is a profile as shown to others, hidden fields are left out
+type PublicProfileResponse struct {
+	Slug        string               `json:"slug" example:"anda-raiwin"`
+	FirstName   string               `json:"firstName" example:"Anda"`
+	LastName    string               `json:"lastName" example:"Raiwin"`
+	PicUrl      string               `json:"picUrl" example:"https://anda-daf-bridge.s3.amazonaws.com/users/profile-pic/48a18dd9-48c3-45a5-b4f3-e8d7a60e2910.png"`
+	HeadLine    string               `json:"headline" example:"Backend developer"`
+	Bio         string               `json:"bio" example:"I build things for the web"`
+	Language    string               `json:"language" example:"Thai, English"`
+	Education   string               `json:"education" example:"B.Eng. Computer Engineering, Chiang Mai University"`
+	FocusField  string               `json:"focusField" example:"Software Engineering"`
+	Province    string               `json:"province" example:"Chiang Mai"`
+	Email       string               `json:"email,omitempty" example:"andaraiwin@gmail.com"`
+	Phone       string               `json:"phone,omitempty" example:"08123456789"`
+	Skills      []SkillShortResponse `json:"skills"`
+	Experiences []ExperienceResponse `json:"experiences,omitempty"`
+}
+
+type ProfileViewResponse struct {
+	OrganizationID     uint   `json:"organizationId" example:"1"`
+	OrganizationName   string `json:"organizationName" example:"DAF Bridge"`
+	OrganizationPicUrl string `json:"organizationPicUrl" example:"https://anda-daf-bridge.s3.amazonaws.com/organizations/1/logo.png"`
+	ViewedAt           string `json:"viewedAt" example:"2025-01-24 13:22:10"`
+}
+
+func BuildProfileVisibilityResponse(profile models.Profile) ProfileVisibilityResponse {
+	res := ProfileVisibilityResponse{
+		IsPublic:        profile.IsPublic,
+		HideEmail:       profile.HideEmail,
+		HidePhone:       profile.HidePhone,
+		HideExperiences: profile.HideExperiences,
+	}
+	if profile.PublicSlug != nil {
+		res.Slug = *profile.PublicSlug
+	}
+
+	return res
+}
+
+func BuildPublicProfileResponse(profile models.Profile) PublicProfileResponse {
+	skills := BuildListDTO(profile.Skills, BuildSkillShortResponse)
+	if skills == nil {
+		skills = []SkillShortResponse{}
+	}
+
+	res := PublicProfileResponse{
+		FirstName:  profile.FirstName,
+		LastName:   profile.LastName,
+		PicUrl:     profile.PicUrl,
+		HeadLine:   profile.HeadLine,
+		Bio:        profile.Bio,
+		Language:   profile.Language,
+		Education:  profile.Education,
+		FocusField: profile.FocusField,
+		Province:   profile.Province,
+		Skills:     skills,
+	}
+	if profile.PublicSlug != nil {
+		res.Slug = *profile.PublicSlug
+	}
+	if !profile.HideEmail {
+		res.Email = profile.Email
+	}
+	if !profile.HidePhone {
+		res.Phone = profile.Phone
+	}
+	if !profile.HideExperiences {
+		res.Experiences = BuildListDTO(profile.Experiences, BuildExperienceResponse)
+	}
+
+	return res
+}
+
+func BuildProfileViewResponse(view models.ProfileView) ProfileViewResponse {
+	return ProfileViewResponse{
+		OrganizationID:     view.OrganizationID,
+		OrganizationName:   view.Organization.Name,
+		OrganizationPicUrl: view.Organization.PicUrl,
+		ViewedAt:           view.CreatedAt.Format("2006-01-02 15:04:05"),
+	}
+}
+
+func BuildTalentDocument(profile models.Profile) TalentDocument {
+	doc := TalentDocument{
+		ID:         profile.ID,
+		FirstName:  profile.FirstName,
+		LastName:   profile.LastName,
+		PicUrl:     profile.PicUrl,
+		HeadLine:   profile.HeadLine,
+		FocusField: profile.FocusField,
+		Province:   profile.Province,
+		Skills:     BuildListDTO(profile.Skills, BuildSkillShortResponse),
+		UpdateAt:   profile.UpdatedAt.Format("2006-01-02 15:04:05"),
+	}
+	if profile.PublicSlug != nil {
+		doc.Slug = *profile.PublicSlug
+	}
+
+	return doc
+}
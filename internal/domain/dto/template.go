@@ -0,0 +1,39 @@
+package dto
+
+import (
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/models"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/utils"
+)
+
+type OrgTemplateRequest struct {
+	Kind     string `json:"kind" example:"event" validate:"required,oneof=event job"`
+	SourceID uint   `json:"sourceId" example:"1" validate:"required"` // ID of the event or the job saved as a template
+	Name     string `json:"name" example:"Monthly meetup" validate:"required,max=255"`
+}
+
+type OrgTemplateResponse struct {
+	ID        uint   `json:"id" example:"1"`
+	Kind      string `json:"kind" example:"event"`
+	Name      string `json:"name" example:"Monthly meetup"`
+	Title     string `json:"title" example:"Builds CMU 2025"` // Name of the event or title of the job
+	PicUrl    string `json:"picUrl" example:"https://example.com/picture.png"`
+	CreatedAt string `json:"createdAt" example:"2025-01-20T03:00:00Z"`
+}
+
+// OrgTemplateDraftResponse is the draft created from a template, either an event or a job depending on its kind.
+type OrgTemplateDraftResponse struct {
+	Kind  string          `json:"kind" example:"event"`
+	Event *EventResponses `json:"event,omitempty"`
+	Job   *JobResponses   `json:"job,omitempty"`
+}
+
+func BuildOrgTemplateResponse(template models.OrgTemplate) OrgTemplateResponse {
+	return OrgTemplateResponse{
+		ID:        template.ID,
+		Kind:      string(template.Kind),
+		Name:      template.Name,
+		Title:     template.Title,
+		PicUrl:    template.PicUrl,
+		CreatedAt: utils.FormatUTC(&template.CreatedAt),
+	}
+}
@@ -0,0 +1,118 @@
+package dto
+
+import (
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/models"
+)
+
+type TicketAvailableRequest struct {
+	Title       string  `json:"title" example:"Early Bird" validate:"required,max=255"`
+	Description string  `json:"description" example:"Limited seats at a lower price"`
+	Quantity    int     `json:"quantity" example:"100" validate:"gte=0"`
+	Price       float64 `json:"price" example:"500" validate:"gte=0"`
+}
+
+type TicketAvailableResponse struct {
+	ID          uint    `json:"id" example:"1"`
+	EventID     uint    `json:"eventId" example:"1"`
+	Title       string  `json:"title" example:"Early Bird"`
+	Description string  `json:"description" example:"Limited seats at a lower price"`
+	Quantity    int     `json:"quantity" example:"42"` // Seats left
+	Price       float64 `json:"price" example:"500"`
+	IsFree      bool    `json:"isFree" example:"false"`
+	SoldOut     bool    `json:"soldOut" example:"false"`
+}
+
+type TicketRegisterRequest struct {
+	Username  string `json:"username" example:"Anda Raiwin" validate:"max=100"`
+	Phone     string `json:"phone" example:"0812345678" validate:"omitempty,numeric,max=20"`
+	PromoCode string `json:"promoCode,omitempty" example:"EARLYBIRD" validate:"max=50"`
+}
+
+type TicketPurchasedResponse struct {
+	ID                uint    `json:"id" example:"1"`
+	EventID           uint    `json:"eventId" example:"1"`
+	EventName         string  `json:"eventName" example:"Builds CMU 2025"`
+	TicketAvailableID uint    `json:"ticketAvailableId" example:"1"`
+	TicketTitle       string  `json:"ticketTitle" example:"Early Bird"`
+	Price             float64 `json:"price" example:"400"` // After any discount
+	DiscountAmount    float64 `json:"discountAmount,omitempty" example:"100"`
+	UserID            string  `json:"userId" example:"48a18dd9-48c3-45a5-b4f3-e8d7a60e2910"`
+	Username          string  `json:"username" example:"Anda Raiwin"`
+	Email             string  `json:"email" example:"anda@example.com"`
+	Phone             string  `json:"phone" example:"0812345678"`
+	Status            string  `json:"status" example:"confirmed"`
+	ConfirmationAt    string  `json:"confirmationAt" example:"2024-11-29 08:00:00"`
+	CheckedInAt       string  `json:"checkedInAt,omitempty" example:"2025-01-25 08:45:00"`
+	CreatedAt         string  `json:"createdAt" example:"2024-11-29 08:00:00"`
+}
+
+type TicketCheckInRequest struct {
+	Code string `json:"code" example:"TA1.1.6b3d0Yc1n2RzQmVwcA.Jx2k..." validate:"required,max=255"`
+}
+
+type TicketCheckInCountResponse struct {
+	TicketAvailableID uint   `json:"ticketAvailableId" example:"1"`
+	TicketTitle       string `json:"ticketTitle" example:"Early Bird"`
+	Registered        int64  `json:"registered" example:"120"`
+	CheckedIn         int64  `json:"checkedIn" example:"87"`
+}
+
+type TicketCheckInSummaryResponse struct {
+	Registered int64                        `json:"registered" example:"150"`
+	CheckedIn  int64                        `json:"checkedIn" example:"98"`
+	Tickets    []TicketCheckInCountResponse `json:"tickets"`
+}
+
+func BuildTicketAvailableResponse(ticket models.TicketAvailable) TicketAvailableResponse {
+	return TicketAvailableResponse{
+		ID:          ticket.ID,
+		EventID:     ticket.EventID,
+		Title:       ticket.Title,
+		Description: ticket.Description,
+		Quantity:    ticket.Quantity,
+		Price:       ticket.Price,
+		IsFree:      ticket.Price == 0,
+		SoldOut:     ticket.Quantity == 0,
+	}
+}
+
+func BuildTicketPurchasedResponse(purchase models.TicketPurchased) TicketPurchasedResponse {
+	res := TicketPurchasedResponse{
+		ID:                purchase.ID,
+		EventID:           purchase.EventID,
+		EventName:         purchase.Event.Name,
+		TicketAvailableID: purchase.TicketAvailableID,
+		TicketTitle:       purchase.TicketTitle,
+		Price:             purchase.Price,
+		DiscountAmount:    purchase.DiscountAmount,
+		UserID:            purchase.UserID.String(),
+		Username:          purchase.Username,
+		Email:             purchase.Email,
+		Phone:             purchase.Phone,
+		Status:            string(purchase.Status),
+		ConfirmationAt:    purchase.ConfirmationAt,
+		CreatedAt:         purchase.CreatedAt.Format("2006-01-02 15:04:05"),
+	}
+
+	if purchase.CheckedInAt != nil {
+		res.CheckedInAt = purchase.CheckedInAt.Format("2006-01-02 15:04:05")
+	}
+
+	return res
+}
+
+func BuildTicketCheckInSummaryResponse(counts []models.TicketCheckInCount) TicketCheckInSummaryResponse {
+	res := TicketCheckInSummaryResponse{Tickets: []TicketCheckInCountResponse{}}
+	for _, count := range counts {
+		res.Registered += count.Registered
+		res.CheckedIn += count.CheckedIn
+		res.Tickets = append(res.Tickets, TicketCheckInCountResponse{
+			TicketAvailableID: count.TicketAvailableID,
+			TicketTitle:       count.TicketTitle,
+			Registered:        count.Registered,
+			CheckedIn:         count.CheckedIn,
+		})
+	}
+
+	return res
+}
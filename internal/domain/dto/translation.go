@@ -0,0 +1,200 @@
+package dto
+
+import (
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/models"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/pkg/richtext"
+)
+
+// The translations of a content are sent as a list with one entry per locale, and returned keyed by
+// locale. A text left empty in a translation falls back to the authored one.
+
+type EventTranslationRequest struct {
+	Locale  string `json:"locale" example:"en" validate:"required,oneof=th en"`
+	Name    string `json:"name" example:"builds IDEA 2024" validate:"max=255"`
+	Content string `json:"content" example:"<h1>Hello</h1>"` // In the content format of the event
+}
+
+type EventTranslationResponse struct {
+	Name        string `json:"name" example:"builds IDEA 2024"`
+	Content     string `json:"content" example:"<h1>Hello</h1>"`
+	ContentHTML string `json:"contentHtml" example:"<h1>Hello</h1>"`
+}
+
+type JobTranslationRequest struct {
+	Locale         string `json:"locale" example:"en" validate:"required,oneof=th en"`
+	JobTitle       string `json:"title" example:"Software Engineer" validate:"max=255"`
+	Description    string `json:"description" example:"This is a description"`                    // In the text format of the job
+	Qualifications string `json:"qualifications" example:"Bachelor's degree in Computer Science"` // In the text format of the job
+}
+
+type JobTranslationResponse struct {
+	JobTitle           string `json:"title" example:"Software Engineer"`
+	Description        string `json:"description" example:"This is a description"`
+	DescriptionHTML    string `json:"descriptionHtml" example:"<p>This is a description</p>"`
+	Qualifications     string `json:"qualifications" example:"Bachelor's degree in Computer Science"`
+	QualificationsHTML string `json:"qualificationsHtml" example:"<p>Bachelor's degree in Computer Science</p>"`
+}
+
+type OrganizationTranslationRequest struct {
+	Locale      string `json:"locale" example:"en" validate:"required,oneof=th en"`
+	Name        string `json:"name" example:"builds CMU" validate:"max=255"`
+	Description string `json:"description" example:"This is a description"` // In the description format of the organization
+}
+
+type OrganizationTranslationResponse struct {
+	Name            string `json:"name" example:"builds CMU"`
+	Description     string `json:"description" example:"This is a description"`
+	DescriptionHTML string `json:"descriptionHtml" example:"<p>This is a description</p>"`
+}
+
+func BuildEventTranslationResponses(event models.Event) map[string]EventTranslationResponse {
+	res := make(map[string]EventTranslationResponse, len(event.Translations))
+	for _, t := range event.Translations {
+		res[t.Locale] = EventTranslationResponse{
+			Name:        t.Name,
+			Content:     t.Content,
+			ContentHTML: richtext.Rendered(t.Content, t.ContentHTML, event.ContentFormat),
+		}
+	}
+
+	return res
+}
+
+func BuildJobTranslationResponses(job models.OrgOpenJob) map[string]JobTranslationResponse {
+	res := make(map[string]JobTranslationResponse, len(job.Translations))
+	for _, t := range job.Translations {
+		res[t.Locale] = JobTranslationResponse{
+			JobTitle:           t.Title,
+			Description:        t.Description,
+			DescriptionHTML:    richtext.Rendered(t.Description, t.DescriptionHTML, job.TextFormat),
+			Qualifications:     t.Qualifications,
+			QualificationsHTML: richtext.Rendered(t.Qualifications, t.QualificationsHTML, job.TextFormat),
+		}
+	}
+
+	return res
+}
+
+func BuildOrganizationTranslationResponses(org models.Organization) map[string]OrganizationTranslationResponse {
+	res := make(map[string]OrganizationTranslationResponse, len(org.Translations))
+	for _, t := range org.Translations {
+		res[t.Locale] = OrganizationTranslationResponse{
+			Name:            t.Name,
+			Description:     t.Description,
+			DescriptionHTML: richtext.Rendered(t.Description, t.DescriptionHTML, org.DescriptionFormat),
+		}
+	}
+
+	return res
+}
+
+func BuildCategoryResponse(category models.Category) CategoryResponses {
+	var translations map[string]string
+	for _, t := range category.Translations {
+		if translations == nil {
+			translations = make(map[string]string, len(category.Translations))
+		}
+		translations[t.Locale] = t.Name
+	}
+
+	return CategoryResponses{
+		Value:        category.ID,
+		Label:        category.Name,
+		Translations: translations,
+	}
+}
+
+func BuildListCategoryResponses(categories []models.Category) []CategoryResponses {
+	var response []CategoryResponses
+	for _, category := range categories {
+		response = append(response, BuildCategoryResponse(category))
+	}
+
+	return response
+}
+
+// translated is the text of the translation, or the authored text when it was not translated.
+func translated(authored string, translation string) string {
+	if translation == "" {
+		return authored
+	}
+
+	return translation
+}
+
+// Localize shows the texts of the locale in place of the authored ones, along with those of its
+// organization and categories.
+func (r *EventResponses) Localize(locale string) {
+	if t, ok := r.Translations[locale]; ok {
+		r.Name = translated(r.Name, t.Name)
+		if t.Content != "" {
+			r.Content, r.ContentHTML = t.Content, t.ContentHTML
+		}
+	}
+
+	r.Organization.Localize(locale)
+	LocalizeCategories(r.Categories, locale)
+}
+
+func (r *EventDocumentDTOResponse) Localize(locale string) {
+	if t, ok := r.Translations[locale]; ok {
+		r.Name = translated(r.Name, t.Name)
+	}
+
+	LocalizeCategories(r.Categories, locale)
+}
+
+func (r *JobResponses) Localize(locale string) {
+	if t, ok := r.Translations[locale]; ok {
+		r.JobTitle = translated(r.JobTitle, t.JobTitle)
+		if t.Description != "" {
+			r.Description, r.DescriptionHTML = t.Description, t.DescriptionHTML
+		}
+		if t.Qualifications != "" {
+			r.Qualifications, r.QualificationsHTML = t.Qualifications, t.QualificationsHTML
+		}
+	}
+
+	LocalizeCategories(r.Categories, locale)
+}
+
+func (r *JobDocument) Localize(locale string) {
+	if t, ok := r.Translations[locale]; ok {
+		r.Title = translated(r.Title, t.Title)
+		r.Description = translated(r.Description, t.Description)
+	}
+
+	LocalizeCategories(r.Categories, locale)
+}
+
+func (r *JobDocumentDTOResponse) Localize(locale string) {
+	if t, ok := r.Translations[locale]; ok {
+		r.Title = translated(r.Title, t.Title)
+		r.Description = translated(r.Description, t.Description)
+	}
+
+	LocalizeCategories(r.Categories, locale)
+}
+
+func (r *OrganizationResponse) Localize(locale string) {
+	if t, ok := r.Translations[locale]; ok {
+		r.Name = translated(r.Name, t.Name)
+		if t.Description != "" {
+			r.Description, r.DescriptionHTML = t.Description, t.DescriptionHTML
+		}
+	}
+
+	LocalizeIndustries(r.Industries, locale)
+}
+
+func LocalizeCategories(categories []CategoryResponses, locale string) {
+	for i := range categories {
+		categories[i].Label = translated(categories[i].Label, categories[i].Translations[locale])
+	}
+}
+
+func LocalizeIndustries(industries []IndustryResponses, locale string) {
+	for i := range industries {
+		industries[i].Name = translated(industries[i].Name, industries[i].Translations[locale])
+	}
+}
@@ -26,15 +26,16 @@ func BuildUserResponses(user models.User) UserResponses {
 }
 
 type ProfileResponses struct {
-	ID        uuid.UUID `json:"id" example:"48a18dd9-48c3-45a5-b4f3-e8d7a60e2910"`
-	FirstName string    `json:"firstName" example:"Anda"`
-	LastName  string    `json:"lastName" example:"Raiwin"`
-	Email     string    `json:"email" example:"andaraiwin@gmail.com"`
-	Phone     string    `json:"phone" example:"08123456789"`
-	PicUrl    string    `json:"picUrl" example:"https://anda-daf-bridge.s3.amazonaws.com/users/profile-pic/48a18dd9-48c3-45a5-b4f3-e8d7a60e2910.png"`
-	Language  string    `json:"language" example:"Indonesia"`
-	Role      string    `json:"role" example:"User"`
-	UpdateAt  string    `json:"updatedAt" example:"2025-01-24T13:22:10.532645Z"`
+	ID        uuid.UUID            `json:"id" example:"48a18dd9-48c3-45a5-b4f3-e8d7a60e2910"`
+	FirstName string               `json:"firstName" example:"Anda"`
+	LastName  string               `json:"lastName" example:"Raiwin"`
+	Email     string               `json:"email" example:"andaraiwin@gmail.com"`
+	Phone     string               `json:"phone" example:"08123456789"`
+	PicUrl    string               `json:"picUrl" example:"https://anda-daf-bridge.s3.amazonaws.com/users/profile-pic/48a18dd9-48c3-45a5-b4f3-e8d7a60e2910.png"`
+	Language  string               `json:"language" example:"Indonesia"`
+	Role      string               `json:"role" example:"User"`
+	Skills    []SkillShortResponse `json:"skills"`
+	UpdateAt  string               `json:"updatedAt" example:"2025-01-24T13:22:10.532645Z"`
 }
 
 type SignUpRequest struct {
@@ -141,3 +142,105 @@ type EventsAreInteractedByUserResponse struct {
 	UserResponses  UserResponses             `json:"user"`
 	EventResponses []EventWithCountResponses `json:"events"`
 }
+
+type ExperienceRequest struct {
+	Title       string `json:"title" example:"Software Engineer" validate:"required,max=255"`
+	Description string `json:"description" example:"Built the job board backend"`
+	StartDate   string `json:"startDate" example:"2022-06-01" validate:"required,datetime=2006-01-02"`
+	EndDate     string `json:"endDate" example:"2024-05-31" validate:"omitempty,datetime=2006-01-02"`
+	Currently   bool   `json:"currently" example:"false"`
+}
+
+type ExperienceResponse struct {
+	ID          uuid.UUID `json:"id" example:"9b2f0c8e-6f7d-4c38-9a0e-0f4c3b0a8f5d"`
+	Title       string    `json:"title" example:"Software Engineer"`
+	Description string    `json:"description" example:"Built the job board backend"`
+	PicUrl      string    `json:"picUrl" example:"https://anda-daf-bridge.s3.amazonaws.com/users/experiences/48a18dd9-48c3-45a5-b4f3-e8d7a60e2910/9b2f0c8e-6f7d-4c38-9a0e-0f4c3b0a8f5d.png"`
+	StartDate   string    `json:"startDate" example:"2022-06-01"`
+	EndDate     string    `json:"endDate" example:"2024-05-31"`
+	Currently   bool      `json:"currently" example:"false"`
+	UpdatedAt   string    `json:"updatedAt" example:"2025-01-24 13:22:10"`
+}
+
+type ProfileUpdateRequest struct {
+	FirstName  string `json:"firstName" example:"Anda" validate:"required,max=100"`
+	LastName   string `json:"lastName" example:"Raiwin" validate:"max=100"`
+	HeadLine   string `json:"headline" example:"Backend developer" validate:"max=255"`
+	Phone      string `json:"phone" example:"08123456789" validate:"omitempty,max=20,numeric"`
+	Bio        string `json:"bio" example:"I build things for the web"`
+	Skill      string `json:"skill" example:"Go, PostgreSQL, Docker" validate:"max=255"`
+	Language   string `json:"language" example:"Thai, English" validate:"max=255"`
+	Education  string `json:"education" example:"B.Eng. Computer Engineering, Chiang Mai University" validate:"max=255"`
+	FocusField string `json:"focusField" example:"Software Engineering" validate:"max=255"`
+	Province   string `json:"province" example:"Chiang Mai" validate:"max=255"`
+}
+
+type ProfileDetailResponse struct {
+	ID          uuid.UUID            `json:"id" example:"48a18dd9-48c3-45a5-b4f3-e8d7a60e2910"`
+	FirstName   string               `json:"firstName" example:"Anda"`
+	LastName    string               `json:"lastName" example:"Raiwin"`
+	Email       string               `json:"email" example:"andaraiwin@gmail.com"`
+	Phone       string               `json:"phone" example:"08123456789"`
+	PicUrl      string               `json:"picUrl" example:"https://anda-daf-bridge.s3.amazonaws.com/users/profile-pic/48a18dd9-48c3-45a5-b4f3-e8d7a60e2910.png"`
+	HeadLine    string               `json:"headline" example:"Backend developer"`
+	Bio         string               `json:"bio" example:"I build things for the web"`
+	Skill       string               `json:"skill" example:"Go, PostgreSQL, Docker"`
+	Language    string               `json:"language" example:"Thai, English"`
+	Education   string               `json:"education" example:"B.Eng. Computer Engineering, Chiang Mai University"`
+	FocusField  string               `json:"focusField" example:"Software Engineering"`
+	Province    string               `json:"province" example:"Chiang Mai"`
+	Skills      []SkillShortResponse `json:"skills"`
+	Experiences []ExperienceResponse `json:"experiences"`
+	UpdateAt    string               `json:"updatedAt" example:"2025-01-24 13:22:10"`
+}
+
+func BuildExperienceResponse(experience models.Experience) ExperienceResponse {
+	res := ExperienceResponse{
+		ID:          experience.UUID,
+		Title:       experience.Title,
+		Description: experience.Description,
+		PicUrl:      experience.PicUrl,
+		Currently:   experience.Currently,
+		UpdatedAt:   experience.UpdatedAt.Format("2006-01-02 15:04:05"),
+	}
+
+	if !experience.StartDate.IsZero() {
+		res.StartDate = experience.StartDate.Format("2006-01-02")
+	}
+	if !experience.Currently && !experience.EndDate.IsZero() {
+		res.EndDate = experience.EndDate.Format("2006-01-02")
+	}
+
+	return res
+}
+
+func BuildProfileDetailResponse(profile models.Profile) ProfileDetailResponse {
+	skills := BuildListDTO(profile.Skills, BuildSkillShortResponse)
+	if skills == nil {
+		skills = []SkillShortResponse{}
+	}
+
+	experiences := BuildListDTO(profile.Experiences, BuildExperienceResponse)
+	if experiences == nil {
+		experiences = []ExperienceResponse{}
+	}
+
+	return ProfileDetailResponse{
+		ID:          profile.UserID,
+		FirstName:   profile.FirstName,
+		LastName:    profile.LastName,
+		Email:       profile.Email,
+		Phone:       profile.Phone,
+		PicUrl:      profile.PicUrl,
+		HeadLine:    profile.HeadLine,
+		Bio:         profile.Bio,
+		Skill:       profile.Skill,
+		Language:    profile.Language,
+		Education:   profile.Education,
+		FocusField:  profile.FocusField,
+		Province:    profile.Province,
+		Skills:      skills,
+		Experiences: experiences,
+		UpdateAt:    profile.UpdatedAt.Format("2006-01-02 15:04:05"),
+	}
+}
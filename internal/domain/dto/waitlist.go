@@ -0,0 +1,57 @@
+package dto
+
+import (
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/models"
+)
+
+type WaitlistEntryResponse struct {
+	ID                uint   `json:"id" example:"1"`
+	EventID           uint   `json:"eventId" example:"1"`
+	EventName         string `json:"eventName" example:"Builds CMU 2025"`
+	TicketAvailableID uint   `json:"ticketAvailableId" example:"1"`
+	TicketTitle       string `json:"ticketTitle" example:"Early Bird"`
+	UserID            string `json:"userId" example:"48a18dd9-48c3-45a5-b4f3-e8d7a60e2910"`
+	Username          string `json:"username" example:"Anda Raiwin"`
+	Email             string `json:"email" example:"anda@example.com"`
+	Position          int    `json:"position" example:"3"`
+	Status            string `json:"status" example:"waiting"`
+	OfferedAt         string `json:"offeredAt,omitempty" example:"2024-11-29 08:00:00"`
+	OfferExpiresAt    string `json:"offerExpiresAt,omitempty" example:"2024-11-30 08:00:00"`
+	TicketPurchasedID *uint  `json:"ticketPurchasedId,omitempty" example:"12"`
+	CreatedAt         string `json:"createdAt" example:"2024-11-29 08:00:00"`
+}
+
+type WaitlistReorderRequest struct {
+	EntryIDs []uint `json:"entryIds" example:"3,1,2" validate:"required,min=1"`
+}
+
+type WaitlistClaimRequest struct {
+	Token string `json:"token" example:"0b8e5f0e-8d1a-4c1b-9a51-1f6e8c2a7d10" validate:"required,uuid"`
+}
+
+func BuildWaitlistEntryResponse(entry models.WaitlistEntry) WaitlistEntryResponse {
+	res := WaitlistEntryResponse{
+		ID:                entry.ID,
+		EventID:           entry.EventID,
+		EventName:         entry.Event.Name,
+		TicketAvailableID: entry.TicketAvailableID,
+		TicketTitle:       entry.TicketAvailable.Title,
+		UserID:            entry.UserID.String(),
+		Username:          entry.User.Name,
+		Email:             entry.User.Email,
+		Position:          entry.Position,
+		Status:            string(entry.Status),
+		TicketPurchasedID: entry.TicketPurchasedID,
+		CreatedAt:         entry.CreatedAt.Format("2006-01-02 15:04:05"),
+	}
+
+	if entry.OfferedAt != nil {
+		res.OfferedAt = entry.OfferedAt.Format("2006-01-02 15:04:05")
+	}
+
+	if entry.OfferExpiresAt != nil {
+		res.OfferExpiresAt = entry.OfferExpiresAt.Format("2006-01-02 15:04:05")
+	}
+
+	return res
+}
@@ -1,15 +1,34 @@
 package models
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 	"gorm.io/gorm"
 )
 
 type EventParticipant struct {
 	gorm.Model
-	UserId    uuid.UUID `gorm:"type:uuid;not null" json:"userId"`
-	User      User      `gorm:"foreignKey:UserId;constraint:onUpdate:CASCADE,onDelete:CASCADE;" json:"user"`
-	EventId   uint      `gorm:"type:uint;not null" json:"eventId"`
-	Event     Event     `gorm:"foreignKey:EventId;constraint:onUpdate:CASCADE,onDelete:CASCADE;" json:"event"`
-	IsVisible bool      `gorm:"type:boolean" json:"isVisible"`
+	UserId      uuid.UUID  `gorm:"type:uuid;not null" json:"userId"`
+	User        User       `gorm:"foreignKey:UserId;constraint:onUpdate:CASCADE,onDelete:CASCADE;" json:"user"`
+	EventId     uint       `gorm:"type:uint;not null" json:"eventId"`
+	Event       Event      `gorm:"foreignKey:EventId;constraint:onUpdate:CASCADE,onDelete:CASCADE;" json:"event"`
+	IsVisible   bool       `gorm:"type:boolean" json:"isVisible"`
+	CheckedInAt *time.Time `json:"checkedInAt"` // Attendance, set when the ticket is scanned at the entrance
+}
+
+// ParticipantFilter narrows the registrations of an event listed to organizers, zero values match everything.
+type ParticipantFilter struct {
+	TicketAvailableID uint
+	CheckedIn         *bool
+	Status            TicketStatus // Empty lists every registration that is not cancelled
+}
+
+type EventParticipantRepository interface {
+	// ListRegistrations lists the tickets taken for the event with the users who took them
+	ListRegistrations(eventID uint, filter ParticipantFilter) ([]TicketPurchased, error)
+	// ListVisible lists the participants who chose to appear on the public attendee list
+	ListVisible(eventID uint) ([]EventParticipant, error)
+	GetByUserAndEvent(userID uuid.UUID, eventID uint) (*EventParticipant, error)
+	UpdateVisibility(userID uuid.UUID, eventID uint, isVisible bool) error
 }
@@ -1,14 +1,25 @@
 package models
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 	"gorm.io/gorm"
 )
 
+type TicketStatus string
+
+const (
+	TicketStatusPending   TicketStatus = "pending" // Paid ticket waiting for its payment
+	TicketStatusConfirmed TicketStatus = "confirmed"
+	TicketStatusCancelled TicketStatus = "cancelled"
+)
+
 type TicketPurchased struct {
 	gorm.Model
-	UserID            uuid.UUID       `gorm:"type:uuid;not null" json:"userId"`
-	EventID           uint            `gorm:"type:uint;not null" json:"eventId"`
+	// A user holds one active registration per event, cancelled ones are kept for history
+	UserID            uuid.UUID       `gorm:"type:uuid;not null;index;uniqueIndex:idx_ticket_purchased_active,where:status <> 'cancelled' AND deleted_at IS NULL" json:"userId"`
+	EventID           uint            `gorm:"type:uint;not null;index;uniqueIndex:idx_ticket_purchased_active" json:"eventId"`
 	Event             Event           `gorm:"foreignKey:EventID;constraint:onUpdate:CASCADE,onDelete:CASCADE;" json:"event"`
 	TicketAvailableID uint            `gorm:"type:uint;not null" json:"ticket_available_id"`
 	TicketAvailable   TicketAvailable `gorm:"foreignKey:TicketAvailableID;constraint:onUpdate:CASCADE,onDelete:CASCADE;" json:"ticketAvailable"`
@@ -16,6 +27,18 @@ type TicketPurchased struct {
 	Email             string          `gorm:"type:varchar(255)" json:"email"`
 	Phone             string          `gorm:"type:varchar(20)" json:"phone"`
 	TicketTitle       string          `gorm:"type:varchar(255)" json:"ticketTitle"`
+	Price             float64         `gorm:"not null;default:0" json:"price"` // Price at the time of registration, after any discount
+	DiscountAmount    float64         `gorm:"not null;default:0" json:"discountAmount"`
+	Status            TicketStatus    `gorm:"type:varchar(20);not null;default:'confirmed'" json:"status"`
 	ConfirmationAt    string          `gorm:"type:varchar(255)" json:"confirmationAt"`
-	Qrcode            string          `gorm:"type:varchar(255)" json:"qrcode"`
+	Qrcode            string          `gorm:"type:varchar(255);index" json:"-"` // Signed payload of the ticket QR code
+	CheckedInAt       *time.Time      `json:"checkedInAt"`
+}
+
+// TicketCheckInCount is the live check-in progress of one ticket type.
+type TicketCheckInCount struct {
+	TicketAvailableID uint
+	TicketTitle       string
+	Registered        int64
+	CheckedIn         int64
 }
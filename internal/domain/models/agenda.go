@@ -0,0 +1,70 @@
+package models
+
+import (
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/utils"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// EventSpeaker is a speaker of an event, optionally the profile of a user of the platform.
+type EventSpeaker struct {
+	gorm.Model
+	EventID     uint       `gorm:"not null;index" db:"event_id"`
+	Event       Event      `gorm:"foreignKey:EventID;constraint:onUpdate:CASCADE,onDelete:CASCADE;" db:"event"`
+	UserID      *uuid.UUID `gorm:"type:uuid;index" db:"user_id"`
+	User        *User      `gorm:"foreignKey:UserID;constraint:onUpdate:CASCADE,onDelete:SET NULL;" db:"user"`
+	Name        string     `gorm:"type:varchar(255);not null" db:"name"`
+	JobTitle    string     `gorm:"type:varchar(255)" db:"job_title"`
+	Company     string     `gorm:"type:varchar(255)" db:"company"`
+	Bio         string     `gorm:"type:text" db:"bio"`
+	PicUrl      string     `gorm:"type:text" db:"pic_url"`
+	ProfileLink string     `gorm:"type:varchar(255)" db:"profile_link"`
+	Position    int        `gorm:"not null;default:0" db:"position"`
+}
+
+// AgendaItem is an entry of the agenda of an event, items are shown in the order of their position.
+type AgendaItem struct {
+	gorm.Model
+	EventID     uint           `gorm:"not null;index" db:"event_id"`
+	Event       Event          `gorm:"foreignKey:EventID;constraint:onUpdate:CASCADE,onDelete:CASCADE;" db:"event"`
+	SessionID   *uint          `gorm:"index" db:"session_id"` // The session the item belongs to, nil for the event as a whole
+	Session     *EventSession  `gorm:"foreignKey:SessionID;constraint:onUpdate:CASCADE,onDelete:SET NULL;" db:"session"`
+	Position    int            `gorm:"not null;default:0" db:"position"`
+	StartTime   utils.TimeOnly `gorm:"type:time without time zone" db:"start_time"`
+	EndTime     utils.TimeOnly `gorm:"type:time without time zone" db:"end_time"`
+	Title       string         `gorm:"type:varchar(255);not null" db:"title"`
+	Description string         `gorm:"type:text" db:"description"`
+	Speakers    []EventSpeaker `gorm:"many2many:agenda_item_speakers;constraint:onUpdate:CASCADE,onDelete:CASCADE;" db:"speakers"`
+}
+
+// EventMaterial is a file attendees can download, such as slides or a workbook.
+type EventMaterial struct {
+	gorm.Model
+	EventID     uint   `gorm:"not null;index" db:"event_id"`
+	Event       Event  `gorm:"foreignKey:EventID;constraint:onUpdate:CASCADE,onDelete:CASCADE;" db:"event"`
+	Title       string `gorm:"type:varchar(255);not null" db:"title"`
+	FileName    string `gorm:"type:varchar(255);not null" db:"file_name"`
+	ContentType string `gorm:"type:varchar(255);not null" db:"content_type"`
+	Size        int64  `gorm:"not null" db:"size"`
+	ObjectKey   string `gorm:"type:text;not null" db:"object_key"`
+	FileUrl     string `gorm:"type:text;not null" db:"file_url"`
+}
+
+type EventProgramRepository interface {
+	// ReplaceAgenda replaces the agenda of the event with the items, in their order
+	ReplaceAgenda(eventID uint, items []AgendaItem) ([]AgendaItem, error)
+	ListAgenda(eventID uint) ([]AgendaItem, error)
+	ListSpeakers(eventID uint) ([]EventSpeaker, error)
+	// FindSpeakers returns the speakers of the event among the ids
+	FindSpeakers(eventID uint, ids []uint) ([]EventSpeaker, error)
+	GetSpeaker(id uint, eventID uint) (*EventSpeaker, error)
+	CreateSpeaker(speaker *EventSpeaker) error
+	UpdateSpeaker(speaker *EventSpeaker) (*EventSpeaker, error)
+	UpdateSpeakerPicture(id uint, eventID uint, picURL string) error
+	// DeleteSpeaker also removes the speaker from the agenda
+	DeleteSpeaker(id uint, eventID uint) error
+	ListMaterials(eventID uint) ([]EventMaterial, error)
+	GetMaterial(id uint, eventID uint) (*EventMaterial, error)
+	CreateMaterial(material *EventMaterial) error
+	DeleteMaterial(id uint, eventID uint) error
+}
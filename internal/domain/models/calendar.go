@@ -0,0 +1,37 @@
+package models
+
+import (
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// SavedEvent bookmarks an event for a user, saved events are listed in the user's calendar feed.
+type SavedEvent struct {
+	gorm.Model
+	UserID  uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_saved_event_user" json:"userId"`
+	User    User      `gorm:"foreignKey:UserID;constraint:onUpdate:CASCADE,onDelete:CASCADE;" json:"-"`
+	EventID uint      `gorm:"not null;uniqueIndex:idx_saved_event_user" json:"eventId"`
+	Event   Event     `gorm:"foreignKey:EventID;constraint:onUpdate:CASCADE,onDelete:CASCADE;" json:"event"`
+}
+
+// CalendarFeed is the secret token of a user's personal calendar feed, calendar apps fetch it without a session.
+type CalendarFeed struct {
+	gorm.Model
+	UserID uuid.UUID `gorm:"type:uuid;not null;uniqueIndex" json:"userId"`
+	User   User      `gorm:"foreignKey:UserID;constraint:onUpdate:CASCADE,onDelete:CASCADE;" json:"-"`
+	Token  uuid.UUID `gorm:"type:uuid;not null;uniqueIndex" json:"-"`
+}
+
+type CalendarRepository interface {
+	// SaveEvent is idempotent, saving an event twice keeps one bookmark
+	SaveEvent(userID uuid.UUID, eventID uint) error
+	UnsaveEvent(userID uuid.UUID, eventID uint) error
+	ListSavedEvents(userID uuid.UUID) ([]Event, error)
+	// GetOrCreateFeed returns the feed of the user, creating its token on first use
+	GetOrCreateFeed(userID uuid.UUID) (*CalendarFeed, error)
+	ResetFeedToken(userID uuid.UUID) (*CalendarFeed, error)
+	GetFeedByToken(token uuid.UUID) (*CalendarFeed, error)
+	ListPublishedByOrgID(orgID uint) ([]Event, error)
+	// ListUserEvents returns the events the user holds an active ticket for or has saved
+	ListUserEvents(userID uuid.UUID) ([]Event, error)
+}
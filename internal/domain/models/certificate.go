@@ -0,0 +1,79 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type CertificateImage string
+
+const (
+	CertificateBackground CertificateImage = "background" // Covers the whole page
+	CertificateSignature  CertificateImage = "signature"
+)
+
+// CertificateTemplate is the design of the participation certificates of an event, placeholders in the
+// text of its fields are filled in for each participant.
+type CertificateTemplate struct {
+	gorm.Model
+	EventID             uint               `gorm:"not null;uniqueIndex" json:"eventId"`
+	Event               Event              `gorm:"foreignKey:EventID;constraint:onUpdate:CASCADE,onDelete:CASCADE;" json:"-"`
+	BackgroundObjectKey string             `gorm:"type:varchar(500)" json:"-"`
+	BackgroundUrl       string             `gorm:"type:varchar(500)" json:"backgroundUrl"`
+	SignatureObjectKey  string             `gorm:"type:varchar(500)" json:"-"`
+	SignatureUrl        string             `gorm:"type:varchar(500)" json:"signatureUrl"`
+	SignatureX          float64            `gorm:"not null" json:"signatureX"`     // Percent of the page width, center of the signature
+	SignatureY          float64            `gorm:"not null" json:"signatureY"`     // Percent of the page height from the top, bottom of the signature
+	SignatureWidth      float64            `gorm:"not null" json:"signatureWidth"` // Percent of the page width
+	Fields              []CertificateField `gorm:"foreignKey:TemplateID;constraint:onUpdate:CASCADE,onDelete:CASCADE;" json:"fields"`
+}
+
+// CertificateField is a line of text of a certificate, anchored in percent of the page from its top left.
+type CertificateField struct {
+	gorm.Model
+	TemplateID uint    `gorm:"not null;index" json:"templateId"`
+	Position   int     `gorm:"not null;default:0" json:"position"`
+	Text       string  `gorm:"type:varchar(500);not null" json:"text"` // With {name}, {event}, {date}, {organization} or {code}
+	X          float64 `gorm:"not null" json:"x"`
+	Y          float64 `gorm:"not null" json:"y"`
+	FontSize   float64 `gorm:"not null" json:"fontSize"`
+	Color      string  `gorm:"type:varchar(7);not null;default:'#1d1d39'" json:"color"`
+	Bold       bool    `gorm:"not null;default:false" json:"bold"`
+	Align      string  `gorm:"type:varchar(10);not null;default:'center'" json:"align"`
+}
+
+// Certificate is issued once to each participant checked in at an event, anyone can verify it by its code.
+type Certificate struct {
+	gorm.Model
+	EventID       uint       `gorm:"not null;uniqueIndex:idx_certificate_event_user" json:"eventId"`
+	Event         Event      `gorm:"foreignKey:EventID;constraint:onUpdate:CASCADE,onDelete:CASCADE;" json:"-"`
+	UserID        uuid.UUID  `gorm:"type:uuid;not null;uniqueIndex:idx_certificate_event_user;index" json:"userId"`
+	User          User       `gorm:"foreignKey:UserID;constraint:onUpdate:CASCADE,onDelete:CASCADE;" json:"-"`
+	Code          string     `gorm:"type:varchar(20);not null;uniqueIndex" json:"code"`
+	RecipientName string     `gorm:"type:varchar(255);not null" json:"recipientName"` // As printed, the name of the user may change later
+	IssuedAt      time.Time  `gorm:"not null" json:"issuedAt"`
+	ObjectKey     string     `gorm:"type:varchar(500)" json:"-"`
+	FileUrl       string     `gorm:"type:varchar(500)" json:"fileUrl"` // Empty until the PDF is stored
+	EmailedAt     *time.Time `json:"emailedAt"`
+}
+
+type CertificateRepository interface {
+	GetTemplate(eventID uint) (*CertificateTemplate, error)
+	// SaveTemplate creates the template of the event or replaces its layout and fields, its images stay
+	SaveTemplate(template *CertificateTemplate) error
+	UpdateTemplateImage(eventID uint, image CertificateImage, objectKey string, url string) error
+	// ListUncertified lists the participants of the event who were checked in and have no certificate yet, with their user
+	ListUncertified(eventID uint) ([]EventParticipant, error)
+	// Claim creates the certificate unless the participant already has one, it reports whether it was created
+	Claim(certificate *Certificate) (bool, error)
+	// Release removes a claimed certificate whose PDF could not be stored, it is issued again on the next run
+	Release(certificateID uint) error
+	UpdateFile(certificateID uint, objectKey string, url string) error
+	MarkEmailed(certificateID uint, at time.Time) error
+	ListByEventID(eventID uint) ([]Certificate, error)
+	ListByUserID(userID uuid.UUID) ([]Certificate, error)
+	// GetByCode finds an issued certificate with its event and organization
+	GetByCode(code string) (*Certificate, error)
+}
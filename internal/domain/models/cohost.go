@@ -0,0 +1,44 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type CoHostStatus string
+
+const (
+	CoHostPending  CoHostStatus = "pending"  // Invited, waiting for an owner of the invited organization
+	CoHostAccepted CoHostStatus = "accepted" // The organization hosts the event with its organizer
+)
+
+// EventCoHost is a partner organization invited to host an event with the organization that created it.
+// Once accepted, the members of the co-host manage the event through their own organization.
+type EventCoHost struct {
+	gorm.Model
+	EventID        uint         `gorm:"not null;uniqueIndex:idx_event_co_host" json:"eventId"`
+	Event          Event        `gorm:"foreignKey:EventID;constraint:onUpdate:CASCADE,onDelete:CASCADE;" json:"-"`
+	OrganizationID uint         `gorm:"not null;uniqueIndex:idx_event_co_host;index" json:"organizationId"`
+	Organization   Organization `gorm:"foreignKey:OrganizationID;constraint:onUpdate:CASCADE,onDelete:CASCADE;" json:"-"`
+	Status         CoHostStatus `gorm:"type:varchar(20);not null;default:'pending'" json:"status"`
+	InvitedBy      uuid.UUID    `gorm:"type:uuid;not null" json:"invitedBy"`
+	RespondedBy    *uuid.UUID   `gorm:"type:uuid" json:"respondedBy"`
+	RespondedAt    *time.Time   `json:"respondedAt"`
+}
+
+type EventCoHostRepository interface {
+	// Invite creates a pending invitation, it reports false when the organization is already invited to the event
+	Invite(coHost *EventCoHost) (bool, error)
+	// ListByEventID lists the co-hosts of the event, pending or accepted, with their organization
+	ListByEventID(eventID uint) ([]EventCoHost, error)
+	// ListInvitations lists the pending invitations of the organization with their event and its organizer
+	ListInvitations(orgID uint) ([]EventCoHost, error)
+	GetInvitation(orgID uint, coHostID uint) (*EventCoHost, error)
+	// Accept turns a pending invitation of the organization into a co-host, it fails with
+	// gorm.ErrRecordNotFound when there is no such pending invitation
+	Accept(orgID uint, coHostID uint, userID uuid.UUID, at time.Time) error
+	// Remove withdraws an invitation, declines it or ends a co-hosting, whichever side asks
+	Remove(eventID uint, orgID uint) error
+}
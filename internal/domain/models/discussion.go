@@ -0,0 +1,59 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type DiscussionTarget string
+
+const (
+	DiscussionEvent DiscussionTarget = "event"
+	DiscussionJob   DiscussionTarget = "job"
+)
+
+// DiscussionQuestion is a question asked publicly about an event or a job, its answers form its thread.
+type DiscussionQuestion struct {
+	gorm.Model
+	TargetType DiscussionTarget   `gorm:"type:varchar(20);not null;index:idx_discussion_target" json:"targetType"`
+	TargetID   uint               `gorm:"not null;index:idx_discussion_target" json:"targetId"` // ID of the event or the job
+	UserID     uuid.UUID          `gorm:"type:uuid;not null;index" json:"userId"`
+	User       User               `gorm:"foreignKey:UserID;constraint:onUpdate:CASCADE,onDelete:CASCADE;" json:"-"`
+	Body       string             `gorm:"type:text;not null" json:"body"`
+	Hidden     bool               `gorm:"not null;default:false" json:"hidden"` // Hidden by the organization, it is only listed to its members
+	Locked     bool               `gorm:"not null;default:false" json:"locked"` // Only the organization answers a locked question
+	Answers    []DiscussionAnswer `gorm:"foreignKey:QuestionID;constraint:onUpdate:CASCADE,onDelete:CASCADE;" json:"answers"`
+}
+
+type DiscussionAnswer struct {
+	gorm.Model
+	QuestionID uint               `gorm:"not null;index" json:"questionId"`
+	Question   DiscussionQuestion `gorm:"foreignKey:QuestionID;constraint:onUpdate:CASCADE,onDelete:CASCADE;" json:"-"`
+	UserID     uuid.UUID          `gorm:"type:uuid;not null" json:"userId"`
+	User       User               `gorm:"foreignKey:UserID;constraint:onUpdate:CASCADE,onDelete:CASCADE;" json:"-"`
+	Body       string             `gorm:"type:text;not null" json:"body"`
+	Official   bool               `gorm:"not null;default:false" json:"official"` // Answered by a member of the organization of the event or the job
+	Hidden     bool               `gorm:"not null;default:false" json:"hidden"`
+	PinnedAt   *time.Time         `gorm:"index" json:"pinnedAt"` // Pinned answers form the FAQ, in the order they were pinned
+}
+
+type DiscussionRepository interface {
+	CreateQuestion(question *DiscussionQuestion) error
+	// ListQuestions lists the questions about the target, newest first, with their answers and authors,
+	// hidden questions and answers are left out unless withHidden
+	ListQuestions(targetType DiscussionTarget, targetID uint, withHidden bool) ([]DiscussionQuestion, error)
+	// ListFAQ lists the visible pinned answers about the target with their question
+	ListFAQ(targetType DiscussionTarget, targetID uint) ([]DiscussionAnswer, error)
+	GetQuestion(targetType DiscussionTarget, targetID uint, id uint) (*DiscussionQuestion, error)
+	// UpdateQuestion saves whether the question is hidden and locked
+	UpdateQuestion(question *DiscussionQuestion) error
+	// DeleteQuestion deletes the question with its answers
+	DeleteQuestion(targetType DiscussionTarget, targetID uint, id uint) error
+	CreateAnswer(answer *DiscussionAnswer) error
+	GetAnswer(questionID uint, id uint) (*DiscussionAnswer, error)
+	// UpdateAnswer saves whether the answer is hidden and pinned
+	UpdateAnswer(answer *DiscussionAnswer) error
+	DeleteAnswer(questionID uint, id uint) error
+}
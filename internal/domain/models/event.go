@@ -1,7 +1,11 @@
 package models
 
 import (
+	"time"
+
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/pkg/richtext"
 	"github.com/DAF-Bridge/Talent-Atmos-Backend/utils"
+	"github.com/google/uuid"
 	"gorm.io/gorm"
 )
 
@@ -43,6 +47,9 @@ const (
 // Models
 //---------------------------------------------------------------------------
 
+// Timeline is the former free-form agenda entry.
+//
+// Deprecated: events keep a structured agenda, see AgendaItem.
 type Timeline struct {
 	Time     string `json:"time" example:"08:00"`
 	Activity string `json:"activity" example:"Registration"`
@@ -57,28 +64,41 @@ type ContactChannel struct {
 
 type Event struct {
 	gorm.Model
-	Name            string            `gorm:"type:varchar(255);not null" db:"event_name"`
-	PicUrl          string            `gorm:"type:text" db:"pic_url"`
-	StartDate       utils.DateOnly    `gorm:"type:date;not null" db:"start_date"`
-	EndDate         utils.DateOnly    `gorm:"type:date" db:"end_date"`
-	StartTime       utils.TimeOnly    `gorm:"type:time without time zone" db:"start_time"`
-	EndTime         utils.TimeOnly    `gorm:"type:time without time zone" db:"end_time"`
-	Content         string            `gorm:"type:text" db:"content"`
-	LocationName    string            `gorm:"type:varchar(255)" db:"location_name"`
-	Latitude        float64           `gorm:"type:decimal(10,8)" db:"latitude"`
-	Longitude       float64           `gorm:"type:decimal(11,8)" db:"longitude"`
-	Province        string            `gorm:"type:varchar(255)" db:"province"`
-	Country         string            `gorm:"type:varchar(255)" db:"country" json:"country"`
-	LocationType    string            `gorm:"type:varchar(50)" db:"location_type" json:"locationType"`
-	Audience        string            `gorm:"type:varchar(50)" db:"audience" json:"audience"`
-	PriceType       string            `gorm:"type:varchar(50)" db:"price_type" json:"priceType"`
-	RegisterLink    string            `gorm:"type:varchar(255)" db:"register_link"`
-	Status          string            `gorm:"type:varchar(50)" db:"status"`
-	ContactChannels []ContactChannel  `gorm:"foreignKey:EventID;references:ID" db:"contact_channels"`
-	Categories      []Category        `gorm:"many2many:category_event;"`
-	OrganizationID  uint              `gorm:"not null" db:"organization_id"`
-	Organization    Organization      `gorm:"foreignKey:OrganizationID;constraint:onUpdate:CASCADE,onDelete:CASCADE;" db:"organizations"`
-	TicketAvailable []TicketAvailable `gorm:"foreignKey:EventID;constraint:onUpdate:CASCADE,onDelete:CASCADE;" db:"ticket_available"`
+	Name            string             `gorm:"type:varchar(255);not null" db:"event_name"`
+	PicUrl          string             `gorm:"type:text" db:"pic_url"`
+	StartDate       utils.DateOnly     `gorm:"type:date;not null" db:"start_date"`
+	EndDate         utils.DateOnly     `gorm:"type:date" db:"end_date"`
+	StartTime       utils.TimeOnly     `gorm:"type:time without time zone" db:"start_time"`
+	EndTime         utils.TimeOnly     `gorm:"type:time without time zone" db:"end_time"`
+	Timezone        string             `gorm:"type:varchar(64);not null;default:'Asia/Bangkok'" db:"timezone"` // IANA zone of the dates and times above
+	StartAt         *time.Time         `gorm:"index" db:"start_at"`                                            // Derived UTC instant
+	EndAt           *time.Time         `gorm:"index" db:"end_at"`                                              // Derived UTC instant, exclusive for all day events
+	Content         string             `gorm:"type:text" db:"content"`
+	ContentFormat   richtext.Format    `gorm:"type:varchar(20);not null;default:'html'" db:"content_format"` // Format the content is authored in
+	ContentHTML     string             `gorm:"type:text" db:"content_html"`                                  // Sanitized rendering of the content
+	LocationName    string             `gorm:"type:varchar(255)" db:"location_name"`
+	Latitude        float64            `gorm:"type:decimal(10,8)" db:"latitude"`
+	Longitude       float64            `gorm:"type:decimal(11,8)" db:"longitude"`
+	Province        string             `gorm:"type:varchar(255)" db:"province"`
+	Country         string             `gorm:"type:varchar(255)" db:"country" json:"country"`
+	LocationType    string             `gorm:"type:varchar(50)" db:"location_type" json:"locationType"`
+	Audience        string             `gorm:"type:varchar(50)" db:"audience" json:"audience"`
+	PriceType       string             `gorm:"type:varchar(50)" db:"price_type" json:"priceType"`
+	RegisterLink    string             `gorm:"type:varchar(255)" db:"register_link"`
+	Status          string             `gorm:"type:varchar(50)" db:"status"`
+	RecurrenceRule  string             `gorm:"type:varchar(255)" db:"recurrence_rule"` // RFC 5545 RRULE the generated sessions follow
+	Hidden          bool               `gorm:"not null;default:false" db:"hidden"`     // Hidden by moderation, left out of search results
+	Sessions        []EventSession     `gorm:"foreignKey:EventID;constraint:onUpdate:CASCADE,onDelete:CASCADE;" db:"sessions"`
+	AgendaItems     []AgendaItem       `gorm:"foreignKey:EventID;constraint:onUpdate:CASCADE,onDelete:CASCADE;" db:"agenda_items"`
+	Speakers        []EventSpeaker     `gorm:"foreignKey:EventID;constraint:onUpdate:CASCADE,onDelete:CASCADE;" db:"speakers"`
+	Materials       []EventMaterial    `gorm:"foreignKey:EventID;constraint:onUpdate:CASCADE,onDelete:CASCADE;" db:"materials"`
+	ContactChannels []ContactChannel   `gorm:"foreignKey:EventID;references:ID" db:"contact_channels"`
+	Categories      []Category         `gorm:"many2many:category_event;"`
+	OrganizationID  uint               `gorm:"not null" db:"organization_id"`
+	Organization    Organization       `gorm:"foreignKey:OrganizationID;constraint:onUpdate:CASCADE,onDelete:CASCADE;" db:"organizations"`
+	CoHosts         []EventCoHost      `gorm:"foreignKey:EventID;constraint:onUpdate:CASCADE,onDelete:CASCADE;" db:"co_hosts"` // Partner organizations hosting the event with its organizer
+	TicketAvailable []TicketAvailable  `gorm:"foreignKey:EventID;constraint:onUpdate:CASCADE,onDelete:CASCADE;" db:"ticket_available"`
+	Translations    []EventTranslation `gorm:"foreignKey:EventID;constraint:onUpdate:CASCADE,onDelete:CASCADE;" db:"translations"`
 }
 
 type TicketAvailable struct {
@@ -99,18 +119,31 @@ type TicketAvailable struct {
 
 type TicketAvailableRepository interface {
 	GetByID(id uint) (*TicketAvailable, error)
+	GetByIDAndEventID(id uint, eventID uint) (*TicketAvailable, error)
 	GetAll() ([]TicketAvailable, error)
+	GetAllByEventID(eventID uint) ([]TicketAvailable, error)
 	Create(ticketAvailable *TicketAvailable) error
-	// Update(ticketAvailable *TicketAvailable) error
-	// Delete(id uint) error
+	Update(ticketAvailable *TicketAvailable) error
+	Delete(id uint) error
+	CountPurchases(id uint) (int64, error)
 }
 
-type TicketAvailableService interface {
-	GetByID(id uint) (*TicketAvailable, error)
-	GetAll() ([]TicketAvailable, error)
-	Create(ticketAvailable *TicketAvailable) error
-	// Update(ticketAvailable *TicketAvailable) error
-	// Delete(id uint) error
+type TicketPurchasedRepository interface {
+	// Register takes one seat of the ticket type and creates the purchase in a single transaction,
+	// a non-empty promo code is redeemed in the same transaction
+	Register(purchase *TicketPurchased, promoCode string) error
+	GetByID(id uint) (*TicketPurchased, error)
+	GetByIDAndUserID(id uint, userID uuid.UUID) (*TicketPurchased, error)
+	GetActiveByUserAndEvent(userID uuid.UUID, eventID uint) (*TicketPurchased, error)
+	GetByQrcode(qrcode string) (*TicketPurchased, error)
+	UpdateQrcode(id uint, qrcode string) error
+	// CheckIn marks the ticket and its event participant as attended, once
+	CheckIn(id uint) (*TicketPurchased, error)
+	CountCheckInsByEventID(eventID uint) ([]TicketCheckInCount, error)
+	// Cancel frees the seat of the ticket, it is offered to the waitlist first and the entry offered is returned
+	Cancel(id uint, offerExpiresAt time.Time) (*WaitlistEntry, error)
+	ListByUserID(userID uuid.UUID) ([]TicketPurchased, error)
+	ListByEventID(eventID uint) ([]TicketPurchased, error)
 }
 
 // ----------- Mock Event ----------- //
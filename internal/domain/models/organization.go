@@ -1,6 +1,7 @@
 package models
 
 import (
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/pkg/richtext"
 	"gorm.io/gorm"
 )
 
@@ -13,6 +14,8 @@ type WorkType string
 type Workplace string
 type CareerStage string
 type JobStatus string
+type SalaryPeriod string
+type OrganizationStatus string
 
 const (
 	// Media Enum
@@ -52,37 +55,53 @@ const (
 	JobStatusArchived  JobStatus = "archived"
 )
 
+const (
+	OrganizationStatusPending  OrganizationStatus = "pending"
+	OrganizationStatusApproved OrganizationStatus = "approved"
+)
+
+const (
+	SalaryPeriodHourly  SalaryPeriod = "hourly"
+	SalaryPeriodMonthly SalaryPeriod = "monthly"
+	SalaryPeriodYearly  SalaryPeriod = "yearly"
+)
+
 //---------------------------------------------------------------------------
 // Models
 //---------------------------------------------------------------------------
 
 type Organization struct {
 	gorm.Model
-	Email                string                `gorm:"type:varchar(255);unique" db:"email"` // Email address (unique constraint)
-	Phone                string                `gorm:"type:varchar(20)" db:"phone"`
-	Name                 string                `gorm:"type:varchar(255);not null" db:"orgName"`
-	PicUrl               string                `gorm:"type:varchar(255)" db:"picUrl"`
-	BgUrl                string                `gorm:"type:varchar(255)" db:"bg_url"`
-	HeadLine             string                `gorm:"type:varchar(255)" db:"headline"`
-	Specialty            string                `gorm:"type:varchar(255)" db:"specialty"` // Organization's area of expertise
-	Description          string                `gorm:"type:text" db:"description"`
-	Address              string                `gorm:"type:varchar(255)" db:"address"` // General location
-	Province             string                `gorm:"type:varchar(255)" db:"province"`
-	Country              string                `gorm:"type:varchar(255)" db:"country"`
-	Latitude             float64               `gorm:"type:decimal(10,8)" db:"latitude"`  // Geographic latitude (stored as string for precision)
-	Longitude            float64               `gorm:"type:decimal(11,8)" db:"longitude"` // Geographic longitude (stored as string for precision)
-	Status               string                `gorm:"type:varchar(50);default:'pending'" db:"status"`
-	OrganizationContacts []OrganizationContact `gorm:"foreignKey:OrganizationID;constraint:onUpdate:CASCADE,onDelete:CASCADE;"`
-	OrgOpenJobs          []OrgOpenJob          `gorm:"foreignKey:OrganizationID;constraint:onUpdate:CASCADE,onDelete:CASCADE;"`
-	OrgMembers           []RoleInOrganization  `gorm:"foreignKey:OrganizationID;constraint:onUpdate:CASCADE,onDelete:CASCADE;"`
-	OrgEvents            []Event               `gorm:"foreignKey:OrganizationID;constraint:onUpdate:CASCADE,onDelete:CASCADE;"`
-	Industries           []*Industry           `gorm:"many2many:organization_industry;"`
+	Email                string                    `gorm:"type:varchar(255);unique" db:"email"` // Email address (unique constraint)
+	Phone                string                    `gorm:"type:varchar(20)" db:"phone"`
+	Name                 string                    `gorm:"type:varchar(255);not null" db:"orgName"`
+	PicUrl               string                    `gorm:"type:varchar(255)" db:"picUrl"`
+	BgUrl                string                    `gorm:"type:varchar(255)" db:"bg_url"`
+	HeadLine             string                    `gorm:"type:varchar(255)" db:"headline"`
+	Specialty            string                    `gorm:"type:varchar(255)" db:"specialty"` // Organization's area of expertise
+	Description          string                    `gorm:"type:text" db:"description"`
+	DescriptionFormat    richtext.Format           `gorm:"type:varchar(20);not null;default:'html'" db:"description_format"` // Format the description is authored in
+	DescriptionHTML      string                    `gorm:"type:text" db:"description_html"`                                  // Sanitized rendering of the description
+	Address              string                    `gorm:"type:varchar(255)" db:"address"`                                   // General location
+	Province             string                    `gorm:"type:varchar(255)" db:"province"`
+	Country              string                    `gorm:"type:varchar(255)" db:"country"`
+	Latitude             float64                   `gorm:"type:decimal(10,8)" db:"latitude"`  // Geographic latitude (stored as string for precision)
+	Longitude            float64                   `gorm:"type:decimal(11,8)" db:"longitude"` // Geographic longitude (stored as string for precision)
+	Status               string                    `gorm:"type:varchar(50);default:'pending'" db:"status"`
+	Suspended            bool                      `gorm:"not null;default:false" db:"suspended"` // Suspended by moderation, its events and jobs are left out of search results
+	OrganizationContacts []OrganizationContact     `gorm:"foreignKey:OrganizationID;constraint:onUpdate:CASCADE,onDelete:CASCADE;"`
+	OrgOpenJobs          []OrgOpenJob              `gorm:"foreignKey:OrganizationID;constraint:onUpdate:CASCADE,onDelete:CASCADE;"`
+	OrgMembers           []RoleInOrganization      `gorm:"foreignKey:OrganizationID;constraint:onUpdate:CASCADE,onDelete:CASCADE;"`
+	OrgEvents            []Event                   `gorm:"foreignKey:OrganizationID;constraint:onUpdate:CASCADE,onDelete:CASCADE;"`
+	Industries           []*Industry               `gorm:"many2many:organization_industry;"`
+	Translations         []OrganizationTranslation `gorm:"foreignKey:OrganizationID;constraint:onUpdate:CASCADE,onDelete:CASCADE;"`
 }
 
 type Industry struct {
 	gorm.Model
-	Industry      string          `gorm:"type:varchar(255);not null" json:"industry"`
-	Organizations []*Organization `gorm:"many2many:organization_industry;constraint:onUpdate:CASCADE,onDelete:CASCADE;"`
+	Industry      string                `gorm:"type:varchar(255);not null" json:"industry"`
+	Organizations []*Organization       `gorm:"many2many:organization_industry;constraint:onUpdate:CASCADE,onDelete:CASCADE;"`
+	Translations  []IndustryTranslation `gorm:"foreignKey:IndustryID;constraint:onUpdate:CASCADE,onDelete:CASCADE;" json:"translations"`
 }
 
 type OrganizationIndustry struct {
@@ -99,25 +118,36 @@ type OrganizationContact struct {
 
 type OrgOpenJob struct {
 	gorm.Model
-	OrganizationID uint           `gorm:"not null" json:"organizationId" example:"1"`
-	Organization   Organization   `gorm:"foreignKey:OrganizationID" json:"organization"`
-	Title          string         `gorm:"type:varchar(255);not null" json:"title" example:"Software Engineer"`
-	PicUrl         string         `gorm:"type:varchar(255)" db:"picUrl"`
-	Description    string         `gorm:"type:text" json:"description" example:"This is a description"`
-	Workplace      Workplace      `gorm:"type:workplace;not null"`
-	WorkType       WorkType       `gorm:"type:work_type;not null"`
-	CareerStage    CareerStage    `gorm:"type:career_stage;not null" json:"careerStage" example:"entrylevel"`
-	Province       string         `gorm:"type:varchar(255)" json:"province" example:"Chiang Mai"`
-	Country        string         `gorm:"type:varchar(255)" json:"country" example:"TH"`
-	Scope          string         `gorm:"type:varchar(255)"`
-	Period         string         `gorm:"type:varchar(255)" json:"period" example:"1 year"`
-	Qualifications string         `gorm:"type:text" json:"qualifications" example:"Bachelor's degree in Computer Science"`
-	Salary         float64        `gorm:"type:decimal(10,2)" json:"salary" example:"30000"`
-	Quantity       int            `json:"quantity" example:"1"`
-	RegisterLink   string         `gorm:"type:text" db:"register_link"`
-	Status         string         `gorm:"type:varchar(50);default:'draft'" json:"status" example:"draft"`
-	Prerequisites  []Prerequisite `gorm:"foreignKey:JobID;constraint:onUpdate:CASCADE,onDelete:CASCADE;"` // Job prerequisites
-	Categories     []Category     `gorm:"many2many:category_job;constraint:OnDelete:CASCADE;"`
+	OrganizationID     uint                    `gorm:"not null" json:"organizationId" example:"1"`
+	Organization       Organization            `gorm:"foreignKey:OrganizationID" json:"organization"`
+	Title              string                  `gorm:"type:varchar(255);not null" json:"title" example:"Software Engineer"`
+	PicUrl             string                  `gorm:"type:varchar(255)" db:"picUrl"`
+	Description        string                  `gorm:"type:text" json:"description" example:"This is a description"`
+	Workplace          Workplace               `gorm:"type:workplace;not null"`
+	WorkType           WorkType                `gorm:"type:work_type;not null"`
+	CareerStage        CareerStage             `gorm:"type:career_stage;not null" json:"careerStage" example:"entrylevel"`
+	Province           string                  `gorm:"type:varchar(255)" json:"province" example:"Chiang Mai"`
+	Country            string                  `gorm:"type:varchar(255)" json:"country" example:"TH"`
+	Scope              string                  `gorm:"type:varchar(255)"`
+	Period             string                  `gorm:"type:varchar(255)" json:"period" example:"1 year"`
+	Qualifications     string                  `gorm:"type:text" json:"qualifications" example:"Bachelor's degree in Computer Science"`
+	TextFormat         richtext.Format         `gorm:"type:varchar(20);not null;default:'html'" json:"textFormat" example:"html"` // Format the description and qualifications are authored in
+	DescriptionHTML    string                  `gorm:"type:text" json:"descriptionHtml"`                                          // Sanitized rendering of the description
+	QualificationsHTML string                  `gorm:"type:text" json:"qualificationsHtml"`                                       // Sanitized rendering of the qualifications
+	SalaryMin          float64                 `gorm:"type:decimal(12,2)" json:"salaryMin" example:"25000"`
+	SalaryMax          float64                 `gorm:"type:decimal(12,2)" json:"salaryMax" example:"35000"`
+	SalaryCurrency     string                  `gorm:"type:varchar(3);default:'THB'" json:"salaryCurrency" example:"THB"` // ISO 4217 currency code
+	SalaryPeriod       SalaryPeriod            `gorm:"type:varchar(20);default:'monthly'" json:"salaryPeriod" example:"monthly"`
+	SalaryNegotiable   bool                    `gorm:"default:false" json:"salaryNegotiable" example:"false"`
+	SalaryHidden       bool                    `gorm:"default:false" json:"salaryHidden" example:"false"` // Amounts are not shown publicly
+	Quantity           int                     `json:"quantity" example:"1"`
+	RegisterLink       string                  `gorm:"type:text" db:"register_link"`
+	Status             string                  `gorm:"type:varchar(50);default:'draft'" json:"status" example:"draft"`
+	Hidden             bool                    `gorm:"not null;default:false" json:"hidden" example:"false"`           // Hidden by moderation, left out of search results
+	Prerequisites      []Prerequisite          `gorm:"foreignKey:JobID;constraint:onUpdate:CASCADE,onDelete:CASCADE;"` // Job prerequisites
+	Categories         []Category              `gorm:"many2many:category_job;constraint:OnDelete:CASCADE;"`
+	Skills             []Skill                 `gorm:"many2many:job_skill;constraint:OnDelete:CASCADE;"` // Required skills
+	Translations       []OrgOpenJobTranslation `gorm:"foreignKey:JobID;constraint:onUpdate:CASCADE,onDelete:CASCADE;" json:"translations"`
 }
 
 type Prerequisite struct {
@@ -0,0 +1,73 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type OrderStatus string
+
+const (
+	OrderStatusPending   OrderStatus = "pending"
+	OrderStatusPaid      OrderStatus = "paid"
+	OrderStatusCancelled OrderStatus = "cancelled"
+	OrderStatusRefunded  OrderStatus = "refunded"
+)
+
+type PaymentStatus string
+
+const (
+	PaymentStatusPending   PaymentStatus = "pending"
+	PaymentStatusSucceeded PaymentStatus = "succeeded"
+	PaymentStatusFailed    PaymentStatus = "failed"
+	PaymentStatusRefunded  PaymentStatus = "refunded"
+)
+
+// Order is what a user owes for a paid ticket, the seat is held until ExpiresAt.
+type Order struct {
+	gorm.Model
+	UserID            uuid.UUID       `gorm:"type:uuid;not null;index" json:"userId"`
+	EventID           uint            `gorm:"not null;index" json:"eventId"`
+	Event             Event           `gorm:"foreignKey:EventID;constraint:onUpdate:CASCADE,onDelete:CASCADE;" json:"event"`
+	TicketPurchasedID uint            `gorm:"not null;index" json:"ticketPurchasedId"`
+	TicketPurchased   TicketPurchased `gorm:"foreignKey:TicketPurchasedID;constraint:onUpdate:CASCADE,onDelete:CASCADE;" json:"ticketPurchased"`
+	Amount            float64         `gorm:"not null;check:amount >= 0" json:"amount"`
+	Currency          string          `gorm:"type:varchar(3);not null;default:'THB'" json:"currency"`
+	Status            OrderStatus     `gorm:"type:varchar(20);not null;default:'pending'" json:"status"`
+	ExpiresAt         time.Time       `gorm:"not null" json:"expiresAt"`
+	PaidAt            *time.Time      `json:"paidAt"`
+	Payments          []Payment       `gorm:"foreignKey:OrderID;constraint:onUpdate:CASCADE,onDelete:CASCADE;" json:"payments"`
+}
+
+// Payment is one attempt to pay an order through a payment provider.
+type Payment struct {
+	gorm.Model
+	OrderID        uint          `gorm:"not null;index" json:"orderId"`
+	Provider       string        `gorm:"type:varchar(50);not null;uniqueIndex:idx_payment_provider_ref" json:"provider"`
+	ProviderRef    string        `gorm:"type:varchar(255);not null;uniqueIndex:idx_payment_provider_ref" json:"providerRef"`
+	Amount         float64       `gorm:"not null" json:"amount"`
+	Status         PaymentStatus `gorm:"type:varchar(20);not null;default:'pending'" json:"status"`
+	QRPayload      string        `gorm:"type:text" json:"qrPayload"`
+	RefundedAmount float64       `gorm:"not null;default:0" json:"refundedAmount"`
+	RefundedAt     *time.Time    `json:"refundedAt"`
+	ManualRefund   bool          `gorm:"not null;default:false" json:"manualRefund"` // The organizer has to transfer the refund by hand
+	LastWebhook    string        `gorm:"type:text" json:"-"`
+}
+
+type OrderRepository interface {
+	Create(order *Order, payment *Payment) error
+	AddPayment(payment *Payment) error
+	GetByID(id uint) (*Order, error)
+	GetByIDAndUserID(id uint, userID uuid.UUID) (*Order, error)
+	GetPendingByPurchaseID(purchaseID uint) (*Order, error)
+	GetPaymentByProviderRef(provider string, providerRef string) (*Payment, error)
+	ListByEventID(eventID uint) ([]Order, error)
+	// MarkPaid settles the order and confirms its ticket, it reports whether the payment was still pending
+	MarkPaid(paymentID uint, webhook string) (*Order, bool, error)
+	MarkFailed(paymentID uint, webhook string) error
+	// Refund cancels the ticket of a paid order, its seat goes back to the waitlist or the quantity
+	Refund(orderID uint, refundedAmount float64, manual bool, offerExpiresAt time.Time) (*WaitlistEntry, error)
+	ListExpiredPendingPurchaseIDs(before time.Time) ([]uint, error)
+}
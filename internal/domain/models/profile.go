@@ -8,24 +8,31 @@ import (
 )
 
 type Profile struct {
-	ID          uint           `gorm:"primaryKey;autoIncrement" db:"id"`
-	HeadLine    string         `gorm:"type:varchar(255)" db:"headline"`
-	FirstName   string         `gorm:"type:varchar(100)" db:"fname"`
-	LastName    string         `gorm:"type:varchar(100)" db:"lname"`
-	Email       string         `gorm:"type:varchar(255)" db:"email"`
-	Phone       string         `gorm:"type:varchar(20)" db:"phone"`
-	PicUrl      string         `gorm:"type:varchar(255)" db:"pic_url"`
-	Bio         string         `gorm:"type:text" db:"bio"`
-	Skill       string         `gorm:"type:varchar(255)" db:"skill"`
-	Language    string         `gorm:"type:varchar(255)" db:"language"`
-	Education   string         `gorm:"type:varchar(255)" db:"education"`
-	FocusField  string         `gorm:"type:varchar(255)" db:"focusField"` //field of expertise
-	CreatedAt   time.Time      `gorm:"autoCreateTime" db:"created_at"`
-	UpdatedAt   time.Time      `gorm:"autoUpdateTime" db:"updated_at"`
-	DeletedAt   gorm.DeletedAt `gorm:"index" db:"deleted_at"`
-	UserID      uuid.UUID      `gorm:"type:uuid;not null" db:"user_id"`
-	User        User           `gorm:"foreignKey:UserID;constraint:onUpdate:CASCADE,onDelete:CASCADE;"`    // One-to-One relationship (has one, use UserID as foreign key)
-	Experiences []Experience   `gorm:"foreignKey:ProfileID;constraint:onUpdate:CASCADE,onDelete:CASCADE;"` // One-to-Many relationship (has many)
+	ID              uint           `gorm:"primaryKey;autoIncrement" db:"id"`
+	HeadLine        string         `gorm:"type:varchar(255)" db:"headline"`
+	FirstName       string         `gorm:"type:varchar(100)" db:"fname"`
+	LastName        string         `gorm:"type:varchar(100)" db:"lname"`
+	Email           string         `gorm:"type:varchar(255)" db:"email"`
+	Phone           string         `gorm:"type:varchar(20)" db:"phone"`
+	PicUrl          string         `gorm:"type:varchar(255)" db:"pic_url"`
+	Bio             string         `gorm:"type:text" db:"bio"`
+	Skill           string         `gorm:"type:varchar(255)" db:"skill"`
+	Language        string         `gorm:"type:varchar(255)" db:"language"`
+	Education       string         `gorm:"type:varchar(255)" db:"education"`
+	FocusField      string         `gorm:"type:varchar(255)" db:"focusField"` //field of expertise
+	Province        string         `gorm:"type:varchar(255)" db:"province"`
+	IsPublic        bool           `gorm:"default:false;not null" db:"is_public"`          // Public profiles are opt-in
+	PublicSlug      *string        `gorm:"type:varchar(100);uniqueIndex" db:"public_slug"` // Stays the same until the owner changes it
+	HideEmail       bool           `gorm:"default:true;not null" db:"hide_email"`
+	HidePhone       bool           `gorm:"default:true;not null" db:"hide_phone"`
+	HideExperiences bool           `gorm:"default:false;not null" db:"hide_experiences"`
+	CreatedAt       time.Time      `gorm:"autoCreateTime" db:"created_at"`
+	UpdatedAt       time.Time      `gorm:"autoUpdateTime" db:"updated_at"`
+	DeletedAt       gorm.DeletedAt `gorm:"index" db:"deleted_at"`
+	UserID          uuid.UUID      `gorm:"type:uuid;not null" db:"user_id"`
+	User            User           `gorm:"foreignKey:UserID;constraint:onUpdate:CASCADE,onDelete:CASCADE;"`    // One-to-One relationship (has one, use UserID as foreign key)
+	Experiences     []Experience   `gorm:"foreignKey:ProfileID;constraint:onUpdate:CASCADE,onDelete:CASCADE;"` // One-to-Many relationship (has many)
+	Skills          []Skill        `gorm:"many2many:profile_skill;"`
 }
 
 type Experience struct {
@@ -42,23 +49,15 @@ type Experience struct {
 	DeletedAt   gorm.DeletedAt `gorm:"index"`
 }
 
-//---------------------------------------------------------------------------
-// Services
-//---------------------------------------------------------------------------
-
-// ProfileService
-type ProfileService interface {
-	Create(profile *Profile) error
-	Update(profile *Profile) error
-}
-
-// ExperienceService
-type ExperienceService interface {
-	CreateExperience(experience *Experience) error
-	ListExperiencesByUserID(userID uuid.UUID) ([]Experience, error)
-	GetExperienceByID(experienceID uuid.UUID) (*Experience, error)
-	UpdateExperience(experience *Experience) error
-	DeleteExperience(experienceID uuid.UUID) error
+// ProfileView records an organization looking at a public profile, so that the candidate can see who looked.
+type ProfileView struct {
+	ID             uint         `gorm:"primaryKey;autoIncrement" db:"id"`
+	ProfileID      uint         `gorm:"not null;index" db:"profile_id"`
+	Profile        Profile      `gorm:"foreignKey:ProfileID;constraint:onUpdate:CASCADE,onDelete:CASCADE;"`
+	OrganizationID uint         `gorm:"not null;index" db:"organization_id"`
+	Organization   Organization `gorm:"foreignKey:OrganizationID;constraint:onUpdate:CASCADE,onDelete:CASCADE;"`
+	ViewerID       uuid.UUID    `gorm:"type:uuid;not null" db:"viewer_id"` // The member of the organization who looked
+	CreatedAt      time.Time    `gorm:"autoCreateTime;index" db:"created_at"`
 }
 
 //---------------------------------------------------------------------------
@@ -69,12 +68,19 @@ type ProfileRepository interface {
 	Create(profile *Profile) error
 	Update(profile *Profile) error
 	GetByUserID(userID uuid.UUID) (*Profile, error)
+	GetPublicBySlug(slug string) (*Profile, error)
+	GetAllPublic() ([]Profile, error)
+	UpdateVisibility(profile *Profile) error
+	CreateView(view *ProfileView) error
+	ListViewsByUserID(userID uuid.UUID) ([]ProfileView, error)
 }
 
 type ExperienceRepository interface {
 	GetByID(experienceID uuid.UUID) (*Experience, error)
+	GetByIDAndUserID(experienceID uuid.UUID, userID uuid.UUID) (*Experience, error)
 	GetByUserID(userID uuid.UUID) ([]Experience, error)
 	Create(experience *Experience) error
 	Update(experience *Experience) error
+	UpdatePicture(experienceID uuid.UUID, picURL string) error
 	Delete(experienceID uuid.UUID) error
 }
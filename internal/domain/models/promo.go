@@ -0,0 +1,70 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type DiscountType string
+
+const (
+	DiscountPercent DiscountType = "percent"
+	DiscountFixed   DiscountType = "fixed"
+)
+
+// PromoCode discounts the paid tickets of an event. Codes are stored in upper case and matched case-insensitively.
+type PromoCode struct {
+	gorm.Model
+	EventID        uint              `gorm:"not null;uniqueIndex:idx_promo_code_event" json:"eventId"`
+	Event          Event             `gorm:"foreignKey:EventID;constraint:onUpdate:CASCADE,onDelete:CASCADE;" json:"-"`
+	Code           string            `gorm:"type:varchar(50);not null;uniqueIndex:idx_promo_code_event" json:"code" example:"EARLYBIRD"`
+	DiscountType   DiscountType      `gorm:"type:varchar(20);not null" json:"discountType" example:"percent"`
+	DiscountValue  float64           `gorm:"not null" json:"discountValue" example:"20"`
+	Tickets        []TicketAvailable `gorm:"many2many:promo_code_tickets;constraint:onUpdate:CASCADE,onDelete:CASCADE;" json:"tickets"` // None applies to every ticket type of the event
+	MaxUses        *int              `json:"maxUses"`                                                                                   // Nil is unlimited
+	MaxUsesPerUser int               `gorm:"not null;default:1" json:"maxUsesPerUser"`                                                  // Zero is unlimited
+	UsedCount      int               `gorm:"not null;default:0" json:"usedCount"`
+	StartsAt       *time.Time        `json:"startsAt"`
+	ExpiresAt      *time.Time        `json:"expiresAt"`
+	IsActive       bool              `gorm:"not null;default:true" json:"isActive"`
+}
+
+// PromoRedemption records one use of a promo code. The use is given back when the ticket is cancelled.
+type PromoRedemption struct {
+	gorm.Model
+	PromoCodeID       uint            `gorm:"not null;index" json:"promoCodeId"`
+	PromoCode         PromoCode       `gorm:"foreignKey:PromoCodeID;constraint:onUpdate:CASCADE,onDelete:CASCADE;" json:"promoCode"`
+	EventID           uint            `gorm:"not null;index" json:"eventId"`
+	UserID            uuid.UUID       `gorm:"type:uuid;not null;index" json:"userId"`
+	User              User            `gorm:"foreignKey:UserID;constraint:onUpdate:CASCADE,onDelete:CASCADE;" json:"user"`
+	TicketPurchasedID uint            `gorm:"not null;uniqueIndex" json:"ticketPurchasedId"`
+	TicketPurchased   TicketPurchased `gorm:"foreignKey:TicketPurchasedID;constraint:onUpdate:CASCADE,onDelete:CASCADE;" json:"ticketPurchased"`
+	OriginalPrice     float64         `gorm:"not null" json:"originalPrice"`
+	DiscountAmount    float64         `gorm:"not null" json:"discountAmount"`
+	ReleasedAt        *time.Time      `json:"releasedAt"`
+}
+
+// PromoCodeUsage sums the redemptions of one promo code.
+type PromoCodeUsage struct {
+	PromoCodeID   uint
+	Redemptions   int64
+	Released      int64
+	TotalDiscount float64
+	Revenue       float64
+}
+
+type PromoCodeRepository interface {
+	Create(promo *PromoCode) error
+	GetByIDAndEventID(id uint, eventID uint) (*PromoCode, error)
+	ListByEventID(eventID uint) ([]PromoCode, error)
+	// Update replaces the settings and the ticket types of the code
+	Update(promo *PromoCode) (*PromoCode, error)
+	// Delete removes a code that was never redeemed
+	Delete(id uint) error
+	// Quote checks that the user can use the code for the ticket type, and returns it with the discount on the price
+	Quote(eventID uint, ticketAvailableID uint, userID uuid.UUID, code string, price float64) (*PromoCode, float64, error)
+	ListRedemptionsByEventID(eventID uint) ([]PromoRedemption, error)
+	CountUsageByEventID(eventID uint) ([]PromoCodeUsage, error)
+}
@@ -0,0 +1,83 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// NotificationPreference holds the mails a user agreed to receive, a user without one receives them all.
+type NotificationPreference struct {
+	gorm.Model
+	UserID             uuid.UUID `gorm:"type:uuid;not null;uniqueIndex" json:"userId"`
+	User               User      `gorm:"foreignKey:UserID;constraint:onUpdate:CASCADE,onDelete:CASCADE;" json:"-"`
+	EventReminders     bool      `gorm:"not null;default:true" json:"eventReminders"`
+	EventAnnouncements bool      `gorm:"not null;default:true" json:"eventAnnouncements"`
+	DiscussionReplies  bool      `gorm:"not null;default:true" json:"discussionReplies"`
+}
+
+type ReminderKind string
+
+const (
+	ReminderDayBefore  ReminderKind = "24h"
+	ReminderHourBefore ReminderKind = "1h"
+)
+
+// EventReminder records a reminder mailed for a ticket, so each one is sent once.
+type EventReminder struct {
+	gorm.Model
+	TicketPurchasedID uint            `gorm:"not null;uniqueIndex:idx_event_reminder_ticket_kind" json:"ticketPurchasedId"`
+	TicketPurchased   TicketPurchased `gorm:"foreignKey:TicketPurchasedID;constraint:onUpdate:CASCADE,onDelete:CASCADE;" json:"-"`
+	Kind              ReminderKind    `gorm:"type:varchar(10);not null;uniqueIndex:idx_event_reminder_ticket_kind" json:"kind"`
+}
+
+type AnnouncementStatus string
+
+const (
+	AnnouncementStatusScheduled AnnouncementStatus = "scheduled"
+	AnnouncementStatusSending   AnnouncementStatus = "sending"
+	AnnouncementStatusSent      AnnouncementStatus = "sent"
+	AnnouncementStatusCancelled AnnouncementStatus = "cancelled"
+)
+
+// EventAnnouncement is a mail from the organizers to every participant of an event, sent now or at ScheduledAt.
+type EventAnnouncement struct {
+	gorm.Model
+	EventID     uint               `gorm:"not null;index" json:"eventId"`
+	Event       Event              `gorm:"foreignKey:EventID;constraint:onUpdate:CASCADE,onDelete:CASCADE;" json:"-"`
+	Subject     string             `gorm:"type:varchar(255);not null" json:"subject"`
+	Message     string             `gorm:"type:text;not null" json:"message"`
+	ScheduledAt time.Time          `gorm:"not null;index" json:"scheduledAt"`
+	Status      AnnouncementStatus `gorm:"type:varchar(20);not null;default:'scheduled';index" json:"status"`
+	SentAt      *time.Time         `json:"sentAt"`
+	Recipients  int                `gorm:"not null;default:0" json:"recipients"`
+}
+
+type NotificationPreferenceRepository interface {
+	// GetByUserID returns the preference of the user, the defaults when none was saved
+	GetByUserID(userID uuid.UUID) (*NotificationPreference, error)
+	Upsert(preference *NotificationPreference) error
+}
+
+type EventReminderRepository interface {
+	// ListDue lists the confirmed tickets of published events starting within (from, to] that have not had
+	// the reminder, leaving out users who turned reminders off
+	ListDue(kind ReminderKind, from time.Time, to time.Time) ([]TicketPurchased, error)
+	// Claim records the reminder before it is mailed, false when another run already did
+	Claim(ticketPurchasedID uint, kind ReminderKind) (bool, error)
+	// Release forgets a claimed reminder whose mail failed, so the next run tries again
+	Release(ticketPurchasedID uint, kind ReminderKind) error
+}
+
+type EventAnnouncementRepository interface {
+	Create(announcement *EventAnnouncement) error
+	ListByEventID(eventID uint) ([]EventAnnouncement, error)
+	// Cancel stops an announcement that is still scheduled
+	Cancel(id uint, eventID uint) error
+	// ClaimDue marks the scheduled announcements due at now as sending and returns them with their event
+	ClaimDue(now time.Time) ([]EventAnnouncement, error)
+	MarkSent(id uint, recipients int, sentAt time.Time) error
+	// ListRecipients lists the registrations of the event whose users accept announcements
+	ListRecipients(eventID uint) ([]TicketPurchased, error)
+}
@@ -0,0 +1,108 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type ReportTarget string
+type ReportReason string
+type ReportStatus string
+type ModerationAction string
+
+const (
+	ReportEvent        ReportTarget = "event"
+	ReportJob          ReportTarget = "job"
+	ReportOrganization ReportTarget = "organization"
+	ReportQuestion     ReportTarget = "question" // Question of a discussion
+	ReportAnswer       ReportTarget = "answer"   // Answer of a discussion
+)
+
+const (
+	ReasonSpam          ReportReason = "spam"
+	ReasonScam          ReportReason = "scam"
+	ReasonInappropriate ReportReason = "inappropriate"
+	ReasonHarassment    ReportReason = "harassment"
+	ReasonMisleading    ReportReason = "misleading"
+	ReasonOther         ReportReason = "other"
+)
+
+const (
+	ReportPending   ReportStatus = "pending"   // Waiting in the moderation queue
+	ReportDismissed ReportStatus = "dismissed" // Nothing was wrong with the content
+	ReportActioned  ReportStatus = "actioned"  // The content was hidden, its organization suspended or its owner warned
+)
+
+const (
+	ActionDismiss  ModerationAction = "dismiss"
+	ActionHide     ModerationAction = "hide"
+	ActionSuspend  ModerationAction = "suspend" // Suspends the organization of the content
+	ActionWarn     ModerationAction = "warn"    // Mails a warning to the owner of the content
+	ActionAutoHide ModerationAction = "auto_hide"
+)
+
+// ContentReport is a report of public content by a user, each user reports a content once.
+type ContentReport struct {
+	gorm.Model
+	TargetType ReportTarget `gorm:"type:varchar(20);not null;uniqueIndex:idx_report_reporter;index:idx_report_target" json:"targetType"`
+	TargetID   uint         `gorm:"not null;uniqueIndex:idx_report_reporter;index:idx_report_target" json:"targetId"`
+	ReporterID uuid.UUID    `gorm:"type:uuid;not null;uniqueIndex:idx_report_reporter" json:"reporterId"`
+	Reporter   User         `gorm:"foreignKey:ReporterID;constraint:onUpdate:CASCADE,onDelete:CASCADE;" json:"-"`
+	Reason     ReportReason `gorm:"type:varchar(20);not null" json:"reason"`
+	Details    string       `gorm:"type:text" json:"details"`
+	Status     ReportStatus `gorm:"type:varchar(20);not null;default:'pending';index" json:"status"`
+	ResolvedAt *time.Time   `json:"resolvedAt"`
+}
+
+// ModerationLog records every action taken on reported content, automatic ones have no moderator.
+type ModerationLog struct {
+	gorm.Model
+	TargetType  ReportTarget     `gorm:"type:varchar(20);not null;index:idx_moderation_target" json:"targetType"`
+	TargetID    uint             `gorm:"not null;index:idx_moderation_target" json:"targetId"`
+	Action      ModerationAction `gorm:"type:varchar(20);not null" json:"action"`
+	ModeratorID *uuid.UUID       `gorm:"type:uuid" json:"moderatorId"`
+	Moderator   *User            `gorm:"foreignKey:ModeratorID;constraint:onUpdate:CASCADE,onDelete:SET NULL;" json:"-"`
+	Note        string           `gorm:"type:text" json:"note"`
+	Reports     int              `gorm:"not null;default:0" json:"reports"` // Number of pending reports the action resolved
+}
+
+// ReportedContent is a content of the moderation queue with the count of its pending reports.
+type ReportedContent struct {
+	TargetType     ReportTarget
+	TargetID       uint
+	Reports        int64
+	LastReportedAt time.Time
+}
+
+// ModeratedContent holds the reported content, only the field of its target type is set.
+type ModeratedContent struct {
+	Event        *Event
+	Job          *OrgOpenJob
+	Organization *Organization
+	Question     *DiscussionQuestion
+	Answer       *DiscussionAnswer
+}
+
+// ModerationChange is what an action changes on the content besides its reports.
+type ModerationChange struct {
+	Hidden                *bool // Hides the content or shows it again
+	SuspendOrganizationID uint  // Organization to suspend, 0 for none
+}
+
+type ContentReportRepository interface {
+	// Create records the report and returns the number of pending reports on the content
+	Create(report *ContentReport) (int64, error)
+	// GetContent loads the reported content with its organization or author
+	GetContent(targetType ReportTarget, targetID uint) (*ModeratedContent, error)
+	// ListQueue lists the contents with pending reports, the most reported first
+	ListQueue(targetType ReportTarget) ([]ReportedContent, error)
+	// ListPending lists the pending reports on the content with their reporter
+	ListPending(targetType ReportTarget, targetID uint) ([]ContentReport, error)
+	// ListLogs lists the actions taken on the content, newest first
+	ListLogs(targetType ReportTarget, targetID uint) ([]ModerationLog, error)
+	// Moderate applies the change and records the log in a single transaction. Unless status is empty,
+	// the pending reports on the content are resolved with it and returned with their reporter
+	Moderate(log *ModerationLog, change ModerationChange, status ReportStatus) ([]ContentReport, error)
+}
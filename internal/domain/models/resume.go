@@ -0,0 +1,39 @@
+package models
+
+import (
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type ApplicationStatus string
+
+const (
+	ApplicationStatusSubmitted ApplicationStatus = "submitted"
+	ApplicationStatusWithdrawn ApplicationStatus = "withdrawn"
+)
+
+// Resume is one uploaded version of a user's CV. The file is stored privately and only served through presigned URLs.
+type Resume struct {
+	gorm.Model
+	UserID        uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_resume_user_version" json:"userId"`
+	User          User      `gorm:"foreignKey:UserID;constraint:onUpdate:CASCADE,onDelete:CASCADE;" json:"-"`
+	Version       int       `gorm:"not null;uniqueIndex:idx_resume_user_version" json:"version" example:"1"`
+	FileName      string    `gorm:"type:varchar(255);not null" json:"fileName" example:"resume.pdf"`
+	ContentType   string    `gorm:"type:varchar(100);not null" json:"contentType" example:"application/pdf"`
+	Size          int64     `gorm:"not null" json:"size" example:"102400"`
+	ObjectKey     string    `gorm:"type:varchar(255);not null" json:"-"`
+	ExtractedText string    `gorm:"type:text" json:"-"`
+}
+
+// JobApplication attaches a resume to an open job, which is what lets the organization see it.
+type JobApplication struct {
+	gorm.Model
+	JobID       uint              `gorm:"not null;uniqueIndex:idx_job_application_user" json:"jobId"`
+	Job         OrgOpenJob        `gorm:"foreignKey:JobID;constraint:onUpdate:CASCADE,onDelete:CASCADE;" json:"job"`
+	UserID      uuid.UUID         `gorm:"type:uuid;not null;uniqueIndex:idx_job_application_user" json:"userId"`
+	User        User              `gorm:"foreignKey:UserID;constraint:onUpdate:CASCADE,onDelete:CASCADE;" json:"user"`
+	ResumeID    *uint             `json:"resumeId"`
+	Resume      *Resume           `gorm:"foreignKey:ResumeID;constraint:onUpdate:CASCADE,onDelete:SET NULL;" json:"resume"`
+	CoverLetter string            `gorm:"type:text" json:"coverLetter"`
+	Status      ApplicationStatus `gorm:"type:varchar(20);default:'submitted'" json:"status" example:"submitted"`
+}
@@ -0,0 +1,48 @@
+package models
+
+import (
+	"time"
+
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/utils"
+	"gorm.io/gorm"
+)
+
+// EventSession is one meeting of an event that runs more than once, such as a weekly workshop or a day of an
+// incubation program. Sessions are either listed one by one or created from the recurrence rule of the event.
+type EventSession struct {
+	gorm.Model
+	EventID      uint           `gorm:"not null;index" db:"event_id"`
+	Event        Event          `gorm:"foreignKey:EventID;constraint:onUpdate:CASCADE,onDelete:CASCADE;" db:"event"`
+	Title        string         `gorm:"type:varchar(255)" db:"title"`
+	StartDate    utils.DateOnly `gorm:"type:date;not null" db:"start_date"`
+	EndDate      utils.DateOnly `gorm:"type:date" db:"end_date"`
+	StartTime    utils.TimeOnly `gorm:"type:time without time zone" db:"start_time"`
+	EndTime      utils.TimeOnly `gorm:"type:time without time zone" db:"end_time"`
+	StartAt      *time.Time     `gorm:"index" db:"start_at"` // Derived UTC instant, in the zone of the event
+	EndAt        *time.Time     `gorm:"index" db:"end_at"`   // Derived UTC instant
+	LocationName string         `gorm:"type:varchar(255)" db:"location_name"`
+	Latitude     float64        `gorm:"type:decimal(10,8)" db:"latitude"`
+	Longitude    float64        `gorm:"type:decimal(11,8)" db:"longitude"`
+	Province     string         `gorm:"type:varchar(255)" db:"province"`
+	LocationType string         `gorm:"type:varchar(50)" db:"location_type"`
+	// RecurrenceID is the original start of a session created from the rule, nil for sessions added by hand
+	RecurrenceID *time.Time `gorm:"index" db:"recurrence_id"`
+	// IsDetached marks an occurrence edited on its own, edits of the whole series and new rules leave it alone
+	IsDetached  bool `gorm:"not null;default:false" db:"is_detached"`
+	IsCancelled bool `gorm:"not null;default:false" db:"is_cancelled"`
+}
+
+type EventSessionRepository interface {
+	ListByEventID(eventID uint) ([]EventSession, error)
+	GetByIDAndEventID(id uint, eventID uint) (*EventSession, error)
+	Create(session *EventSession) error
+	Update(session *EventSession) error
+	// UpdateSeries saves the sessions of an edit of the whole series in a single transaction
+	UpdateSeries(sessions []EventSession) error
+	Delete(id uint) error
+	// ReplaceRule stores the rule of the event and replaces the sessions created from the previous rule,
+	// detached occurrences are kept and their starts are not created again
+	ReplaceRule(eventID uint, rule string, sessions []EventSession) ([]EventSession, error)
+	// DeleteSeries removes the rule of the event and every session created from it, sessions added by hand are kept
+	DeleteSeries(eventID uint) error
+}
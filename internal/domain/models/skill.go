@@ -0,0 +1,25 @@
+package models
+
+import (
+	"gorm.io/gorm"
+)
+
+type Skill struct {
+	gorm.Model
+	Name      string         `gorm:"type:varchar(255);not null" db:"name"`
+	Slug      string         `gorm:"type:varchar(255);uniqueIndex" db:"slug"` // Stable identifier used by clients and in the search index
+	ParentID  *uint          `gorm:"index" db:"parent_id"`                    // Broader skill, e.g. "Programming" → "Go"
+	Parent    *Skill         `gorm:"foreignKey:ParentID"`
+	SubSkills []Skill        `gorm:"foreignKey:ParentID"`
+	Synonyms  []SkillSynonym `gorm:"foreignKey:SkillID;constraint:onUpdate:CASCADE,onDelete:CASCADE;"`
+	IsActive  bool           `gorm:"default:true" db:"is_active"`
+	Profiles  []Profile      `gorm:"many2many:profile_skill;"`
+	Jobs      []OrgOpenJob   `gorm:"many2many:job_skill;"`
+}
+
+// SkillSynonym is an alternative name of a skill, e.g. "golang" for "Go".
+type SkillSynonym struct {
+	gorm.Model
+	SkillID uint   `gorm:"not null;index" db:"skill_id"`
+	Name    string `gorm:"type:varchar(255);not null;index" db:"name"`
+}
@@ -0,0 +1,100 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type SurveyQuestionType string
+
+const (
+	SurveyQuestionRating SurveyQuestionType = "rating" // 1 to 5 stars
+	SurveyQuestionChoice SurveyQuestionType = "choice" // One of the options
+	SurveyQuestionText   SurveyQuestionType = "text"
+)
+
+const (
+	MinSurveyRating = 1
+	MaxSurveyRating = 5
+)
+
+// EventSurvey is the feedback form of an event, mailed to the participants checked in once the event has ended.
+type EventSurvey struct {
+	gorm.Model
+	EventID            uint             `gorm:"not null;uniqueIndex" json:"eventId"`
+	Event              Event            `gorm:"foreignKey:EventID;constraint:onUpdate:CASCADE,onDelete:CASCADE;" json:"-"`
+	Title              string           `gorm:"type:varchar(255);not null" json:"title"`
+	Description        string           `gorm:"type:text" json:"description"`
+	ShowRatingPublicly bool             `gorm:"not null;default:false" json:"showRatingPublicly"` // The average rating appears on the event and organization pages
+	SentAt             *time.Time       `json:"sentAt"`                                           // Set once the invitations are mailed
+	Questions          []SurveyQuestion `gorm:"foreignKey:SurveyID;constraint:onUpdate:CASCADE,onDelete:CASCADE;" json:"questions"`
+}
+
+type SurveyQuestion struct {
+	gorm.Model
+	SurveyID uint                   `gorm:"not null;index" json:"surveyId"`
+	Position int                    `gorm:"not null;default:0" json:"position"`
+	Type     SurveyQuestionType     `gorm:"type:varchar(20);not null" json:"type"`
+	Prompt   string                 `gorm:"type:varchar(500);not null" json:"prompt"`
+	Required bool                   `gorm:"not null;default:false" json:"required"`
+	Options  []SurveyQuestionOption `gorm:"foreignKey:QuestionID;constraint:onUpdate:CASCADE,onDelete:CASCADE;" json:"options"`
+}
+
+type SurveyQuestionOption struct {
+	gorm.Model
+	QuestionID uint   `gorm:"not null;index" json:"questionId"`
+	Position   int    `gorm:"not null;default:0" json:"position"`
+	Label      string `gorm:"type:varchar(255);not null" json:"label"`
+}
+
+// SurveyResponse is the feedback of one participant, each participant answers once.
+type SurveyResponse struct {
+	gorm.Model
+	SurveyID uint           `gorm:"not null;uniqueIndex:idx_survey_response_user" json:"surveyId"`
+	Survey   EventSurvey    `gorm:"foreignKey:SurveyID;constraint:onUpdate:CASCADE,onDelete:CASCADE;" json:"-"`
+	EventID  uint           `gorm:"not null;index" json:"eventId"`
+	UserID   uuid.UUID      `gorm:"type:uuid;not null;uniqueIndex:idx_survey_response_user" json:"userId"`
+	User     User           `gorm:"foreignKey:UserID;constraint:onUpdate:CASCADE,onDelete:CASCADE;" json:"-"`
+	Answers  []SurveyAnswer `gorm:"foreignKey:ResponseID;constraint:onUpdate:CASCADE,onDelete:CASCADE;" json:"answers"`
+}
+
+type SurveyAnswer struct {
+	gorm.Model
+	ResponseID uint   `gorm:"not null;index" json:"responseId"`
+	QuestionID uint   `gorm:"not null;index" json:"questionId"`
+	Rating     *int   `json:"rating"`
+	OptionID   *uint  `json:"optionId"`
+	Text       string `gorm:"type:text" json:"text"`
+}
+
+// SurveyRating is the average of the rating answers of an event.
+type SurveyRating struct {
+	EventID   uint
+	EventName string
+	Responses int64
+	Ratings   int64
+	Average   float64
+}
+
+type EventSurveyRepository interface {
+	GetByEventID(eventID uint) (*EventSurvey, error)
+	// Save creates the survey of the event or replaces it with its questions
+	Save(survey *EventSurvey) error
+	// UpdateDetails changes the survey but keeps its questions, the only change allowed once it is answered
+	UpdateDetails(survey *EventSurvey) error
+	Delete(eventID uint) error
+	CountResponses(surveyID uint) (int64, error)
+	// ClaimEnded marks the unsent surveys of events ended before now as sent and returns them with their event
+	ClaimEnded(now time.Time) ([]EventSurvey, error)
+	// ListCheckedIn lists the participants of the event who were checked in, with their user
+	ListCheckedIn(eventID uint) ([]EventParticipant, error)
+	IsCheckedIn(userID uuid.UUID, eventID uint) (bool, error)
+	CreateResponse(response *SurveyResponse) error
+	GetResponse(surveyID uint, userID uuid.UUID) (*SurveyResponse, error)
+	ListResponses(surveyID uint) ([]SurveyResponse, error)
+	// RatingsByOrgID averages the rating answers of each event of the organization that has a survey
+	RatingsByOrgID(orgID uint, publicOnly bool) ([]SurveyRating, error)
+	RatingByEventID(eventID uint) (*SurveyRating, error)
+}
@@ -0,0 +1,35 @@
+package models
+
+import (
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type OrgTemplateKind string
+
+const (
+	EventTemplate OrgTemplateKind = "event"
+	JobTemplate   OrgTemplateKind = "job"
+)
+
+// OrgTemplate is an event or a job saved by an organization to start new drafts from.
+// Content holds the JSON of the saved Event or OrgOpenJob, its pictures are copies owned by the template.
+type OrgTemplate struct {
+	gorm.Model
+	OrganizationID uint            `gorm:"not null;index" json:"organizationId"`
+	Organization   Organization    `gorm:"foreignKey:OrganizationID;constraint:onUpdate:CASCADE,onDelete:CASCADE;" json:"-"`
+	Kind           OrgTemplateKind `gorm:"type:varchar(20);not null" json:"kind"`
+	Name           string          `gorm:"type:varchar(255);not null" json:"name"`
+	Title          string          `gorm:"type:varchar(255)" json:"title"` // Name of the event or title of the job
+	PicUrl         string          `gorm:"type:text" json:"picUrl"`
+	Content        string          `gorm:"type:text;not null" json:"-"`
+	CreatedBy      uuid.UUID       `gorm:"type:uuid;not null" json:"createdBy"`
+}
+
+type OrgTemplateRepository interface {
+	Create(template *OrgTemplate) error
+	// ListByOrgID lists the templates of the organization, newest first, an empty kind lists all of them
+	ListByOrgID(orgID uint, kind OrgTemplateKind) ([]OrgTemplate, error)
+	GetByID(orgID uint, id uint) (*OrgTemplate, error)
+	Delete(orgID uint, id uint) error
+}
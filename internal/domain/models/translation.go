@@ -0,0 +1,51 @@
+package models
+
+import (
+	"gorm.io/gorm"
+)
+
+// The translations hold the texts of a content in another locale than the one it was authored in,
+// a text left empty falls back to the authored one. Each content has one translation per locale.
+
+type EventTranslation struct {
+	gorm.Model
+	EventID     uint   `gorm:"not null;uniqueIndex:idx_event_translation" json:"eventId"`
+	Locale      string `gorm:"type:varchar(5);not null;uniqueIndex:idx_event_translation" json:"locale"`
+	Name        string `gorm:"type:varchar(255)" json:"name"`
+	Content     string `gorm:"type:text" json:"content"`
+	ContentHTML string `gorm:"type:text" json:"contentHtml"` // Sanitized rendering of the content, in the format of the event
+}
+
+type OrgOpenJobTranslation struct {
+	gorm.Model
+	JobID              uint   `gorm:"not null;uniqueIndex:idx_job_translation" json:"jobId"`
+	Locale             string `gorm:"type:varchar(5);not null;uniqueIndex:idx_job_translation" json:"locale"`
+	Title              string `gorm:"type:varchar(255)" json:"title"`
+	Description        string `gorm:"type:text" json:"description"`
+	DescriptionHTML    string `gorm:"type:text" json:"descriptionHtml"`
+	Qualifications     string `gorm:"type:text" json:"qualifications"`
+	QualificationsHTML string `gorm:"type:text" json:"qualificationsHtml"`
+}
+
+type OrganizationTranslation struct {
+	gorm.Model
+	OrganizationID  uint   `gorm:"not null;uniqueIndex:idx_organization_translation" json:"organizationId"`
+	Locale          string `gorm:"type:varchar(5);not null;uniqueIndex:idx_organization_translation" json:"locale"`
+	Name            string `gorm:"type:varchar(255)" json:"name"`
+	Description     string `gorm:"type:text" json:"description"`
+	DescriptionHTML string `gorm:"type:text" json:"descriptionHtml"`
+}
+
+type CategoryTranslation struct {
+	gorm.Model
+	CategoryID uint   `gorm:"not null;uniqueIndex:idx_category_translation" json:"categoryId"`
+	Locale     string `gorm:"type:varchar(5);not null;uniqueIndex:idx_category_translation" json:"locale"`
+	Name       string `gorm:"type:varchar(255);not null" json:"name"`
+}
+
+type IndustryTranslation struct {
+	gorm.Model
+	IndustryID uint   `gorm:"not null;uniqueIndex:idx_industry_translation" json:"industryId"`
+	Locale     string `gorm:"type:varchar(5);not null;uniqueIndex:idx_industry_translation" json:"locale"`
+	Name       string `gorm:"type:varchar(255);not null" json:"name"`
+}
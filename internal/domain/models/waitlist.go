@@ -0,0 +1,54 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type WaitlistStatus string
+
+const (
+	WaitlistStatusWaiting WaitlistStatus = "waiting"
+	WaitlistStatusOffered WaitlistStatus = "offered" // A seat is held for the entry until the offer expires
+	WaitlistStatusClaimed WaitlistStatus = "claimed"
+	WaitlistStatusExpired WaitlistStatus = "expired"
+	WaitlistStatusLeft    WaitlistStatus = "left"
+)
+
+// WaitlistEntry queues a user for a sold out ticket type, freed seats are offered by ascending position.
+type WaitlistEntry struct {
+	gorm.Model
+	TicketAvailableID uint            `gorm:"not null;index" json:"ticketAvailableId"`
+	TicketAvailable   TicketAvailable `gorm:"foreignKey:TicketAvailableID;constraint:onUpdate:CASCADE,onDelete:CASCADE;" json:"ticketAvailable"`
+	EventID           uint            `gorm:"not null;index" json:"eventId"`
+	Event             Event           `gorm:"foreignKey:EventID;constraint:onUpdate:CASCADE,onDelete:CASCADE;" json:"event"`
+	UserID            uuid.UUID       `gorm:"type:uuid;not null;index" json:"userId"`
+	User              User            `gorm:"foreignKey:UserID;constraint:onUpdate:CASCADE,onDelete:CASCADE;" json:"user"`
+	Position          int             `gorm:"not null" json:"position"`
+	Status            WaitlistStatus  `gorm:"type:varchar(20);not null;default:'waiting'" json:"status"`
+	OfferToken        *uuid.UUID      `gorm:"type:uuid;uniqueIndex" json:"-"`
+	OfferedAt         *time.Time      `json:"offeredAt"`
+	OfferExpiresAt    *time.Time      `json:"offerExpiresAt"`
+	TicketPurchasedID *uint           `json:"ticketPurchasedId"`
+}
+
+type WaitlistRepository interface {
+	// Join appends the entry at the end of the waitlist, only while the ticket type is sold out
+	Join(entry *WaitlistEntry) error
+	GetByID(id uint) (*WaitlistEntry, error)
+	GetByIDAndUserID(id uint, userID uuid.UUID) (*WaitlistEntry, error)
+	GetByOfferToken(token uuid.UUID) (*WaitlistEntry, error)
+	GetActiveByUserAndTicket(userID uuid.UUID, ticketAvailableID uint) (*WaitlistEntry, error)
+	ListActiveByTicketID(ticketAvailableID uint) ([]WaitlistEntry, error)
+	ListByUserID(userID uuid.UUID) ([]WaitlistEntry, error)
+	// Leave removes the entry, a seat held for it goes to the next entry
+	Leave(id uint, offerExpiresAt time.Time) (*WaitlistEntry, error)
+	Reorder(ticketAvailableID uint, entryIDs []uint) error
+	// Promote offers a seat to the entry out of order, adding a seat when none is free
+	Promote(id uint, offerExpiresAt time.Time) (*WaitlistEntry, error)
+	Claim(token uuid.UUID, userID uuid.UUID, purchase *TicketPurchased) error
+	// ExpireOffers passes the seats of the offers expired before now to the next entries, and returns the new offers
+	ExpireOffers(now time.Time, offerExpiresAt time.Time) ([]WaitlistEntry, error)
+}
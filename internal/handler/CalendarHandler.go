@@ -0,0 +1,221 @@
+package handler
+
+import (
+	"fmt"
+
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/errs"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/infrastructure/calendar"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/service"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/utils"
+	"github.com/gofiber/fiber/v2"
+)
+
+type CalendarHandler struct {
+	service service.CalendarService
+}
+
+func NewCalendarHandler(service service.CalendarService) *CalendarHandler {
+	return &CalendarHandler{service: service}
+}
+
+// @Summary Download the calendar entry of an event
+// @Description iCalendar (.ics) file of a published event, times are in Asia/Bangkok
+// @Tags Event Calendars
+// @Produce text/calendar
+// @Param id path int true "Event ID"
+// @Success 200 {file} binary
+// @Failure 400 {object} map[string]string "error: event id is required"
+// @Failure 404 {object} map[string]string "error: event not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /events/{id}/calendar.ics [get]
+func (h *CalendarHandler) GetEventCalendar(c *fiber.Ctx) error {
+	eventID, err := utils.GetParamFormFiberCtx(c, "id", "event")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	ics, err := h.service.EventCalendar(eventID)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return sendCalendar(c, ics, fmt.Sprintf("event-%d.ics", eventID), "public, max-age=900")
+}
+
+// @Summary Subscribe to the events of an organization
+// @Description iCalendar feed of the published events of an organization, to subscribe to with webcal://
+// @Tags Event Calendars
+// @Produce text/calendar
+// @Param orgID path int true "Organization ID"
+// @Success 200 {file} binary
+// @Failure 400 {object} map[string]string "error: organization id is required"
+// @Failure 404 {object} map[string]string "error: organization not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /orgs/{orgID}/calendar.ics [get]
+func (h *CalendarHandler) GetOrganizationCalendar(c *fiber.Ctx) error {
+	orgID, err := utils.GetOrgIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	ics, err := h.service.OrganizationCalendar(orgID)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return sendCalendar(c, ics, fmt.Sprintf("organization-%d.ics", orgID), "public, max-age=900")
+}
+
+// @Summary Personal calendar feed
+// @Description iCalendar feed of the events the user is registered for or has saved. The token in the URL is the only credential
+// @Tags Event Calendars
+// @Produce text/calendar
+// @Param token path string true "Calendar feed token"
+// @Success 200 {file} binary
+// @Failure 404 {object} map[string]string "error: calendar not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /calendar/users/{token}.ics [get]
+func (h *CalendarHandler) GetUserCalendar(c *fiber.Ctx) error {
+	ics, err := h.service.UserCalendar(c.Params("token"))
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return sendCalendar(c, ics, "my-events.ics", "private, max-age=900")
+}
+
+// @Summary Get my calendar feed
+// @Description Get the URLs of the personal calendar feed of the current user
+// @Tags Event Calendars
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} dto.CalendarFeedResponse
+// @Failure 401 {object} map[string]string "error: unauthorized"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /users/me/calendar [get]
+func (h *CalendarHandler) GetMyCalendarFeed(c *fiber.Ctx) error {
+	userID, err := utils.GetUserIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	feed, err := h.service.GetMyCalendarFeed(userID)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(feed)
+}
+
+// @Summary Reset my calendar feed
+// @Description Replace the secret token of the personal calendar feed, the previous URL stops working
+// @Tags Event Calendars
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} dto.CalendarFeedResponse
+// @Failure 401 {object} map[string]string "error: unauthorized"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /users/me/calendar/reset [post]
+func (h *CalendarHandler) ResetMyCalendarFeed(c *fiber.Ctx) error {
+	userID, err := utils.GetUserIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	feed, err := h.service.ResetMyCalendarFeed(userID)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(feed)
+}
+
+// @Summary List my saved events
+// @Description List the events saved by the current user
+// @Tags Event Calendars
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} []dto.EventResponses
+// @Failure 401 {object} map[string]string "error: unauthorized"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /users/me/saved-events [get]
+func (h *CalendarHandler) ListSavedEvents(c *fiber.Ctx) error {
+	userID, err := utils.GetUserIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	events, err := h.service.ListSavedEvents(userID)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(events)
+}
+
+// @Summary Save an event
+// @Description Save a published event, saved events are added to the personal calendar feed
+// @Tags Event Calendars
+// @Produce json
+// @Security BearerAuth
+// @Param eventID path int true "Event ID"
+// @Success 200 {object} map[string]string "message: event saved successfully"
+// @Failure 400 {object} map[string]string "error: event id is required"
+// @Failure 401 {object} map[string]string "error: unauthorized"
+// @Failure 404 {object} map[string]string "error: event not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /users/me/saved-events/{eventID} [put]
+func (h *CalendarHandler) SaveEvent(c *fiber.Ctx) error {
+	userID, err := utils.GetUserIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	eventID, err := utils.GetParamFormFiberCtx(c, "eventID", "event")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	if err := h.service.SaveEvent(userID, eventID); err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{"message": "event saved successfully"})
+}
+
+// @Summary Unsave an event
+// @Description Remove an event from the saved events of the current user
+// @Tags Event Calendars
+// @Produce json
+// @Security BearerAuth
+// @Param eventID path int true "Event ID"
+// @Success 200 {object} map[string]string "message: event removed from saved events"
+// @Failure 400 {object} map[string]string "error: event id is required"
+// @Failure 401 {object} map[string]string "error: unauthorized"
+// @Failure 404 {object} map[string]string "error: saved event not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /users/me/saved-events/{eventID} [delete]
+func (h *CalendarHandler) UnsaveEvent(c *fiber.Ctx) error {
+	userID, err := utils.GetUserIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	eventID, err := utils.GetParamFormFiberCtx(c, "eventID", "event")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	if err := h.service.UnsaveEvent(userID, eventID); err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{"message": "event removed from saved events"})
+}
+
+func sendCalendar(c *fiber.Ctx, ics []byte, fileName string, cacheControl string) error {
+	c.Set(fiber.HeaderContentType, calendar.ContentType)
+	c.Set(fiber.HeaderContentDisposition, fmt.Sprintf(`inline; filename="%s"`, fileName))
+	c.Set(fiber.HeaderCacheControl, cacheControl)
+	return c.Status(fiber.StatusOK).Send(ics)
+}
@@ -0,0 +1,280 @@
+package handler
+
+import (
+	"fmt"
+
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/errs"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/dto"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/models"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/infrastructure/certificate"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/service"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/utils"
+	"github.com/gofiber/fiber/v2"
+)
+
+type CertificateHandler struct {
+	service service.CertificateService
+}
+
+func NewCertificateHandler(service service.CertificateService) *CertificateHandler {
+	return &CertificateHandler{service: service}
+}
+
+// @Summary Get the certificate template of an event
+// @Description Get the design of the participation certificates of an event of the organization
+// @Tags Organization Event Certificates
+// @Produce json
+// @Security BearerAuth
+// @Param orgID path int true "Organization ID"
+// @Param id path int true "Event ID"
+// @Success 200 {object} dto.CertificateTemplateResponse
+// @Failure 400 {object} map[string]string "error: event id is required"
+// @Failure 403 {object} map[string]string "error: You are not authorized"
+// @Failure 404 {object} map[string]string "error: certificate template not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /admin/orgs/{orgID}/events/{id}/certificates/template [get]
+func (h *CertificateHandler) GetTemplate(c *fiber.Ctx) error {
+	orgID, err := utils.GetOrgIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	eventID, err := utils.GetParamFormFiberCtx(c, "id", "event")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	template, err := h.service.GetTemplate(orgID, eventID)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(template)
+}
+
+// @Summary Create or replace the certificate template of an event
+// @Description Set the layout of the participation certificates of an event. Each field is a line of text placed in percent of the page, {name}, {event}, {date}, {organization} and {code} are filled in for each participant.
+// @Description Without fields a standard layout is used. The background and signature images are uploaded separately and stay when the layout changes
+// @Tags Organization Event Certificates
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param orgID path int true "Organization ID"
+// @Param id path int true "Event ID"
+// @Param body body dto.CertificateTemplateRequest true "Certificate template"
+// @Success 200 {object} dto.CertificateTemplateResponse
+// @Failure 400 {object} map[string]string "error: Invalid request body"
+// @Failure 403 {object} map[string]string "error: You are not authorized"
+// @Failure 404 {object} map[string]string "error: event not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /admin/orgs/{orgID}/events/{id}/certificates/template [put]
+func (h *CertificateHandler) SaveTemplate(c *fiber.Ctx) error {
+	orgID, err := utils.GetOrgIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	eventID, err := utils.GetParamFormFiberCtx(c, "id", "event")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	var req dto.CertificateTemplateRequest
+	if err := utils.ParseJSONAndValidate(c, &req); err != nil {
+		return err
+	}
+
+	template, err := h.service.SaveTemplate(orgID, eventID, req)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(template)
+}
+
+// @Summary Upload an image of the certificate template of an event
+// @Description Upload the background, covering the whole page, or the signature of the certificates of an event as a JPEG or PNG of at most 10 MB. The template must be saved first
+// @Tags Organization Event Certificates
+// @Accept multipart/form-data
+// @Produce json
+// @Security BearerAuth
+// @Param orgID path int true "Organization ID"
+// @Param id path int true "Event ID"
+// @Param image path string true "Image of the template" Enums(background, signature)
+// @Param image formData file true "Image"
+// @Success 200 {object} dto.CertificateTemplateResponse
+// @Failure 400 {object} map[string]string "error: Failed to get image from form"
+// @Failure 403 {object} map[string]string "error: You are not authorized"
+// @Failure 404 {object} map[string]string "error: certificate template not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /admin/orgs/{orgID}/events/{id}/certificates/template/{image} [put]
+func (h *CertificateHandler) UploadTemplateImage(c *fiber.Ctx) error {
+	orgID, err := utils.GetOrgIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	eventID, err := utils.GetParamFormFiberCtx(c, "id", "event")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	image := models.CertificateImage(c.Params("image"))
+	if image != models.CertificateBackground && image != models.CertificateSignature {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "image must be background or signature"})
+	}
+
+	file, fileHeader, err := utils.UploadImage(c)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+	defer file.Close()
+
+	template, err := h.service.UploadTemplateImage(c.Context(), orgID, eventID, image, file, fileHeader)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(template)
+}
+
+// @Summary Preview the certificate of an event
+// @Description Render the certificate template of an event as a PDF for a sample participant
+// @Tags Organization Event Certificates
+// @Produce application/pdf
+// @Security BearerAuth
+// @Param orgID path int true "Organization ID"
+// @Param id path int true "Event ID"
+// @Success 200 {file} file "Certificate"
+// @Failure 400 {object} map[string]string "error: event id is required"
+// @Failure 403 {object} map[string]string "error: You are not authorized"
+// @Failure 404 {object} map[string]string "error: certificate template not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /admin/orgs/{orgID}/events/{id}/certificates/preview [get]
+func (h *CertificateHandler) PreviewCertificate(c *fiber.Ctx) error {
+	orgID, err := utils.GetOrgIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	eventID, err := utils.GetParamFormFiberCtx(c, "id", "event")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	file, err := h.service.PreviewCertificate(c.Context(), orgID, eventID)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	c.Set(fiber.HeaderContentType, certificate.ContentType)
+	c.Set(fiber.HeaderContentDisposition, fmt.Sprintf(`inline; filename="%s"`, file.FileName))
+	c.Set(fiber.HeaderCacheControl, "private, no-store")
+	return c.Status(fiber.StatusOK).Send(file.Data)
+}
+
+// @Summary Issue the certificates of an event
+// @Description Generate the certificates of the participants checked in at the event who have none yet, store them and email them to the participants.
+// @Description The certificates are generated in the background, the response tells how many are pending. Issuing again only certifies participants checked in since
+// @Tags Organization Event Certificates
+// @Produce json
+// @Security BearerAuth
+// @Param orgID path int true "Organization ID"
+// @Param id path int true "Event ID"
+// @Success 202 {object} dto.CertificateIssueResponse
+// @Failure 400 {object} map[string]string "error: event id is required"
+// @Failure 403 {object} map[string]string "error: You are not authorized"
+// @Failure 404 {object} map[string]string "error: certificate template not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /admin/orgs/{orgID}/events/{id}/certificates/issue [post]
+func (h *CertificateHandler) IssueCertificates(c *fiber.Ctx) error {
+	orgID, err := utils.GetOrgIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	eventID, err := utils.GetParamFormFiberCtx(c, "id", "event")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	res, err := h.service.IssueCertificates(c.Context(), orgID, eventID)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusAccepted).JSON(res)
+}
+
+// @Summary List the certificates of an event
+// @Description List the certificates issued to the participants of an event of the organization
+// @Tags Organization Event Certificates
+// @Produce json
+// @Security BearerAuth
+// @Param orgID path int true "Organization ID"
+// @Param id path int true "Event ID"
+// @Success 200 {array} dto.CertificateResponse
+// @Failure 400 {object} map[string]string "error: event id is required"
+// @Failure 403 {object} map[string]string "error: You are not authorized"
+// @Failure 404 {object} map[string]string "error: event not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /admin/orgs/{orgID}/events/{id}/certificates [get]
+func (h *CertificateHandler) ListCertificates(c *fiber.Ctx) error {
+	orgID, err := utils.GetOrgIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	eventID, err := utils.GetParamFormFiberCtx(c, "id", "event")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	certificates, err := h.service.ListCertificates(orgID, eventID)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(certificates)
+}
+
+// @Summary List my certificates
+// @Description List the participation certificates issued to the current user, newest first
+// @Tags Certificates
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {array} dto.CertificateResponse
+// @Failure 401 {object} map[string]string "error: Unauthorized"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /users/me/certificates [get]
+func (h *CertificateHandler) ListMyCertificates(c *fiber.Ctx) error {
+	userID, err := utils.GetUserIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	certificates, err := h.service.ListMyCertificates(userID)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(certificates)
+}
+
+// @Summary Verify a certificate
+// @Description Check that a certificate was issued by its code, printed at the bottom of the certificate
+// @Tags Certificates
+// @Produce json
+// @Param code path string true "Certificate code" example(K7QX-2M9P-VH4D)
+// @Success 200 {object} dto.CertificateVerificationResponse
+// @Failure 404 {object} map[string]string "error: certificate not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /certificates/{code} [get]
+func (h *CertificateHandler) VerifyCertificate(c *fiber.Ctx) error {
+	certificate, err := h.service.VerifyCertificate(c.Params("code"))
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(certificate)
+}
@@ -0,0 +1,226 @@
+package handler
+
+import (
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/errs"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/dto"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/service"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/utils"
+	"github.com/gofiber/fiber/v2"
+)
+
+type CoHostHandler struct {
+	service service.CoHostService
+}
+
+func NewCoHostHandler(service service.CoHostService) *CoHostHandler {
+	return &CoHostHandler{service: service}
+}
+
+// @Summary Invite an organization to co-host an event
+// @Description Invite a partner organization to host the event with its organizer. Once an owner of the invited organization accepts, the event is listed under both organizations and their moderators can edit it
+// @Tags Organization Event Co-hosts
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param orgID path int true "Organization ID of the organizer"
+// @Param id path int true "Event ID"
+// @Param body body dto.CoHostInviteRequest true "Invited organization"
+// @Success 201 {object} dto.EventCoHostResponse
+// @Failure 400 {object} map[string]string "error: the organizer of the event cannot co-host it"
+// @Failure 401 {object} map[string]string "error: Unauthorized"
+// @Failure 403 {object} map[string]string "error: only the organizer of the event can invite co-hosts"
+// @Failure 404 {object} map[string]string "error: organization not found"
+// @Failure 409 {object} map[string]string "error: the organization is already invited to co-host the event"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /admin/orgs/{orgID}/events/{id}/co-hosts [post]
+func (h *CoHostHandler) InviteCoHost(c *fiber.Ctx) error {
+	userID, err := utils.GetUserIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	orgID, err := utils.GetOrgIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	eventID, err := utils.GetParamFormFiberCtx(c, "id", "event")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	var req dto.CoHostInviteRequest
+	if err := utils.ParseJSONAndValidate(c, &req); err != nil {
+		return err
+	}
+
+	coHost, err := h.service.InviteCoHost(userID, orgID, eventID, req)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(coHost)
+}
+
+// @Summary List the co-hosts of an event
+// @Description List the organizations invited to co-host an event or hosting it, for its organizer and its co-hosts
+// @Tags Organization Event Co-hosts
+// @Produce json
+// @Security BearerAuth
+// @Param orgID path int true "Organization ID"
+// @Param id path int true "Event ID"
+// @Success 200 {array} dto.EventCoHostResponse
+// @Failure 400 {object} map[string]string "error: event id is required"
+// @Failure 403 {object} map[string]string "error: You are not authorized"
+// @Failure 404 {object} map[string]string "error: event not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /admin/orgs/{orgID}/events/{id}/co-hosts [get]
+func (h *CoHostHandler) ListCoHosts(c *fiber.Ctx) error {
+	orgID, err := utils.GetOrgIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	eventID, err := utils.GetParamFormFiberCtx(c, "id", "event")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	coHosts, err := h.service.ListCoHosts(orgID, eventID)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(coHosts)
+}
+
+// @Summary Remove a co-host of an event
+// @Description The organizer removes a co-host or withdraws an invitation, a co-host leaves the event by removing its own organization
+// @Tags Organization Event Co-hosts
+// @Produce json
+// @Security BearerAuth
+// @Param orgID path int true "Organization ID"
+// @Param id path int true "Event ID"
+// @Param coHostOrgID path int true "Organization ID of the co-host"
+// @Success 200 {object} map[string]string "message: co-host removed successfully"
+// @Failure 400 {object} map[string]string "error: the organizer of the event cannot be removed"
+// @Failure 403 {object} map[string]string "error: only the organizer of the event can remove other co-hosts"
+// @Failure 404 {object} map[string]string "error: co-host not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /admin/orgs/{orgID}/events/{id}/co-hosts/{coHostOrgID} [delete]
+func (h *CoHostHandler) RemoveCoHost(c *fiber.Ctx) error {
+	orgID, err := utils.GetOrgIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	eventID, err := utils.GetParamFormFiberCtx(c, "id", "event")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	coHostOrgID, err := utils.GetParamFormFiberCtx(c, "coHostOrgID", "co-host organization")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	if err := h.service.RemoveCoHost(orgID, eventID, coHostOrgID); err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{"message": "co-host removed successfully"})
+}
+
+// @Summary List the co-host invitations of an organization
+// @Description List the invitations of other organizations to co-host their events that the organization has not answered yet
+// @Tags Organization Event Co-hosts
+// @Produce json
+// @Security BearerAuth
+// @Param orgID path int true "Organization ID"
+// @Success 200 {array} dto.CoHostInvitationResponse
+// @Failure 400 {object} map[string]string "error: organization id is required"
+// @Failure 403 {object} map[string]string "error: You are not authorized"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /admin/orgs/{orgID}/co-host-invitations [get]
+func (h *CoHostHandler) ListInvitations(c *fiber.Ctx) error {
+	orgID, err := utils.GetOrgIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	invitations, err := h.service.ListInvitations(orgID)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(invitations)
+}
+
+// @Summary Accept a co-host invitation
+// @Description An owner of the invited organization accepts to co-host the event, its moderators can then edit the event
+// @Tags Organization Event Co-hosts
+// @Produce json
+// @Security BearerAuth
+// @Param orgID path int true "Organization ID of the invited organization"
+// @Param invitationID path int true "Invitation ID"
+// @Success 200 {object} dto.EventCoHostResponse
+// @Failure 400 {object} map[string]string "error: invitation id is required"
+// @Failure 401 {object} map[string]string "error: Unauthorized"
+// @Failure 403 {object} map[string]string "error: You are not authorized"
+// @Failure 404 {object} map[string]string "error: invitation not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /admin/orgs/{orgID}/co-host-invitations/{invitationID}/accept [post]
+func (h *CoHostHandler) AcceptInvitation(c *fiber.Ctx) error {
+	userID, err := utils.GetUserIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	orgID, err := utils.GetOrgIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	invitationID, err := utils.GetParamFormFiberCtx(c, "invitationID", "invitation")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	coHost, err := h.service.AcceptInvitation(userID, orgID, invitationID)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(coHost)
+}
+
+// @Summary Decline a co-host invitation
+// @Description An owner of the invited organization declines to co-host the event, the organizer may invite it again
+// @Tags Organization Event Co-hosts
+// @Produce json
+// @Security BearerAuth
+// @Param orgID path int true "Organization ID of the invited organization"
+// @Param invitationID path int true "Invitation ID"
+// @Success 200 {object} map[string]string "message: invitation declined successfully"
+// @Failure 400 {object} map[string]string "error: invitation id is required"
+// @Failure 403 {object} map[string]string "error: You are not authorized"
+// @Failure 404 {object} map[string]string "error: invitation not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /admin/orgs/{orgID}/co-host-invitations/{invitationID}/decline [post]
+func (h *CoHostHandler) DeclineInvitation(c *fiber.Ctx) error {
+	orgID, err := utils.GetOrgIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	invitationID, err := utils.GetParamFormFiberCtx(c, "invitationID", "invitation")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	if err := h.service.DeclineInvitation(orgID, invitationID); err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{"message": "invitation declined successfully"})
+}
@@ -0,0 +1,439 @@
+package handler
+
+import (
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/errs"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/dto"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/models"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/service"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/utils"
+	"github.com/gofiber/fiber/v2"
+)
+
+// DiscussionHandler serves the same threads for events and jobs, each route is bound to the kind of its target.
+type DiscussionHandler struct {
+	service service.DiscussionService
+}
+
+func NewDiscussionHandler(service service.DiscussionService) *DiscussionHandler {
+	return &DiscussionHandler{service: service}
+}
+
+// @Summary List the questions about an event or a job
+// @Description List the questions asked about a published event or job, newest first, with their answers. Hidden questions and answers are left out
+// @Tags Discussions
+// @Produce json
+// @Param id path int true "Event or job ID"
+// @Success 200 {array} dto.DiscussionQuestionResponse
+// @Failure 400 {object} map[string]string "error: event id is required"
+// @Failure 404 {object} map[string]string "error: event not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /events/{id}/questions [get]
+// @Router /jobs/{id}/questions [get]
+func (h *DiscussionHandler) ListQuestions(targetType models.DiscussionTarget) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		targetID, err := utils.GetParamFormFiberCtx(c, "id", string(targetType))
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+		}
+
+		questions, err := h.service.ListQuestions(targetType, targetID)
+		if err != nil {
+			return errs.SendFiberError(c, err)
+		}
+
+		return c.Status(fiber.StatusOK).JSON(questions)
+	}
+}
+
+// @Summary Get the FAQ of an event or a job
+// @Description List the answers the organization pinned about a published event or job, in the order they were pinned
+// @Tags Discussions
+// @Produce json
+// @Param id path int true "Event or job ID"
+// @Success 200 {array} dto.DiscussionFAQResponse
+// @Failure 400 {object} map[string]string "error: event id is required"
+// @Failure 404 {object} map[string]string "error: event not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /events/{id}/faq [get]
+// @Router /jobs/{id}/faq [get]
+func (h *DiscussionHandler) ListFAQ(targetType models.DiscussionTarget) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		targetID, err := utils.GetParamFormFiberCtx(c, "id", string(targetType))
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+		}
+
+		faq, err := h.service.ListFAQ(targetType, targetID)
+		if err != nil {
+			return errs.SendFiberError(c, err)
+		}
+
+		return c.Status(fiber.StatusOK).JSON(faq)
+	}
+}
+
+// @Summary Ask a question about an event or a job
+// @Description Ask a public question about a published event or job
+// @Tags Discussions
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Event or job ID"
+// @Param body body dto.DiscussionPostRequest true "Question"
+// @Success 201 {object} dto.DiscussionQuestionResponse
+// @Failure 400 {object} map[string]string "error: body is required"
+// @Failure 401 {object} map[string]string "error: Unauthorized"
+// @Failure 404 {object} map[string]string "error: event not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /events/{id}/questions [post]
+// @Router /jobs/{id}/questions [post]
+func (h *DiscussionHandler) AskQuestion(targetType models.DiscussionTarget) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		userID, err := utils.GetUserIDFormFiberCtx(c)
+		if err != nil {
+			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": err.Error()})
+		}
+
+		targetID, err := utils.GetParamFormFiberCtx(c, "id", string(targetType))
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+		}
+
+		var req dto.DiscussionPostRequest
+		if err := utils.ParseJSONAndValidate(c, &req); err != nil {
+			return err
+		}
+
+		question, err := h.service.AskQuestion(userID, targetType, targetID, req)
+		if err != nil {
+			return errs.SendFiberError(c, err)
+		}
+
+		return c.Status(fiber.StatusCreated).JSON(question)
+	}
+}
+
+// @Summary Reply to a question
+// @Description Reply to a question about a published event or job. Locked questions are only answered by the organization, the author of the question is notified unless they turned it off
+// @Tags Discussions
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Event or job ID"
+// @Param questionID path int true "Question ID"
+// @Param body body dto.DiscussionPostRequest true "Reply"
+// @Success 201 {object} dto.DiscussionAnswerResponse
+// @Failure 400 {object} map[string]string "error: body is required"
+// @Failure 401 {object} map[string]string "error: Unauthorized"
+// @Failure 403 {object} map[string]string "error: the question is locked"
+// @Failure 404 {object} map[string]string "error: question not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /events/{id}/questions/{questionID}/answers [post]
+// @Router /jobs/{id}/questions/{questionID}/answers [post]
+func (h *DiscussionHandler) Reply(targetType models.DiscussionTarget) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		userID, err := utils.GetUserIDFormFiberCtx(c)
+		if err != nil {
+			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": err.Error()})
+		}
+
+		targetID, err := utils.GetParamFormFiberCtx(c, "id", string(targetType))
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+		}
+
+		questionID, err := utils.GetParamFormFiberCtx(c, "questionID", "question")
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+		}
+
+		var req dto.DiscussionPostRequest
+		if err := utils.ParseJSONAndValidate(c, &req); err != nil {
+			return err
+		}
+
+		answer, err := h.service.Reply(userID, targetType, targetID, questionID, req)
+		if err != nil {
+			return errs.SendFiberError(c, err)
+		}
+
+		return c.Status(fiber.StatusCreated).JSON(answer)
+	}
+}
+
+// @Summary List the questions about an event or a job of the organization
+// @Description List all the questions about an event or a job of the organization with their answers, hidden ones included
+// @Tags Discussions
+// @Produce json
+// @Security BearerAuth
+// @Param orgID path int true "Organization ID"
+// @Param id path int true "Event or job ID"
+// @Success 200 {array} dto.DiscussionQuestionResponse
+// @Failure 400 {object} map[string]string "error: event id is required"
+// @Failure 403 {object} map[string]string "error: You are not authorized"
+// @Failure 404 {object} map[string]string "error: event not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /admin/orgs/{orgID}/events/{id}/questions [get]
+// @Router /admin/orgs/{orgID}/jobs/{id}/questions [get]
+func (h *DiscussionHandler) ListOrgQuestions(targetType models.DiscussionTarget) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		orgID, err := utils.GetOrgIDFormFiberCtx(c)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+		}
+
+		targetID, err := utils.GetParamFormFiberCtx(c, "id", string(targetType))
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+		}
+
+		questions, err := h.service.ListOrgQuestions(orgID, targetType, targetID)
+		if err != nil {
+			return errs.SendFiberError(c, err)
+		}
+
+		return c.Status(fiber.StatusOK).JSON(questions)
+	}
+}
+
+// @Summary Answer a question on behalf of the organization
+// @Description Answer a question about an event or a job of the organization. The answer is marked official and locked questions can still be answered
+// @Tags Discussions
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param orgID path int true "Organization ID"
+// @Param id path int true "Event or job ID"
+// @Param questionID path int true "Question ID"
+// @Param body body dto.DiscussionPostRequest true "Answer"
+// @Success 201 {object} dto.DiscussionAnswerResponse
+// @Failure 400 {object} map[string]string "error: body is required"
+// @Failure 401 {object} map[string]string "error: Unauthorized"
+// @Failure 403 {object} map[string]string "error: You are not authorized"
+// @Failure 404 {object} map[string]string "error: question not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /admin/orgs/{orgID}/events/{id}/questions/{questionID}/answers [post]
+// @Router /admin/orgs/{orgID}/jobs/{id}/questions/{questionID}/answers [post]
+func (h *DiscussionHandler) AnswerQuestion(targetType models.DiscussionTarget) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		userID, err := utils.GetUserIDFormFiberCtx(c)
+		if err != nil {
+			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": err.Error()})
+		}
+
+		orgID, err := utils.GetOrgIDFormFiberCtx(c)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+		}
+
+		targetID, err := utils.GetParamFormFiberCtx(c, "id", string(targetType))
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+		}
+
+		questionID, err := utils.GetParamFormFiberCtx(c, "questionID", "question")
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+		}
+
+		var req dto.DiscussionPostRequest
+		if err := utils.ParseJSONAndValidate(c, &req); err != nil {
+			return err
+		}
+
+		answer, err := h.service.AnswerQuestion(userID, orgID, targetType, targetID, questionID, req)
+		if err != nil {
+			return errs.SendFiberError(c, err)
+		}
+
+		return c.Status(fiber.StatusCreated).JSON(answer)
+	}
+}
+
+// @Summary Moderate a question
+// @Description Hide or show a question, lock it to stop users replying or unlock it
+// @Tags Discussions
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param orgID path int true "Organization ID"
+// @Param id path int true "Event or job ID"
+// @Param questionID path int true "Question ID"
+// @Param body body dto.DiscussionQuestionModerationRequest true "Changes"
+// @Success 200 {object} dto.DiscussionQuestionResponse
+// @Failure 400 {object} map[string]string "error: question id is required"
+// @Failure 403 {object} map[string]string "error: You are not authorized"
+// @Failure 404 {object} map[string]string "error: question not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /admin/orgs/{orgID}/events/{id}/questions/{questionID} [patch]
+// @Router /admin/orgs/{orgID}/jobs/{id}/questions/{questionID} [patch]
+func (h *DiscussionHandler) ModerateQuestion(targetType models.DiscussionTarget) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		orgID, err := utils.GetOrgIDFormFiberCtx(c)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+		}
+
+		targetID, err := utils.GetParamFormFiberCtx(c, "id", string(targetType))
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+		}
+
+		questionID, err := utils.GetParamFormFiberCtx(c, "questionID", "question")
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+		}
+
+		var req dto.DiscussionQuestionModerationRequest
+		if err := utils.ParseJSONAndValidate(c, &req); err != nil {
+			return err
+		}
+
+		question, err := h.service.ModerateQuestion(orgID, targetType, targetID, questionID, req)
+		if err != nil {
+			return errs.SendFiberError(c, err)
+		}
+
+		return c.Status(fiber.StatusOK).JSON(question)
+	}
+}
+
+// @Summary Delete a question
+// @Description Delete a question about an event or a job of the organization with its answers
+// @Tags Discussions
+// @Produce json
+// @Security BearerAuth
+// @Param orgID path int true "Organization ID"
+// @Param id path int true "Event or job ID"
+// @Param questionID path int true "Question ID"
+// @Success 200 {object} map[string]string "message: question deleted successfully"
+// @Failure 400 {object} map[string]string "error: question id is required"
+// @Failure 403 {object} map[string]string "error: You are not authorized"
+// @Failure 404 {object} map[string]string "error: question not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /admin/orgs/{orgID}/events/{id}/questions/{questionID} [delete]
+// @Router /admin/orgs/{orgID}/jobs/{id}/questions/{questionID} [delete]
+func (h *DiscussionHandler) DeleteQuestion(targetType models.DiscussionTarget) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		orgID, err := utils.GetOrgIDFormFiberCtx(c)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+		}
+
+		targetID, err := utils.GetParamFormFiberCtx(c, "id", string(targetType))
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+		}
+
+		questionID, err := utils.GetParamFormFiberCtx(c, "questionID", "question")
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+		}
+
+		if err := h.service.DeleteQuestion(orgID, targetType, targetID, questionID); err != nil {
+			return errs.SendFiberError(c, err)
+		}
+
+		return c.Status(fiber.StatusOK).JSON(fiber.Map{"message": "question deleted successfully"})
+	}
+}
+
+// @Summary Moderate an answer
+// @Description Hide or show an answer, pin it to the FAQ of the event or the job or unpin it
+// @Tags Discussions
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param orgID path int true "Organization ID"
+// @Param id path int true "Event or job ID"
+// @Param questionID path int true "Question ID"
+// @Param answerID path int true "Answer ID"
+// @Param body body dto.DiscussionAnswerModerationRequest true "Changes"
+// @Success 200 {object} dto.DiscussionAnswerResponse
+// @Failure 400 {object} map[string]string "error: answer id is required"
+// @Failure 403 {object} map[string]string "error: You are not authorized"
+// @Failure 404 {object} map[string]string "error: answer not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /admin/orgs/{orgID}/events/{id}/questions/{questionID}/answers/{answerID} [patch]
+// @Router /admin/orgs/{orgID}/jobs/{id}/questions/{questionID}/answers/{answerID} [patch]
+func (h *DiscussionHandler) ModerateAnswer(targetType models.DiscussionTarget) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		orgID, err := utils.GetOrgIDFormFiberCtx(c)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+		}
+
+		targetID, err := utils.GetParamFormFiberCtx(c, "id", string(targetType))
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+		}
+
+		questionID, err := utils.GetParamFormFiberCtx(c, "questionID", "question")
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+		}
+
+		answerID, err := utils.GetParamFormFiberCtx(c, "answerID", "answer")
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+		}
+
+		var req dto.DiscussionAnswerModerationRequest
+		if err := utils.ParseJSONAndValidate(c, &req); err != nil {
+			return err
+		}
+
+		answer, err := h.service.ModerateAnswer(orgID, targetType, targetID, questionID, answerID, req)
+		if err != nil {
+			return errs.SendFiberError(c, err)
+		}
+
+		return c.Status(fiber.StatusOK).JSON(answer)
+	}
+}
+
+// @Summary Delete an answer
+// @Description Delete an answer to a question about an event or a job of the organization
+// @Tags Discussions
+// @Produce json
+// @Security BearerAuth
+// @Param orgID path int true "Organization ID"
+// @Param id path int true "Event or job ID"
+// @Param questionID path int true "Question ID"
+// @Param answerID path int true "Answer ID"
+// @Success 200 {object} map[string]string "message: answer deleted successfully"
+// @Failure 400 {object} map[string]string "error: answer id is required"
+// @Failure 403 {object} map[string]string "error: You are not authorized"
+// @Failure 404 {object} map[string]string "error: answer not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /admin/orgs/{orgID}/events/{id}/questions/{questionID}/answers/{answerID} [delete]
+// @Router /admin/orgs/{orgID}/jobs/{id}/questions/{questionID}/answers/{answerID} [delete]
+func (h *DiscussionHandler) DeleteAnswer(targetType models.DiscussionTarget) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		orgID, err := utils.GetOrgIDFormFiberCtx(c)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+		}
+
+		targetID, err := utils.GetParamFormFiberCtx(c, "id", string(targetType))
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+		}
+
+		questionID, err := utils.GetParamFormFiberCtx(c, "questionID", "question")
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+		}
+
+		answerID, err := utils.GetParamFormFiberCtx(c, "answerID", "answer")
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+		}
+
+		if err := h.service.DeleteAnswer(orgID, targetType, targetID, questionID, answerID); err != nil {
+			return errs.SendFiberError(c, err)
+		}
+
+		return c.Status(fiber.StatusOK).JSON(fiber.Map{"message": "answer deleted successfully"})
+	}
+}
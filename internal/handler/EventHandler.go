@@ -110,6 +110,7 @@ func (h EventHandler) CreateEvent(c *fiber.Ctx) error {
 // @Description Get a list of all events
 // @Tags Events
 // @Produce json
+// @Param Accept-Language header string false "Locale of the texts: th (default) or en"
 // @Success 200 {array} []dto.EventResponses
 // @Failure 404 {object} map[string]string "error: events not found"
 // @Failure 500 {object} map[string]string "error: Internal Server Error"
@@ -122,6 +123,11 @@ func (h EventHandler) ListEvents(c *fiber.Ctx) error {
 		return errs.SendFiberError(c, err)
 	}
 
+	locale := utils.GetLocaleFromFiberCtx(c)
+	for i := range events {
+		events[i].Localize(locale)
+	}
+
 	return c.JSON(events)
 }
 
@@ -130,10 +136,11 @@ func (h EventHandler) ListEvents(c *fiber.Ctx) error {
 // @Tags Organization Events
 // @Produce json
 // @Param orgID path int true "Organization ID"
+// @Param Accept-Language header string false "Locale of the texts: th (default) or en"
 // @Success 200 {array} []dto.EventResponses
 // @Failure 400 {object} map[string]string "error: Invalid parameters"
 // @Failure 500 {object} map[string]string "error: Internal Server Error"
-// @Router /orgs/{orgID}/events [get]
+// @Router /admin/orgs/{orgID}/events [get]
 func (h EventHandler) ListEventsByOrgID(c *fiber.Ctx) error {
 	orgID, err := c.ParamsInt("orgID")
 
@@ -147,6 +154,41 @@ func (h EventHandler) ListEventsByOrgID(c *fiber.Ctx) error {
 		return errs.SendFiberError(c, err)
 	}
 
+	locale := utils.GetLocaleFromFiberCtx(c)
+	for i := range events {
+		events[i].Localize(locale)
+	}
+
+	return c.JSON(events)
+}
+
+// @Summary List the public events of an organization
+// @Description Get the events an organization hosts, without those hidden by moderation
+// @Tags Organization Events
+// @Produce json
+// @Param orgID path int true "Organization ID"
+// @Param Accept-Language header string false "Locale of the texts: th (default) or en"
+// @Success 200 {array} []dto.EventResponses
+// @Failure 400 {object} map[string]string "error: Invalid parameters"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /orgs/{orgID}/events [get]
+func (h EventHandler) ListPublicEventsByOrgID(c *fiber.Ctx) error {
+	orgID, err := c.ParamsInt("orgID")
+
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "organization id is required"})
+	}
+
+	events, err := h.eventService.GetListedEventsByOrgID(uint(orgID))
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	locale := utils.GetLocaleFromFiberCtx(c)
+	for i := range events {
+		events[i].Localize(locale)
+	}
+
 	return c.JSON(events)
 }
 
@@ -162,6 +204,8 @@ func (h EventHandler) GetEventByID(c *fiber.Ctx) error {
 		return errs.SendFiberError(c, err)
 	}
 
+	event.Localize(utils.GetLocaleFromFiberCtx(c))
+
 	return c.JSON(event)
 }
 
@@ -174,7 +218,7 @@ func (h EventHandler) GetEventByID(c *fiber.Ctx) error {
 // @Success 200 {object} []dto.EventResponses
 // @Failure 400 {object} map[string]string "error: Invalid parameters"
 // @Failure 500 {object} map[string]string "error: Internal Server Error"
-// @Router /orgs/{orgID}/events/{id} [get]
+// @Router /admin/orgs/{orgID}/events/{id} [get]
 func (h EventHandler) GetEventByIDwithOrgID(c *fiber.Ctx) error {
 	orgID, err := c.ParamsInt("orgID")
 
@@ -196,10 +240,46 @@ func (h EventHandler) GetEventByIDwithOrgID(c *fiber.Ctx) error {
 	return c.JSON(event)
 }
 
+// @Summary Get a public event of an organization
+// @Description Get an event the organization hosts, events hidden by moderation are not found
+// @Tags Organization Events
+// @Produce json
+// @Param orgID path int true "Organization ID"
+// @Param id path int true "Event ID"
+// @Param Accept-Language header string false "Locale of the texts: th (default) or en"
+// @Success 200 {object} dto.EventResponses
+// @Failure 400 {object} map[string]string "error: Invalid parameters"
+// @Failure 404 {object} map[string]string "error: event not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /orgs/{orgID}/events/{id} [get]
+func (h EventHandler) GetPublicEventByIDwithOrgID(c *fiber.Ctx) error {
+	orgID, err := c.ParamsInt("orgID")
+
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "organization id is required"})
+	}
+
+	eventID, err := c.ParamsInt("id")
+
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "event id is required"})
+	}
+
+	event, err := h.eventService.GetListedEventByIDwithOrgID(uint(orgID), uint(eventID))
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	event.Localize(utils.GetLocaleFromFiberCtx(c))
+
+	return c.JSON(event)
+}
+
 // @Summary List all categories
 // @Description Get a list of all event categories
 // @Tags Events
 // @Produce json
+// @Param Accept-Language header string false "Locale of the texts: th (default) or en"
 // @Success 200 {object} dto.CategoryListResponse
 // @Failure 500 {object} map[string]string "error: Internal Server Error"
 // @Router /categories [get]
@@ -209,6 +289,8 @@ func (h EventHandler) ListAllCategories(c *fiber.Ctx) error {
 		return errs.SendFiberError(c, err)
 	}
 
+	dto.LocalizeCategories(categories.Categories, utils.GetLocaleFromFiberCtx(c))
+
 	return c.Status(fiber.StatusOK).JSON(categories)
 }
 
@@ -217,6 +299,7 @@ func (h EventHandler) ListAllCategories(c *fiber.Ctx) error {
 // @Tags Events
 // @Produce json
 // @Param page query int true "Page number"
+// @Param Accept-Language header string false "Locale of the texts: th (default) or en"
 // @Success 200 {object} dto.PaginatedEventsResponse
 // @Failure 400 {object} map[string]string "error: Invalid parameters"
 // @Failure 500 {object} map[string]string "error: Internal Server Error"
@@ -232,6 +315,11 @@ func (h EventHandler) EventPaginate(c *fiber.Ctx) error {
 		return errs.SendFiberError(c, err)
 	}
 
+	locale := utils.GetLocaleFromFiberCtx(c)
+	for i := range events {
+		events[i].Localize(locale)
+	}
+
 	//total, err := h.eventService.CountEvent()
 	//if err != nil {
 	//	return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
@@ -355,6 +443,11 @@ func (h EventHandler) DeleteEvent(c *fiber.Ctx) error {
 // @Param locationType query string false "Location Type of events"
 // @Param audience query string false "Main Audience of events"
 // @Param price query string false "Price Type of events"
+// @Param dateRange query string false "Date range of events: today, tomorrow, thisWeek, nextWeek, thisMonth, nextMonth"
+// @Param tz query string false "IANA timezone the date range is computed in, defaults to Asia/Bangkok"
+// @Param orgId query int false "Only events hosted or co-hosted by the organization"
+// @Param X-Timezone header string false "IANA timezone used when the tz query is not given"
+// @Param Accept-Language header string false "Locale of the texts: th (default) or en"
 // @Success 200 {array} []dto.EventResponses
 // @Failure 400 {object} map[string]string "error - Invalid query parameters"
 // @Failure 404 {object} map[string]string "error - events not found"
@@ -370,6 +463,9 @@ func (h EventHandler) SearchEvents(c *fiber.Ctx) error {
 			"error": "Invalid query parameters",
 		})
 	}
+	if query.Timezone == "" {
+		query.Timezone = c.Get("X-Timezone")
+	}
 	// Use the provided or default pagination values
 	if query.Page > 0 {
 		page = query.Page
@@ -384,6 +480,11 @@ func (h EventHandler) SearchEvents(c *fiber.Ctx) error {
 		return errs.SendFiberError(c, err)
 	}
 
+	locale := utils.GetLocaleFromFiberCtx(c)
+	for i := range events.Events {
+		events.Events[i].Localize(locale)
+	}
+
 	return c.Status(fiber.StatusOK).JSON(events)
 }
 
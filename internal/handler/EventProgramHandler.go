@@ -0,0 +1,365 @@
+package handler
+
+import (
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/errs"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/dto"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/service"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/utils"
+	"github.com/gofiber/fiber/v2"
+)
+
+type EventProgramHandler struct {
+	service service.EventProgramService
+}
+
+func NewEventProgramHandler(service service.EventProgramService) *EventProgramHandler {
+	return &EventProgramHandler{service: service}
+}
+
+// @Summary Replace the agenda of an event
+// @Description Replace the agenda of an event with the items in the order given. Items may name speakers and a session of the event
+// @Tags Organization Event Program
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param orgID path int true "Organization ID"
+// @Param id path int true "Event ID"
+// @Param body body dto.AgendaRequest true "Agenda"
+// @Success 200 {object} []dto.AgendaItemResponse
+// @Failure 400 {object} map[string]string "error: agenda speakers must be speakers of the event"
+// @Failure 403 {object} map[string]string "error: You are not authorized"
+// @Failure 404 {object} map[string]string "error: event not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /admin/orgs/{orgID}/events/{id}/agenda [put]
+func (h *EventProgramHandler) UpdateAgenda(c *fiber.Ctx) error {
+	orgID, err := utils.GetOrgIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	eventID, err := utils.GetParamFormFiberCtx(c, "id", "event")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	var req dto.AgendaRequest
+	if err := utils.ParseJSONAndValidate(c, &req); err != nil {
+		return err
+	}
+
+	agenda, err := h.service.UpdateAgenda(orgID, eventID, req)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(agenda)
+}
+
+// @Summary List the speakers of an event
+// @Description List the speakers of an event of the organization in their order
+// @Tags Organization Event Program
+// @Produce json
+// @Security BearerAuth
+// @Param orgID path int true "Organization ID"
+// @Param id path int true "Event ID"
+// @Success 200 {object} []dto.EventSpeakerResponse
+// @Failure 400 {object} map[string]string "error: event id is required"
+// @Failure 403 {object} map[string]string "error: You are not authorized"
+// @Failure 404 {object} map[string]string "error: event not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /admin/orgs/{orgID}/events/{id}/speakers [get]
+func (h *EventProgramHandler) ListSpeakers(c *fiber.Ctx) error {
+	orgID, err := utils.GetOrgIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	eventID, err := utils.GetParamFormFiberCtx(c, "id", "event")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	speakers, err := h.service.ListSpeakers(orgID, eventID)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(speakers)
+}
+
+// @Summary Add a speaker
+// @Description Add a speaker to an event, optionally linked to a user of the platform whose name and picture are used when none are given
+// @Tags Organization Event Program
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param orgID path int true "Organization ID"
+// @Param id path int true "Event ID"
+// @Param body body dto.EventSpeakerRequest true "Speaker"
+// @Success 201 {object} dto.EventSpeakerResponse
+// @Failure 400 {object} map[string]string "error: name is required"
+// @Failure 403 {object} map[string]string "error: You are not authorized"
+// @Failure 404 {object} map[string]string "error: user not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /admin/orgs/{orgID}/events/{id}/speakers [post]
+func (h *EventProgramHandler) CreateSpeaker(c *fiber.Ctx) error {
+	orgID, err := utils.GetOrgIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	eventID, err := utils.GetParamFormFiberCtx(c, "id", "event")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	var req dto.EventSpeakerRequest
+	if err := utils.ParseJSONAndValidate(c, &req); err != nil {
+		return err
+	}
+
+	speaker, err := h.service.CreateSpeaker(orgID, eventID, req)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(speaker)
+}
+
+// @Summary Update a speaker
+// @Description Replace the profile of a speaker of an event, the picture is kept
+// @Tags Organization Event Program
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param orgID path int true "Organization ID"
+// @Param id path int true "Event ID"
+// @Param speakerID path int true "Speaker ID"
+// @Param body body dto.EventSpeakerRequest true "Speaker"
+// @Success 200 {object} dto.EventSpeakerResponse
+// @Failure 400 {object} map[string]string "error: name is required"
+// @Failure 403 {object} map[string]string "error: You are not authorized"
+// @Failure 404 {object} map[string]string "error: speaker not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /admin/orgs/{orgID}/events/{id}/speakers/{speakerID} [put]
+func (h *EventProgramHandler) UpdateSpeaker(c *fiber.Ctx) error {
+	orgID, err := utils.GetOrgIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	eventID, err := utils.GetParamFormFiberCtx(c, "id", "event")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	speakerID, err := utils.GetParamFormFiberCtx(c, "speakerID", "speaker")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	var req dto.EventSpeakerRequest
+	if err := utils.ParseJSONAndValidate(c, &req); err != nil {
+		return err
+	}
+
+	speaker, err := h.service.UpdateSpeaker(orgID, eventID, speakerID, req)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(speaker)
+}
+
+// @Summary Upload the picture of a speaker
+// @Description Upload the picture of a speaker of an event
+// @Tags Organization Event Program
+// @Accept multipart/form-data
+// @Produce json
+// @Security BearerAuth
+// @Param orgID path int true "Organization ID"
+// @Param id path int true "Event ID"
+// @Param speakerID path int true "Speaker ID"
+// @Param image formData file true "Picture"
+// @Success 200 {object} dto.EventSpeakerResponse
+// @Failure 400 {object} map[string]string "error: Failed to get image from form"
+// @Failure 403 {object} map[string]string "error: You are not authorized"
+// @Failure 404 {object} map[string]string "error: speaker not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /admin/orgs/{orgID}/events/{id}/speakers/{speakerID}/picture [post]
+func (h *EventProgramHandler) UploadSpeakerPicture(c *fiber.Ctx) error {
+	orgID, err := utils.GetOrgIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	eventID, err := utils.GetParamFormFiberCtx(c, "id", "event")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	speakerID, err := utils.GetParamFormFiberCtx(c, "speakerID", "speaker")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	file, fileHeader, err := utils.UploadImage(c)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+	defer file.Close()
+
+	speaker, err := h.service.UploadSpeakerPicture(c.Context(), orgID, eventID, speakerID, file, fileHeader)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(speaker)
+}
+
+// @Summary Delete a speaker
+// @Description Remove a speaker from an event and from its agenda
+// @Tags Organization Event Program
+// @Produce json
+// @Security BearerAuth
+// @Param orgID path int true "Organization ID"
+// @Param id path int true "Event ID"
+// @Param speakerID path int true "Speaker ID"
+// @Success 200 {object} map[string]string "message: speaker deleted successfully"
+// @Failure 400 {object} map[string]string "error: speaker id is required"
+// @Failure 403 {object} map[string]string "error: You are not authorized"
+// @Failure 404 {object} map[string]string "error: speaker not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /admin/orgs/{orgID}/events/{id}/speakers/{speakerID} [delete]
+func (h *EventProgramHandler) DeleteSpeaker(c *fiber.Ctx) error {
+	orgID, err := utils.GetOrgIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	eventID, err := utils.GetParamFormFiberCtx(c, "id", "event")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	speakerID, err := utils.GetParamFormFiberCtx(c, "speakerID", "speaker")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	if err := h.service.DeleteSpeaker(orgID, eventID, speakerID); err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{"message": "speaker deleted successfully"})
+}
+
+// @Summary List the materials of an event
+// @Description List the downloadable materials of an event of the organization
+// @Tags Organization Event Program
+// @Produce json
+// @Security BearerAuth
+// @Param orgID path int true "Organization ID"
+// @Param id path int true "Event ID"
+// @Success 200 {object} []dto.EventMaterialResponse
+// @Failure 400 {object} map[string]string "error: event id is required"
+// @Failure 403 {object} map[string]string "error: You are not authorized"
+// @Failure 404 {object} map[string]string "error: event not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /admin/orgs/{orgID}/events/{id}/materials [get]
+func (h *EventProgramHandler) ListMaterials(c *fiber.Ctx) error {
+	orgID, err := utils.GetOrgIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	eventID, err := utils.GetParamFormFiberCtx(c, "id", "event")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	materials, err := h.service.ListMaterials(orgID, eventID)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(materials)
+}
+
+// @Summary Upload a material
+// @Description Upload a file attendees can download: PDF, PNG, JPEG, DOCX, PPTX, XLSX or zip, up to 20 MB
+// @Tags Organization Event Program
+// @Accept multipart/form-data
+// @Produce json
+// @Security BearerAuth
+// @Param orgID path int true "Organization ID"
+// @Param id path int true "Event ID"
+// @Param file formData file true "Material"
+// @Param title formData string false "Title, defaults to the file name"
+// @Success 201 {object} dto.EventMaterialResponse
+// @Failure 400 {object} map[string]string "error: material content does not match its file type"
+// @Failure 403 {object} map[string]string "error: You are not authorized"
+// @Failure 404 {object} map[string]string "error: event not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /admin/orgs/{orgID}/events/{id}/materials [post]
+func (h *EventProgramHandler) UploadMaterial(c *fiber.Ctx) error {
+	orgID, err := utils.GetOrgIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	eventID, err := utils.GetParamFormFiberCtx(c, "id", "event")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	file, fileHeader, err := utils.UploadMaterial(c)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+	defer file.Close()
+
+	material, err := h.service.UploadMaterial(c.Context(), orgID, eventID, c.FormValue("title"), file, fileHeader)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(material)
+}
+
+// @Summary Delete a material
+// @Description Remove a material of an event and its file
+// @Tags Organization Event Program
+// @Produce json
+// @Security BearerAuth
+// @Param orgID path int true "Organization ID"
+// @Param id path int true "Event ID"
+// @Param materialID path int true "Material ID"
+// @Success 200 {object} map[string]string "message: material deleted successfully"
+// @Failure 400 {object} map[string]string "error: material id is required"
+// @Failure 403 {object} map[string]string "error: You are not authorized"
+// @Failure 404 {object} map[string]string "error: material not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /admin/orgs/{orgID}/events/{id}/materials/{materialID} [delete]
+func (h *EventProgramHandler) DeleteMaterial(c *fiber.Ctx) error {
+	orgID, err := utils.GetOrgIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	eventID, err := utils.GetParamFormFiberCtx(c, "id", "event")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	materialID, err := utils.GetParamFormFiberCtx(c, "materialID", "material")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	if err := h.service.DeleteMaterial(c.Context(), orgID, eventID, materialID); err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{"message": "material deleted successfully"})
+}
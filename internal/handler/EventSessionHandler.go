@@ -0,0 +1,235 @@
+package handler
+
+import (
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/errs"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/dto"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/service"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/utils"
+	"github.com/gofiber/fiber/v2"
+)
+
+type EventSessionHandler struct {
+	service service.EventSessionService
+}
+
+func NewEventSessionHandler(service service.EventSessionService) *EventSessionHandler {
+	return &EventSessionHandler{service: service}
+}
+
+// @Summary List the sessions of an event
+// @Description List the sessions of an event in time order, with the recurrence rule they were created from
+// @Tags Events
+// @Produce json
+// @Param id path int true "Event ID"
+// @Success 200 {object} dto.EventSessionsResponse
+// @Failure 400 {object} map[string]string "error: event id is required"
+// @Failure 404 {object} map[string]string "error: event not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /events/{id}/sessions [get]
+func (h *EventSessionHandler) ListSessions(c *fiber.Ctx) error {
+	eventID, err := utils.GetParamFormFiberCtx(c, "id", "event")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	sessions, err := h.service.ListSessions(eventID)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(sessions)
+}
+
+// @Summary List the sessions of an event of the organization
+// @Description List every session of an event including cancelled occurrences, with the recurrence rule
+// @Tags Organization Event Sessions
+// @Produce json
+// @Security BearerAuth
+// @Param orgID path int true "Organization ID"
+// @Param id path int true "Event ID"
+// @Success 200 {object} dto.EventSessionsResponse
+// @Failure 400 {object} map[string]string "error: event id is required"
+// @Failure 403 {object} map[string]string "error: You are not authorized"
+// @Failure 404 {object} map[string]string "error: event not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /admin/orgs/{orgID}/events/{id}/sessions [get]
+func (h *EventSessionHandler) ListEventSessions(c *fiber.Ctx) error {
+	orgID, err := utils.GetOrgIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	eventID, err := utils.GetParamFormFiberCtx(c, "id", "event")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	sessions, err := h.service.ListEventSessions(orgID, eventID)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(sessions)
+}
+
+// @Summary Add a session
+// @Description Add a session with its own date, time and location to an event. A session without a location takes the location of the event
+// @Tags Organization Event Sessions
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param orgID path int true "Organization ID"
+// @Param id path int true "Event ID"
+// @Param body body dto.EventSessionRequest true "Session"
+// @Success 201 {object} dto.EventSessionResponse
+// @Failure 400 {object} map[string]string "error: endDate must not be before startDate"
+// @Failure 403 {object} map[string]string "error: You are not authorized"
+// @Failure 404 {object} map[string]string "error: event not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /admin/orgs/{orgID}/events/{id}/sessions [post]
+func (h *EventSessionHandler) CreateSession(c *fiber.Ctx) error {
+	orgID, err := utils.GetOrgIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	eventID, err := utils.GetParamFormFiberCtx(c, "id", "event")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	var req dto.EventSessionRequest
+	if err := utils.ParseJSONAndValidate(c, &req); err != nil {
+		return err
+	}
+
+	session, err := h.service.CreateSession(orgID, eventID, req)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(session)
+}
+
+// @Summary Set the recurrence of an event
+// @Description Set the RFC 5545 recurrence rule of an event, e.g. FREQ=WEEKLY;BYDAY=TU,TH;COUNT=8. Sessions are created from the start date, time and location of the event and replace those of the previous rule, occurrences edited on their own are kept. An empty rule removes the series
+// @Tags Organization Event Sessions
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param orgID path int true "Organization ID"
+// @Param id path int true "Event ID"
+// @Param body body dto.EventRecurrenceRequest true "Recurrence rule"
+// @Success 200 {object} dto.EventSessionsResponse
+// @Failure 400 {object} map[string]string "error: recurrence rule needs COUNT or UNTIL"
+// @Failure 403 {object} map[string]string "error: You are not authorized"
+// @Failure 404 {object} map[string]string "error: event not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /admin/orgs/{orgID}/events/{id}/sessions/recurrence [put]
+func (h *EventSessionHandler) SetRecurrence(c *fiber.Ctx) error {
+	orgID, err := utils.GetOrgIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	eventID, err := utils.GetParamFormFiberCtx(c, "id", "event")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	var req dto.EventRecurrenceRequest
+	if err := utils.ParseJSONAndValidate(c, &req); err != nil {
+		return err
+	}
+
+	sessions, err := h.service.SetRecurrence(orgID, eventID, req)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(sessions)
+}
+
+// @Summary Update a session
+// @Description Edit one session, or with scope=all every occurrence of its series. An edit of the series moves each occurrence by as many days as this one moved, and gives them the times and location of the edit; occurrences edited on their own keep their changes
+// @Tags Organization Event Sessions
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param orgID path int true "Organization ID"
+// @Param id path int true "Event ID"
+// @Param sessionID path int true "Session ID"
+// @Param scope query string false "this (default) or all"
+// @Param body body dto.EventSessionRequest true "Session"
+// @Success 200 {object} []dto.EventSessionResponse
+// @Failure 400 {object} map[string]string "error: session is not part of a recurring series"
+// @Failure 403 {object} map[string]string "error: You are not authorized"
+// @Failure 404 {object} map[string]string "error: session not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /admin/orgs/{orgID}/events/{id}/sessions/{sessionID} [put]
+func (h *EventSessionHandler) UpdateSession(c *fiber.Ctx) error {
+	orgID, err := utils.GetOrgIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	eventID, err := utils.GetParamFormFiberCtx(c, "id", "event")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	sessionID, err := utils.GetParamFormFiberCtx(c, "sessionID", "session")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	var req dto.EventSessionRequest
+	if err := utils.ParseJSONAndValidate(c, &req); err != nil {
+		return err
+	}
+
+	sessions, err := h.service.UpdateSession(orgID, eventID, sessionID, c.Query("scope"), req)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(sessions)
+}
+
+// @Summary Delete a session
+// @Description Remove one session, or with scope=all the recurrence rule and every session of its series. A single occurrence of a series is kept as cancelled
+// @Tags Organization Event Sessions
+// @Produce json
+// @Security BearerAuth
+// @Param orgID path int true "Organization ID"
+// @Param id path int true "Event ID"
+// @Param sessionID path int true "Session ID"
+// @Param scope query string false "this (default) or all"
+// @Success 200 {object} map[string]string "message: session deleted successfully"
+// @Failure 400 {object} map[string]string "error: scope must be this or all"
+// @Failure 403 {object} map[string]string "error: You are not authorized"
+// @Failure 404 {object} map[string]string "error: session not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /admin/orgs/{orgID}/events/{id}/sessions/{sessionID} [delete]
+func (h *EventSessionHandler) DeleteSession(c *fiber.Ctx) error {
+	orgID, err := utils.GetOrgIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	eventID, err := utils.GetParamFormFiberCtx(c, "id", "event")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	sessionID, err := utils.GetParamFormFiberCtx(c, "sessionID", "session")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	if err := h.service.DeleteSession(orgID, eventID, sessionID, c.Query("scope")); err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{"message": "session deleted successfully"})
+}
@@ -0,0 +1,143 @@
+package handler
+
+import (
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/errs"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/dto"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/service"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/utils"
+	"github.com/gofiber/fiber/v2"
+)
+
+type JobApplicationHandler struct {
+	service service.JobApplicationService
+}
+
+func NewJobApplicationHandler(service service.JobApplicationService) *JobApplicationHandler {
+	return &JobApplicationHandler{service: service}
+}
+
+// @Summary Apply to a job
+// @Description Apply to a published job, optionally attaching one of the current user's resumes
+// @Tags Job Applications
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param jobID path int true "Job ID"
+// @Param body body dto.JobApplicationRequest true "Application"
+// @Success 201 {object} dto.JobApplicationResponse
+// @Failure 400 {object} map[string]string "error: job is not open for applications"
+// @Failure 401 {object} map[string]string "error: unauthorized"
+// @Failure 404 {object} map[string]string "error: job not found"
+// @Failure 409 {object} map[string]string "error: you have already applied to this job"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /jobs/{jobID}/apply [post]
+func (h *JobApplicationHandler) Apply(c *fiber.Ctx) error {
+	userID, err := utils.GetUserIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	jobID, err := utils.GetParamFormFiberCtx(c, "jobID", "job")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	var req dto.JobApplicationRequest
+	if err := utils.ParseJSONAndValidate(c, &req); err != nil {
+		return err
+	}
+
+	application, err := h.service.Apply(userID, jobID, req)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(application)
+}
+
+// @Summary List my job applications
+// @Description List the job applications of the current user
+// @Tags Job Applications
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} []dto.JobApplicationResponse
+// @Failure 401 {object} map[string]string "error: unauthorized"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /users/me/applications [get]
+func (h *JobApplicationHandler) ListMyApplications(c *fiber.Ctx) error {
+	userID, err := utils.GetUserIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	applications, err := h.service.ListMyApplications(userID)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(applications)
+}
+
+// @Summary List the applications of a job
+// @Description List the applications received by a job of the organization
+// @Tags Organization Job Applications
+// @Produce json
+// @Security BearerAuth
+// @Param orgID path int true "Organization ID"
+// @Param jobID path int true "Job ID"
+// @Success 200 {object} []dto.JobApplicationResponse
+// @Failure 400 {object} map[string]string "error: job id is required"
+// @Failure 403 {object} map[string]string "error: You are not authorized"
+// @Failure 404 {object} map[string]string "error: job not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /admin/orgs/{orgID}/jobs/{jobID}/applications [get]
+func (h *JobApplicationHandler) ListJobApplications(c *fiber.Ctx) error {
+	orgID, err := utils.GetParamFormFiberCtx(c, "orgID", "organization")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	jobID, err := utils.GetParamFormFiberCtx(c, "jobID", "job")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	applications, err := h.service.ListJobApplications(orgID, jobID)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(applications)
+}
+
+// @Summary Get a download URL for an applicant's resume
+// @Description Get a short-lived presigned URL to the resume attached to an application for one of the organization's jobs
+// @Tags Organization Job Applications
+// @Produce json
+// @Security BearerAuth
+// @Param orgID path int true "Organization ID"
+// @Param applicationID path int true "Application ID"
+// @Success 200 {object} dto.ResumeURLResponse
+// @Failure 400 {object} map[string]string "error: application id is required"
+// @Failure 403 {object} map[string]string "error: You are not authorized"
+// @Failure 404 {object} map[string]string "error: no resume is attached to this application"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /admin/orgs/{orgID}/applications/{applicationID}/resume [get]
+func (h *JobApplicationHandler) GetApplicationResumeURL(c *fiber.Ctx) error {
+	orgID, err := utils.GetParamFormFiberCtx(c, "orgID", "organization")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	applicationID, err := utils.GetParamFormFiberCtx(c, "applicationID", "application")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	res, err := h.service.GetApplicationResumeURL(c.Context(), orgID, applicationID)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(res)
+}
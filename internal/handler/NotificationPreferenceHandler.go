@@ -0,0 +1,71 @@
+package handler
+
+import (
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/errs"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/dto"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/service"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/utils"
+	"github.com/gofiber/fiber/v2"
+)
+
+type NotificationPreferenceHandler struct {
+	service service.NotificationPreferenceService
+}
+
+func NewNotificationPreferenceHandler(service service.NotificationPreferenceService) *NotificationPreferenceHandler {
+	return &NotificationPreferenceHandler{service: service}
+}
+
+// @Summary Get my notification preferences
+// @Description Get the mails the current user receives about events they registered for, all of them until changed
+// @Tags Notification Preferences
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} dto.NotificationPreferenceResponse
+// @Failure 401 {object} map[string]string "error: unauthorized"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /users/me/notification-preferences [get]
+func (h *NotificationPreferenceHandler) GetMyNotificationPreferences(c *fiber.Ctx) error {
+	userID, err := utils.GetUserIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	preference, err := h.service.GetMyNotificationPreferences(userID)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(preference)
+}
+
+// @Summary Update my notification preferences
+// @Description Turn event reminders and organizer announcements on or off for the current user
+// @Tags Notification Preferences
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param body body dto.NotificationPreferenceRequest true "Notification preferences"
+// @Success 200 {object} dto.NotificationPreferenceResponse
+// @Failure 400 {object} map[string]string "error: eventReminders is required"
+// @Failure 401 {object} map[string]string "error: unauthorized"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /users/me/notification-preferences [put]
+func (h *NotificationPreferenceHandler) UpdateMyNotificationPreferences(c *fiber.Ctx) error {
+	userID, err := utils.GetUserIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	var req dto.NotificationPreferenceRequest
+	if err := utils.ParseJSONAndValidate(c, &req); err != nil {
+		return err
+	}
+
+	preference, err := h.service.UpdateMyNotificationPreferences(userID, req)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(preference)
+}
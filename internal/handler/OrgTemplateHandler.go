@@ -0,0 +1,209 @@
+package handler
+
+import (
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/errs"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/dto"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/service"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/utils"
+	"github.com/gofiber/fiber/v2"
+)
+
+type OrgTemplateHandler struct {
+	service service.OrgTemplateService
+}
+
+func NewOrgTemplateHandler(service service.OrgTemplateService) *OrgTemplateHandler {
+	return &OrgTemplateHandler{service: service}
+}
+
+// @Summary Duplicate an event
+// @Description Create a draft event copied from an event the organization hosts, with its categories, contact channels, ticket types, speakers and agenda. Pictures are copied, sessions, materials and co-hosts are not
+// @Tags Organization Templates
+// @Produce json
+// @Security BearerAuth
+// @Param orgID path int true "Organization ID"
+// @Param id path int true "Event ID"
+// @Success 201 {object} dto.EventResponses
+// @Failure 400 {object} map[string]string "error: event id is required"
+// @Failure 403 {object} map[string]string "error: You are not authorized"
+// @Failure 404 {object} map[string]string "error: event not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /admin/orgs/{orgID}/events/{id}/duplicate [post]
+func (h *OrgTemplateHandler) DuplicateEvent(c *fiber.Ctx) error {
+	orgID, err := utils.GetOrgIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	eventID, err := utils.GetParamFormFiberCtx(c, "id", "event")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	event, err := h.service.DuplicateEvent(c.Context(), orgID, eventID)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(event)
+}
+
+// @Summary Duplicate a job
+// @Description Create a draft job copied from a job of the organization, with its prerequisites, categories and skills. The banner is copied
+// @Tags Organization Templates
+// @Produce json
+// @Security BearerAuth
+// @Param orgID path int true "Organization ID"
+// @Param id path int true "Job ID"
+// @Success 201 {object} dto.JobResponses
+// @Failure 400 {object} map[string]string "error: job id is required"
+// @Failure 403 {object} map[string]string "error: You are not authorized"
+// @Failure 404 {object} map[string]string "error: job not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /admin/orgs/{orgID}/jobs/duplicate/{id} [post]
+func (h *OrgTemplateHandler) DuplicateJob(c *fiber.Ctx) error {
+	orgID, err := utils.GetOrgIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	jobID, err := utils.GetParamFormFiberCtx(c, "id", "job")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	job, err := h.service.DuplicateJob(c.Context(), orgID, jobID)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(job)
+}
+
+// @Summary Save a template
+// @Description Save an event or a job of the organization as a template to start new drafts from. The template keeps its own copy, later changes to the event or the job do not alter it
+// @Tags Organization Templates
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param orgID path int true "Organization ID"
+// @Param body body dto.OrgTemplateRequest true "Event or job to save"
+// @Success 201 {object} dto.OrgTemplateResponse
+// @Failure 400 {object} map[string]string "error: kind must be event or job"
+// @Failure 401 {object} map[string]string "error: Unauthorized"
+// @Failure 403 {object} map[string]string "error: You are not authorized"
+// @Failure 404 {object} map[string]string "error: event not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /admin/orgs/{orgID}/templates [post]
+func (h *OrgTemplateHandler) SaveTemplate(c *fiber.Ctx) error {
+	userID, err := utils.GetUserIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	orgID, err := utils.GetOrgIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	var req dto.OrgTemplateRequest
+	if err := utils.ParseJSONAndValidate(c, &req); err != nil {
+		return err
+	}
+
+	template, err := h.service.SaveTemplate(c.Context(), userID, orgID, req)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(template)
+}
+
+// @Summary List the templates of an organization
+// @Description List the event and job templates of the organization, newest first
+// @Tags Organization Templates
+// @Produce json
+// @Security BearerAuth
+// @Param orgID path int true "Organization ID"
+// @Param kind query string false "Only list the templates of a kind" Enums(event, job)
+// @Success 200 {array} dto.OrgTemplateResponse
+// @Failure 400 {object} map[string]string "error: kind must be event or job"
+// @Failure 403 {object} map[string]string "error: You are not authorized"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /admin/orgs/{orgID}/templates [get]
+func (h *OrgTemplateHandler) ListTemplates(c *fiber.Ctx) error {
+	orgID, err := utils.GetOrgIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	templates, err := h.service.ListTemplates(orgID, c.Query("kind"))
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(templates)
+}
+
+// @Summary Delete a template
+// @Description Delete a template of the organization and its pictures, the drafts started from it are kept
+// @Tags Organization Templates
+// @Produce json
+// @Security BearerAuth
+// @Param orgID path int true "Organization ID"
+// @Param templateID path int true "Template ID"
+// @Success 200 {object} map[string]string "message: template deleted successfully"
+// @Failure 400 {object} map[string]string "error: template id is required"
+// @Failure 403 {object} map[string]string "error: You are not authorized"
+// @Failure 404 {object} map[string]string "error: template not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /admin/orgs/{orgID}/templates/{templateID} [delete]
+func (h *OrgTemplateHandler) DeleteTemplate(c *fiber.Ctx) error {
+	orgID, err := utils.GetOrgIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	templateID, err := utils.GetParamFormFiberCtx(c, "templateID", "template")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	if err := h.service.DeleteTemplate(c.Context(), orgID, templateID); err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{"message": "template deleted successfully"})
+}
+
+// @Summary Create a draft from a template
+// @Description Start a new draft event or job of the organization from one of its templates, depending on the kind of the template
+// @Tags Organization Templates
+// @Produce json
+// @Security BearerAuth
+// @Param orgID path int true "Organization ID"
+// @Param templateID path int true "Template ID"
+// @Success 201 {object} dto.OrgTemplateDraftResponse
+// @Failure 400 {object} map[string]string "error: template id is required"
+// @Failure 403 {object} map[string]string "error: You are not authorized"
+// @Failure 404 {object} map[string]string "error: template not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /admin/orgs/{orgID}/templates/{templateID}/draft [post]
+func (h *OrgTemplateHandler) CreateDraft(c *fiber.Ctx) error {
+	orgID, err := utils.GetOrgIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	templateID, err := utils.GetParamFormFiberCtx(c, "templateID", "template")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	draft, err := h.service.CreateDraft(c.Context(), orgID, templateID)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(draft)
+}
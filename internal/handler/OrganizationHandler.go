@@ -84,6 +84,7 @@ func (h *OrganizationHandler) CreateOrganization(c *fiber.Ctx) error {
 // @Tags Organization
 // @Accept json
 // @Produce json
+// @Param Accept-Language header string false "Locale of the texts: th (default) or en"
 // @Success 200 {array} dto.OrganizationResponse
 // @Failure 500 {object} map[string]string "error: Internal Server Error"
 // @Router /orgs/list [get]
@@ -94,6 +95,11 @@ func (h *OrganizationHandler) ListOrganizations(c *fiber.Ctx) error {
 		return errs.SendFiberError(c, err)
 	}
 
+	locale := utils.GetLocaleFromFiberCtx(c)
+	for i := range orgs {
+		orgs[i].Localize(locale)
+	}
+
 	return c.Status(fiber.StatusOK).JSON(orgs)
 }
 
@@ -103,6 +109,8 @@ func (h *OrganizationHandler) ListIndustries(c *fiber.Ctx) error {
 		return errs.SendFiberError(c, err)
 	}
 
+	dto.LocalizeIndustries(industries.Industries, utils.GetLocaleFromFiberCtx(c))
+
 	return c.Status(fiber.StatusOK).JSON(industries)
 }
 
@@ -112,6 +120,7 @@ func (h *OrganizationHandler) ListIndustries(c *fiber.Ctx) error {
 // @Accept json
 // @Produce json
 // @Param id path int true "Organization ID"
+// @Param Accept-Language header string false "Locale of the texts: th (default) or en"
 // @Success 200 {object} dto.OrganizationResponse
 // @Failure 400 {object} map[string]string "error: organization id is required"
 // @Failure 404 {object} map[string]string "error: organization not found"
@@ -132,6 +141,8 @@ func (h *OrganizationHandler) GetOrganizationByID(c *fiber.Ctx) error {
 		return errs.SendFiberError(c, err)
 	}
 
+	org.Localize(utils.GetLocaleFromFiberCtx(c))
+
 	return c.Status(fiber.StatusOK).JSON(org)
 }
 
@@ -141,6 +152,7 @@ func (h *OrganizationHandler) GetOrganizationByID(c *fiber.Ctx) error {
 // @Accept json
 // @Produce json
 // @Param page query int false "Page number"
+// @Param Accept-Language header string false "Locale of the texts: th (default) or en"
 // @Success 200 {array} dto.EventShortResponseDTO
 // @Failure 400 {object} map[string]string "error: invalid page"
 // @Failure 500 {object} map[string]string "error: Internal Server Error"
@@ -157,6 +169,11 @@ func (h *OrganizationHandler) GetOrganizationPaginate(c *fiber.Ctx) error {
 		return errs.SendFiberError(c, err)
 	}
 
+	locale := utils.GetLocaleFromFiberCtx(c)
+	for i := range organizations {
+		organizations[i].Localize(locale)
+	}
+
 	return c.Status(fiber.StatusOK).JSON(organizations)
 }
 
@@ -438,12 +455,28 @@ func (h *OrganizationContactHandler) DeleteContact(c *fiber.Ctx) error {
 // --------------------------------------------------------------------------
 
 type OrgOpenJobHandler struct {
-	service service.OrgOpenJobService
+	service      service.OrgOpenJobService
+	skillService service.SkillService
 }
 
 // Constructor
-func NewOrgOpenJobHandler(service service.OrgOpenJobService) *OrgOpenJobHandler {
-	return &OrgOpenJobHandler{service: service}
+func NewOrgOpenJobHandler(service service.OrgOpenJobService, skillService service.SkillService) *OrgOpenJobHandler {
+	return &OrgOpenJobHandler{service: service, skillService: skillService}
+}
+
+// matchSkillsForCurrentUser scores the required skills of each job against the skills of the logged-in candidate.
+// It returns nil for anonymous requests.
+func (h *OrgOpenJobHandler) matchSkillsForCurrentUser(c *fiber.Ctx, requiredSkills [][]dto.SkillShortResponse) ([]*dto.SkillMatchResponse, error) {
+	if h.skillService == nil {
+		return nil, nil
+	}
+
+	userID, err := utils.GetUserIDFormFiberCtx(c)
+	if err != nil {
+		return nil, nil
+	}
+
+	return h.skillService.MatchSkills(userID, requiredSkills)
 }
 
 // @Summary Create a new organization open job
@@ -486,6 +519,7 @@ func (h *OrgOpenJobHandler) CreateOrgOpenJob(c *fiber.Ctx) error {
 // @Tags Organization Job
 // @Accept json
 // @Produce json
+// @Param Accept-Language header string false "Locale of the texts: th (default) or en"
 // @Success 200 {array} dto.JobResponses
 // @Failure 500 {object} map[string]string "error: Internal Server Error"
 // @Router /orgs/jobs/list/all [get]
@@ -495,6 +529,11 @@ func (h *OrgOpenJobHandler) ListAllOrganizationJobs(c *fiber.Ctx) error {
 		return errs.SendFiberError(c, err)
 	}
 
+	locale := utils.GetLocaleFromFiberCtx(c)
+	for i := range orgs {
+		orgs[i].Localize(locale)
+	}
+
 	return c.Status(fiber.StatusOK).JSON(orgs)
 }
 
@@ -507,7 +546,7 @@ func (h *OrgOpenJobHandler) ListAllOrganizationJobs(c *fiber.Ctx) error {
 // @Success 200 {array} dto.JobResponses
 // @Failure 400 {object} map[string]string "error: Bad Request - organization id is required"
 // @Failure 500 {object} map[string]string "error: Internal Server Error"
-// @Router /orgs/{orgID}/jobs/list [get]
+// @Router /admin/orgs/{orgID}/jobs/list [get]
 func (h *OrgOpenJobHandler) ListOrgOpenJobsByOrgID(c *fiber.Ctx) error {
 	orgID, err := c.ParamsInt("orgID")
 	if err != nil {
@@ -525,6 +564,49 @@ func (h *OrgOpenJobHandler) ListOrgOpenJobsByOrgID(c *fiber.Ctx) error {
 	return c.Status(fiber.StatusOK).JSON(org)
 }
 
+// @Summary List the public jobs of an organization
+// @Description Get the jobs of an organization page, without those hidden by moderation
+// @Tags Organization Job
+// @Produce json
+// @Param orgID path int true "Organization ID"
+// @Param Accept-Language header string false "Locale of the texts: th (default) or en"
+// @Success 200 {array} dto.JobResponses
+// @Failure 400 {object} map[string]string "error: Bad Request - organization id is required"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /orgs/{orgID}/jobs/list [get]
+func (h *OrgOpenJobHandler) ListPublicOrgOpenJobsByOrgID(c *fiber.Ctx) error {
+	orgID, err := c.ParamsInt("orgID")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "organization id is required"})
+	}
+	if orgID < 1 {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid organization id"})
+	}
+
+	jobs, err := h.service.GetListedJobsByOrgID(uint(orgID))
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	locale := utils.GetLocaleFromFiberCtx(c)
+	for i := range jobs {
+		jobs[i].Localize(locale)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(jobs)
+}
+
+// @Summary Get a published job by ID
+// @Description Get a job by ID. For a logged-in candidate the response includes a skill match score.
+// @Tags Organization Job
+// @Produce json
+// @Param id path int true "Job ID"
+// @Param Accept-Language header string false "Locale of the texts: th (default) or en"
+// @Success 200 {object} dto.JobResponses
+// @Failure 400 {object} map[string]string "error: job id is required"
+// @Failure 404 {object} map[string]string "error: job not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /jobs/get/{id} [get]
 func (h *OrgOpenJobHandler) GetJobByID(c *fiber.Ctx) error {
 	jobID, err := c.ParamsInt("id")
 	if err != nil {
@@ -536,6 +618,16 @@ func (h *OrgOpenJobHandler) GetJobByID(c *fiber.Ctx) error {
 		return errs.SendFiberError(c, err)
 	}
 
+	matches, err := h.matchSkillsForCurrentUser(c, [][]dto.SkillShortResponse{job.Skills})
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+	if matches != nil {
+		job.SkillMatch = matches[0]
+	}
+
+	job.Localize(utils.GetLocaleFromFiberCtx(c))
+
 	return c.Status(fiber.StatusOK).JSON(job)
 }
 
@@ -550,7 +642,7 @@ func (h *OrgOpenJobHandler) GetJobByID(c *fiber.Ctx) error {
 // @Failure 400 {object} map[string]string "error: Bad Request - organization id & job id is required"
 // @Failure 404 {object} map[string]string "error: jobs not found"
 // @Failure 500 {object} map[string]string "error: Internal Server Error"
-// @Router /orgs/{orgID}/jobs/get/{id} [get]
+// @Router /admin/orgs/{orgID}/jobs/get/{id} [get]
 func (h *OrgOpenJobHandler) GetOrgOpenJobByIDwithOrgID(c *fiber.Ctx) error {
 	orgID, err := c.ParamsInt("orgID")
 	if err != nil {
@@ -570,6 +662,39 @@ func (h *OrgOpenJobHandler) GetOrgOpenJobByIDwithOrgID(c *fiber.Ctx) error {
 	return c.Status(fiber.StatusOK).JSON(org)
 }
 
+// @Summary Get a public job of an organization
+// @Description Get a job of an organization page, jobs hidden by moderation are not found
+// @Tags Organization Job
+// @Produce json
+// @Param orgID path int true "Organization ID"
+// @Param id path int true "Job ID"
+// @Param Accept-Language header string false "Locale of the texts: th (default) or en"
+// @Success 200 {object} dto.JobResponses
+// @Failure 400 {object} map[string]string "error: Bad Request - organization id & job id is required"
+// @Failure 404 {object} map[string]string "error: job not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /orgs/{orgID}/jobs/get/{id} [get]
+func (h *OrgOpenJobHandler) GetPublicOrgOpenJobByIDwithOrgID(c *fiber.Ctx) error {
+	orgID, err := c.ParamsInt("orgID")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "organization id is required"})
+	}
+
+	jobID, err := c.ParamsInt("id")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "job id is required"})
+	}
+
+	job, err := h.service.GetListedJobByIDwithOrgID(uint(orgID), uint(jobID))
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	job.Localize(utils.GetLocaleFromFiberCtx(c))
+
+	return c.Status(fiber.StatusOK).JSON(job)
+}
+
 // @Summary Update an organization open job by ID
 // @Description Update an organization open job by ID
 // @Tags Organization Job
@@ -667,24 +792,32 @@ func (h *OrgOpenJobHandler) GetPaginateOrgOpenJob(c *fiber.Ctx) error {
 		return errs.SendFiberError(c, err)
 	}
 
+	locale := utils.GetLocaleFromFiberCtx(c)
+	for i := range jobs {
+		jobs[i].Localize(locale)
+	}
+
 	return c.Status(fiber.StatusOK).JSON(jobs)
 }
 
 // SearchJobs handles the search for job postings based on the provided query parameters.
 // @Summary Search for job postings
-// @Description Search for job postings using various query parameters such as page and offset for pagination.
+// @Description Search for job postings using various query parameters such as page and offset for pagination. For a logged-in candidate each job includes a skill match score.
 // @Tags Organization Job
 // @Accept json
 // @Produce json
-// @Param q query string true "Keyword to search for jobs (Support: title, description, location, organization)"
+// @Param q query string true "Keyword to search for jobs (Support: title, description, location, organization, skills)"
 // @Param categories query string true "Category of jobs: all, environment, social, governance"
 // @Param workplace query string false "Workplace of jobs:  remote"
 // @Param workType query string false "Work type of jobs:  fulltime"
 // @Param careerStage query string false "Career stage of jobs:  entrylevel"
 // @Param salaryLowerBound query float64 false "Salary lower bound"
 // @Param salaryUpperBound query float64 false "Salary upper bound"
+// @Param salaryCurrency query string false "Currency of the salary bounds" default(THB)
+// @Param salaryPeriod query string false "Period of the salary bounds: hourly, monthly, yearly" default(monthly)
 // @Param page query int false "Page number for pagination" default(1)
 // @Param offset query int false "Number of items per page" default(12)
+// @Param Accept-Language header string false "Locale of the texts: th (default) or en"
 // @Success 200 {object} []dto.JobResponses
 // @Failure 400 {object} map[string]string "error: Bad Request - invalid query parameters"
 // @Failure 500 {object} map[string]string "error: Bad Request - Internal Server Error"
@@ -708,13 +841,31 @@ func (h *OrgOpenJobHandler) SearchJobs(c *fiber.Ctx) error {
 		Offset = query.Offset
 	}
 
-	events, err := h.service.SearchJobs(query, page, Offset)
+	jobs, err := h.service.SearchJobs(query, page, Offset)
 
 	if err != nil {
 		return errs.SendFiberError(c, err)
 	}
 
-	return c.Status(fiber.StatusOK).JSON(events)
+	requiredSkills := make([][]dto.SkillShortResponse, len(jobs.Jobs))
+	for i, job := range jobs.Jobs {
+		requiredSkills[i] = job.Skills
+	}
+
+	matches, err := h.matchSkillsForCurrentUser(c, requiredSkills)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+	for i := range matches {
+		jobs.Jobs[i].SkillMatch = matches[i]
+	}
+
+	locale := utils.GetLocaleFromFiberCtx(c)
+	for i := range jobs.Jobs {
+		jobs.Jobs[i].Localize(locale)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(jobs)
 }
 
 func (h *OrgOpenJobHandler) SyncJobs(c *fiber.Ctx) error {
@@ -0,0 +1,284 @@
+package handler
+
+import (
+	"fmt"
+
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/errs"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/dto"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/service"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/utils"
+	"github.com/gofiber/fiber/v2"
+)
+
+type ParticipantHandler struct {
+	service service.ParticipantService
+}
+
+func NewParticipantHandler(service service.ParticipantService) *ParticipantHandler {
+	return &ParticipantHandler{service: service}
+}
+
+// @Summary List the participants of an event
+// @Description List the registrations of an event of the organization, filtered by ticket type, check-in state and status
+// @Tags Organization Event Participants
+// @Produce json
+// @Security BearerAuth
+// @Param orgID path int true "Organization ID"
+// @Param id path int true "Event ID"
+// @Param ticketId query int false "Ticket type ID"
+// @Param checkedIn query string false "Check-in state" Enums(true, false)
+// @Param status query string false "Registration status, every status but cancelled when empty" Enums(pending, confirmed, cancelled)
+// @Success 200 {object} []dto.TicketPurchasedResponse
+// @Failure 400 {object} map[string]string "error: Invalid query parameters"
+// @Failure 403 {object} map[string]string "error: You are not authorized"
+// @Failure 404 {object} map[string]string "error: event not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /admin/orgs/{orgID}/events/{id}/participants [get]
+func (h *ParticipantHandler) ListParticipants(c *fiber.Ctx) error {
+	orgID, err := utils.GetOrgIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	eventID, err := utils.GetParamFormFiberCtx(c, "id", "event")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	query, ok := parseParticipantQuery(c)
+	if !ok {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "Invalid query parameters"})
+	}
+
+	participants, err := h.service.ListParticipants(orgID, eventID, query)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(participants)
+}
+
+// @Summary Export the participants of an event
+// @Description Download the registrations of an event of the organization as a CSV or XLSX file, with the same filters as the list
+// @Tags Organization Event Participants
+// @Produce text/csv
+// @Produce application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+// @Security BearerAuth
+// @Param orgID path int true "Organization ID"
+// @Param id path int true "Event ID"
+// @Param format query string false "File format, csv when empty" Enums(csv, xlsx)
+// @Param ticketId query int false "Ticket type ID"
+// @Param checkedIn query string false "Check-in state" Enums(true, false)
+// @Param status query string false "Registration status, every status but cancelled when empty" Enums(pending, confirmed, cancelled)
+// @Success 200 {file} file "Participants"
+// @Failure 400 {object} map[string]string "error: Invalid query parameters"
+// @Failure 403 {object} map[string]string "error: You are not authorized"
+// @Failure 404 {object} map[string]string "error: event not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /admin/orgs/{orgID}/events/{id}/participants/export [get]
+func (h *ParticipantHandler) ExportParticipants(c *fiber.Ctx) error {
+	orgID, err := utils.GetOrgIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	eventID, err := utils.GetParamFormFiberCtx(c, "id", "event")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	query, ok := parseParticipantQuery(c)
+	if !ok {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "Invalid query parameters"})
+	}
+
+	file, err := h.service.ExportParticipants(orgID, eventID, query)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	c.Set(fiber.HeaderContentType, file.ContentType)
+	c.Set(fiber.HeaderContentDisposition, fmt.Sprintf(`attachment; filename="%s"`, file.FileName))
+	c.Set(fiber.HeaderCacheControl, "private, no-store")
+	return c.Status(fiber.StatusOK).Send(file.Data)
+}
+
+// @Summary Email the participants of an event
+// @Description Send an announcement to every registrant of the event who accepts announcements, once per email address.
+// @Description Without scheduledAt the mails are sent right away in the background, otherwise at that time
+// @Tags Organization Event Participants
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param orgID path int true "Organization ID"
+// @Param id path int true "Event ID"
+// @Param body body dto.EventAnnouncementRequest true "Announcement"
+// @Success 202 {object} dto.EventAnnouncementResponse
+// @Failure 400 {object} map[string]string "error: scheduledAt must be in the future"
+// @Failure 403 {object} map[string]string "error: You are not authorized"
+// @Failure 404 {object} map[string]string "error: event not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /admin/orgs/{orgID}/events/{id}/participants/announcements [post]
+func (h *ParticipantHandler) SendAnnouncement(c *fiber.Ctx) error {
+	orgID, err := utils.GetOrgIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	eventID, err := utils.GetParamFormFiberCtx(c, "id", "event")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	var req dto.EventAnnouncementRequest
+	if err := utils.ParseJSONAndValidate(c, &req); err != nil {
+		return err
+	}
+
+	res, err := h.service.SendAnnouncement(orgID, eventID, req)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusAccepted).JSON(res)
+}
+
+// @Summary List the announcements of an event
+// @Description List the announcements sent and scheduled for an event of the organization, latest first
+// @Tags Organization Event Participants
+// @Produce json
+// @Security BearerAuth
+// @Param orgID path int true "Organization ID"
+// @Param id path int true "Event ID"
+// @Success 200 {object} []dto.EventAnnouncementResponse
+// @Failure 400 {object} map[string]string "error: event id is required"
+// @Failure 403 {object} map[string]string "error: You are not authorized"
+// @Failure 404 {object} map[string]string "error: event not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /admin/orgs/{orgID}/events/{id}/participants/announcements [get]
+func (h *ParticipantHandler) ListAnnouncements(c *fiber.Ctx) error {
+	orgID, err := utils.GetOrgIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	eventID, err := utils.GetParamFormFiberCtx(c, "id", "event")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	announcements, err := h.service.ListAnnouncements(orgID, eventID)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(announcements)
+}
+
+// @Summary Cancel a scheduled announcement
+// @Description Cancel an announcement of an event that has not been sent yet
+// @Tags Organization Event Participants
+// @Produce json
+// @Security BearerAuth
+// @Param orgID path int true "Organization ID"
+// @Param id path int true "Event ID"
+// @Param announcementID path int true "Announcement ID"
+// @Success 200 {object} map[string]string "message: announcement cancelled successfully"
+// @Failure 400 {object} map[string]string "error: announcement id is required"
+// @Failure 403 {object} map[string]string "error: You are not authorized"
+// @Failure 404 {object} map[string]string "error: announcement not found"
+// @Failure 409 {object} map[string]string "error: only scheduled announcements can be cancelled"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /admin/orgs/{orgID}/events/{id}/participants/announcements/{announcementID} [delete]
+func (h *ParticipantHandler) CancelAnnouncement(c *fiber.Ctx) error {
+	orgID, err := utils.GetOrgIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	eventID, err := utils.GetParamFormFiberCtx(c, "id", "event")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	announcementID, err := utils.GetParamFormFiberCtx(c, "announcementID", "announcement")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	if err := h.service.CancelAnnouncement(orgID, eventID, announcementID); err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{"message": "announcement cancelled successfully"})
+}
+
+// @Summary List the attendees of an event
+// @Description List the participants of an event who chose to appear on its public attendee list
+// @Tags Event Participants
+// @Produce json
+// @Param id path int true "Event ID"
+// @Success 200 {object} dto.PublicParticipantsResponse
+// @Failure 400 {object} map[string]string "error: event id is required"
+// @Failure 404 {object} map[string]string "error: event not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /events/{id}/participants [get]
+func (h *ParticipantHandler) ListPublicParticipants(c *fiber.Ctx) error {
+	eventID, err := utils.GetParamFormFiberCtx(c, "id", "event")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	participants, err := h.service.ListPublicParticipants(eventID)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(participants)
+}
+
+// @Summary Show or hide me on the attendee list
+// @Description Choose whether the current user appears on the public attendee list of an event they registered for
+// @Tags Event Participants
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Event ID"
+// @Param body body dto.ParticipantVisibilityRequest true "Visibility"
+// @Success 200 {object} map[string]string "message: visibility updated successfully"
+// @Failure 400 {object} map[string]string "error: isVisible is required"
+// @Failure 401 {object} map[string]string "error: unauthorized"
+// @Failure 404 {object} map[string]string "error: you are not a participant of this event"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /events/{id}/participants/me [put]
+func (h *ParticipantHandler) UpdateMyVisibility(c *fiber.Ctx) error {
+	userID, err := utils.GetUserIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	eventID, err := utils.GetParamFormFiberCtx(c, "id", "event")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	var req dto.ParticipantVisibilityRequest
+	if err := utils.ParseJSONAndValidate(c, &req); err != nil {
+		return err
+	}
+
+	if err := h.service.UpdateMyVisibility(userID, eventID, req); err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{"message": "visibility updated successfully"})
+}
+
+func parseParticipantQuery(c *fiber.Ctx) (dto.ParticipantQuery, bool) {
+	var query dto.ParticipantQuery
+	if err := c.QueryParser(&query); err != nil {
+		return query, false
+	}
+
+	return query, len(utils.ValidateStruct(&query)) == 0
+}
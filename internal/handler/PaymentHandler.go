@@ -0,0 +1,235 @@
+package handler
+
+import (
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/errs"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/infrastructure/payment"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/service"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/utils"
+	"github.com/gofiber/fiber/v2"
+)
+
+type PaymentHandler struct {
+	service service.PaymentService
+}
+
+func NewPaymentHandler(service service.PaymentService) *PaymentHandler {
+	return &PaymentHandler{service: service}
+}
+
+// @Summary Pay for a ticket
+// @Description Open the order of a pending ticket and get a payment QR code. Calling it again returns the same order, with a new charge if the last one failed
+// @Tags Payments
+// @Produce json
+// @Security BearerAuth
+// @Param purchaseID path int true "Ticket ID"
+// @Success 200 {object} dto.OrderResponse
+// @Failure 400 {object} map[string]string "error: ticket is not waiting for a payment"
+// @Failure 401 {object} map[string]string "error: unauthorized"
+// @Failure 404 {object} map[string]string "error: ticket not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /users/me/tickets/{purchaseID}/checkout [post]
+func (h *PaymentHandler) Checkout(c *fiber.Ctx) error {
+	userID, err := utils.GetUserIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	purchaseID, err := utils.GetParamFormFiberCtx(c, "purchaseID", "ticket")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	order, err := h.service.Checkout(c.Context(), userID, purchaseID)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(order)
+}
+
+// @Summary Get my order
+// @Description Get an order of the current user with its latest payment attempt
+// @Tags Payments
+// @Produce json
+// @Security BearerAuth
+// @Param orderID path int true "Order ID"
+// @Success 200 {object} dto.OrderResponse
+// @Failure 400 {object} map[string]string "error: order id is required"
+// @Failure 401 {object} map[string]string "error: unauthorized"
+// @Failure 404 {object} map[string]string "error: order not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /users/me/orders/{orderID} [get]
+func (h *PaymentHandler) GetMyOrder(c *fiber.Ctx) error {
+	userID, err := utils.GetUserIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	orderID, err := utils.GetParamFormFiberCtx(c, "orderID", "order")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	order, err := h.service.GetMyOrder(userID, orderID)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(order)
+}
+
+// @Summary Get the payment QR code of my order
+// @Description Get the PromptPay QR code of the pending payment of an order as a PNG image
+// @Tags Payments
+// @Produce png
+// @Security BearerAuth
+// @Param orderID path int true "Order ID"
+// @Success 200 {file} binary
+// @Failure 400 {object} map[string]string "error: order has no payment QR code to pay"
+// @Failure 401 {object} map[string]string "error: unauthorized"
+// @Failure 404 {object} map[string]string "error: order not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /users/me/orders/{orderID}/qrcode [get]
+func (h *PaymentHandler) GetMyOrderQRCode(c *fiber.Ctx) error {
+	userID, err := utils.GetUserIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	orderID, err := utils.GetParamFormFiberCtx(c, "orderID", "order")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	png, err := h.service.GetMyOrderQRCode(userID, orderID)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	c.Set(fiber.HeaderContentType, "image/png")
+	c.Set(fiber.HeaderCacheControl, "private, no-store")
+	return c.Status(fiber.StatusOK).Send(png)
+}
+
+// @Summary Simulate the payment of my order
+// @Description Pay an order through the fake provider, only available when the fake provider is configured
+// @Tags Payments
+// @Produce json
+// @Security BearerAuth
+// @Param orderID path int true "Order ID"
+// @Success 200 {object} dto.OrderResponse
+// @Failure 400 {object} map[string]string "error: order has no payment"
+// @Failure 401 {object} map[string]string "error: unauthorized"
+// @Failure 403 {object} map[string]string "error: payments can only be simulated with the fake provider"
+// @Failure 404 {object} map[string]string "error: order not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /users/me/orders/{orderID}/simulate-payment [post]
+func (h *PaymentHandler) SimulatePayment(c *fiber.Ctx) error {
+	userID, err := utils.GetUserIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	orderID, err := utils.GetParamFormFiberCtx(c, "orderID", "order")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	order, err := h.service.SimulatePayment(userID, orderID)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(order)
+}
+
+// @Summary Receive a payment notification
+// @Description Webhook called by the payment provider. The raw body must be signed with the shared webhook secret (hex HMAC-SHA256 in X-Payment-Signature)
+// @Tags Payments
+// @Accept json
+// @Produce json
+// @Param provider path string true "Payment provider" Enums(promptpay, fake)
+// @Param X-Payment-Signature header string true "HMAC-SHA256 signature of the body"
+// @Success 200 {object} map[string]string "message: ok"
+// @Failure 400 {object} map[string]string "error: invalid webhook payload"
+// @Failure 401 {object} map[string]string "error: invalid webhook signature"
+// @Failure 404 {object} map[string]string "error: payment not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /payments/webhook/{provider} [post]
+func (h *PaymentHandler) Webhook(c *fiber.Ctx) error {
+	if err := h.service.HandleWebhook(c.Params("provider"), c.Body(), c.Get(payment.SignatureHeader)); err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{"message": "ok"})
+}
+
+// @Summary List the orders of an event
+// @Description List the orders of an event of the organization with their latest payment attempt
+// @Tags Organization Event Payments
+// @Produce json
+// @Security BearerAuth
+// @Param orgID path int true "Organization ID"
+// @Param id path int true "Event ID"
+// @Success 200 {object} []dto.OrderResponse
+// @Failure 400 {object} map[string]string "error: event id is required"
+// @Failure 403 {object} map[string]string "error: You are not authorized"
+// @Failure 404 {object} map[string]string "error: event not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /admin/orgs/{orgID}/events/{id}/orders [get]
+func (h *PaymentHandler) ListEventOrders(c *fiber.Ctx) error {
+	orgID, err := utils.GetOrgIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	eventID, err := utils.GetParamFormFiberCtx(c, "id", "event")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	orders, err := h.service.ListEventOrders(orgID, eventID)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(orders)
+}
+
+// @Summary Refund an order
+// @Description Refund a paid order and cancel its ticket, the seat goes to the waitlist or back on sale. PromptPay refunds are made manually and recorded as such
+// @Tags Organization Event Payments
+// @Produce json
+// @Security BearerAuth
+// @Param orgID path int true "Organization ID"
+// @Param id path int true "Event ID"
+// @Param orderID path int true "Order ID"
+// @Success 200 {object} dto.OrderResponse
+// @Failure 400 {object} map[string]string "error: order cannot be refunded"
+// @Failure 403 {object} map[string]string "error: You are not authorized"
+// @Failure 404 {object} map[string]string "error: order not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /admin/orgs/{orgID}/events/{id}/orders/{orderID}/refund [post]
+func (h *PaymentHandler) RefundOrder(c *fiber.Ctx) error {
+	orgID, err := utils.GetOrgIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	eventID, err := utils.GetParamFormFiberCtx(c, "id", "event")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	orderID, err := utils.GetParamFormFiberCtx(c, "orderID", "order")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	order, err := h.service.RefundOrder(c.Context(), orgID, eventID, orderID)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(order)
+}
@@ -0,0 +1,275 @@
+package handler
+
+import (
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/errs"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/dto"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/service"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/utils"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+type ProfileHandler struct {
+	service service.ProfileService
+}
+
+func NewProfileHandler(service service.ProfileService) *ProfileHandler {
+	return &ProfileHandler{service: service}
+}
+
+// @Summary Get my profile
+// @Description Get the full profile of the current user with skills and experiences
+// @Tags Profile
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} dto.ProfileDetailResponse
+// @Failure 401 {object} map[string]string "error: unauthorized"
+// @Failure 404 {object} map[string]string "error: profile not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /users/me/profile [get]
+func (h *ProfileHandler) GetMyProfile(c *fiber.Ctx) error {
+	userID, err := utils.GetUserIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	profile, err := h.service.GetMyProfile(userID)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(profile)
+}
+
+// @Summary Update my profile
+// @Description Update the profile of the current user, fields left empty are cleared
+// @Tags Profile
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param body body dto.ProfileUpdateRequest true "Profile"
+// @Success 200 {object} dto.ProfileDetailResponse
+// @Failure 400 {object} map[string]string "error: Bad Request - invalid profile"
+// @Failure 401 {object} map[string]string "error: unauthorized"
+// @Failure 404 {object} map[string]string "error: profile not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /users/me/profile [put]
+func (h *ProfileHandler) UpdateMyProfile(c *fiber.Ctx) error {
+	userID, err := utils.GetUserIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	var req dto.ProfileUpdateRequest
+	if err := utils.ParseJSONAndValidate(c, &req); err != nil {
+		return err
+	}
+
+	profile, err := h.service.UpdateMyProfile(userID, req)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(profile)
+}
+
+type ExperienceHandler struct {
+	service service.ExperienceService
+}
+
+func NewExperienceHandler(service service.ExperienceService) *ExperienceHandler {
+	return &ExperienceHandler{service: service}
+}
+
+// @Summary List my experiences
+// @Description List the experiences of the current user, current roles first
+// @Tags Profile
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} []dto.ExperienceResponse
+// @Failure 401 {object} map[string]string "error: unauthorized"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /users/me/experiences [get]
+func (h *ExperienceHandler) ListMyExperiences(c *fiber.Ctx) error {
+	userID, err := utils.GetUserIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	experiences, err := h.service.ListExperiences(userID)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(experiences)
+}
+
+// @Summary Get one of my experiences
+// @Description Get an experience of the current user
+// @Tags Profile
+// @Produce json
+// @Security BearerAuth
+// @Param experienceID path string true "Experience ID"
+// @Success 200 {object} dto.ExperienceResponse
+// @Failure 400 {object} map[string]string "error: invalid experience id"
+// @Failure 401 {object} map[string]string "error: unauthorized"
+// @Failure 404 {object} map[string]string "error: experience not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /users/me/experiences/{experienceID} [get]
+func (h *ExperienceHandler) GetMyExperience(c *fiber.Ctx) error {
+	userID, err := utils.GetUserIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	experienceID, err := uuid.Parse(c.Params("experienceID"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid experience id"})
+	}
+
+	experience, err := h.service.GetExperience(userID, experienceID)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(experience)
+}
+
+// @Summary Add an experience
+// @Description Add an experience to the current user's profile
+// @Tags Profile
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param body body dto.ExperienceRequest true "Experience"
+// @Success 201 {object} dto.ExperienceResponse
+// @Failure 400 {object} map[string]string "error: experience end date must be after its start date"
+// @Failure 401 {object} map[string]string "error: unauthorized"
+// @Failure 404 {object} map[string]string "error: profile not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /users/me/experiences [post]
+func (h *ExperienceHandler) CreateMyExperience(c *fiber.Ctx) error {
+	userID, err := utils.GetUserIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	var req dto.ExperienceRequest
+	if err := utils.ParseJSONAndValidate(c, &req); err != nil {
+		return err
+	}
+
+	experience, err := h.service.CreateExperience(userID, req)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(experience)
+}
+
+// @Summary Update an experience
+// @Description Update an experience of the current user
+// @Tags Profile
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param experienceID path string true "Experience ID"
+// @Param body body dto.ExperienceRequest true "Experience"
+// @Success 200 {object} dto.ExperienceResponse
+// @Failure 400 {object} map[string]string "error: invalid experience id"
+// @Failure 401 {object} map[string]string "error: unauthorized"
+// @Failure 404 {object} map[string]string "error: experience not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /users/me/experiences/{experienceID} [put]
+func (h *ExperienceHandler) UpdateMyExperience(c *fiber.Ctx) error {
+	userID, err := utils.GetUserIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	experienceID, err := uuid.Parse(c.Params("experienceID"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid experience id"})
+	}
+
+	var req dto.ExperienceRequest
+	if err := utils.ParseJSONAndValidate(c, &req); err != nil {
+		return err
+	}
+
+	experience, err := h.service.UpdateExperience(userID, experienceID, req)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(experience)
+}
+
+// @Summary Upload an experience picture
+// @Description Upload the picture (e.g. company logo) of an experience of the current user
+// @Tags Profile
+// @Accept multipart/form-data
+// @Produce json
+// @Security BearerAuth
+// @Param experienceID path string true "Experience ID"
+// @Param image formData file true "Experience picture"
+// @Success 200 {object} map[string]string "picUrl: https://..."
+// @Failure 400 {object} map[string]string "error: Failed to get image from form"
+// @Failure 401 {object} map[string]string "error: unauthorized"
+// @Failure 404 {object} map[string]string "error: experience not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /users/me/experiences/{experienceID}/picture [post]
+func (h *ExperienceHandler) UploadMyExperiencePicture(c *fiber.Ctx) error {
+	userID, err := utils.GetUserIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	experienceID, err := uuid.Parse(c.Params("experienceID"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid experience id"})
+	}
+
+	file, fileHeader, err := utils.UploadImage(c)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+	defer file.Close()
+
+	picURL, err := h.service.UpdateExperiencePicture(c.Context(), userID, experienceID, file, fileHeader)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{"picUrl": picURL})
+}
+
+// @Summary Delete an experience
+// @Description Delete an experience of the current user
+// @Tags Profile
+// @Produce json
+// @Security BearerAuth
+// @Param experienceID path string true "Experience ID"
+// @Success 200 {object} map[string]string "message: Experience deleted successfully"
+// @Failure 400 {object} map[string]string "error: invalid experience id"
+// @Failure 401 {object} map[string]string "error: unauthorized"
+// @Failure 404 {object} map[string]string "error: experience not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /users/me/experiences/{experienceID} [delete]
+func (h *ExperienceHandler) DeleteMyExperience(c *fiber.Ctx) error {
+	userID, err := utils.GetUserIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	experienceID, err := uuid.Parse(c.Params("experienceID"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid experience id"})
+	}
+
+	if err := h.service.DeleteExperience(userID, experienceID); err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{"message": "Experience deleted successfully"})
+}
@@ -0,0 +1,249 @@
+package handler
+
+import (
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/errs"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/dto"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/service"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/utils"
+	"github.com/gofiber/fiber/v2"
+)
+
+type PromoCodeHandler struct {
+	service service.PromoCodeService
+}
+
+func NewPromoCodeHandler(service service.PromoCodeService) *PromoCodeHandler {
+	return &PromoCodeHandler{service: service}
+}
+
+// @Summary Preview a promo code
+// @Description Check a promo code against a ticket type for the current user and get the discounted price. The code is only redeemed on registration
+// @Tags Event Tickets
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Event ID"
+// @Param ticketID path int true "Ticket ID"
+// @Param body body dto.PromoCodeQuoteRequest true "Promo code"
+// @Success 200 {object} dto.PromoCodeQuoteResponse
+// @Failure 400 {object} map[string]string "error: promo code is not valid"
+// @Failure 401 {object} map[string]string "error: unauthorized"
+// @Failure 404 {object} map[string]string "error: ticket not found"
+// @Failure 409 {object} map[string]string "error: promo code has been fully redeemed"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /events/{id}/tickets/{ticketID}/promo-codes/quote [post]
+func (h *PromoCodeHandler) QuotePromoCode(c *fiber.Ctx) error {
+	userID, err := utils.GetUserIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	eventID, err := utils.GetParamFormFiberCtx(c, "id", "event")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	ticketID, err := utils.GetParamFormFiberCtx(c, "ticketID", "ticket")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	var req dto.PromoCodeQuoteRequest
+	if err := utils.ParseJSONAndValidate(c, &req); err != nil {
+		return err
+	}
+
+	quote, err := h.service.QuotePromoCode(userID, eventID, ticketID, req)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(quote)
+}
+
+// @Summary List the promo codes of an event
+// @Description List the promo codes of an event of the organization
+// @Tags Organization Event Promo Codes
+// @Produce json
+// @Security BearerAuth
+// @Param orgID path int true "Organization ID"
+// @Param id path int true "Event ID"
+// @Success 200 {object} []dto.PromoCodeResponse
+// @Failure 400 {object} map[string]string "error: event id is required"
+// @Failure 403 {object} map[string]string "error: You are not authorized"
+// @Failure 404 {object} map[string]string "error: event not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /admin/orgs/{orgID}/events/{id}/promo-codes [get]
+func (h *PromoCodeHandler) ListPromoCodes(c *fiber.Ctx) error {
+	orgID, err := utils.GetOrgIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	eventID, err := utils.GetParamFormFiberCtx(c, "id", "event")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	promos, err := h.service.ListPromoCodes(orgID, eventID)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(promos)
+}
+
+// @Summary Create a promo code
+// @Description Create a percentage or fixed amount promo code for some or all ticket types of an event
+// @Tags Organization Event Promo Codes
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param orgID path int true "Organization ID"
+// @Param id path int true "Event ID"
+// @Param body body dto.PromoCodeRequest true "Promo code"
+// @Success 201 {object} dto.PromoCodeResponse
+// @Failure 400 {object} map[string]string "error: ticket types must belong to the event"
+// @Failure 403 {object} map[string]string "error: You are not authorized"
+// @Failure 404 {object} map[string]string "error: event not found"
+// @Failure 409 {object} map[string]string "error: promo code already exists for this event"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /admin/orgs/{orgID}/events/{id}/promo-codes [post]
+func (h *PromoCodeHandler) CreatePromoCode(c *fiber.Ctx) error {
+	orgID, err := utils.GetOrgIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	eventID, err := utils.GetParamFormFiberCtx(c, "id", "event")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	var req dto.PromoCodeRequest
+	if err := utils.ParseJSONAndValidate(c, &req); err != nil {
+		return err
+	}
+
+	promo, err := h.service.CreatePromoCode(orgID, eventID, req)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(promo)
+}
+
+// @Summary Update a promo code
+// @Description Replace the settings and the ticket types of a promo code, its usage is kept
+// @Tags Organization Event Promo Codes
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param orgID path int true "Organization ID"
+// @Param id path int true "Event ID"
+// @Param promoID path int true "Promo code ID"
+// @Param body body dto.PromoCodeRequest true "Promo code"
+// @Success 200 {object} dto.PromoCodeResponse
+// @Failure 400 {object} map[string]string "error: ticket types must belong to the event"
+// @Failure 403 {object} map[string]string "error: You are not authorized"
+// @Failure 404 {object} map[string]string "error: promo code not found"
+// @Failure 409 {object} map[string]string "error: promo code already exists for this event"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /admin/orgs/{orgID}/events/{id}/promo-codes/{promoID} [put]
+func (h *PromoCodeHandler) UpdatePromoCode(c *fiber.Ctx) error {
+	orgID, eventID, promoID, err := getOrgEventPromoIDs(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	var req dto.PromoCodeRequest
+	if err := utils.ParseJSONAndValidate(c, &req); err != nil {
+		return err
+	}
+
+	promo, err := h.service.UpdatePromoCode(orgID, eventID, promoID, req)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(promo)
+}
+
+// @Summary Delete a promo code
+// @Description Delete a promo code that was never redeemed, redeemed codes can only be deactivated
+// @Tags Organization Event Promo Codes
+// @Produce json
+// @Security BearerAuth
+// @Param orgID path int true "Organization ID"
+// @Param id path int true "Event ID"
+// @Param promoID path int true "Promo code ID"
+// @Success 200 {object} map[string]string "message: promo code deleted successfully"
+// @Failure 400 {object} map[string]string "error: promo code id is required"
+// @Failure 403 {object} map[string]string "error: You are not authorized"
+// @Failure 404 {object} map[string]string "error: promo code not found"
+// @Failure 409 {object} map[string]string "error: promo code has been redeemed, deactivate it instead"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /admin/orgs/{orgID}/events/{id}/promo-codes/{promoID} [delete]
+func (h *PromoCodeHandler) DeletePromoCode(c *fiber.Ctx) error {
+	orgID, eventID, promoID, err := getOrgEventPromoIDs(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	if err := h.service.DeletePromoCode(orgID, eventID, promoID); err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{"message": "promo code deleted successfully"})
+}
+
+// @Summary Get the promo code redemption report of an event
+// @Description Usage, discount and revenue of every promo code of an event, with the list of redemptions
+// @Tags Organization Event Promo Codes
+// @Produce json
+// @Security BearerAuth
+// @Param orgID path int true "Organization ID"
+// @Param id path int true "Event ID"
+// @Success 200 {object} dto.PromoCodeReportResponse
+// @Failure 400 {object} map[string]string "error: event id is required"
+// @Failure 403 {object} map[string]string "error: You are not authorized"
+// @Failure 404 {object} map[string]string "error: event not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /admin/orgs/{orgID}/events/{id}/promo-codes/report [get]
+func (h *PromoCodeHandler) GetPromoCodeReport(c *fiber.Ctx) error {
+	orgID, err := utils.GetOrgIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	eventID, err := utils.GetParamFormFiberCtx(c, "id", "event")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	report, err := h.service.GetPromoCodeReport(orgID, eventID)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(report)
+}
+
+func getOrgEventPromoIDs(c *fiber.Ctx) (uint, uint, uint, error) {
+	orgID, err := utils.GetOrgIDFormFiberCtx(c)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+
+	eventID, err := utils.GetParamFormFiberCtx(c, "id", "event")
+	if err != nil {
+		return 0, 0, 0, err
+	}
+
+	promoID, err := utils.GetParamFormFiberCtx(c, "promoID", "promo code")
+	if err != nil {
+		return 0, 0, 0, err
+	}
+
+	return orgID, eventID, promoID, nil
+}
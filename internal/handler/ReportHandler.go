@@ -0,0 +1,136 @@
+package handler
+
+import (
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/errs"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/dto"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/service"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/utils"
+	"github.com/gofiber/fiber/v2"
+)
+
+type ReportHandler struct {
+	service service.ReportService
+}
+
+func NewReportHandler(service service.ReportService) *ReportHandler {
+	return &ReportHandler{service: service}
+}
+
+// @Summary Report content
+// @Description Report an event, a job, an organization or a discussion post to the moderators. Content gets hidden until reviewed once enough users report it
+// @Tags Reports
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param body body dto.ContentReportRequest true "Report"
+// @Success 201 {object} dto.ContentReportResponse
+// @Failure 400 {object} map[string]string "error: reason is required"
+// @Failure 401 {object} map[string]string "error: Unauthorized"
+// @Failure 404 {object} map[string]string "error: event not found"
+// @Failure 409 {object} map[string]string "error: you already reported this content"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /reports [post]
+func (h *ReportHandler) Report(c *fiber.Ctx) error {
+	userID, err := utils.GetUserIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	var req dto.ContentReportRequest
+	if err := utils.ParseJSONAndValidate(c, &req); err != nil {
+		return err
+	}
+
+	report, err := h.service.Report(userID, req)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(report)
+}
+
+// @Summary List the moderation queue
+// @Description List the contents with pending reports, the most reported first. Only for system admins
+// @Tags Reports
+// @Produce json
+// @Security BearerAuth
+// @Param targetType query string false "Only list a type of content" Enums(event, job, organization, question, answer)
+// @Success 200 {array} dto.ReportedContentResponse
+// @Failure 400 {object} map[string]string "error: target type must be one of event, job, organization, question or answer"
+// @Failure 403 {object} map[string]string "error: You are not authorized"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /admin/moderation/reports [get]
+func (h *ReportHandler) ListQueue(c *fiber.Ctx) error {
+	queue, err := h.service.ListQueue(c.Query("targetType"))
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(queue)
+}
+
+// @Summary Get a reported content
+// @Description Get a content of the moderation queue with its pending reports and the actions taken on it. Only for system admins
+// @Tags Reports
+// @Produce json
+// @Security BearerAuth
+// @Param targetType path string true "Type of content" Enums(event, job, organization, question, answer)
+// @Param targetID path int true "Content ID"
+// @Success 200 {object} dto.ReportedContentDetailResponse
+// @Failure 400 {object} map[string]string "error: target type must be one of event, job, organization, question or answer"
+// @Failure 403 {object} map[string]string "error: You are not authorized"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /admin/moderation/reports/{targetType}/{targetID} [get]
+func (h *ReportHandler) GetReportedContent(c *fiber.Ctx) error {
+	targetID, err := utils.GetParamFormFiberCtx(c, "targetID", "target")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	content, err := h.service.GetReportedContent(c.Params("targetType"), targetID)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(content)
+}
+
+// @Summary Take action on a reported content
+// @Description Dismiss the pending reports on a content, hide it, suspend its organization or warn its owner. The action is recorded and the reporters are told the outcome. Only for system admins
+// @Tags Reports
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param targetType path string true "Type of content" Enums(event, job, organization, question, answer)
+// @Param targetID path int true "Content ID"
+// @Param body body dto.ModerationActionRequest true "Action"
+// @Success 201 {object} dto.ModerationLogResponse
+// @Failure 400 {object} map[string]string "error: organizations are suspended rather than hidden"
+// @Failure 401 {object} map[string]string "error: Unauthorized"
+// @Failure 403 {object} map[string]string "error: You are not authorized"
+// @Failure 404 {object} map[string]string "error: event not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /admin/moderation/reports/{targetType}/{targetID}/actions [post]
+func (h *ReportHandler) TakeAction(c *fiber.Ctx) error {
+	userID, err := utils.GetUserIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	targetID, err := utils.GetParamFormFiberCtx(c, "targetID", "target")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	var req dto.ModerationActionRequest
+	if err := utils.ParseJSONAndValidate(c, &req); err != nil {
+		return err
+	}
+
+	log, err := h.service.TakeAction(userID, c.Params("targetType"), targetID, req)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(log)
+}
@@ -0,0 +1,202 @@
+package handler
+
+import (
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/errs"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/dto"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/service"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/utils"
+	"github.com/gofiber/fiber/v2"
+)
+
+type ResumeHandler struct {
+	service service.ResumeService
+}
+
+func NewResumeHandler(service service.ResumeService) *ResumeHandler {
+	return &ResumeHandler{service: service}
+}
+
+// @Summary Upload a resume
+// @Description Upload a new version of the current user's resume (PDF or DOCX, max 5 MB) and get the profile suggestions extracted from it
+// @Tags Resumes
+// @Accept multipart/form-data
+// @Produce json
+// @Security BearerAuth
+// @Param resume formData file true "Resume file"
+// @Success 201 {object} dto.ResumeUploadResponse
+// @Failure 400 {object} map[string]string "error: resume must be a PDF or DOCX file"
+// @Failure 401 {object} map[string]string "error: unauthorized"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /users/me/resumes [post]
+func (h *ResumeHandler) UploadResume(c *fiber.Ctx) error {
+	userID, err := utils.GetUserIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	file, fileHeader, err := utils.UploadResume(c)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+	defer file.Close()
+
+	res, err := h.service.UploadResume(c.Context(), userID, file, fileHeader)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(res)
+}
+
+// @Summary List my resumes
+// @Description List every version of the current user's resume, latest first
+// @Tags Resumes
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} []dto.ResumeResponse
+// @Failure 401 {object} map[string]string "error: unauthorized"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /users/me/resumes [get]
+func (h *ResumeHandler) ListMyResumes(c *fiber.Ctx) error {
+	userID, err := utils.GetUserIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	resumes, err := h.service.ListResumes(userID)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(resumes)
+}
+
+// @Summary Get a download URL for my resume
+// @Description Get a short-lived presigned URL to download one of the current user's resumes
+// @Tags Resumes
+// @Produce json
+// @Security BearerAuth
+// @Param resumeID path int true "Resume ID"
+// @Success 200 {object} dto.ResumeURLResponse
+// @Failure 400 {object} map[string]string "error: resume id is required"
+// @Failure 401 {object} map[string]string "error: unauthorized"
+// @Failure 404 {object} map[string]string "error: resume not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /users/me/resumes/{resumeID}/url [get]
+func (h *ResumeHandler) GetMyResumeURL(c *fiber.Ctx) error {
+	userID, err := utils.GetUserIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	resumeID, err := utils.GetParamFormFiberCtx(c, "resumeID", "resume")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	res, err := h.service.GetResumeURL(c.Context(), userID, resumeID)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(res)
+}
+
+// @Summary Get the profile suggestions of my resume
+// @Description Get the education, skills and experiences extracted from one of the current user's resumes
+// @Tags Resumes
+// @Produce json
+// @Security BearerAuth
+// @Param resumeID path int true "Resume ID"
+// @Success 200 {object} dto.ResumeSuggestionResponse
+// @Failure 400 {object} map[string]string "error: resume id is required"
+// @Failure 401 {object} map[string]string "error: unauthorized"
+// @Failure 404 {object} map[string]string "error: resume not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /users/me/resumes/{resumeID}/suggestions [get]
+func (h *ResumeHandler) GetMyResumeSuggestions(c *fiber.Ctx) error {
+	userID, err := utils.GetUserIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	resumeID, err := utils.GetParamFormFiberCtx(c, "resumeID", "resume")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	res, err := h.service.GetResumeSuggestions(userID, resumeID)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(res)
+}
+
+// @Summary Confirm the profile suggestions of my resume
+// @Description Save the reviewed suggestions to the current user's profile: education and skill text are replaced, skills and experiences are added
+// @Tags Resumes
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param resumeID path int true "Resume ID"
+// @Param body body dto.ResumeConfirmRequest true "Confirmed suggestions"
+// @Success 200 {object} map[string]string "message: Profile updated successfully"
+// @Failure 400 {object} map[string]string "error: some skills do not exist"
+// @Failure 401 {object} map[string]string "error: unauthorized"
+// @Failure 404 {object} map[string]string "error: resume not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /users/me/resumes/{resumeID}/confirm [post]
+func (h *ResumeHandler) ConfirmMyResumeSuggestions(c *fiber.Ctx) error {
+	userID, err := utils.GetUserIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	resumeID, err := utils.GetParamFormFiberCtx(c, "resumeID", "resume")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	var req dto.ResumeConfirmRequest
+	if err := utils.ParseJSONAndValidate(c, &req); err != nil {
+		return err
+	}
+
+	if err := h.service.ConfirmResumeSuggestions(userID, resumeID, req); err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{"message": "Profile updated successfully"})
+}
+
+// @Summary Delete my resume
+// @Description Delete one version of the current user's resume, a resume attached to a job application cannot be deleted
+// @Tags Resumes
+// @Produce json
+// @Security BearerAuth
+// @Param resumeID path int true "Resume ID"
+// @Success 200 {object} map[string]string "message: Resume deleted successfully"
+// @Failure 400 {object} map[string]string "error: resume id is required"
+// @Failure 401 {object} map[string]string "error: unauthorized"
+// @Failure 404 {object} map[string]string "error: resume not found"
+// @Failure 409 {object} map[string]string "error: resume is attached to a job application"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /users/me/resumes/{resumeID} [delete]
+func (h *ResumeHandler) DeleteMyResume(c *fiber.Ctx) error {
+	userID, err := utils.GetUserIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	resumeID, err := utils.GetParamFormFiberCtx(c, "resumeID", "resume")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	if err := h.service.DeleteResume(c.Context(), userID, resumeID); err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{"message": "Resume deleted successfully"})
+}
@@ -0,0 +1,214 @@
+package handler
+
+import (
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/errs"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/dto"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/service"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/utils"
+	"github.com/gofiber/fiber/v2"
+)
+
+type SkillHandler struct {
+	service service.SkillService
+}
+
+func NewSkillHandler(service service.SkillService) *SkillHandler {
+	return &SkillHandler{service: service}
+}
+
+// @Summary Create a new skill
+// @Description Add a skill to the skills catalogue (System Admin only)
+// @Tags Skills
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param body body dto.SkillRequest true "Skill"
+// @Success 201 {object} dto.SkillResponse
+// @Failure 400 {object} map[string]string "error: Bad Request - invalid skill"
+// @Failure 403 {object} map[string]string "error: You are not authorized"
+// @Failure 409 {object} map[string]string "error: skill slug already exists"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /admin/skills/create [post]
+func (h *SkillHandler) CreateSkill(c *fiber.Ctx) error {
+	var req dto.SkillRequest
+	if err := utils.ParseJSONAndValidate(c, &req); err != nil {
+		return err
+	}
+
+	skill, err := h.service.CreateSkill(req)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(skill)
+}
+
+// @Summary List all skills
+// @Description List the whole skills catalogue with synonyms and parents
+// @Tags Skills
+// @Produce json
+// @Success 200 {object} dto.SkillListResponse
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /skills/list [get]
+func (h *SkillHandler) ListSkills(c *fiber.Ctx) error {
+	skills, err := h.service.ListSkills()
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(skills)
+}
+
+// @Summary Get a skill by ID
+// @Description Get a skill by ID
+// @Tags Skills
+// @Produce json
+// @Param id path int true "Skill ID"
+// @Success 200 {object} dto.SkillResponse
+// @Failure 400 {object} map[string]string "error: skill id is required"
+// @Failure 404 {object} map[string]string "error: skill not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /skills/get/{id} [get]
+func (h *SkillHandler) GetSkillByID(c *fiber.Ctx) error {
+	skillID, err := utils.GetParamFormFiberCtx(c, "id", "skill")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	skill, err := h.service.GetSkillByID(skillID)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(skill)
+}
+
+// @Summary Autocomplete skills
+// @Description Suggest active skills whose name, slug or synonyms contain the keyword
+// @Tags Skills
+// @Produce json
+// @Param q query string true "Keyword"
+// @Param limit query int false "Maximum number of suggestions" default(20)
+// @Success 200 {object} []dto.SkillShortResponse
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /skills/autocomplete [get]
+func (h *SkillHandler) AutocompleteSkills(c *fiber.Ctx) error {
+	skills, err := h.service.AutocompleteSkills(c.Query("q"), c.QueryInt("limit", 0))
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(skills)
+}
+
+// @Summary Update a skill
+// @Description Update a skill of the catalogue, synonyms are replaced (System Admin only)
+// @Tags Skills
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Skill ID"
+// @Param body body dto.SkillRequest true "Skill"
+// @Success 200 {object} dto.SkillResponse
+// @Failure 400 {object} map[string]string "error: Bad Request - invalid skill"
+// @Failure 404 {object} map[string]string "error: skill not found"
+// @Failure 409 {object} map[string]string "error: skill slug already exists"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /admin/skills/update/{id} [put]
+func (h *SkillHandler) UpdateSkill(c *fiber.Ctx) error {
+	skillID, err := utils.GetParamFormFiberCtx(c, "id", "skill")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	var req dto.SkillRequest
+	if err := utils.ParseJSONAndValidate(c, &req); err != nil {
+		return err
+	}
+
+	skill, err := h.service.UpdateSkill(skillID, req)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(skill)
+}
+
+// @Summary Delete a skill
+// @Description Delete a skill and detach it from profiles and jobs (System Admin only)
+// @Tags Skills
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Skill ID"
+// @Success 200 {object} map[string]string "message: Skill deleted successfully"
+// @Failure 400 {object} map[string]string "error: skill id is required"
+// @Failure 404 {object} map[string]string "error: skill not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /admin/skills/delete/{id} [delete]
+func (h *SkillHandler) DeleteSkill(c *fiber.Ctx) error {
+	skillID, err := utils.GetParamFormFiberCtx(c, "id", "skill")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	if err := h.service.DeleteSkill(skillID); err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{"message": "Skill deleted successfully"})
+}
+
+// @Summary Get my skills
+// @Description Get the skills of the current user's profile
+// @Tags Skills
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} []dto.SkillShortResponse
+// @Failure 401 {object} map[string]string "error: unauthorized"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /users/me/skills [get]
+func (h *SkillHandler) GetMySkills(c *fiber.Ctx) error {
+	userID, err := utils.GetUserIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	skills, err := h.service.GetUserSkills(userID)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(skills)
+}
+
+// @Summary Replace my skills
+// @Description Replace the skills of the current user's profile
+// @Tags Skills
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param body body dto.UserSkillsRequest true "Skill IDs"
+// @Success 200 {object} []dto.SkillShortResponse
+// @Failure 400 {object} map[string]string "error: some skills do not exist"
+// @Failure 401 {object} map[string]string "error: unauthorized"
+// @Failure 404 {object} map[string]string "error: profile not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /users/me/skills [put]
+func (h *SkillHandler) UpdateMySkills(c *fiber.Ctx) error {
+	userID, err := utils.GetUserIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	var req dto.UserSkillsRequest
+	if err := utils.ParseJSONAndValidate(c, &req); err != nil {
+		return err
+	}
+
+	skills, err := h.service.UpdateUserSkills(userID, req)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(skills)
+}
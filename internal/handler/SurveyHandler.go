@@ -0,0 +1,297 @@
+package handler
+
+import (
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/errs"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/dto"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/service"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/utils"
+	"github.com/gofiber/fiber/v2"
+)
+
+type SurveyHandler struct {
+	service service.SurveyService
+}
+
+func NewSurveyHandler(service service.SurveyService) *SurveyHandler {
+	return &SurveyHandler{service: service}
+}
+
+// @Summary Get the survey of an event
+// @Description Get the feedback survey of an event of the organization with its questions
+// @Tags Organization Event Surveys
+// @Produce json
+// @Security BearerAuth
+// @Param orgID path int true "Organization ID"
+// @Param id path int true "Event ID"
+// @Success 200 {object} dto.EventSurveyResponse
+// @Failure 400 {object} map[string]string "error: event id is required"
+// @Failure 403 {object} map[string]string "error: You are not authorized"
+// @Failure 404 {object} map[string]string "error: survey not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /admin/orgs/{orgID}/events/{id}/survey [get]
+func (h *SurveyHandler) GetEventSurvey(c *fiber.Ctx) error {
+	orgID, err := utils.GetOrgIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	eventID, err := utils.GetParamFormFiberCtx(c, "id", "event")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	survey, err := h.service.GetEventSurvey(orgID, eventID)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(survey)
+}
+
+// @Summary Create or replace the survey of an event
+// @Description Set the feedback survey mailed to the participants checked in once the event has ended. Rating questions are answered from 1 to 5, choice questions with one of at least two options. Leave the questions out to only change the title, description and rating visibility, the questions cannot change once participants have answered
+// @Tags Organization Event Surveys
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param orgID path int true "Organization ID"
+// @Param id path int true "Event ID"
+// @Param body body dto.EventSurveyRequest true "Survey"
+// @Success 200 {object} dto.EventSurveyResponse
+// @Failure 400 {object} map[string]string "error: choice questions need at least two options"
+// @Failure 403 {object} map[string]string "error: You are not authorized"
+// @Failure 404 {object} map[string]string "error: event not found"
+// @Failure 409 {object} map[string]string "error: the questions cannot change once participants have answered the survey"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /admin/orgs/{orgID}/events/{id}/survey [put]
+func (h *SurveyHandler) SaveEventSurvey(c *fiber.Ctx) error {
+	orgID, err := utils.GetOrgIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	eventID, err := utils.GetParamFormFiberCtx(c, "id", "event")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	var req dto.EventSurveyRequest
+	if err := utils.ParseJSONAndValidate(c, &req); err != nil {
+		return err
+	}
+
+	survey, err := h.service.SaveEventSurvey(orgID, eventID, req)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(survey)
+}
+
+// @Summary Delete the survey of an event
+// @Description Delete the feedback survey of an event of the organization along with its responses
+// @Tags Organization Event Surveys
+// @Produce json
+// @Security BearerAuth
+// @Param orgID path int true "Organization ID"
+// @Param id path int true "Event ID"
+// @Success 200 {object} map[string]string "message: survey deleted successfully"
+// @Failure 400 {object} map[string]string "error: event id is required"
+// @Failure 403 {object} map[string]string "error: You are not authorized"
+// @Failure 404 {object} map[string]string "error: survey not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /admin/orgs/{orgID}/events/{id}/survey [delete]
+func (h *SurveyHandler) DeleteEventSurvey(c *fiber.Ctx) error {
+	orgID, err := utils.GetOrgIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	eventID, err := utils.GetParamFormFiberCtx(c, "id", "event")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	if err := h.service.DeleteEventSurvey(orgID, eventID); err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{"message": "survey deleted successfully"})
+}
+
+// @Summary Get the survey results of an event
+// @Description Get the answers to the survey of an event summed per question: rating averages and counts, option counts and free texts
+// @Tags Organization Event Surveys
+// @Produce json
+// @Security BearerAuth
+// @Param orgID path int true "Organization ID"
+// @Param id path int true "Event ID"
+// @Success 200 {object} dto.SurveyResultsResponse
+// @Failure 400 {object} map[string]string "error: event id is required"
+// @Failure 403 {object} map[string]string "error: You are not authorized"
+// @Failure 404 {object} map[string]string "error: survey not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /admin/orgs/{orgID}/events/{id}/survey/results [get]
+func (h *SurveyHandler) GetSurveyResults(c *fiber.Ctx) error {
+	orgID, err := utils.GetOrgIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	eventID, err := utils.GetParamFormFiberCtx(c, "id", "event")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	results, err := h.service.GetSurveyResults(orgID, eventID)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(results)
+}
+
+// @Summary Get the survey ratings of an organization
+// @Description Get the average rating of each event of the organization with a survey, public or not, and their overall average
+// @Tags Organization Event Surveys
+// @Produce json
+// @Security BearerAuth
+// @Param orgID path int true "Organization ID"
+// @Success 200 {object} dto.OrganizationRatingResponse
+// @Failure 400 {object} map[string]string "error: organization id is required"
+// @Failure 403 {object} map[string]string "error: You are not authorized"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /admin/orgs/{orgID}/surveys/results [get]
+func (h *SurveyHandler) GetOrganizationSurveyResults(c *fiber.Ctx) error {
+	orgID, err := utils.GetOrgIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	results, err := h.service.GetOrganizationSurveyResults(orgID)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(results)
+}
+
+// @Summary Get my survey of an event
+// @Description Get the feedback survey of an event the current user was checked in at, open once the event has ended
+// @Tags Event Surveys
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Event ID"
+// @Success 200 {object} dto.ParticipantSurveyResponse
+// @Failure 400 {object} map[string]string "error: event id is required"
+// @Failure 401 {object} map[string]string "error: unauthorized"
+// @Failure 403 {object} map[string]string "error: only participants checked in at the event can answer its survey"
+// @Failure 404 {object} map[string]string "error: survey not found"
+// @Failure 422 {object} map[string]string "error: the survey opens once the event has ended"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /events/{id}/survey [get]
+func (h *SurveyHandler) GetMySurvey(c *fiber.Ctx) error {
+	userID, err := utils.GetUserIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	eventID, err := utils.GetParamFormFiberCtx(c, "id", "event")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	survey, err := h.service.GetMySurvey(userID, eventID)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(survey)
+}
+
+// @Summary Answer the survey of an event
+// @Description Submit the answers of the current user to the feedback survey of an event they were checked in at, each participant answers once
+// @Tags Event Surveys
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Event ID"
+// @Param body body dto.SurveySubmitRequest true "Answers"
+// @Success 201 {object} map[string]string "message: thank you for your feedback"
+// @Failure 400 {object} map[string]string "error: every required question must be answered"
+// @Failure 401 {object} map[string]string "error: unauthorized"
+// @Failure 403 {object} map[string]string "error: only participants checked in at the event can answer its survey"
+// @Failure 404 {object} map[string]string "error: survey not found"
+// @Failure 409 {object} map[string]string "error: you have already answered this survey"
+// @Failure 422 {object} map[string]string "error: the survey opens once the event has ended"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /events/{id}/survey/responses [post]
+func (h *SurveyHandler) SubmitSurvey(c *fiber.Ctx) error {
+	userID, err := utils.GetUserIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	eventID, err := utils.GetParamFormFiberCtx(c, "id", "event")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	var req dto.SurveySubmitRequest
+	if err := utils.ParseJSONAndValidate(c, &req); err != nil {
+		return err
+	}
+
+	if err := h.service.SubmitSurvey(userID, eventID, req); err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{"message": "thank you for your feedback"})
+}
+
+// @Summary Get the rating of an event
+// @Description Get the average survey rating of an event, when its organizers show it publicly
+// @Tags Event Surveys
+// @Produce json
+// @Param id path int true "Event ID"
+// @Success 200 {object} dto.EventRatingResponse
+// @Failure 400 {object} map[string]string "error: event id is required"
+// @Failure 404 {object} map[string]string "error: rating not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /events/{id}/rating [get]
+func (h *SurveyHandler) GetEventRating(c *fiber.Ctx) error {
+	eventID, err := utils.GetParamFormFiberCtx(c, "id", "event")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	rating, err := h.service.GetEventRating(eventID)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(rating)
+}
+
+// @Summary Get the rating of an organization
+// @Description Get the average survey rating of the events of an organization whose organizers show it publicly
+// @Tags Event Surveys
+// @Produce json
+// @Param orgID path int true "Organization ID"
+// @Success 200 {object} dto.OrganizationRatingResponse
+// @Failure 400 {object} map[string]string "error: organization id is required"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /orgs/{orgID}/rating [get]
+func (h *SurveyHandler) GetOrganizationRating(c *fiber.Ctx) error {
+	orgID, err := utils.GetOrgIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	rating, err := h.service.GetOrganizationRating(orgID)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(rating)
+}
@@ -0,0 +1,203 @@
+package handler
+
+import (
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/errs"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/dto"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/service"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/utils"
+	"github.com/gofiber/fiber/v2"
+)
+
+type TalentHandler struct {
+	service service.TalentService
+}
+
+func NewTalentHandler(service service.TalentService) *TalentHandler {
+	return &TalentHandler{service: service}
+}
+
+// @Summary Get my profile visibility
+// @Description Get whether the current user's profile is public, its slug and which fields are hidden
+// @Tags Talents
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} dto.ProfileVisibilityResponse
+// @Failure 401 {object} map[string]string "error: unauthorized"
+// @Failure 404 {object} map[string]string "error: profile not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /users/me/profile/visibility [get]
+func (h *TalentHandler) GetMyVisibility(c *fiber.Ctx) error {
+	userID, err := utils.GetUserIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	visibility, err := h.service.GetVisibility(userID)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(visibility)
+}
+
+// @Summary Update my profile visibility
+// @Description Publish or unpublish the current user's profile and choose which fields are hidden. A slug is generated from the name when none is given
+// @Tags Talents
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param body body dto.ProfileVisibilityRequest true "Visibility settings"
+// @Success 200 {object} dto.ProfileVisibilityResponse
+// @Failure 400 {object} map[string]string "error: invalid slug"
+// @Failure 401 {object} map[string]string "error: unauthorized"
+// @Failure 404 {object} map[string]string "error: profile not found"
+// @Failure 409 {object} map[string]string "error: slug is already taken"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /users/me/profile/visibility [put]
+func (h *TalentHandler) UpdateMyVisibility(c *fiber.Ctx) error {
+	userID, err := utils.GetUserIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	var req dto.ProfileVisibilityRequest
+	if err := utils.ParseJSONAndValidate(c, &req); err != nil {
+		return err
+	}
+
+	visibility, err := h.service.UpdateVisibility(userID, req)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(visibility)
+}
+
+// @Summary List who viewed my profile
+// @Description List the organizations that looked at the current user's public profile, latest first
+// @Tags Talents
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} []dto.ProfileViewResponse
+// @Failure 401 {object} map[string]string "error: unauthorized"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /users/me/profile/views [get]
+func (h *TalentHandler) ListMyProfileViews(c *fiber.Ctx) error {
+	userID, err := utils.GetUserIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	views, err := h.service.ListMyProfileViews(userID)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(views)
+}
+
+// @Summary Get a public profile
+// @Description Get a public candidate profile by its slug, hidden fields are left out
+// @Tags Talents
+// @Produce json
+// @Param slug path string true "Profile slug"
+// @Success 200 {object} dto.PublicProfileResponse
+// @Failure 404 {object} map[string]string "error: profile not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /profiles/{slug} [get]
+func (h *TalentHandler) GetPublicProfile(c *fiber.Ctx) error {
+	profile, err := h.service.GetPublicProfile(c.Params("slug"))
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(profile)
+}
+
+// @Summary View a candidate profile as an organization
+// @Description Get a public candidate profile on behalf of the organization, the view is shown to the candidate
+// @Tags Organization Talents
+// @Produce json
+// @Security BearerAuth
+// @Param orgID path int true "Organization ID"
+// @Param slug path string true "Profile slug"
+// @Success 200 {object} dto.PublicProfileResponse
+// @Failure 401 {object} map[string]string "error: unauthorized"
+// @Failure 403 {object} map[string]string "error: You are not authorized"
+// @Failure 404 {object} map[string]string "error: profile not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /admin/orgs/{orgID}/talents/{slug} [get]
+func (h *TalentHandler) ViewProfileAsOrganization(c *fiber.Ctx) error {
+	userID, err := utils.GetUserIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	orgID, err := utils.GetOrgIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	profile, err := h.service.ViewProfileAsOrganization(orgID, userID, c.Params("slug"))
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(profile)
+}
+
+// @Summary Search talents
+// @Description Search public candidate profiles by keyword, skills, province and field of expertise (approved organizations only)
+// @Tags Organization Talents
+// @Produce json
+// @Security BearerAuth
+// @Param orgID path int true "Organization ID"
+// @Param q query string false "Keyword to search for talents (Support: name, headline, focus field, skills)"
+// @Param skills query string false "Comma separated skill slugs, every skill is required"
+// @Param province query string false "Province"
+// @Param focusField query string false "Field of expertise"
+// @Param page query int false "Page number for pagination" default(1)
+// @Param offset query int false "Number of items per page" default(12)
+// @Success 200 {object} dto.SearchTalentResponse
+// @Failure 400 {object} map[string]string "error: Invalid query parameters"
+// @Failure 403 {object} map[string]string "error: only approved organizations can search talents"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /admin/orgs/{orgID}/talents/search [get]
+func (h *TalentHandler) SearchTalents(c *fiber.Ctx) error {
+	page := 1
+	Offset := 12
+
+	orgID, err := utils.GetOrgIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	var query dto.SearchTalentQuery
+	if err := c.QueryParser(&query); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid query parameters",
+		})
+	}
+	if query.Page > 0 {
+		page = query.Page
+	}
+	if query.Offset > 0 {
+		Offset = query.Offset
+	}
+
+	talents, err := h.service.SearchTalents(orgID, query, page, Offset)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(talents)
+}
+
+func (h *TalentHandler) SyncTalents(c *fiber.Ctx) error {
+	err := h.service.SyncTalents()
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,461 @@
+package handler
+
+import (
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/errs"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/dto"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/service"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/utils"
+	"github.com/gofiber/fiber/v2"
+)
+
+type TicketHandler struct {
+	service service.TicketService
+}
+
+func NewTicketHandler(service service.TicketService) *TicketHandler {
+	return &TicketHandler{service: service}
+}
+
+// @Summary List the tickets of an event
+// @Description List the ticket types of an event with the seats left
+// @Tags Event Tickets
+// @Produce json
+// @Param id path int true "Event ID"
+// @Success 200 {object} []dto.TicketAvailableResponse
+// @Failure 400 {object} map[string]string "error: event id is required"
+// @Failure 404 {object} map[string]string "error: event not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /events/{id}/tickets [get]
+func (h *TicketHandler) ListEventTickets(c *fiber.Ctx) error {
+	eventID, err := utils.GetParamFormFiberCtx(c, "id", "event")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	tickets, err := h.service.ListEventTickets(eventID)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(tickets)
+}
+
+// @Summary Register for an event
+// @Description Take a seat of a ticket type. Free tickets are confirmed right away, paid tickets stay pending until paid. A promo code discounts a paid ticket
+// @Tags Event Tickets
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Event ID"
+// @Param ticketID path int true "Ticket ID"
+// @Param body body dto.TicketRegisterRequest true "Attendee details"
+// @Success 201 {object} dto.TicketPurchasedResponse
+// @Failure 400 {object} map[string]string "error: event is not open for registration"
+// @Failure 401 {object} map[string]string "error: unauthorized"
+// @Failure 404 {object} map[string]string "error: ticket not found"
+// @Failure 409 {object} map[string]string "error: ticket is sold out"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /events/{id}/tickets/{ticketID}/register [post]
+func (h *TicketHandler) Register(c *fiber.Ctx) error {
+	userID, err := utils.GetUserIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	eventID, err := utils.GetParamFormFiberCtx(c, "id", "event")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	ticketID, err := utils.GetParamFormFiberCtx(c, "ticketID", "ticket")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	var req dto.TicketRegisterRequest
+	if err := utils.ParseJSONAndValidate(c, &req); err != nil {
+		return err
+	}
+
+	purchase, err := h.service.Register(userID, eventID, ticketID, req)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(purchase)
+}
+
+// @Summary List my tickets
+// @Description List the tickets of the current user, latest first
+// @Tags Event Tickets
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} []dto.TicketPurchasedResponse
+// @Failure 401 {object} map[string]string "error: unauthorized"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /users/me/tickets [get]
+func (h *TicketHandler) ListMyTickets(c *fiber.Ctx) error {
+	userID, err := utils.GetUserIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	tickets, err := h.service.ListMyTickets(userID)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(tickets)
+}
+
+// @Summary Get my ticket
+// @Description Get one ticket of the current user
+// @Tags Event Tickets
+// @Produce json
+// @Security BearerAuth
+// @Param purchaseID path int true "Purchased ticket ID"
+// @Success 200 {object} dto.TicketPurchasedResponse
+// @Failure 400 {object} map[string]string "error: ticket id is required"
+// @Failure 401 {object} map[string]string "error: unauthorized"
+// @Failure 404 {object} map[string]string "error: ticket not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /users/me/tickets/{purchaseID} [get]
+func (h *TicketHandler) GetMyTicket(c *fiber.Ctx) error {
+	userID, err := utils.GetUserIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	purchaseID, err := utils.GetParamFormFiberCtx(c, "purchaseID", "ticket")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	ticket, err := h.service.GetMyTicket(userID, purchaseID)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(ticket)
+}
+
+// @Summary Cancel my ticket
+// @Description Cancel a ticket of the current user, the seat is offered to the waitlist of the ticket type first
+// @Tags Event Tickets
+// @Produce json
+// @Security BearerAuth
+// @Param purchaseID path int true "Purchased ticket ID"
+// @Success 200 {object} map[string]string "message: ticket cancelled successfully"
+// @Failure 400 {object} map[string]string "error: ticket is already cancelled"
+// @Failure 401 {object} map[string]string "error: unauthorized"
+// @Failure 404 {object} map[string]string "error: ticket not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /users/me/tickets/{purchaseID}/cancel [post]
+func (h *TicketHandler) CancelMyTicket(c *fiber.Ctx) error {
+	userID, err := utils.GetUserIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	purchaseID, err := utils.GetParamFormFiberCtx(c, "purchaseID", "ticket")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	if err := h.service.CancelMyTicket(userID, purchaseID); err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{"message": "ticket cancelled successfully"})
+}
+
+// @Summary Get the QR code of my ticket
+// @Description Get the QR code to show at the entrance as a PNG image, only for confirmed tickets
+// @Tags Event Tickets
+// @Produce png
+// @Security BearerAuth
+// @Param purchaseID path int true "Purchased ticket ID"
+// @Success 200 {file} file "QR code image"
+// @Failure 400 {object} map[string]string "error: ticket is not confirmed"
+// @Failure 401 {object} map[string]string "error: unauthorized"
+// @Failure 404 {object} map[string]string "error: ticket not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /users/me/tickets/{purchaseID}/qrcode [get]
+func (h *TicketHandler) GetMyTicketQRCode(c *fiber.Ctx) error {
+	userID, err := utils.GetUserIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	purchaseID, err := utils.GetParamFormFiberCtx(c, "purchaseID", "ticket")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	png, err := h.service.GetMyTicketQRCode(userID, purchaseID)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	c.Set(fiber.HeaderContentType, "image/png")
+	c.Set(fiber.HeaderCacheControl, "private, no-store")
+	return c.Status(fiber.StatusOK).Send(png)
+}
+
+// @Summary List the ticket types of an event
+// @Description List the ticket types of an event of the organization
+// @Tags Organization Event Tickets
+// @Produce json
+// @Security BearerAuth
+// @Param orgID path int true "Organization ID"
+// @Param id path int true "Event ID"
+// @Success 200 {object} []dto.TicketAvailableResponse
+// @Failure 400 {object} map[string]string "error: event id is required"
+// @Failure 403 {object} map[string]string "error: You are not authorized"
+// @Failure 404 {object} map[string]string "error: event not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /admin/orgs/{orgID}/events/{id}/tickets [get]
+func (h *TicketHandler) ListTicketTypes(c *fiber.Ctx) error {
+	orgID, err := utils.GetOrgIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	eventID, err := utils.GetParamFormFiberCtx(c, "id", "event")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	tickets, err := h.service.ListTicketTypes(orgID, eventID)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(tickets)
+}
+
+// @Summary Create a ticket type
+// @Description Create a free (price 0) or paid ticket type for an event of the organization
+// @Tags Organization Event Tickets
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param orgID path int true "Organization ID"
+// @Param id path int true "Event ID"
+// @Param body body dto.TicketAvailableRequest true "Ticket type"
+// @Success 201 {object} dto.TicketAvailableResponse
+// @Failure 400 {object} map[string]string "error: bad request"
+// @Failure 403 {object} map[string]string "error: You are not authorized"
+// @Failure 404 {object} map[string]string "error: event not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /admin/orgs/{orgID}/events/{id}/tickets [post]
+func (h *TicketHandler) CreateTicketType(c *fiber.Ctx) error {
+	orgID, err := utils.GetOrgIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	eventID, err := utils.GetParamFormFiberCtx(c, "id", "event")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	var req dto.TicketAvailableRequest
+	if err := utils.ParseJSONAndValidate(c, &req); err != nil {
+		return err
+	}
+
+	ticket, err := h.service.CreateTicketType(orgID, eventID, req)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(ticket)
+}
+
+// @Summary Update a ticket type
+// @Description Update a ticket type, the quantity is the number of seats left
+// @Tags Organization Event Tickets
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param orgID path int true "Organization ID"
+// @Param id path int true "Event ID"
+// @Param ticketID path int true "Ticket ID"
+// @Param body body dto.TicketAvailableRequest true "Ticket type"
+// @Success 200 {object} dto.TicketAvailableResponse
+// @Failure 400 {object} map[string]string "error: bad request"
+// @Failure 403 {object} map[string]string "error: You are not authorized"
+// @Failure 404 {object} map[string]string "error: ticket not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /admin/orgs/{orgID}/events/{id}/tickets/{ticketID} [put]
+func (h *TicketHandler) UpdateTicketType(c *fiber.Ctx) error {
+	orgID, err := utils.GetOrgIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	eventID, err := utils.GetParamFormFiberCtx(c, "id", "event")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	ticketID, err := utils.GetParamFormFiberCtx(c, "ticketID", "ticket")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	var req dto.TicketAvailableRequest
+	if err := utils.ParseJSONAndValidate(c, &req); err != nil {
+		return err
+	}
+
+	ticket, err := h.service.UpdateTicketType(orgID, eventID, ticketID, req)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(ticket)
+}
+
+// @Summary Delete a ticket type
+// @Description Delete a ticket type nobody registered for yet
+// @Tags Organization Event Tickets
+// @Produce json
+// @Security BearerAuth
+// @Param orgID path int true "Organization ID"
+// @Param id path int true "Event ID"
+// @Param ticketID path int true "Ticket ID"
+// @Success 200 {object} map[string]string "message: ticket deleted successfully"
+// @Failure 400 {object} map[string]string "error: ticket id is required"
+// @Failure 403 {object} map[string]string "error: You are not authorized"
+// @Failure 404 {object} map[string]string "error: ticket not found"
+// @Failure 409 {object} map[string]string "error: ticket already has registrations"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /admin/orgs/{orgID}/events/{id}/tickets/{ticketID} [delete]
+func (h *TicketHandler) DeleteTicketType(c *fiber.Ctx) error {
+	orgID, err := utils.GetOrgIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	eventID, err := utils.GetParamFormFiberCtx(c, "id", "event")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	ticketID, err := utils.GetParamFormFiberCtx(c, "ticketID", "ticket")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	if err := h.service.DeleteTicketType(orgID, eventID, ticketID); err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{"message": "ticket deleted successfully"})
+}
+
+// @Summary List the registrations of an event
+// @Description List every ticket taken for an event of the organization
+// @Tags Organization Event Tickets
+// @Produce json
+// @Security BearerAuth
+// @Param orgID path int true "Organization ID"
+// @Param id path int true "Event ID"
+// @Success 200 {object} []dto.TicketPurchasedResponse
+// @Failure 400 {object} map[string]string "error: event id is required"
+// @Failure 403 {object} map[string]string "error: You are not authorized"
+// @Failure 404 {object} map[string]string "error: event not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /admin/orgs/{orgID}/events/{id}/registrations [get]
+func (h *TicketHandler) ListEventRegistrations(c *fiber.Ctx) error {
+	orgID, err := utils.GetOrgIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	eventID, err := utils.GetParamFormFiberCtx(c, "id", "event")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	registrations, err := h.service.ListEventRegistrations(orgID, eventID)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(registrations)
+}
+
+// @Summary Check in a ticket
+// @Description Validate a scanned ticket QR code and mark the attendee as present
+// @Tags Organization Event Tickets
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param orgID path int true "Organization ID"
+// @Param id path int true "Event ID"
+// @Param body body dto.TicketCheckInRequest true "Scanned QR code"
+// @Success 200 {object} dto.TicketPurchasedResponse
+// @Failure 400 {object} map[string]string "error: ticket is for another event"
+// @Failure 403 {object} map[string]string "error: You are not authorized"
+// @Failure 404 {object} map[string]string "error: ticket not found"
+// @Failure 409 {object} map[string]string "error: ticket was already checked in"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /admin/orgs/{orgID}/events/{id}/check-in [post]
+func (h *TicketHandler) CheckIn(c *fiber.Ctx) error {
+	orgID, err := utils.GetOrgIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	eventID, err := utils.GetParamFormFiberCtx(c, "id", "event")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	var req dto.TicketCheckInRequest
+	if err := utils.ParseJSONAndValidate(c, &req); err != nil {
+		return err
+	}
+
+	ticket, err := h.service.CheckIn(orgID, eventID, req)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(ticket)
+}
+
+// @Summary Get the check-in progress of an event
+// @Description Count registered and checked in attendees per ticket type
+// @Tags Organization Event Tickets
+// @Produce json
+// @Security BearerAuth
+// @Param orgID path int true "Organization ID"
+// @Param id path int true "Event ID"
+// @Success 200 {object} dto.TicketCheckInSummaryResponse
+// @Failure 400 {object} map[string]string "error: event id is required"
+// @Failure 403 {object} map[string]string "error: You are not authorized"
+// @Failure 404 {object} map[string]string "error: event not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /admin/orgs/{orgID}/events/{id}/check-in/summary [get]
+func (h *TicketHandler) GetCheckInSummary(c *fiber.Ctx) error {
+	orgID, err := utils.GetOrgIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	eventID, err := utils.GetParamFormFiberCtx(c, "id", "event")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	summary, err := h.service.GetCheckInSummary(orgID, eventID)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(summary)
+}
@@ -0,0 +1,257 @@
+package handler
+
+import (
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/errs"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/dto"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/service"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/utils"
+	"github.com/gofiber/fiber/v2"
+)
+
+type WaitlistHandler struct {
+	service service.WaitlistService
+}
+
+func NewWaitlistHandler(service service.WaitlistService) *WaitlistHandler {
+	return &WaitlistHandler{service: service}
+}
+
+// @Summary Join the waitlist of a ticket
+// @Description Queue for a sold out ticket type, freed seats are offered in order by email
+// @Tags Event Waitlists
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Event ID"
+// @Param ticketID path int true "Ticket ID"
+// @Success 201 {object} dto.WaitlistEntryResponse
+// @Failure 400 {object} map[string]string "error: ticket is not sold out, register for it instead"
+// @Failure 401 {object} map[string]string "error: unauthorized"
+// @Failure 404 {object} map[string]string "error: ticket not found"
+// @Failure 409 {object} map[string]string "error: you are already on the waitlist of this ticket"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /events/{id}/tickets/{ticketID}/waitlist [post]
+func (h *WaitlistHandler) JoinWaitlist(c *fiber.Ctx) error {
+	userID, err := utils.GetUserIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	eventID, err := utils.GetParamFormFiberCtx(c, "id", "event")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	ticketID, err := utils.GetParamFormFiberCtx(c, "ticketID", "ticket")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	entry, err := h.service.JoinWaitlist(userID, eventID, ticketID)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(entry)
+}
+
+// @Summary List my waitlists
+// @Description List the waitlist entries of the current user, including the offers to claim
+// @Tags Event Waitlists
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} []dto.WaitlistEntryResponse
+// @Failure 401 {object} map[string]string "error: unauthorized"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /users/me/waitlists [get]
+func (h *WaitlistHandler) ListMyWaitlists(c *fiber.Ctx) error {
+	userID, err := utils.GetUserIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	entries, err := h.service.ListMyWaitlists(userID)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(entries)
+}
+
+// @Summary Leave a waitlist
+// @Description Leave a waitlist, a seat offered to the current user goes to the next person
+// @Tags Event Waitlists
+// @Produce json
+// @Security BearerAuth
+// @Param entryID path int true "Waitlist entry ID"
+// @Success 200 {object} map[string]string "message: left the waitlist successfully"
+// @Failure 400 {object} map[string]string "error: waitlist entry is no longer active"
+// @Failure 401 {object} map[string]string "error: unauthorized"
+// @Failure 404 {object} map[string]string "error: waitlist entry not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /users/me/waitlists/{entryID} [delete]
+func (h *WaitlistHandler) LeaveWaitlist(c *fiber.Ctx) error {
+	userID, err := utils.GetUserIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	entryID, err := utils.GetParamFormFiberCtx(c, "entryID", "waitlist entry")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	if err := h.service.LeaveWaitlist(userID, entryID); err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{"message": "left the waitlist successfully"})
+}
+
+// @Summary Claim a waitlist offer
+// @Description Claim the seat offered by the link of a waitlist offer email before it expires
+// @Tags Event Waitlists
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param body body dto.WaitlistClaimRequest true "Offer token"
+// @Success 201 {object} dto.TicketPurchasedResponse
+// @Failure 400 {object} map[string]string "error: this offer has expired"
+// @Failure 401 {object} map[string]string "error: unauthorized"
+// @Failure 404 {object} map[string]string "error: offer not found"
+// @Failure 409 {object} map[string]string "error: this offer is no longer available"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /waitlists/claim [post]
+func (h *WaitlistHandler) ClaimOffer(c *fiber.Ctx) error {
+	userID, err := utils.GetUserIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	var req dto.WaitlistClaimRequest
+	if err := utils.ParseJSONAndValidate(c, &req); err != nil {
+		return err
+	}
+
+	ticket, err := h.service.ClaimOffer(userID, req)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(ticket)
+}
+
+// @Summary List the waitlist of a ticket
+// @Description List the active entries of the waitlist of a ticket type in offer order
+// @Tags Organization Event Waitlists
+// @Produce json
+// @Security BearerAuth
+// @Param orgID path int true "Organization ID"
+// @Param id path int true "Event ID"
+// @Param ticketID path int true "Ticket ID"
+// @Success 200 {object} []dto.WaitlistEntryResponse
+// @Failure 400 {object} map[string]string "error: ticket id is required"
+// @Failure 403 {object} map[string]string "error: You are not authorized"
+// @Failure 404 {object} map[string]string "error: ticket not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /admin/orgs/{orgID}/events/{id}/tickets/{ticketID}/waitlist [get]
+func (h *WaitlistHandler) ListWaitlist(c *fiber.Ctx) error {
+	orgID, eventID, ticketID, err := getOrgEventTicketIDs(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	entries, err := h.service.ListWaitlist(orgID, eventID, ticketID)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(entries)
+}
+
+// @Summary Reorder the waitlist of a ticket
+// @Description Set the offer order of the waitlist, every active entry must be listed once
+// @Tags Organization Event Waitlists
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param orgID path int true "Organization ID"
+// @Param id path int true "Event ID"
+// @Param ticketID path int true "Ticket ID"
+// @Param body body dto.WaitlistReorderRequest true "Entry IDs in the new order"
+// @Success 200 {object} []dto.WaitlistEntryResponse
+// @Failure 400 {object} map[string]string "error: the new order must list every active entry of the waitlist once"
+// @Failure 403 {object} map[string]string "error: You are not authorized"
+// @Failure 404 {object} map[string]string "error: ticket not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /admin/orgs/{orgID}/events/{id}/tickets/{ticketID}/waitlist/order [put]
+func (h *WaitlistHandler) ReorderWaitlist(c *fiber.Ctx) error {
+	orgID, eventID, ticketID, err := getOrgEventTicketIDs(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	var req dto.WaitlistReorderRequest
+	if err := utils.ParseJSONAndValidate(c, &req); err != nil {
+		return err
+	}
+
+	entries, err := h.service.ReorderWaitlist(orgID, eventID, ticketID, req)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(entries)
+}
+
+// @Summary Promote a waitlist entry
+// @Description Offer a seat to a waitlisted person out of order, a seat is added when none is free
+// @Tags Organization Event Waitlists
+// @Produce json
+// @Security BearerAuth
+// @Param orgID path int true "Organization ID"
+// @Param id path int true "Event ID"
+// @Param ticketID path int true "Ticket ID"
+// @Param entryID path int true "Waitlist entry ID"
+// @Success 200 {object} dto.WaitlistEntryResponse
+// @Failure 400 {object} map[string]string "error: only waiting entries can be promoted"
+// @Failure 403 {object} map[string]string "error: You are not authorized"
+// @Failure 404 {object} map[string]string "error: waitlist entry not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /admin/orgs/{orgID}/events/{id}/tickets/{ticketID}/waitlist/{entryID}/promote [post]
+func (h *WaitlistHandler) PromoteEntry(c *fiber.Ctx) error {
+	orgID, eventID, ticketID, err := getOrgEventTicketIDs(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	entryID, err := utils.GetParamFormFiberCtx(c, "entryID", "waitlist entry")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	entry, err := h.service.PromoteEntry(orgID, eventID, ticketID, entryID)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(entry)
+}
+
+func getOrgEventTicketIDs(c *fiber.Ctx) (uint, uint, uint, error) {
+	orgID, err := utils.GetOrgIDFormFiberCtx(c)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+
+	eventID, err := utils.GetParamFormFiberCtx(c, "id", "event")
+	if err != nil {
+		return 0, 0, 0, err
+	}
+
+	ticketID, err := utils.GetParamFormFiberCtx(c, "ticketID", "ticket")
+	if err != nil {
+		return 0, 0, 0, err
+	}
+
+	return orgID, eventID, ticketID, nil
+}
@@ -29,7 +29,7 @@ func NewRoleRouter(app *fiber.App, db *gorm.DB, enforcer casbin.IEnforcer, mail
 	app.Post("/callback-invitation", roleHandler.CallBackInvitationForMember)
 	app.Post("/updated-enforcer", roleHandler.UpdateRoleToEnforcer)
 
-	rbac := middleware.NewRBACMiddleware(enforcer)
+	rbac := middleware.NewRBACMiddleware(enforcer, repository.NewOrganizationRepository(db))
 	app.Get("/admin/my-orgs", authMiddleware, roleHandler.GetDomainsByUser)
 	role := app.Group("admin/roles/orgs/:orgID", authMiddleware)
 	role.Get("/", rbac.EnforceMiddleware("Role", "read"), roleHandler.GetRolesForUserInDomain)
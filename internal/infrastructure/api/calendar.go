@@ -0,0 +1,33 @@
+package api
+
+import (
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/handler"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/repository"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/service"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/middleware"
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+func NewCalendarRouter(app *fiber.App, db *gorm.DB, jwtSecret string, baseEventURL string, baseFeedURL string) {
+	// Dependencies Injections for Calendars
+	calendarRepo := repository.NewCalendarRepository(db)
+	eventRepo := repository.NewEventRepository(db)
+	orgRepo := repository.NewOrganizationRepository(db)
+	calendarService := service.NewCalendarService(calendarRepo, eventRepo, orgRepo, baseEventURL, baseFeedURL)
+	calendarHandler := handler.NewCalendarHandler(calendarService)
+
+	// Feeds read by calendar apps, without a session
+	app.Get("/events/:id/calendar.ics", calendarHandler.GetEventCalendar)
+	app.Get("/orgs/:orgID/calendar.ics", calendarHandler.GetOrganizationCalendar)
+	app.Get("/calendar/users/:token.ics", calendarHandler.GetUserCalendar)
+
+	feed := app.Group("/users/me/calendar", middleware.AuthMiddleware(jwtSecret))
+	feed.Get("/", calendarHandler.GetMyCalendarFeed)
+	feed.Post("/reset", calendarHandler.ResetMyCalendarFeed)
+
+	saved := app.Group("/users/me/saved-events", middleware.AuthMiddleware(jwtSecret))
+	saved.Get("/", calendarHandler.ListSavedEvents)
+	saved.Put("/:eventID", calendarHandler.SaveEvent)
+	saved.Delete("/:eventID", calendarHandler.UnsaveEvent)
+}
@@ -0,0 +1,57 @@
+package api
+
+import (
+	"fmt"
+	"html/template"
+
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/handler"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/infrastructure"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/infrastructure/certificate"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/repository"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/service"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/logs"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/middleware"
+	"github.com/casbin/casbin/v2"
+	"github.com/gofiber/fiber/v2"
+	"gopkg.in/gomail.v2"
+	"gorm.io/gorm"
+)
+
+func NewCertificateRouter(app *fiber.App, db *gorm.DB, enforcer casbin.IEnforcer, mail *gomail.Dialer, s3 *infrastructure.S3Uploader, jwtSecret string,
+	eventTmpl *template.Template,
+	baseEventURL string,
+	fontPath string) {
+	// A TrueType font is needed to print Thai names, Helvetica only covers Latin letters
+	var font *certificate.Font
+	if fontPath != "" {
+		var err error
+		if font, err = certificate.LoadFontFile(fontPath); err != nil {
+			logs.Warn(fmt.Sprintf("Failed to load certificate font %s, falling back to Helvetica: %v", fontPath, err))
+		}
+	}
+
+	// Dependencies Injections for Certificates
+	certificateRepo := repository.NewCertificateRepository(db)
+	eventRepo := repository.NewEventRepository(db)
+	eventMailRepo := repository.NewEventAttendeeMailRepository(mail, eventTmpl, baseEventURL)
+	certificateService := service.NewCertificateService(certificateRepo, eventRepo, eventMailRepo, s3, font, baseEventURL)
+	certificateHandler := handler.NewCertificateHandler(certificateService)
+
+	// Public verification
+	app.Get("/certificates/:code", certificateHandler.VerifyCertificate)
+
+	// Attendees
+	app.Get("/users/me/certificates", middleware.AuthMiddleware(jwtSecret), certificateHandler.ListMyCertificates)
+
+	// Organizers
+	rbac := middleware.NewRBACMiddleware(enforcer, repository.NewOrganizationRepository(db))
+	enforceMiddlewareWithEvent := rbac.EnforceMiddlewareWithResources("Event")
+
+	event := app.Group("/admin/orgs/:orgID/events/:id/certificates", middleware.AuthMiddleware(jwtSecret))
+	event.Get("/", enforceMiddlewareWithEvent("read"), certificateHandler.ListCertificates)
+	event.Get("/template", enforceMiddlewareWithEvent("read"), certificateHandler.GetTemplate)
+	event.Put("/template", enforceMiddlewareWithEvent("update"), certificateHandler.SaveTemplate)
+	event.Put("/template/:image", enforceMiddlewareWithEvent("update"), certificateHandler.UploadTemplateImage)
+	event.Get("/preview", enforceMiddlewareWithEvent("read"), certificateHandler.PreviewCertificate)
+	event.Post("/issue", enforceMiddlewareWithEvent("update"), certificateHandler.IssueCertificates)
+}
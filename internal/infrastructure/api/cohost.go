@@ -0,0 +1,42 @@
+package api
+
+import (
+	"html/template"
+
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/handler"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/repository"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/service"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/middleware"
+	"github.com/casbin/casbin/v2"
+	"github.com/gofiber/fiber/v2"
+	"github.com/opensearch-project/opensearch-go"
+	"gopkg.in/gomail.v2"
+	"gorm.io/gorm"
+)
+
+func NewCoHostRouter(app *fiber.App, db *gorm.DB, enforcer casbin.IEnforcer, es *opensearch.Client, mail *gomail.Dialer, jwtSecret string,
+	eventTmpl *template.Template,
+	baseEventURL string) {
+	// Dependencies Injections for Co-hosts
+	coHostRepo := repository.NewEventCoHostRepository(db)
+	eventRepo := repository.NewEventRepository(db)
+	orgRepo := repository.NewOrganizationRepository(db)
+	eventMailRepo := repository.NewEventAttendeeMailRepository(mail, eventTmpl, baseEventURL)
+	coHostService := service.NewCoHostService(coHostRepo, eventRepo, orgRepo, eventMailRepo, db, es)
+	coHostHandler := handler.NewCoHostHandler(coHostService)
+
+	// Co-hosts edit the event with the Event permissions of their own organization,
+	// binding an organization to the event of another is left to its owners
+	rbac := middleware.NewRBACMiddleware(enforcer, repository.NewOrganizationRepository(db))
+	enforceMiddlewareWithCoHost := rbac.EnforceMiddlewareWithResources("EventCoHost")
+
+	event := app.Group("/admin/orgs/:orgID/events/:id/co-hosts", middleware.AuthMiddleware(jwtSecret))
+	event.Get("/", enforceMiddlewareWithCoHost("read"), coHostHandler.ListCoHosts)
+	event.Post("/", enforceMiddlewareWithCoHost("invite"), coHostHandler.InviteCoHost)
+	event.Delete("/:coHostOrgID", enforceMiddlewareWithCoHost("remove"), coHostHandler.RemoveCoHost)
+
+	invitations := app.Group("/admin/orgs/:orgID/co-host-invitations", middleware.AuthMiddleware(jwtSecret))
+	invitations.Get("/", enforceMiddlewareWithCoHost("read"), coHostHandler.ListInvitations)
+	invitations.Post("/:invitationID/accept", enforceMiddlewareWithCoHost("accept"), coHostHandler.AcceptInvitation)
+	invitations.Post("/:invitationID/decline", enforceMiddlewareWithCoHost("accept"), coHostHandler.DeclineInvitation)
+}
@@ -0,0 +1,58 @@
+package api
+
+import (
+	"html/template"
+
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/models"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/handler"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/repository"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/service"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/middleware"
+	"github.com/casbin/casbin/v2"
+	"github.com/gofiber/fiber/v2"
+	"gopkg.in/gomail.v2"
+	"gorm.io/gorm"
+)
+
+func NewDiscussionRouter(app *fiber.App, db *gorm.DB, enforcer casbin.IEnforcer, mail *gomail.Dialer, jwtSecret string,
+	eventTmpl *template.Template,
+	baseEventURL string) {
+	// Dependencies Injections for Discussions
+	discussionRepo := repository.NewDiscussionRepository(db)
+	eventRepo := repository.NewEventRepository(db)
+	jobRepo := repository.NewOrgOpenJobRepository(db)
+	preferenceRepo := repository.NewNotificationPreferenceRepository(db)
+	eventMailRepo := repository.NewEventAttendeeMailRepository(mail, eventTmpl, baseEventURL)
+	discussionService := service.NewDiscussionService(discussionRepo, eventRepo, jobRepo, preferenceRepo, eventMailRepo)
+	discussionHandler := handler.NewDiscussionHandler(discussionService)
+
+	rbac := middleware.NewRBACMiddleware(enforcer, repository.NewOrganizationRepository(db))
+	targets := []struct {
+		prefix     string
+		targetType models.DiscussionTarget
+		resource   string
+	}{
+		{"/events", models.DiscussionEvent, "Event"},
+		{"/jobs", models.DiscussionJob, "OrganizationOpenJob"},
+	}
+
+	for _, target := range targets {
+		// Anyone reads the threads, signed in users ask and reply
+		questions := app.Group(target.prefix + "/:id/questions")
+		questions.Get("/", discussionHandler.ListQuestions(target.targetType))
+		questions.Post("/", middleware.AuthMiddleware(jwtSecret), discussionHandler.AskQuestion(target.targetType))
+		questions.Post("/:questionID/answers", middleware.AuthMiddleware(jwtSecret), discussionHandler.Reply(target.targetType))
+		app.Get(target.prefix+"/:id/faq", discussionHandler.ListFAQ(target.targetType))
+
+		// Members answer officially and moderate with the permissions they have on the event or the job
+		enforceMiddleware := rbac.EnforceMiddlewareWithResources(target.resource)
+
+		moderated := app.Group("/admin/orgs/:orgID"+target.prefix+"/:id/questions", middleware.AuthMiddleware(jwtSecret))
+		moderated.Get("/", enforceMiddleware("read"), discussionHandler.ListOrgQuestions(target.targetType))
+		moderated.Patch("/:questionID", enforceMiddleware("update"), discussionHandler.ModerateQuestion(target.targetType))
+		moderated.Delete("/:questionID", enforceMiddleware("delete"), discussionHandler.DeleteQuestion(target.targetType))
+		moderated.Post("/:questionID/answers", enforceMiddleware("update"), discussionHandler.AnswerQuestion(target.targetType))
+		moderated.Patch("/:questionID/answers/:answerID", enforceMiddleware("update"), discussionHandler.ModerateAnswer(target.targetType))
+		moderated.Delete("/:questionID/answers/:answerID", enforceMiddleware("delete"), discussionHandler.DeleteAnswer(target.targetType))
+	}
+}
@@ -16,6 +16,8 @@ func NewEventRouter(app *fiber.App, db *gorm.DB, enforcer casbin.IEnforcer, es *
 	eventRepo := repository.NewEventRepository(db)
 	eventService := service.NewEventService(eventRepo, db, es, s3)
 	eventHandler := handler.NewEventHandler(eventService)
+	sessionService := service.NewEventSessionService(repository.NewEventSessionRepository(db), eventRepo)
+	sessionHandler := handler.NewEventSessionHandler(sessionService)
 	//rbac := middleware.NewRBACMiddleware(enforcer)
 	//enforceMiddlewareWithEvent := rbac.EnforceMiddlewareWithResources("Event")
 
@@ -29,13 +31,14 @@ func NewEventRouter(app *fiber.App, db *gorm.DB, enforcer casbin.IEnforcer, es *
 	app.Get("events/categories/list", eventHandler.ListAllCategories)
 
 	// CRUD
-	event.Get("/", eventHandler.ListEventsByOrgID)
+	event.Get("/", eventHandler.ListPublicEventsByOrgID)
 	event.Get("/count", eventHandler.GetNumberOfEvents)
 	app.Get("/events-paginate", eventHandler.EventPaginate)
 	//event.Post("/create", middleware.AuthMiddleware(jwtSecret), enforceMiddlewareWithEvent("create"), eventHandler.CreateEvent)
 	app.Get("/events", eventHandler.ListEvents)
 	app.Get("/events/:id", eventHandler.GetEventByID)
-	event.Get("/:id", eventHandler.GetEventByIDwithOrgID)
+	app.Get("/events/:id/sessions", sessionHandler.ListSessions)
+	event.Get("/:id", eventHandler.GetPublicEventByIDwithOrgID)
 	//event.Put("/:id", middleware.AuthMiddleware(jwtSecret), enforceMiddlewareWithEvent("update"), eventHandler.UpdateEvent)
 	//event.Delete("/:id", middleware.AuthMiddleware(jwtSecret), enforceMiddlewareWithEvent("delete"), eventHandler.DeleteEvent)
 	//event.Get("/", middleware.AuthMiddleware(jwtSecret), eventHandler.ListEventsByOrgID)
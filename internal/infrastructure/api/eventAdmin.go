@@ -17,7 +17,18 @@ func NewEventAdminRouter(app *fiber.App, db *gorm.DB, enforcer casbin.IEnforcer,
 	eventRepo := repository.NewEventRepository(db)
 	eventService := service.NewEventService(eventRepo, db, es, s3)
 	eventHandler := handler.NewEventHandler(eventService)
-	rbac := middleware.NewRBACMiddleware(enforcer)
+	// Dependencies Injections for Sessions
+	sessionRepo := repository.NewEventSessionRepository(db)
+	sessionService := service.NewEventSessionService(sessionRepo, eventRepo)
+	sessionHandler := handler.NewEventSessionHandler(sessionService)
+
+	// Dependencies Injections for Agenda, Speakers and Materials
+	programRepo := repository.NewEventProgramRepository(db)
+	userRepo := repository.NewUserRepository(db)
+	programService := service.NewEventProgramService(programRepo, eventRepo, userRepo, s3)
+	programHandler := handler.NewEventProgramHandler(programService)
+
+	rbac := middleware.NewRBACMiddleware(enforcer, repository.NewOrganizationRepository(db))
 	enforceMiddlewareWithEvent := rbac.EnforceMiddlewareWithResources("Event")
 
 	event := app.Group("admin/orgs/:orgID/events", middleware.AuthMiddleware(jwtSecret))
@@ -29,4 +40,22 @@ func NewEventAdminRouter(app *fiber.App, db *gorm.DB, enforcer casbin.IEnforcer,
 	event.Get("/:id", enforceMiddlewareWithEvent("read"), eventHandler.GetEventByIDwithOrgID)
 	event.Put("/:id", enforceMiddlewareWithEvent("update"), eventHandler.UpdateEvent)
 	event.Delete("/:id", enforceMiddlewareWithEvent("delete"), eventHandler.DeleteEvent)
+
+	// Sessions and recurrence
+	event.Get("/:id/sessions", enforceMiddlewareWithEvent("read"), sessionHandler.ListEventSessions)
+	event.Post("/:id/sessions", enforceMiddlewareWithEvent("update"), sessionHandler.CreateSession)
+	event.Put("/:id/sessions/recurrence", enforceMiddlewareWithEvent("update"), sessionHandler.SetRecurrence)
+	event.Put("/:id/sessions/:sessionID", enforceMiddlewareWithEvent("update"), sessionHandler.UpdateSession)
+	event.Delete("/:id/sessions/:sessionID", enforceMiddlewareWithEvent("update"), sessionHandler.DeleteSession)
+
+	// Agenda, speakers and materials
+	event.Put("/:id/agenda", enforceMiddlewareWithEvent("update"), programHandler.UpdateAgenda)
+	event.Get("/:id/speakers", enforceMiddlewareWithEvent("read"), programHandler.ListSpeakers)
+	event.Post("/:id/speakers", enforceMiddlewareWithEvent("update"), programHandler.CreateSpeaker)
+	event.Put("/:id/speakers/:speakerID", enforceMiddlewareWithEvent("update"), programHandler.UpdateSpeaker)
+	event.Post("/:id/speakers/:speakerID/picture", enforceMiddlewareWithEvent("update"), programHandler.UploadSpeakerPicture)
+	event.Delete("/:id/speakers/:speakerID", enforceMiddlewareWithEvent("update"), programHandler.DeleteSpeaker)
+	event.Get("/:id/materials", enforceMiddlewareWithEvent("read"), programHandler.ListMaterials)
+	event.Post("/:id/materials", enforceMiddlewareWithEvent("update"), programHandler.UploadMaterial)
+	event.Delete("/:id/materials/:materialID", enforceMiddlewareWithEvent("update"), programHandler.DeleteMaterial)
 }
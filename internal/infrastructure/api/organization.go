@@ -5,13 +5,14 @@ import (
 	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/infrastructure"
 	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/repository"
 	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/service"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/middleware"
 	"github.com/casbin/casbin/v2"
 	"github.com/gofiber/fiber/v2"
 	"github.com/opensearch-project/opensearch-go"
 	"gorm.io/gorm"
 )
 
-func NewOrganizationRouter(app *fiber.App, db *gorm.DB, enforcer casbin.IEnforcer, es *opensearch.Client, s3 *infrastructure.S3Uploader) {
+func NewOrganizationRouter(app *fiber.App, db *gorm.DB, enforcer casbin.IEnforcer, es *opensearch.Client, s3 *infrastructure.S3Uploader, jwtSecret string) {
 	// Dependencies Injections for Organization
 	organizationRepo := repository.NewOrganizationRepository(db)
 	casbinRoleRepository := repository.NewCasbinRoleRepository(enforcer)
@@ -53,27 +54,28 @@ func NewOrganizationRouter(app *fiber.App, db *gorm.DB, enforcer casbin.IEnforce
 	orgOpenJobRepo := repository.NewOrgOpenJobRepository(db)
 	jobPreqRepo := repository.NewPrerequisiteRepository(db)
 	orgOpenJobService := service.NewOrgOpenJobService(orgOpenJobRepo, organizationRepo, jobPreqRepo, db, es, s3)
-	orgOpenJobHandler := handler.NewOrgOpenJobHandler(orgOpenJobService)
+	skillService := service.NewSkillService(repository.NewSkillRepository(db), repository.NewProfileRepository(db), es)
+	orgOpenJobHandler := handler.NewOrgOpenJobHandler(orgOpenJobService, skillService)
 	//enforceMiddlewareWithOpenJob := rbac.EnforceMiddlewareWithResources("OrganizationOpenJob")
 
 	// Define routes for Organization Open Jobs
 	org.Get("/jobs/list/all", orgOpenJobHandler.ListAllOrganizationJobs)
 	org.Get("/jobs/jobs-paginate", orgOpenJobHandler.GetPaginateOrgOpenJob)
 
-	org.Get("/:orgID/jobs/list", orgOpenJobHandler.ListOrgOpenJobsByOrgID)
-	org.Get("/:orgID/jobs/get/:id", orgOpenJobHandler.GetOrgOpenJobByIDwithOrgID)
+	org.Get("/:orgID/jobs/list", orgOpenJobHandler.ListPublicOrgOpenJobsByOrgID)
+	org.Get("/:orgID/jobs/get/:id", orgOpenJobHandler.GetPublicOrgOpenJobByIDwithOrgID)
 	org.Get("/:orgID/jobs/count", orgOpenJobHandler.GetNumberOfJobs)
 	//org.Post("/:orgID/jobs/create", authMiddleware, enforceMiddlewareWithOpenJob("create"), orgOpenJobHandler.CreateOrgOpenJob)
 	//org.Put("/:orgID/jobs/update/:id", authMiddleware, enforceMiddlewareWithOpenJob("update"), orgOpenJobHandler.UpdateOrgOpenJob)
 	//org.Delete("/:orgID/jobs/delete/:id", authMiddleware, enforceMiddlewareWithOpenJob("delete"), orgOpenJobHandler.DeleteOrgOpenJob)
 
 	// Searching Jobs
-	app.Get("/jobs-paginate/search", orgOpenJobHandler.SearchJobs)
+	app.Get("/jobs-paginate/search", middleware.OptionalAuthMiddleware(jwtSecret), orgOpenJobHandler.SearchJobs)
 	// Sync PostGres to OpenSearch
 	app.Get("/sync-orgs-jobs", orgOpenJobHandler.SyncJobs)
 
 	// Get job for frontend
-	app.Get("/jobs/get/:id", orgOpenJobHandler.GetJobByID)
+	app.Get("/jobs/get/:id", middleware.OptionalAuthMiddleware(jwtSecret), orgOpenJobHandler.GetJobByID)
 	app.Get("/orgs/:id", organizationHandler.GetOrganizationByID)
 
 	// Pre-requisite
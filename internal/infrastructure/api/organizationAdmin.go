@@ -20,7 +20,7 @@ func NewOrganizationAdminRouter(app *fiber.App, db *gorm.DB, enforcer casbin.IEn
 	organizationHandler := handler.NewOrganizationHandler(organizationService)
 
 	//rbac
-	rbac := middleware.NewRBACMiddleware(enforcer)
+	rbac := middleware.NewRBACMiddleware(enforcer, repository.NewOrganizationRepository(db))
 	enforceMiddlewareWithOrganization := rbac.EnforceMiddlewareWithResources("Organization")
 
 	org := app.Group("/admin/orgs", middleware.AuthMiddleware(jwtSecret))
@@ -48,7 +48,8 @@ func NewOrganizationAdminRouter(app *fiber.App, db *gorm.DB, enforcer casbin.IEn
 	orgOpenJobRepo := repository.NewOrgOpenJobRepository(db)
 	jobPreqRepo := repository.NewPrerequisiteRepository(db)
 	orgOpenJobService := service.NewOrgOpenJobService(orgOpenJobRepo, organizationRepo, jobPreqRepo, db, es, s3)
-	orgOpenJobHandler := handler.NewOrgOpenJobHandler(orgOpenJobService)
+	skillService := service.NewSkillService(repository.NewSkillRepository(db), repository.NewProfileRepository(db), es)
+	orgOpenJobHandler := handler.NewOrgOpenJobHandler(orgOpenJobService, skillService)
 	enforceMiddlewareWithOpenJob := rbac.EnforceMiddlewareWithResources("OrganizationOpenJob")
 
 	// Define routes for Organization Open Jobs
@@ -0,0 +1,35 @@
+package api
+
+import (
+	"html/template"
+
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/handler"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/repository"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/service"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/middleware"
+	"github.com/casbin/casbin/v2"
+	"github.com/gofiber/fiber/v2"
+	"github.com/opensearch-project/opensearch-go"
+	"gopkg.in/gomail.v2"
+	"gorm.io/gorm"
+)
+
+func NewReportRouter(app *fiber.App, db *gorm.DB, enforcer casbin.IEnforcer, es *opensearch.Client, mail *gomail.Dialer, jwtSecret string,
+	eventTmpl *template.Template,
+	baseEventURL string,
+	autoHideThreshold string) {
+	// Dependencies Injections for Reports
+	reportRepo := repository.NewContentReportRepository(db)
+	eventMailRepo := repository.NewEventAttendeeMailRepository(mail, eventTmpl, baseEventURL)
+	reportService := service.NewReportService(reportRepo, eventMailRepo, service.ReportAutoHideThreshold(autoHideThreshold), db, es)
+	reportHandler := handler.NewReportHandler(reportService)
+
+	app.Post("/reports", middleware.AuthMiddleware(jwtSecret), reportHandler.Report)
+
+	// The moderation queue spans every organization, it is left to system admins
+	rbac := middleware.NewRBACMiddleware(enforcer, repository.NewOrganizationRepository(db))
+	moderation := app.Group("/admin/moderation", middleware.AuthMiddleware(jwtSecret), rbac.EnforceSystemAdminMiddleware())
+	moderation.Get("/reports", reportHandler.ListQueue)
+	moderation.Get("/reports/:targetType/:targetID", reportHandler.GetReportedContent)
+	moderation.Post("/reports/:targetType/:targetID/actions", reportHandler.TakeAction)
+}
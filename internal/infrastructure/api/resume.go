@@ -0,0 +1,47 @@
+package api
+
+import (
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/handler"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/infrastructure"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/repository"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/service"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/middleware"
+	"github.com/casbin/casbin/v2"
+	"github.com/gofiber/fiber/v2"
+	"github.com/opensearch-project/opensearch-go"
+	"gorm.io/gorm"
+)
+
+func NewResumeRouter(app *fiber.App, db *gorm.DB, enforcer casbin.IEnforcer, es *opensearch.Client, s3 *infrastructure.S3Uploader, jwtSecret string) {
+	// Dependencies Injections for Resumes
+	resumeRepo := repository.NewResumeRepository(db)
+	skillRepo := repository.NewSkillRepository(db)
+	profileRepo := repository.NewProfileRepository(db)
+	resumeService := service.NewResumeService(resumeRepo, skillRepo, profileRepo, s3, es)
+	resumeHandler := handler.NewResumeHandler(resumeService)
+
+	resume := app.Group("/users/me/resumes", middleware.AuthMiddleware(jwtSecret))
+	resume.Post("/", resumeHandler.UploadResume)
+	resume.Get("/", resumeHandler.ListMyResumes)
+	resume.Get("/:resumeID/url", resumeHandler.GetMyResumeURL)
+	resume.Get("/:resumeID/suggestions", resumeHandler.GetMyResumeSuggestions)
+	resume.Post("/:resumeID/confirm", resumeHandler.ConfirmMyResumeSuggestions)
+	resume.Delete("/:resumeID", resumeHandler.DeleteMyResume)
+
+	// Dependencies Injections for Job Applications
+	applicationRepo := repository.NewJobApplicationRepository(db)
+	orgOpenJobRepo := repository.NewOrgOpenJobRepository(db)
+	applicationService := service.NewJobApplicationService(applicationRepo, resumeRepo, orgOpenJobRepo, s3)
+	applicationHandler := handler.NewJobApplicationHandler(applicationService)
+
+	app.Post("/jobs/:jobID/apply", middleware.AuthMiddleware(jwtSecret), applicationHandler.Apply)
+	app.Get("/users/me/applications", middleware.AuthMiddleware(jwtSecret), applicationHandler.ListMyApplications)
+
+	// Organizations only reach resumes through the applications sent to their jobs
+	rbac := middleware.NewRBACMiddleware(enforcer, repository.NewOrganizationRepository(db))
+	enforceMiddlewareWithOpenJob := rbac.EnforceMiddlewareWithResources("OrganizationOpenJob")
+
+	org := app.Group("/admin/orgs", middleware.AuthMiddleware(jwtSecret))
+	org.Get("/:orgID/jobs/:jobID/applications", enforceMiddlewareWithOpenJob("read"), applicationHandler.ListJobApplications)
+	org.Get("/:orgID/applications/:applicationID/resume", enforceMiddlewareWithOpenJob("read"), applicationHandler.GetApplicationResumeURL)
+}
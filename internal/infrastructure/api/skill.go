@@ -0,0 +1,36 @@
+package api
+
+import (
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/handler"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/repository"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/service"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/middleware"
+	"github.com/casbin/casbin/v2"
+	"github.com/gofiber/fiber/v2"
+	"github.com/opensearch-project/opensearch-go"
+	"gorm.io/gorm"
+)
+
+func NewSkillRouter(app *fiber.App, db *gorm.DB, enforcer casbin.IEnforcer, es *opensearch.Client, jwtSecret string) {
+	// Dependencies Injections for Skills
+	skillRepo := repository.NewSkillRepository(db)
+	profileRepo := repository.NewProfileRepository(db)
+	skillService := service.NewSkillService(skillRepo, profileRepo, es)
+	skillHandler := handler.NewSkillHandler(skillService)
+
+	rbac := middleware.NewRBACMiddleware(enforcer, repository.NewOrganizationRepository(db))
+
+	skill := app.Group("/skills")
+	skill.Get("/list", skillHandler.ListSkills)
+	skill.Get("/autocomplete", skillHandler.AutocompleteSkills)
+	skill.Get("/get/:id", skillHandler.GetSkillByID)
+
+	// The skills catalogue is shared by every organization, so it is managed by system admins
+	admin := app.Group("/admin/skills", middleware.AuthMiddleware(jwtSecret), rbac.EnforceSystemAdminMiddleware())
+	admin.Post("/create", skillHandler.CreateSkill)
+	admin.Put("/update/:id", skillHandler.UpdateSkill)
+	admin.Delete("/delete/:id", skillHandler.DeleteSkill)
+
+	app.Get("/users/me/skills", middleware.AuthMiddleware(jwtSecret), skillHandler.GetMySkills)
+	app.Put("/users/me/skills", middleware.AuthMiddleware(jwtSecret), skillHandler.UpdateMySkills)
+}
@@ -0,0 +1,50 @@
+package api
+
+import (
+	"html/template"
+	"time"
+
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/handler"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/infrastructure/scheduler"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/repository"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/service"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/middleware"
+	"github.com/casbin/casbin/v2"
+	"github.com/gofiber/fiber/v2"
+	"gopkg.in/gomail.v2"
+	"gorm.io/gorm"
+)
+
+func NewSurveyRouter(app *fiber.App, db *gorm.DB, enforcer casbin.IEnforcer, mail *gomail.Dialer, jwtSecret string,
+	eventTmpl *template.Template,
+	baseEventURL string) {
+	// Dependencies Injections for Surveys
+	surveyRepo := repository.NewEventSurveyRepository(db)
+	eventRepo := repository.NewEventRepository(db)
+	eventMailRepo := repository.NewEventAttendeeMailRepository(mail, eventTmpl, baseEventURL)
+	surveyService := service.NewSurveyService(surveyRepo, eventRepo, eventMailRepo)
+	surveyHandler := handler.NewSurveyHandler(surveyService)
+
+	// Participants checked in are invited to the survey once the event has ended
+	scheduler.Every(time.Minute, "send ended event surveys", surveyService.SendEndedSurveys)
+
+	// Public ratings
+	app.Get("/events/:id/rating", surveyHandler.GetEventRating)
+	app.Get("/orgs/:orgID/rating", surveyHandler.GetOrganizationRating)
+
+	// Attendees
+	app.Get("/events/:id/survey", middleware.AuthMiddleware(jwtSecret), surveyHandler.GetMySurvey)
+	app.Post("/events/:id/survey/responses", middleware.AuthMiddleware(jwtSecret), surveyHandler.SubmitSurvey)
+
+	// Organizers
+	rbac := middleware.NewRBACMiddleware(enforcer, repository.NewOrganizationRepository(db))
+	enforceMiddlewareWithEvent := rbac.EnforceMiddlewareWithResources("Event")
+
+	app.Get("/admin/orgs/:orgID/surveys/results", middleware.AuthMiddleware(jwtSecret), enforceMiddlewareWithEvent("read"), surveyHandler.GetOrganizationSurveyResults)
+
+	event := app.Group("/admin/orgs/:orgID/events/:id/survey", middleware.AuthMiddleware(jwtSecret))
+	event.Get("/", enforceMiddlewareWithEvent("read"), surveyHandler.GetEventSurvey)
+	event.Put("/", enforceMiddlewareWithEvent("update"), surveyHandler.SaveEventSurvey)
+	event.Delete("/", enforceMiddlewareWithEvent("update"), surveyHandler.DeleteEventSurvey)
+	event.Get("/results", enforceMiddlewareWithEvent("read"), surveyHandler.GetSurveyResults)
+}
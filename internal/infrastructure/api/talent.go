@@ -0,0 +1,34 @@
+package api
+
+import (
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/handler"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/repository"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/service"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/middleware"
+	"github.com/casbin/casbin/v2"
+	"github.com/gofiber/fiber/v2"
+	"github.com/opensearch-project/opensearch-go"
+	"gorm.io/gorm"
+)
+
+func NewTalentRouter(app *fiber.App, db *gorm.DB, enforcer casbin.IEnforcer, es *opensearch.Client, jwtSecret string) {
+	// Dependencies Injections for Talents
+	profileRepo := repository.NewProfileRepository(db)
+	organizationRepo := repository.NewOrganizationRepository(db)
+	talentService := service.NewTalentService(profileRepo, organizationRepo, db, es)
+	talentHandler := handler.NewTalentHandler(talentService)
+
+	app.Get("/users/me/profile/visibility", middleware.AuthMiddleware(jwtSecret), talentHandler.GetMyVisibility)
+	app.Put("/users/me/profile/visibility", middleware.AuthMiddleware(jwtSecret), talentHandler.UpdateMyVisibility)
+	app.Get("/users/me/profile/views", middleware.AuthMiddleware(jwtSecret), talentHandler.ListMyProfileViews)
+
+	app.Get("/profiles/:slug", talentHandler.GetPublicProfile)
+	app.Get("/sync-talents", talentHandler.SyncTalents)
+
+	rbac := middleware.NewRBACMiddleware(enforcer, repository.NewOrganizationRepository(db))
+	enforceMiddlewareWithOrganization := rbac.EnforceMiddlewareWithResources("Organization")
+
+	org := app.Group("/admin/orgs", middleware.AuthMiddleware(jwtSecret))
+	org.Get("/:orgID/talents/search", enforceMiddlewareWithOrganization("read"), talentHandler.SearchTalents)
+	org.Get("/:orgID/talents/:slug", enforceMiddlewareWithOrganization("read"), talentHandler.ViewProfileAsOrganization)
+}
@@ -0,0 +1,38 @@
+package api
+
+import (
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/handler"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/infrastructure"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/repository"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/service"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/middleware"
+	"github.com/casbin/casbin/v2"
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+func NewOrgTemplateRouter(app *fiber.App, db *gorm.DB, enforcer casbin.IEnforcer, s3 *infrastructure.S3Uploader, jwtSecret string) {
+	// Dependencies Injections for Duplicates and Templates
+	templateRepo := repository.NewOrgTemplateRepository(db)
+	eventRepo := repository.NewEventRepository(db)
+	ticketRepo := repository.NewTicketAvailableRepository(db)
+	programRepo := repository.NewEventProgramRepository(db)
+	jobRepo := repository.NewOrgOpenJobRepository(db)
+	templateService := service.NewOrgTemplateService(templateRepo, eventRepo, ticketRepo, programRepo, jobRepo, s3)
+	templateHandler := handler.NewOrgTemplateHandler(templateService)
+
+	rbac := middleware.NewRBACMiddleware(enforcer, repository.NewOrganizationRepository(db))
+	enforceMiddlewareWithEvent := rbac.EnforceMiddlewareWithResources("Event")
+	enforceMiddlewareWithOpenJob := rbac.EnforceMiddlewareWithResources("OrganizationOpenJob")
+	enforceMiddlewareWithTemplate := rbac.EnforceMiddlewareWithResources("OrganizationTemplate")
+
+	org := app.Group("/admin/orgs/:orgID", middleware.AuthMiddleware(jwtSecret))
+	org.Post("/events/:id/duplicate", enforceMiddlewareWithEvent("create"), templateHandler.DuplicateEvent)
+	org.Post("/jobs/duplicate/:id", enforceMiddlewareWithOpenJob("create"), templateHandler.DuplicateJob)
+
+	templates := org.Group("/templates")
+	templates.Get("/", enforceMiddlewareWithTemplate("read"), templateHandler.ListTemplates)
+	templates.Post("/", enforceMiddlewareWithTemplate("create"), templateHandler.SaveTemplate)
+	templates.Delete("/:templateID", enforceMiddlewareWithTemplate("delete"), templateHandler.DeleteTemplate)
+	templates.Post("/:templateID/draft", enforceMiddlewareWithTemplate("create"), templateHandler.CreateDraft)
+}
@@ -0,0 +1,140 @@
+package api
+
+import (
+	"html/template"
+	"time"
+
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/handler"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/infrastructure/payment"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/infrastructure/scheduler"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/infrastructure/ticket"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/repository"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/service"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/middleware"
+	"github.com/casbin/casbin/v2"
+	"github.com/gofiber/fiber/v2"
+	"gopkg.in/gomail.v2"
+	"gorm.io/gorm"
+)
+
+func NewTicketRouter(app *fiber.App, db *gorm.DB, enforcer casbin.IEnforcer, mail *gomail.Dialer, jwtSecret string,
+	eventTmpl *template.Template,
+	baseEventURL string,
+	paymentProvider payment.PaymentProvider) {
+	// Dependencies Injections for Tickets
+	ticketRepo := repository.NewTicketAvailableRepository(db)
+	purchaseRepo := repository.NewTicketPurchasedRepository(db)
+	eventRepo := repository.NewEventRepository(db)
+	userRepo := repository.NewUserRepository(db)
+	eventMailRepo := repository.NewEventAttendeeMailRepository(mail, eventTmpl, baseEventURL)
+	qrSigner := ticket.NewQRSigner(jwtSecret)
+	ticketService := service.NewTicketService(ticketRepo, purchaseRepo, eventRepo, userRepo, eventMailRepo, qrSigner)
+	ticketHandler := handler.NewTicketHandler(ticketService)
+
+	// Dependencies Injections for Waitlists
+	waitlistRepo := repository.NewWaitlistRepository(db)
+	waitlistService := service.NewWaitlistService(waitlistRepo, ticketRepo, purchaseRepo, eventRepo, eventMailRepo, qrSigner)
+	waitlistHandler := handler.NewWaitlistHandler(waitlistService)
+
+	// Dependencies Injections for Promo Codes
+	promoRepo := repository.NewPromoCodeRepository(db)
+	promoService := service.NewPromoCodeService(promoRepo, ticketRepo, eventRepo)
+	promoHandler := handler.NewPromoCodeHandler(promoService)
+
+	// Dependencies Injections for Payments
+	orderRepo := repository.NewOrderRepository(db)
+	paymentService := service.NewPaymentService(orderRepo, purchaseRepo, eventRepo, eventMailRepo, paymentProvider)
+	paymentHandler := handler.NewPaymentHandler(paymentService)
+
+	// Dependencies Injections for Participants
+	participantRepo := repository.NewEventParticipantRepository(db)
+	announcementRepo := repository.NewEventAnnouncementRepository(db)
+	participantService := service.NewParticipantService(participantRepo, announcementRepo, eventRepo, eventMailRepo)
+	participantHandler := handler.NewParticipantHandler(participantService)
+
+	// Dependencies Injections for Reminders
+	reminderRepo := repository.NewEventReminderRepository(db)
+	reminderService := service.NewReminderService(reminderRepo, purchaseRepo, eventMailRepo, qrSigner, baseEventURL)
+	preferenceRepo := repository.NewNotificationPreferenceRepository(db)
+	preferenceService := service.NewNotificationPreferenceService(preferenceRepo)
+	preferenceHandler := handler.NewNotificationPreferenceHandler(preferenceService)
+
+	// Unclaimed waitlist offers pass to the next person in line
+	scheduler.Every(time.Minute, "expire waitlist offers", waitlistService.ExpireOffers)
+	// Seats of tickets left unpaid are released
+	scheduler.Every(time.Minute, "expire unpaid tickets", paymentService.ExpirePendingTickets)
+	// Registrants are reminded a day and an hour before the start of their events
+	scheduler.Every(time.Minute, "send event reminders", reminderService.SendDueReminders)
+	scheduler.Every(time.Minute, "send scheduled announcements", participantService.SendScheduledAnnouncements)
+
+	// Attendees
+	app.Get("/events/:id/tickets", ticketHandler.ListEventTickets)
+	app.Post("/events/:id/tickets/:ticketID/register", middleware.AuthMiddleware(jwtSecret), ticketHandler.Register)
+	app.Post("/events/:id/tickets/:ticketID/waitlist", middleware.AuthMiddleware(jwtSecret), waitlistHandler.JoinWaitlist)
+	app.Post("/events/:id/tickets/:ticketID/promo-codes/quote", middleware.AuthMiddleware(jwtSecret), promoHandler.QuotePromoCode)
+	app.Get("/events/:id/participants", participantHandler.ListPublicParticipants)
+	app.Put("/events/:id/participants/me", middleware.AuthMiddleware(jwtSecret), participantHandler.UpdateMyVisibility)
+	app.Post("/waitlists/claim", middleware.AuthMiddleware(jwtSecret), waitlistHandler.ClaimOffer)
+
+	me := app.Group("/users/me/tickets", middleware.AuthMiddleware(jwtSecret))
+	me.Get("/", ticketHandler.ListMyTickets)
+	me.Get("/:purchaseID", ticketHandler.GetMyTicket)
+	me.Get("/:purchaseID/qrcode", ticketHandler.GetMyTicketQRCode)
+	me.Post("/:purchaseID/cancel", ticketHandler.CancelMyTicket)
+	me.Post("/:purchaseID/checkout", paymentHandler.Checkout)
+
+	orders := app.Group("/users/me/orders", middleware.AuthMiddleware(jwtSecret))
+	orders.Get("/:orderID", paymentHandler.GetMyOrder)
+	orders.Get("/:orderID/qrcode", paymentHandler.GetMyOrderQRCode)
+	if _, ok := paymentProvider.(*payment.FakeProvider); ok {
+		orders.Post("/:orderID/simulate-payment", paymentHandler.SimulatePayment)
+	}
+
+	// Payment provider notifications, authenticated by their signature
+	app.Post("/payments/webhook/:provider", paymentHandler.Webhook)
+
+	app.Get("/users/me/notification-preferences", middleware.AuthMiddleware(jwtSecret), preferenceHandler.GetMyNotificationPreferences)
+	app.Put("/users/me/notification-preferences", middleware.AuthMiddleware(jwtSecret), preferenceHandler.UpdateMyNotificationPreferences)
+
+	waitlist := app.Group("/users/me/waitlists", middleware.AuthMiddleware(jwtSecret))
+	waitlist.Get("/", waitlistHandler.ListMyWaitlists)
+	waitlist.Delete("/:entryID", waitlistHandler.LeaveWaitlist)
+
+	// Organizers
+	rbac := middleware.NewRBACMiddleware(enforcer, repository.NewOrganizationRepository(db))
+	enforceMiddlewareWithEvent := rbac.EnforceMiddlewareWithResources("Event")
+
+	event := app.Group("/admin/orgs/:orgID/events/:id", middleware.AuthMiddleware(jwtSecret))
+	event.Get("/tickets", enforceMiddlewareWithEvent("read"), ticketHandler.ListTicketTypes)
+	event.Post("/tickets", enforceMiddlewareWithEvent("update"), ticketHandler.CreateTicketType)
+	event.Put("/tickets/:ticketID", enforceMiddlewareWithEvent("update"), ticketHandler.UpdateTicketType)
+	event.Delete("/tickets/:ticketID", enforceMiddlewareWithEvent("update"), ticketHandler.DeleteTicketType)
+	event.Get("/registrations", enforceMiddlewareWithEvent("read"), ticketHandler.ListEventRegistrations)
+
+	// Waitlists
+	event.Get("/tickets/:ticketID/waitlist", enforceMiddlewareWithEvent("read"), waitlistHandler.ListWaitlist)
+	event.Put("/tickets/:ticketID/waitlist/order", enforceMiddlewareWithEvent("update"), waitlistHandler.ReorderWaitlist)
+	event.Post("/tickets/:ticketID/waitlist/:entryID/promote", enforceMiddlewareWithEvent("update"), waitlistHandler.PromoteEntry)
+
+	// Check-in at the entrance
+	event.Post("/check-in", enforceMiddlewareWithEvent("update"), ticketHandler.CheckIn)
+	event.Get("/check-in/summary", enforceMiddlewareWithEvent("read"), ticketHandler.GetCheckInSummary)
+
+	// Promo codes
+	event.Get("/promo-codes", enforceMiddlewareWithEvent("read"), promoHandler.ListPromoCodes)
+	event.Post("/promo-codes", enforceMiddlewareWithEvent("update"), promoHandler.CreatePromoCode)
+	event.Get("/promo-codes/report", enforceMiddlewareWithEvent("read"), promoHandler.GetPromoCodeReport)
+	event.Put("/promo-codes/:promoID", enforceMiddlewareWithEvent("update"), promoHandler.UpdatePromoCode)
+	event.Delete("/promo-codes/:promoID", enforceMiddlewareWithEvent("update"), promoHandler.DeletePromoCode)
+
+	// Payments
+	event.Get("/orders", enforceMiddlewareWithEvent("read"), paymentHandler.ListEventOrders)
+	event.Post("/orders/:orderID/refund", enforceMiddlewareWithEvent("update"), paymentHandler.RefundOrder)
+
+	// Participants
+	event.Get("/participants", enforceMiddlewareWithEvent("read"), participantHandler.ListParticipants)
+	event.Get("/participants/export", enforceMiddlewareWithEvent("read"), participantHandler.ExportParticipants)
+	event.Get("/participants/announcements", enforceMiddlewareWithEvent("read"), participantHandler.ListAnnouncements)
+	event.Post("/participants/announcements", enforceMiddlewareWithEvent("update"), participantHandler.SendAnnouncement)
+	event.Delete("/participants/announcements/:announcementID", enforceMiddlewareWithEvent("update"), participantHandler.CancelAnnouncement)
+}
@@ -7,10 +7,11 @@ import (
 	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/service"
 	"github.com/DAF-Bridge/Talent-Atmos-Backend/middleware"
 	"github.com/gofiber/fiber/v2"
+	"github.com/opensearch-project/opensearch-go"
 	"gorm.io/gorm"
 )
 
-func NewUserRouter(app *fiber.App, db *gorm.DB, s3 *infrastructure.S3Uploader, jwtSecret string) {
+func NewUserRouter(app *fiber.App, db *gorm.DB, es *opensearch.Client, s3 *infrastructure.S3Uploader, jwtSecret string) {
 	// Dependencies Injections for User
 	userRepo := repository.NewUserRepository(db)
 	userService := service.NewUserService(userRepo, s3)
@@ -24,6 +25,24 @@ func NewUserRouter(app *fiber.App, db *gorm.DB, s3 *infrastructure.S3Uploader, j
 
 	app.Get("/current-user-profile", middleware.AuthMiddleware(jwtSecret), userHandler.GetCurrentUser)
 
+	// Dependencies Injections for Profile && Experiences
+	profileRepo := repository.NewProfileRepository(db)
+	experienceRepo := repository.NewExperienceRepository(db)
+	profileService := service.NewProfileService(profileRepo, es)
+	experienceService := service.NewExperienceService(experienceRepo, profileRepo, s3)
+	profileHandler := handler.NewProfileHandler(profileService)
+	experienceHandler := handler.NewExperienceHandler(experienceService)
+
+	me := app.Group("/users/me", middleware.AuthMiddleware(jwtSecret))
+	me.Get("/profile", profileHandler.GetMyProfile)
+	me.Put("/profile", profileHandler.UpdateMyProfile)
+	me.Get("/experiences", experienceHandler.ListMyExperiences)
+	me.Post("/experiences", experienceHandler.CreateMyExperience)
+	me.Get("/experiences/:experienceID", experienceHandler.GetMyExperience)
+	me.Put("/experiences/:experienceID", experienceHandler.UpdateMyExperience)
+	me.Post("/experiences/:experienceID/picture", experienceHandler.UploadMyExperiencePicture)
+	me.Delete("/experiences/:experienceID", experienceHandler.DeleteMyExperience)
+
 	// Dependencies Injections for User Preference
 	userPreferenceRepo := repository.NewUserPreferenceRepository(db)
 	eventRepo := repository.NewEventRepository(db)
@@ -0,0 +1,132 @@
+package calendar
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// TimezoneID is the zone every event time is written in. Thailand has no daylight saving time,
+// so a fixed offset is exact and does not depend on the tz database of the host.
+const TimezoneID = "Asia/Bangkok"
+
+var Bangkok = time.FixedZone(TimezoneID, 7*60*60)
+
+const (
+	ContentType = "text/calendar; charset=utf-8"
+	productID   = "-//Talent Atmos//Events//EN"
+	// Lines longer than this many octets are folded (RFC 5545 section 3.1)
+	maxLineOctets = 75
+	dateFormat    = "20060102"
+	localFormat   = "20060102T150405"
+	utcFormat     = "20060102T150405Z"
+)
+
+type Event struct {
+	UID         string
+	Summary     string
+	Description string
+	Location    string
+	URL         string
+	Start       time.Time
+	End         time.Time
+	AllDay      bool // Start and End are dates, End is exclusive
+	Latitude    float64
+	Longitude   float64
+	Cancelled   bool
+	Updated     time.Time
+}
+
+// Render writes the events as an iCalendar document. Timed events in Asia/Bangkok carry its TZID,
+// which is defined in the document so that clients do not have to know it. Events in other zones are in UTC.
+func Render(name string, events []Event, now time.Time) []byte {
+	w := &writer{}
+	w.line("BEGIN:VCALENDAR")
+	w.line("VERSION:2.0")
+	w.line("PRODID:" + productID)
+	w.line("CALSCALE:GREGORIAN")
+	w.line("METHOD:PUBLISH")
+	w.line("X-WR-CALNAME:" + escapeText(name))
+	w.line("X-WR-TIMEZONE:" + TimezoneID)
+	w.line("REFRESH-INTERVAL;VALUE=DURATION:PT1H")
+	w.line("X-PUBLISHED-TTL:PT1H")
+
+	w.line("BEGIN:VTIMEZONE")
+	w.line("TZID:" + TimezoneID)
+	w.line("BEGIN:STANDARD")
+	w.line("DTSTART:19700101T000000")
+	w.line("TZOFFSETFROM:+0700")
+	w.line("TZOFFSETTO:+0700")
+	w.line("TZNAME:ICT")
+	w.line("END:STANDARD")
+	w.line("END:VTIMEZONE")
+
+	for _, event := range events {
+		w.line("BEGIN:VEVENT")
+		w.line("UID:" + event.UID)
+		w.line("DTSTAMP:" + now.UTC().Format(utcFormat))
+		if event.AllDay {
+			w.line("DTSTART;VALUE=DATE:" + event.Start.Format(dateFormat))
+			w.line("DTEND;VALUE=DATE:" + event.End.Format(dateFormat))
+		} else if event.Start.Location().String() == TimezoneID {
+			w.line("DTSTART;TZID=" + TimezoneID + ":" + event.Start.In(Bangkok).Format(localFormat))
+			w.line("DTEND;TZID=" + TimezoneID + ":" + event.End.In(Bangkok).Format(localFormat))
+		} else {
+			// Other zones are not defined in the document, their events are written as UTC instants
+			w.line("DTSTART:" + event.Start.UTC().Format(utcFormat))
+			w.line("DTEND:" + event.End.UTC().Format(utcFormat))
+		}
+		w.line("SUMMARY:" + escapeText(event.Summary))
+		if event.Description != "" {
+			w.line("DESCRIPTION:" + escapeText(event.Description))
+		}
+		if event.Location != "" {
+			w.line("LOCATION:" + escapeText(event.Location))
+		}
+		if event.Latitude != 0 || event.Longitude != 0 {
+			w.line(fmt.Sprintf("GEO:%.6f;%.6f", event.Latitude, event.Longitude))
+		}
+		if event.URL != "" {
+			w.line("URL:" + event.URL)
+		}
+		if !event.Updated.IsZero() {
+			w.line("LAST-MODIFIED:" + event.Updated.UTC().Format(utcFormat))
+		}
+		if event.Cancelled {
+			w.line("STATUS:CANCELLED")
+		} else {
+			w.line("STATUS:CONFIRMED")
+		}
+		w.line("END:VEVENT")
+	}
+
+	w.line("END:VCALENDAR")
+	return []byte(w.String())
+}
+
+type writer struct {
+	strings.Builder
+}
+
+// line writes a content line ending with CRLF, folded without splitting a UTF-8 character.
+func (w *writer) line(s string) {
+	octets := 0
+	for _, r := range s {
+		size := utf8.RuneLen(r)
+		if octets+size > maxLineOctets {
+			w.WriteString("\r\n ")
+			// The leading space of a continuation line counts toward its length
+			octets = 1
+		}
+		w.WriteRune(r)
+		octets += size
+	}
+	w.WriteString("\r\n")
+}
+
+var textEscaper = strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`, "\r", `\n`)
+
+func escapeText(s string) string {
+	return textEscaper.Replace(s)
+}
@@ -0,0 +1,275 @@
+package calendar
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// MaxOccurrences caps the sessions a rule can create, a rule has to end before it with COUNT or UNTIL.
+const MaxOccurrences = 366
+
+type Frequency string
+
+const (
+	Daily   Frequency = "DAILY"
+	Weekly  Frequency = "WEEKLY"
+	Monthly Frequency = "MONTHLY"
+)
+
+var ErrRuleUnbounded = errors.New("recurrence rule needs COUNT or UNTIL")
+var ErrTooManyOccurrences = fmt.Errorf("recurrence rule has more than %d occurrences", MaxOccurrences)
+
+var weekdays = map[string]time.Weekday{
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+	"SU": time.Sunday,
+}
+
+// Rule is the subset of an RFC 5545 RRULE that programs need: daily, weekly on some days
+// and monthly on some days of the month. The week starts on Monday.
+type Rule struct {
+	Freq       Frequency
+	Interval   int
+	Count      int
+	Until      time.Time // Inclusive, a date only UNTIL is the end of that day
+	UntilIsUTC bool
+	ByDay      []time.Weekday
+	ByMonthDay []int
+}
+
+// ParseRule reads an RRULE value such as "FREQ=WEEKLY;BYDAY=TU,TH;COUNT=8", the "RRULE:" prefix is optional.
+func ParseRule(s string) (Rule, error) {
+	rule := Rule{Interval: 1}
+
+	s = strings.TrimPrefix(strings.TrimSpace(s), "RRULE:")
+	if s == "" {
+		return rule, errors.New("recurrence rule is empty")
+	}
+
+	for _, part := range strings.Split(s, ";") {
+		name, value, ok := strings.Cut(part, "=")
+		if !ok || value == "" {
+			return rule, fmt.Errorf("invalid recurrence rule part: %s", part)
+		}
+
+		var err error
+		switch strings.ToUpper(name) {
+		case "FREQ":
+			rule.Freq = Frequency(strings.ToUpper(value))
+			if rule.Freq != Daily && rule.Freq != Weekly && rule.Freq != Monthly {
+				return rule, fmt.Errorf("unsupported recurrence frequency: %s", value)
+			}
+		case "INTERVAL":
+			rule.Interval, err = strconv.Atoi(value)
+			if err != nil || rule.Interval < 1 {
+				return rule, fmt.Errorf("invalid recurrence interval: %s", value)
+			}
+		case "COUNT":
+			rule.Count, err = strconv.Atoi(value)
+			if err != nil || rule.Count < 1 {
+				return rule, fmt.Errorf("invalid recurrence count: %s", value)
+			}
+		case "UNTIL":
+			rule.Until, rule.UntilIsUTC, err = parseUntil(value)
+			if err != nil {
+				return rule, err
+			}
+		case "BYDAY":
+			for _, day := range strings.Split(strings.ToUpper(value), ",") {
+				weekday, ok := weekdays[day]
+				if !ok {
+					return rule, fmt.Errorf("unsupported recurrence day: %s", day)
+				}
+				rule.ByDay = append(rule.ByDay, weekday)
+			}
+		case "BYMONTHDAY":
+			for _, day := range strings.Split(value, ",") {
+				monthDay, err := strconv.Atoi(day)
+				if err != nil || monthDay < 1 || monthDay > 31 {
+					return rule, fmt.Errorf("unsupported recurrence day of month: %s", day)
+				}
+				rule.ByMonthDay = append(rule.ByMonthDay, monthDay)
+			}
+		case "WKST":
+			if strings.ToUpper(value) != "MO" {
+				return rule, errors.New("recurrence weeks start on Monday")
+			}
+		default:
+			return rule, fmt.Errorf("unsupported recurrence rule part: %s", name)
+		}
+	}
+
+	if rule.Freq == "" {
+		return rule, errors.New("recurrence rule needs FREQ")
+	}
+	if rule.Count > 0 && !rule.Until.IsZero() {
+		return rule, errors.New("recurrence rule cannot have both COUNT and UNTIL")
+	}
+	if rule.Count == 0 && rule.Until.IsZero() {
+		return rule, ErrRuleUnbounded
+	}
+	if rule.Count > MaxOccurrences {
+		return rule, ErrTooManyOccurrences
+	}
+	if len(rule.ByDay) > 0 && rule.Freq != Weekly {
+		return rule, errors.New("BYDAY is only supported with FREQ=WEEKLY")
+	}
+	if len(rule.ByMonthDay) > 0 && rule.Freq != Monthly {
+		return rule, errors.New("BYMONTHDAY is only supported with FREQ=MONTHLY")
+	}
+
+	return rule, nil
+}
+
+func parseUntil(value string) (time.Time, bool, error) {
+	if t, err := time.Parse(utcFormat, value); err == nil {
+		return t, true, nil
+	}
+	if t, err := time.Parse(localFormat, value); err == nil {
+		return t, false, nil
+	}
+	if t, err := time.Parse(dateFormat, value); err == nil {
+		return t.AddDate(0, 0, 1).Add(-time.Second), false, nil
+	}
+
+	return time.Time{}, false, fmt.Errorf("invalid recurrence until: %s", value)
+}
+
+// String writes the rule back as an RRULE value.
+func (r Rule) String() string {
+	parts := []string{"FREQ=" + string(r.Freq)}
+	if r.Interval > 1 {
+		parts = append(parts, "INTERVAL="+strconv.Itoa(r.Interval))
+	}
+	if len(r.ByDay) > 0 {
+		days := make([]string, 0, len(r.ByDay))
+		for _, weekday := range r.ByDay {
+			for name, day := range weekdays {
+				if day == weekday {
+					days = append(days, name)
+				}
+			}
+		}
+		parts = append(parts, "BYDAY="+strings.Join(days, ","))
+	}
+	if len(r.ByMonthDay) > 0 {
+		days := make([]string, 0, len(r.ByMonthDay))
+		for _, day := range r.ByMonthDay {
+			days = append(days, strconv.Itoa(day))
+		}
+		parts = append(parts, "BYMONTHDAY="+strings.Join(days, ","))
+	}
+	if r.Count > 0 {
+		parts = append(parts, "COUNT="+strconv.Itoa(r.Count))
+	}
+	if !r.Until.IsZero() {
+		if r.UntilIsUTC {
+			parts = append(parts, "UNTIL="+r.Until.UTC().Format(utcFormat))
+		} else {
+			parts = append(parts, "UNTIL="+r.Until.Format(localFormat))
+		}
+	}
+
+	return strings.Join(parts, ";")
+}
+
+// Occurrences lists the starts of the rule from start on. Occurrences keep the wall clock time of start
+// in its zone, so that a weekly 09:00 session stays at 09:00 across daylight saving changes.
+// Days that a month does not have are skipped, as RFC 5545 requires.
+func (r Rule) Occurrences(start time.Time) ([]time.Time, error) {
+	loc := start.Location()
+	until := r.Until
+	if !until.IsZero() && !r.UntilIsUTC {
+		// A floating UNTIL is read in the zone of the event
+		until = time.Date(until.Year(), until.Month(), until.Day(), until.Hour(), until.Minute(), until.Second(), 0, loc)
+	}
+
+	occurrences := make([]time.Time, 0)
+	// add reports whether the rule goes on after t
+	add := func(t time.Time) (bool, error) {
+		if t.Before(start) {
+			return true, nil
+		}
+		if !until.IsZero() && t.After(until) {
+			return false, nil
+		}
+		if len(occurrences) == MaxOccurrences {
+			return false, ErrTooManyOccurrences
+		}
+
+		occurrences = append(occurrences, t)
+		return r.Count == 0 || len(occurrences) < r.Count, nil
+	}
+	at := func(year int, month time.Month, day int) time.Time {
+		return time.Date(year, month, day, start.Hour(), start.Minute(), start.Second(), 0, loc)
+	}
+
+	switch r.Freq {
+	case Daily:
+		for i := 0; ; i++ {
+			more, err := add(at(start.Year(), start.Month(), start.Day()+i*r.Interval))
+			if err != nil || !more {
+				return occurrences, err
+			}
+		}
+	case Weekly:
+		days := r.ByDay
+		if len(days) == 0 {
+			days = []time.Weekday{start.Weekday()}
+		}
+		offsets := make([]int, 0, len(days))
+		for _, day := range days {
+			// Days from Monday
+			offsets = append(offsets, (int(day)+6)%7)
+		}
+		sort.Ints(offsets)
+
+		monday := start.Day() - (int(start.Weekday())+6)%7
+		for week := 0; ; week += r.Interval {
+			for _, offset := range offsets {
+				more, err := add(at(start.Year(), start.Month(), monday+week*7+offset))
+				if err != nil || !more {
+					return occurrences, err
+				}
+			}
+		}
+	case Monthly:
+		days := r.ByMonthDay
+		if len(days) == 0 {
+			days = []int{start.Day()}
+		}
+		days = append([]int(nil), days...)
+		sort.Ints(days)
+
+		for month := 0; ; month += r.Interval {
+			first := time.Date(start.Year(), start.Month()+time.Month(month), 1, 0, 0, 0, 0, loc)
+			if !until.IsZero() && first.After(until) {
+				return occurrences, nil
+			}
+			for _, day := range days {
+				t := at(first.Year(), first.Month(), day)
+				if t.Month() != first.Month() {
+					continue
+				}
+				more, err := add(t)
+				if err != nil || !more {
+					return occurrences, err
+				}
+			}
+			// A rule that only matches missing days, such as the 31st every other February, cannot run forever
+			if month > MaxOccurrences*r.Interval {
+				return occurrences, nil
+			}
+		}
+	}
+
+	return occurrences, fmt.Errorf("unsupported recurrence frequency: %s", r.Freq)
+}
@@ -0,0 +1,244 @@
+package certificate
+
+import (
+	"encoding/binary"
+	"errors"
+	"os"
+)
+
+var ErrInvalidFont = errors.New("font must be a TrueType font")
+
+// Font is a TrueType font embedded in the certificates, it draws scripts the built-in Helvetica cannot,
+// such as Thai names.
+type Font struct {
+	data       []byte
+	unitsPerEm float64
+	advances   []uint16
+	glyphs     map[rune]uint16
+	bbox       [4]int16
+	ascent     int16
+	descent    int16
+}
+
+// LoadFontFile reads a TrueType (.ttf) font, font collections are not supported.
+func LoadFontFile(path string) (*Font, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return ParseFont(data)
+}
+
+func ParseFont(data []byte) (*Font, error) {
+	if len(data) < 12 {
+		return nil, ErrInvalidFont
+	}
+	if version := binary.BigEndian.Uint32(data); version != 0x00010000 && version != 0x74727565 {
+		return nil, ErrInvalidFont
+	}
+
+	tables := map[string][]byte{}
+	numTables := int(binary.BigEndian.Uint16(data[4:]))
+	for i := 0; i < numTables; i++ {
+		record := 12 + i*16
+		if record+16 > len(data) {
+			return nil, ErrInvalidFont
+		}
+		offset := int(binary.BigEndian.Uint32(data[record+8:]))
+		length := int(binary.BigEndian.Uint32(data[record+12:]))
+		if offset < 0 || length < 0 || offset+length > len(data) {
+			return nil, ErrInvalidFont
+		}
+		tables[string(data[record:record+4])] = data[offset : offset+length]
+	}
+
+	head, hhea, hmtx, cmap := tables["head"], tables["hhea"], tables["hmtx"], tables["cmap"]
+	if len(head) < 54 || len(hhea) < 36 || cmap == nil || tables["glyf"] == nil {
+		return nil, ErrInvalidFont
+	}
+
+	font := &Font{
+		data:       data,
+		unitsPerEm: float64(binary.BigEndian.Uint16(head[18:])),
+		ascent:     int16(binary.BigEndian.Uint16(hhea[4:])),
+		descent:    int16(binary.BigEndian.Uint16(hhea[6:])),
+	}
+	if font.unitsPerEm == 0 {
+		return nil, ErrInvalidFont
+	}
+	for i := range font.bbox {
+		font.bbox[i] = int16(binary.BigEndian.Uint16(head[36+i*2:]))
+	}
+
+	numberOfHMetrics := int(binary.BigEndian.Uint16(hhea[34:]))
+	if numberOfHMetrics == 0 || len(hmtx) < numberOfHMetrics*4 {
+		return nil, ErrInvalidFont
+	}
+	font.advances = make([]uint16, numberOfHMetrics)
+	for i := range font.advances {
+		font.advances[i] = binary.BigEndian.Uint16(hmtx[i*4:])
+	}
+
+	glyphs, err := parseCmap(cmap)
+	if err != nil {
+		return nil, err
+	}
+	font.glyphs = glyphs
+
+	return font, nil
+}
+
+// parseCmap reads the Unicode character map of the font, the full repertoire (format 12) when it has one.
+func parseCmap(cmap []byte) (map[rune]uint16, error) {
+	if len(cmap) < 4 {
+		return nil, ErrInvalidFont
+	}
+
+	var format4, format12 []byte
+	numTables := int(binary.BigEndian.Uint16(cmap[2:]))
+	for i := 0; i < numTables; i++ {
+		record := 4 + i*8
+		if record+8 > len(cmap) {
+			return nil, ErrInvalidFont
+		}
+		platformID := binary.BigEndian.Uint16(cmap[record:])
+		encodingID := binary.BigEndian.Uint16(cmap[record+2:])
+		offset := int(binary.BigEndian.Uint32(cmap[record+4:]))
+		if offset+4 > len(cmap) || (platformID != 0 && !(platformID == 3 && (encodingID == 1 || encodingID == 10))) {
+			continue
+		}
+
+		switch binary.BigEndian.Uint16(cmap[offset:]) {
+		case 4:
+			format4 = cmap[offset:]
+		case 12:
+			format12 = cmap[offset:]
+		}
+	}
+
+	glyphs := map[rune]uint16{}
+	switch {
+	case format12 != nil && len(format12) >= 16:
+		groups := int(binary.BigEndian.Uint32(format12[12:]))
+		for i := 0; i < groups && 16+i*12+12 <= len(format12); i++ {
+			group := format12[16+i*12:]
+			start, end, glyph := binary.BigEndian.Uint32(group), binary.BigEndian.Uint32(group[4:]), binary.BigEndian.Uint32(group[8:])
+			for c := start; c <= end && c <= 0x10ffff; c++ {
+				glyphs[rune(c)] = uint16(glyph + c - start)
+			}
+		}
+	case format4 != nil && len(format4) >= 14:
+		segCount := int(binary.BigEndian.Uint16(format4[6:])) / 2
+		if len(format4) < 16+segCount*8 {
+			return nil, ErrInvalidFont
+		}
+		ends, starts := format4[14:], format4[16+segCount*2:]
+		deltas, rangeOffsets := format4[16+segCount*4:], format4[16+segCount*6:]
+		for i := 0; i < segCount; i++ {
+			start, end := binary.BigEndian.Uint16(starts[i*2:]), binary.BigEndian.Uint16(ends[i*2:])
+			delta, rangeOffset := binary.BigEndian.Uint16(deltas[i*2:]), int(binary.BigEndian.Uint16(rangeOffsets[i*2:]))
+			for c := int(start); c <= int(end) && c != 0xffff; c++ {
+				glyph := uint16(c) + delta
+				if rangeOffset != 0 {
+					at := 16 + segCount*6 + i*2 + rangeOffset + (c-int(start))*2
+					if at+2 > len(format4) {
+						continue
+					}
+					if glyph = binary.BigEndian.Uint16(format4[at:]); glyph != 0 {
+						glyph += delta
+					}
+				}
+				if glyph != 0 {
+					glyphs[rune(c)] = glyph
+				}
+			}
+		}
+	default:
+		return nil, ErrInvalidFont
+	}
+
+	return glyphs, nil
+}
+
+// glyph is the glyph drawing the character, the missing glyph 0 when the font has none.
+func (f *Font) glyph(r rune) uint16 {
+	return f.glyphs[r]
+}
+
+// width is the advance of the glyph in thousandths of the font size.
+func (f *Font) width(glyph uint16) float64 {
+	advance := f.advances[len(f.advances)-1]
+	if int(glyph) < len(f.advances) {
+		advance = f.advances[glyph]
+	}
+
+	return float64(advance) * 1000 / f.unitsPerEm
+}
+
+func (f *Font) scale(v int16) int {
+	return int(float64(v) * 1000 / f.unitsPerEm)
+}
+
+// winAnsi maps the characters outside Latin-1 that the WinAnsi encoding of the built-in fonts can draw.
+var winAnsi = map[rune]byte{
+	'€': 0x80, '‚': 0x82, 'ƒ': 0x83, '„': 0x84, '…': 0x85, '†': 0x86, '‡': 0x87, 'ˆ': 0x88, '‰': 0x89,
+	'Š': 0x8a, '‹': 0x8b, 'Œ': 0x8c, 'Ž': 0x8e, '‘': 0x91, '’': 0x92, '“': 0x93, '”': 0x94, '•': 0x95,
+	'–': 0x96, '—': 0x97, '˜': 0x98, '™': 0x99, 'š': 0x9a, '›': 0x9b, 'œ': 0x9c, 'ž': 0x9e, 'Ÿ': 0x9f,
+}
+
+// encodeWinAnsi encodes text for the built-in fonts, characters they cannot draw become "?".
+func encodeWinAnsi(text string) []byte {
+	encoded := make([]byte, 0, len(text))
+	for _, r := range text {
+		switch {
+		case r >= 0x20 && r <= 0x7e, r >= 0xa0 && r <= 0xff:
+			encoded = append(encoded, byte(r))
+		case winAnsi[r] != 0:
+			encoded = append(encoded, winAnsi[r])
+		default:
+			encoded = append(encoded, '?')
+		}
+	}
+
+	return encoded
+}
+
+// Advance widths of the printable ASCII characters (32 to 126) in Helvetica and Helvetica-Bold,
+// other characters are measured as a digit.
+var (
+	helveticaWidths = [95]uint16{
+		278, 278, 355, 556, 556, 889, 667, 191, 333, 333, 389, 584, 278, 333, 278, 278,
+		556, 556, 556, 556, 556, 556, 556, 556, 556, 556, 278, 278, 584, 584, 584, 556,
+		1015, 667, 667, 722, 722, 667, 611, 778, 722, 278, 500, 667, 556, 833, 722, 778,
+		667, 778, 722, 667, 611, 722, 667, 944, 667, 667, 611, 278, 278, 278, 469, 556,
+		333, 556, 556, 500, 556, 556, 278, 556, 556, 222, 222, 500, 222, 833, 556, 556,
+		556, 556, 333, 500, 278, 556, 500, 722, 500, 500, 500, 334, 260, 334, 584,
+	}
+	helveticaBoldWidths = [95]uint16{
+		278, 333, 474, 556, 556, 889, 722, 238, 333, 333, 389, 584, 278, 333, 278, 278,
+		556, 556, 556, 556, 556, 556, 556, 556, 556, 556, 333, 333, 584, 584, 584, 611,
+		975, 722, 722, 722, 722, 667, 611, 778, 722, 278, 556, 722, 611, 833, 722, 778,
+		667, 778, 722, 667, 611, 722, 667, 944, 667, 667, 611, 333, 278, 333, 584, 556,
+		333, 556, 611, 556, 611, 556, 333, 611, 611, 278, 278, 556, 278, 889, 611, 611,
+		611, 611, 389, 556, 333, 611, 556, 778, 556, 556, 500, 389, 280, 389, 584,
+	}
+)
+
+func helveticaWidth(encoded []byte, bold bool) float64 {
+	widths := &helveticaWidths
+	if bold {
+		widths = &helveticaBoldWidths
+	}
+
+	total := 0.0
+	for _, b := range encoded {
+		if b >= 32 && b <= 126 {
+			total += float64(widths[b-32])
+		} else {
+			total += 556
+		}
+	}
+
+	return total
+}
@@ -0,0 +1,93 @@
+package certificate
+
+import (
+	"bytes"
+	"compress/zlib"
+	"errors"
+	"image"
+	"image/color"
+	_ "image/jpeg"
+	_ "image/png"
+)
+
+// maxImagePixels keeps a decoded image within a few hundred MB, enough for a 300 DPI A3 page.
+const maxImagePixels = 40_000_000
+
+var ErrInvalidImage = errors.New("image must be a JPEG or PNG")
+
+// Image is a picture ready to be placed on a page. JPEG photos are embedded as they are, other images
+// are stored as compressed RGB with their transparency as a soft mask.
+type Image struct {
+	Width      int
+	Height     int
+	colorSpace string
+	filter     string
+	data       []byte
+	mask       []byte
+}
+
+// LoadImage reads a JPEG or PNG file.
+func LoadImage(data []byte) (*Image, error) {
+	config, format, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil || (format != "jpeg" && format != "png") {
+		return nil, ErrInvalidImage
+	}
+	if config.Width <= 0 || config.Height <= 0 || config.Width*config.Height > maxImagePixels {
+		return nil, errors.New("image is too large")
+	}
+
+	if format == "jpeg" {
+		switch config.ColorModel {
+		case color.YCbCrModel:
+			return &Image{Width: config.Width, Height: config.Height, colorSpace: "/DeviceRGB", filter: "/DCTDecode", data: data}, nil
+		case color.GrayModel:
+			return &Image{Width: config.Width, Height: config.Height, colorSpace: "/DeviceGray", filter: "/DCTDecode", data: data}, nil
+		}
+	}
+
+	// CMYK JPEGs and PNGs are converted to RGB
+	decoded, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, ErrInvalidImage
+	}
+
+	bounds := decoded.Bounds()
+	rgb := make([]byte, 0, bounds.Dx()*bounds.Dy()*3)
+	alpha := make([]byte, 0, bounds.Dx()*bounds.Dy())
+	opaque := true
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			c := color.NRGBAModel.Convert(decoded.At(x, y)).(color.NRGBA)
+			rgb = append(rgb, c.R, c.G, c.B)
+			alpha = append(alpha, c.A)
+			if c.A != 0xff {
+				opaque = false
+			}
+		}
+	}
+
+	img := &Image{Width: bounds.Dx(), Height: bounds.Dy(), colorSpace: "/DeviceRGB", filter: "/FlateDecode"}
+	if img.data, err = deflate(rgb); err != nil {
+		return nil, err
+	}
+	if !opaque {
+		if img.mask, err = deflate(alpha); err != nil {
+			return nil, err
+		}
+	}
+
+	return img, nil
+}
+
+func deflate(data []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	w := zlib.NewWriter(&buf)
+	if _, err := w.Write(data); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,333 @@
+package certificate
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// A4 landscape, in points
+const (
+	PageWidth  = 841.89
+	PageHeight = 595.28
+)
+
+const ContentType = "application/pdf"
+
+type Align string
+
+const (
+	AlignLeft   Align = "left"
+	AlignCenter Align = "center"
+	AlignRight  Align = "right"
+)
+
+// Text is a line of the page, anchored at X and Y in points from the bottom left of the page.
+type Text struct {
+	Value string
+	X     float64
+	Y     float64
+	Size  float64
+	Bold  bool
+	Color [3]float64 // RGB from 0 to 1
+	Align Align
+}
+
+// Placement puts an image on the page, its bottom left corner at X and Y and scaled to Width.
+type Placement struct {
+	Image *Image
+	X     float64
+	Y     float64
+	Width float64
+}
+
+// Page is a certificate: a background stretched over the whole page, then images, then text.
+type Page struct {
+	Title      string
+	Background *Image
+	Images     []Placement
+	Texts      []Text
+}
+
+// RenderPDF writes the page as a one page PDF. Text is drawn with the font when one is given,
+// otherwise with Helvetica, which only covers Western European characters.
+func RenderPDF(page Page, font *Font) ([]byte, error) {
+	w := &pdfWriter{}
+	catalog, pages, pageID := w.reserve(), w.reserve(), w.reserve()
+
+	var resources strings.Builder
+	var content bytes.Buffer
+
+	images := map[string]int{}
+	addImage := func(img *Image) (string, error) {
+		name := fmt.Sprintf("Im%d", len(images)+1)
+		id, err := w.image(img)
+		if err != nil {
+			return "", err
+		}
+		images[name] = id
+		return name, nil
+	}
+
+	if page.Background != nil {
+		name, err := addImage(page.Background)
+		if err != nil {
+			return nil, err
+		}
+		fmt.Fprintf(&content, "q %s 0 0 %s 0 0 cm /%s Do Q\n", num(PageWidth), num(PageHeight), name)
+	}
+
+	for _, placement := range page.Images {
+		if placement.Image == nil || placement.Width <= 0 {
+			continue
+		}
+		name, err := addImage(placement.Image)
+		if err != nil {
+			return nil, err
+		}
+		height := placement.Width * float64(placement.Image.Height) / float64(placement.Image.Width)
+		fmt.Fprintf(&content, "q %s 0 0 %s %s %s cm /%s Do Q\n", num(placement.Width), num(height), num(placement.X), num(placement.Y), name)
+	}
+
+	fonts, err := w.fonts(font, page.Texts)
+	if err != nil {
+		return nil, err
+	}
+	for _, text := range page.Texts {
+		writeText(&content, text, font)
+	}
+
+	resources.WriteString("<< /Font <<")
+	for _, name := range sortedKeys(fonts) {
+		fmt.Fprintf(&resources, " /%s %d 0 R", name, fonts[name])
+	}
+	resources.WriteString(" >> /XObject <<")
+	for _, name := range sortedKeys(images) {
+		fmt.Fprintf(&resources, " /%s %d 0 R", name, images[name])
+	}
+	resources.WriteString(" >> >>")
+
+	contentID, err := w.stream("", content.Bytes(), true)
+	if err != nil {
+		return nil, err
+	}
+
+	w.set(pageID, fmt.Sprintf("<< /Type /Page /Parent %d 0 R /MediaBox [0 0 %s %s] /Resources %s /Contents %d 0 R >>",
+		pages, num(PageWidth), num(PageHeight), resources.String(), contentID))
+	w.set(pages, fmt.Sprintf("<< /Type /Pages /Kids [%d 0 R] /Count 1 >>", pageID))
+	w.set(catalog, fmt.Sprintf("<< /Type /Catalog /Pages %d 0 R >>", pages))
+	info := w.add(fmt.Sprintf("<< /Title %s /Producer (Talent Atmos) >>", textString(page.Title)))
+
+	return w.bytes(catalog, info), nil
+}
+
+func writeText(content *bytes.Buffer, text Text, font *Font) {
+	if text.Value == "" || text.Size <= 0 {
+		return
+	}
+
+	var encoded string
+	var width float64
+	fontName := "F1"
+	if font != nil {
+		var hex strings.Builder
+		for _, r := range text.Value {
+			glyph := font.glyph(r)
+			fmt.Fprintf(&hex, "%04X", glyph)
+			width += font.width(glyph)
+		}
+		encoded = "<" + hex.String() + ">"
+	} else {
+		raw := encodeWinAnsi(text.Value)
+		width = helveticaWidth(raw, text.Bold)
+		encoded = fmt.Sprintf("<%X>", raw)
+		if text.Bold {
+			fontName = "F2"
+		}
+	}
+
+	x := text.X
+	switch text.Align {
+	case AlignCenter:
+		x -= width * text.Size / 2000
+	case AlignRight:
+		x -= width * text.Size / 1000
+	}
+
+	color := fmt.Sprintf("%s %s %s", num(text.Color[0]), num(text.Color[1]), num(text.Color[2]))
+	// The graphics state is saved around each line, the outline of bold text would otherwise carry on
+	content.WriteString("q BT ")
+	if font != nil && text.Bold {
+		// An embedded font has a single weight, bold text is outlined to thicken it
+		fmt.Fprintf(content, "2 Tr %s w %s RG ", num(text.Size*0.03), color)
+	}
+	fmt.Fprintf(content, "/%s %s Tf %s rg %s %s Td %s Tj ET Q\n", fontName, num(text.Size), color, num(x), num(text.Y), encoded)
+}
+
+type pdfWriter struct {
+	objects [][]byte
+}
+
+func (w *pdfWriter) reserve() int {
+	w.objects = append(w.objects, nil)
+	return len(w.objects)
+}
+
+func (w *pdfWriter) set(id int, body string) {
+	w.objects[id-1] = []byte(body)
+}
+
+func (w *pdfWriter) add(body string) int {
+	id := w.reserve()
+	w.set(id, body)
+	return id
+}
+
+// stream adds a stream object, dict holds the entries of its dictionary besides its length.
+func (w *pdfWriter) stream(dict string, data []byte, compress bool) (int, error) {
+	if compress {
+		compressed, err := deflate(data)
+		if err != nil {
+			return 0, err
+		}
+		data = compressed
+		dict += " /Filter /FlateDecode"
+	}
+
+	var body bytes.Buffer
+	fmt.Fprintf(&body, "<<%s /Length %d >>\nstream\n", dict, len(data))
+	body.Write(data)
+	body.WriteString("\nendstream")
+
+	id := w.reserve()
+	w.objects[id-1] = body.Bytes()
+	return id, nil
+}
+
+func (w *pdfWriter) image(img *Image) (int, error) {
+	dict := fmt.Sprintf(" /Type /XObject /Subtype /Image /Width %d /Height %d /ColorSpace %s /BitsPerComponent 8 /Filter %s",
+		img.Width, img.Height, img.colorSpace, img.filter)
+	if img.mask != nil {
+		maskID, err := w.stream(fmt.Sprintf(" /Type /XObject /Subtype /Image /Width %d /Height %d /ColorSpace /DeviceGray /BitsPerComponent 8 /Filter /FlateDecode",
+			img.Width, img.Height), img.mask, false)
+		if err != nil {
+			return 0, err
+		}
+		dict += fmt.Sprintf(" /SMask %d 0 R", maskID)
+	}
+
+	return w.stream(dict, img.data, false)
+}
+
+// fonts adds the fonts the texts are drawn with and returns them by resource name.
+func (w *pdfWriter) fonts(font *Font, texts []Text) (map[string]int, error) {
+	if font == nil {
+		return map[string]int{
+			"F1": w.add("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica /Encoding /WinAnsiEncoding >>"),
+			"F2": w.add("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica-Bold /Encoding /WinAnsiEncoding >>"),
+		}, nil
+	}
+
+	// The widths and text of the glyphs in use, so viewers space them right and can copy the text
+	used := map[uint16]rune{}
+	for _, text := range texts {
+		for _, r := range text.Value {
+			if glyph := font.glyph(r); glyph != 0 {
+				used[glyph] = r
+			}
+		}
+	}
+	glyphs := make([]int, 0, len(used))
+	for glyph := range used {
+		glyphs = append(glyphs, int(glyph))
+	}
+	sort.Ints(glyphs)
+
+	var widths, unicode strings.Builder
+	for _, glyph := range glyphs {
+		fmt.Fprintf(&widths, "%d [%d] ", glyph, int(font.width(uint16(glyph))))
+		fmt.Fprintf(&unicode, "<%04X> <%s>\n", glyph, utf16Hex(used[uint16(glyph)]))
+	}
+
+	fileID, err := w.stream(fmt.Sprintf(" /Length1 %d", len(font.data)), font.data, true)
+	if err != nil {
+		return nil, err
+	}
+	descriptorID := w.add(fmt.Sprintf("<< /Type /FontDescriptor /FontName /CertificateFont /Flags 32 /FontBBox [%d %d %d %d] /ItalicAngle 0 /Ascent %d /Descent %d /CapHeight %d /StemV 80 /FontFile2 %d 0 R >>",
+		font.scale(font.bbox[0]), font.scale(font.bbox[1]), font.scale(font.bbox[2]), font.scale(font.bbox[3]),
+		font.scale(font.ascent), font.scale(font.descent), font.scale(font.ascent), fileID))
+	cidFontID := w.add(fmt.Sprintf("<< /Type /Font /Subtype /CIDFontType2 /BaseFont /CertificateFont /CIDSystemInfo << /Registry (Adobe) /Ordering (Identity) /Supplement 0 >> /FontDescriptor %d 0 R /CIDToGIDMap /Identity /W [%s] >>",
+		descriptorID, widths.String()))
+
+	cmap := "/CIDInit /ProcSet findresource begin\n12 dict begin\nbegincmap\n" +
+		"/CIDSystemInfo << /Registry (Adobe) /Ordering (UCS) /Supplement 0 >> def\n" +
+		"/CMapName /Adobe-Identity-UCS def\n/CMapType 2 def\n" +
+		"1 begincodespacerange\n<0000> <FFFF>\nendcodespacerange\n" +
+		fmt.Sprintf("%d beginbfchar\n%sendbfchar\n", len(glyphs), unicode.String()) +
+		"endcmap\nCMapName currentdict /CMap defineresource pop\nend\nend"
+	toUnicodeID, err := w.stream("", []byte(cmap), true)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]int{
+		"F1": w.add(fmt.Sprintf("<< /Type /Font /Subtype /Type0 /BaseFont /CertificateFont /Encoding /Identity-H /DescendantFonts [%d 0 R] /ToUnicode %d 0 R >>",
+			cidFontID, toUnicodeID)),
+	}, nil
+}
+
+func (w *pdfWriter) bytes(root int, info int) []byte {
+	var buf bytes.Buffer
+	buf.WriteString("%PDF-1.4\n%\xe2\xe3\xcf\xd3\n")
+
+	offsets := make([]int, len(w.objects))
+	for i, object := range w.objects {
+		offsets[i] = buf.Len()
+		fmt.Fprintf(&buf, "%d 0 obj\n", i+1)
+		buf.Write(object)
+		buf.WriteString("\nendobj\n")
+	}
+
+	xref := buf.Len()
+	fmt.Fprintf(&buf, "xref\n0 %d\n0000000000 65535 f \n", len(w.objects)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&buf, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&buf, "trailer\n<< /Size %d /Root %d 0 R /Info %d 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(w.objects)+1, root, info, xref)
+
+	return buf.Bytes()
+}
+
+// textString encodes a document string as UTF-16, which every viewer reads whatever the script.
+func textString(s string) string {
+	var hex strings.Builder
+	hex.WriteString("<FEFF")
+	for _, r := range s {
+		hex.WriteString(utf16Hex(r))
+	}
+	hex.WriteString(">")
+	return hex.String()
+}
+
+func utf16Hex(r rune) string {
+	if r > 0xffff {
+		r -= 0x10000
+		return fmt.Sprintf("%04X%04X", 0xd800+(r>>10), 0xdc00+(r&0x3ff))
+	}
+	return fmt.Sprintf("%04X", r)
+}
+
+func num(v float64) string {
+	s := strings.TrimRight(fmt.Sprintf("%.3f", v), "0")
+	return strings.TrimSuffix(s, ".")
+}
+
+func sortedKeys(m map[string]int) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,227 @@
+package export
+
+import (
+	"archive/zip"
+	"encoding/csv"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+const (
+	CSVContentType  = "text/csv; charset=utf-8"
+	XLSXContentType = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+)
+
+var ErrUnknownFormat = errors.New("unknown export format")
+
+// Table is a sheet of text cells, the header is written as its first row.
+type Table struct {
+	Header []string
+	Rows   [][]string
+}
+
+// Format is the file an export is written to.
+type Format string
+
+const (
+	CSV  Format = "csv"
+	XLSX Format = "xlsx"
+)
+
+func ParseFormat(s string) (Format, error) {
+	switch Format(strings.ToLower(s)) {
+	case "", CSV:
+		return CSV, nil
+	case XLSX:
+		return XLSX, nil
+	}
+
+	return "", ErrUnknownFormat
+}
+
+func (f Format) ContentType() string {
+	if f == XLSX {
+		return XLSXContentType
+	}
+
+	return CSVContentType
+}
+
+func (f Format) Write(w io.Writer, sheetName string, table Table) error {
+	if f == XLSX {
+		return WriteXLSX(w, sheetName, table)
+	}
+
+	return WriteCSV(w, table)
+}
+
+// WriteCSV writes the table with a byte order mark, so spreadsheet programs read Thai text as UTF-8.
+func WriteCSV(w io.Writer, table Table) error {
+	if _, err := io.WriteString(w, "\ufeff"); err != nil {
+		return err
+	}
+
+	writer := csv.NewWriter(w)
+	if err := writer.Write(escapeFormulas(table.Header)); err != nil {
+		return err
+	}
+	for _, row := range table.Rows {
+		if err := writer.Write(escapeFormulas(row)); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+
+	return writer.Error()
+}
+
+// escapeFormulas keeps cells starting like a formula from being run when the file is opened in a spreadsheet.
+func escapeFormulas(row []string) []string {
+	escaped := make([]string, len(row))
+	for i, cell := range row {
+		if cell != "" && strings.ContainsRune("=+-@\t\r", rune(cell[0])) {
+			cell = "'" + cell
+		}
+		escaped[i] = cell
+	}
+
+	return escaped
+}
+
+// WriteXLSX writes the table as a workbook of one sheet. Cells are inline strings, so the workbook needs
+// no shared string table or styles.
+func WriteXLSX(w io.Writer, sheetName string, table Table) error {
+	archive := zip.NewWriter(w)
+
+	files := []struct {
+		name    string
+		content string
+	}{
+		{"[Content_Types].xml", xlsxContentTypes},
+		{"_rels/.rels", xlsxRootRels},
+		{"xl/workbook.xml", fmt.Sprintf(xlsxWorkbook, xmlEscape(sheetTitle(sheetName)))},
+		{"xl/_rels/workbook.xml.rels", xlsxWorkbookRels},
+	}
+	for _, file := range files {
+		if err := writeZipFile(archive, file.name, file.content); err != nil {
+			return err
+		}
+	}
+
+	sheet, err := archive.Create("xl/worksheets/sheet1.xml")
+	if err != nil {
+		return err
+	}
+	if _, err := io.WriteString(sheet, xlsxSheetStart); err != nil {
+		return err
+	}
+	if err := writeXLSXRow(sheet, 1, table.Header); err != nil {
+		return err
+	}
+	for i, row := range table.Rows {
+		if err := writeXLSXRow(sheet, i+2, row); err != nil {
+			return err
+		}
+	}
+	if _, err := io.WriteString(sheet, xlsxSheetEnd); err != nil {
+		return err
+	}
+
+	return archive.Close()
+}
+
+func writeZipFile(archive *zip.Writer, name string, content string) error {
+	file, err := archive.Create(name)
+	if err != nil {
+		return err
+	}
+
+	_, err = io.WriteString(file, content)
+	return err
+}
+
+func writeXLSXRow(w io.Writer, number int, cells []string) error {
+	var row strings.Builder
+	fmt.Fprintf(&row, `<row r="%d">`, number)
+	for i, cell := range cells {
+		fmt.Fprintf(&row, `<c r="%s%d" t="inlineStr"><is><t xml:space="preserve">%s</t></is></c>`, columnName(i), number, xmlEscape(cell))
+	}
+	row.WriteString("</row>")
+
+	_, err := io.WriteString(w, row.String())
+	return err
+}
+
+// columnName is the letter of the zero based column: A, B, ..., Z, AA, AB, ...
+func columnName(index int) string {
+	name := ""
+	for index >= 0 {
+		name = string(rune('A'+index%26)) + name
+		index = index/26 - 1
+	}
+
+	return name
+}
+
+// sheetTitle keeps the name within what spreadsheet programs accept: 31 characters without []:*?/\
+func sheetTitle(name string) string {
+	name = strings.Map(func(r rune) rune {
+		if strings.ContainsRune(`[]:*?/\`, r) {
+			return ' '
+		}
+		return r
+	}, name)
+
+	if runes := []rune(strings.TrimSpace(name)); len(runes) > 31 {
+		name = string(runes[:31])
+	}
+	if name = strings.TrimSpace(name); name == "" {
+		return "Sheet1"
+	}
+
+	return name
+}
+
+func xmlEscape(s string) string {
+	var b strings.Builder
+	// Only fails on invalid characters, which are dropped rather than breaking the workbook
+	_ = xml.EscapeText(&b, []byte(strings.Map(func(r rune) rune {
+		if r == '\t' || r == '\n' || r == '\r' || r >= 0x20 {
+			return r
+		}
+		return -1
+	}, s)))
+
+	return b.String()
+}
+
+const xlsxContentTypes = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">` +
+	`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>` +
+	`<Default Extension="xml" ContentType="application/xml"/>` +
+	`<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>` +
+	`<Override PartName="/xl/worksheets/sheet1.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>` +
+	`</Types>`
+
+const xlsxRootRels = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+	`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/>` +
+	`</Relationships>`
+
+const xlsxWorkbook = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">` +
+	`<sheets><sheet name="%s" sheetId="1" r:id="rId1"/></sheets>` +
+	`</workbook>`
+
+const xlsxWorkbookRels = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+	`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/>` +
+	`</Relationships>`
+
+const xlsxSheetStart = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>`
+
+const xlsxSheetEnd = `</sheetData></worksheet>`
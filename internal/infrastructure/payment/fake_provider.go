@@ -0,0 +1,54 @@
+package payment
+
+import (
+	"context"
+	"encoding/json"
+)
+
+// fakePromptPayID is a dummy phone number so that fake charges still show a scannable PromptPay QR.
+const fakePromptPayID = "0000000000"
+
+// FakeProvider accepts every charge without any network call, payments are completed with Simulate.
+// It lets the purchase flow run locally and in tests.
+type FakeProvider struct {
+	webhookSecret string
+}
+
+func NewFakeProvider(webhookSecret string) *FakeProvider {
+	return &FakeProvider{webhookSecret: webhookSecret}
+}
+
+func (p *FakeProvider) Name() string {
+	return "fake"
+}
+
+func (p *FakeProvider) CreateCharge(_ context.Context, req ChargeRequest) (*Charge, error) {
+	payload, err := PromptPayPayload(fakePromptPayID, req.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Charge{
+		ProviderRef: "fake_" + req.Reference,
+		QRPayload:   payload,
+		ExpiresAt:   req.ExpiresAt,
+	}, nil
+}
+
+func (p *FakeProvider) Refund(_ context.Context, providerRef string, amount float64) (*Refund, error) {
+	return &Refund{ProviderRef: providerRef, Amount: amount}, nil
+}
+
+func (p *FakeProvider) ParseWebhook(body []byte, signature string) (*WebhookEvent, error) {
+	return parseSignedWebhook(p.webhookSecret, body, signature)
+}
+
+// Simulate returns the signed webhook the provider would send for the event.
+func (p *FakeProvider) Simulate(event WebhookEvent) ([]byte, string, error) {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return body, SignWebhook(p.webhookSecret, body), nil
+}
@@ -0,0 +1,100 @@
+package payment
+
+import (
+	"fmt"
+	"strings"
+)
+
+// EMVCo merchant presented QR fields used by Thai PromptPay.
+const (
+	emvPayloadFormat      = "00"
+	emvPointOfInitiation  = "01"
+	emvMerchantPromptPay  = "29"
+	emvCountryCode        = "58"
+	emvCurrency           = "53"
+	emvAmount             = "54"
+	emvCRC                = "63"
+	promptPayAID          = "A000000677010111"
+	promptPayPhone        = "01"
+	promptPayNationalID   = "02"
+	promptPayEWallet      = "03"
+	pointOfInitiationOnce = "12" // Dynamic QR, carries an amount
+	pointOfInitiationMany = "11" // Static QR, the payer types the amount
+	currencyCodeTHB       = "764"
+)
+
+// PromptPayPayload builds the EMVCo payload of a PromptPay QR for a phone number (10 digits),
+// a national or tax ID (13 digits) or an e-wallet ID (15 digits). Without an amount the QR can be reused.
+func PromptPayPayload(target string, amount float64) (string, error) {
+	target = digitsOnly(target)
+
+	var account string
+	switch len(target) {
+	case 10:
+		// 0812345678 is sent as 0066812345678
+		account = emvField(promptPayPhone, "0066"+target[1:])
+	case 13:
+		account = emvField(promptPayNationalID, target)
+	case 15:
+		account = emvField(promptPayEWallet, target)
+	default:
+		return "", fmt.Errorf("invalid promptpay id: %q", target)
+	}
+
+	if amount < 0 {
+		return "", fmt.Errorf("invalid amount: %.2f", amount)
+	}
+
+	pointOfInitiation := pointOfInitiationMany
+	if amount > 0 {
+		pointOfInitiation = pointOfInitiationOnce
+	}
+
+	var builder strings.Builder
+	builder.WriteString(emvField(emvPayloadFormat, "01"))
+	builder.WriteString(emvField(emvPointOfInitiation, pointOfInitiation))
+	builder.WriteString(emvField(emvMerchantPromptPay, emvField("00", promptPayAID)+account))
+	builder.WriteString(emvField(emvCountryCode, "TH"))
+	builder.WriteString(emvField(emvCurrency, currencyCodeTHB))
+	if amount > 0 {
+		builder.WriteString(emvField(emvAmount, fmt.Sprintf("%.2f", amount)))
+	}
+
+	// The checksum covers its own tag and length
+	builder.WriteString(emvCRC + "04")
+	builder.WriteString(fmt.Sprintf("%04X", crc16CCITT([]byte(builder.String()))))
+
+	return builder.String(), nil
+}
+
+func emvField(id string, value string) string {
+	return fmt.Sprintf("%s%02d%s", id, len(value), value)
+}
+
+func digitsOnly(s string) string {
+	var builder strings.Builder
+	for _, r := range s {
+		if r >= '0' && r <= '9' {
+			builder.WriteRune(r)
+		}
+	}
+
+	return builder.String()
+}
+
+// crc16CCITT is the CRC-16/CCITT-FALSE checksum (polynomial 0x1021, initial value 0xFFFF) required by EMVCo.
+func crc16CCITT(data []byte) uint16 {
+	crc := uint16(0xFFFF)
+	for _, b := range data {
+		crc ^= uint16(b) << 8
+		for i := 0; i < 8; i++ {
+			if crc&0x8000 != 0 {
+				crc = crc<<1 ^ 0x1021
+			} else {
+				crc <<= 1
+			}
+		}
+	}
+
+	return crc
+}
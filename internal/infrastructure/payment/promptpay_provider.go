@@ -0,0 +1,51 @@
+package payment
+
+import (
+	"context"
+	"fmt"
+)
+
+// PromptPayProvider charges with dynamic PromptPay QR codes paid to the platform PromptPay ID.
+// PromptPay has no callback of its own: the bank or slip verification service notifying incoming
+// transfers posts signed webhooks, and refunds are transferred back by hand.
+type PromptPayProvider struct {
+	promptPayID   string
+	webhookSecret string
+}
+
+func NewPromptPayProvider(promptPayID string, webhookSecret string) *PromptPayProvider {
+	return &PromptPayProvider{
+		promptPayID:   promptPayID,
+		webhookSecret: webhookSecret,
+	}
+}
+
+func (p *PromptPayProvider) Name() string {
+	return "promptpay"
+}
+
+func (p *PromptPayProvider) CreateCharge(_ context.Context, req ChargeRequest) (*Charge, error) {
+	if req.Currency != CurrencyTHB {
+		return nil, fmt.Errorf("promptpay only supports %s, got %s", CurrencyTHB, req.Currency)
+	}
+
+	payload, err := PromptPayPayload(p.promptPayID, req.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Charge{
+		// The transfer is matched back to the order by its reference
+		ProviderRef: "promptpay_" + req.Reference,
+		QRPayload:   payload,
+		ExpiresAt:   req.ExpiresAt,
+	}, nil
+}
+
+func (p *PromptPayProvider) Refund(_ context.Context, providerRef string, amount float64) (*Refund, error) {
+	return &Refund{ProviderRef: providerRef, Amount: amount, Manual: true}, nil
+}
+
+func (p *PromptPayProvider) ParseWebhook(body []byte, signature string) (*WebhookEvent, error) {
+	return parseSignedWebhook(p.webhookSecret, body, signature)
+}
@@ -0,0 +1,97 @@
+package payment
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"time"
+)
+
+const (
+	CurrencyTHB = "THB"
+
+	// SignatureHeader carries the hex HMAC-SHA256 of the raw webhook body.
+	SignatureHeader = "X-Payment-Signature"
+)
+
+var (
+	ErrInvalidSignature = errors.New("invalid webhook signature")
+	ErrInvalidWebhook   = errors.New("invalid webhook payload")
+)
+
+type WebhookStatus string
+
+const (
+	WebhookStatusPaid     WebhookStatus = "paid"
+	WebhookStatusFailed   WebhookStatus = "failed"
+	WebhookStatusRefunded WebhookStatus = "refunded"
+)
+
+type ChargeRequest struct {
+	Reference   string // Our order reference, echoed back by the webhooks
+	Amount      float64
+	Currency    string
+	Description string
+	ExpiresAt   time.Time
+}
+
+type Charge struct {
+	ProviderRef string
+	QRPayload   string // Payload to render as a QR code, empty when the provider redirects instead
+	ExpiresAt   time.Time
+}
+
+type Refund struct {
+	ProviderRef string
+	Amount      float64
+	Manual      bool // The provider cannot send money back, the organizer transfers it by hand
+}
+
+// WebhookEvent is the provider independent content of a verified webhook.
+type WebhookEvent struct {
+	ProviderRef string        `json:"providerRef"`
+	Reference   string        `json:"reference"`
+	Status      WebhookStatus `json:"status"`
+	Amount      float64       `json:"amount"`
+}
+
+// PaymentProvider takes the money of paid tickets. Payments are confirmed asynchronously through signed webhooks.
+type PaymentProvider interface {
+	Name() string
+	CreateCharge(ctx context.Context, req ChargeRequest) (*Charge, error)
+	Refund(ctx context.Context, providerRef string, amount float64) (*Refund, error)
+	// ParseWebhook verifies the signature of a webhook before decoding it
+	ParseWebhook(body []byte, signature string) (*WebhookEvent, error)
+}
+
+// SignWebhook computes the signature expected in SignatureHeader for a webhook body.
+func SignWebhook(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func parseSignedWebhook(secret string, body []byte, signature string) (*WebhookEvent, error) {
+	if !hmac.Equal([]byte(signature), []byte(SignWebhook(secret, body))) {
+		return nil, ErrInvalidSignature
+	}
+
+	event := &WebhookEvent{}
+	if err := json.Unmarshal(body, event); err != nil {
+		return nil, ErrInvalidWebhook
+	}
+
+	if event.ProviderRef == "" {
+		return nil, ErrInvalidWebhook
+	}
+
+	switch event.Status {
+	case WebhookStatusPaid, WebhookStatusFailed, WebhookStatusRefunded:
+		return event, nil
+	default:
+		return nil, ErrInvalidWebhook
+	}
+}
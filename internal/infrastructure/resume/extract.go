@@ -0,0 +1,159 @@
+package resume
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/ledongthuc/pdf"
+)
+
+const (
+	ContentTypePDF  = "application/pdf"
+	ContentTypeDOCX = "application/vnd.openxmlformats-officedocument.wordprocessingml.document"
+)
+
+const (
+	maxPDFPages = 50
+	// maxTextSize is far more than any resume, reading stops once the text reaches it
+	maxTextSize = 1 << 20
+	// maxDocumentXMLSize bounds the uncompressed body of a DOCX, a small upload can inflate to gigabytes
+	maxDocumentXMLSize = 20 << 20
+)
+
+// ExtractText returns the plain text of a PDF or DOCX document.
+func ExtractText(data []byte, contentType string) (text string, err error) {
+	// The pdf reader panics on some malformed documents instead of returning an error
+	defer func() {
+		if r := recover(); r != nil {
+			text, err = "", fmt.Errorf("failed to read document: %v", r)
+		}
+	}()
+
+	switch contentType {
+	case ContentTypePDF:
+		return extractPDFText(data)
+	case ContentTypeDOCX:
+		return extractDOCXText(data)
+	default:
+		return "", fmt.Errorf("unsupported content type: %s", contentType)
+	}
+}
+
+func extractPDFText(data []byte) (string, error) {
+	reader, err := pdf.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return "", fmt.Errorf("failed to open pdf: %w", err)
+	}
+
+	var builder strings.Builder
+	// The page count comes from the document itself
+	for i := 1; i <= min(reader.NumPage(), maxPDFPages) && builder.Len() < maxTextSize; i++ {
+		page := reader.Page(i)
+		if page.V.IsNull() {
+			continue
+		}
+
+		rows, err := page.GetTextByRow()
+		if err != nil {
+			return "", fmt.Errorf("failed to read pdf page %d: %w", i, err)
+		}
+
+		for _, row := range rows {
+			for _, word := range row.Content {
+				builder.WriteString(word.S)
+			}
+			builder.WriteString("\n")
+		}
+	}
+
+	return builder.String(), nil
+}
+
+func extractDOCXText(data []byte) (string, error) {
+	archive, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return "", fmt.Errorf("failed to open docx: %w", err)
+	}
+
+	for _, file := range archive.File {
+		if file.Name != "word/document.xml" {
+			continue
+		}
+		if file.UncompressedSize64 > maxDocumentXMLSize {
+			return "", fmt.Errorf("docx document is too large")
+		}
+
+		document, err := file.Open()
+		if err != nil {
+			return "", fmt.Errorf("failed to open docx document: %w", err)
+		}
+		defer document.Close()
+
+		// The declared size is not trusted either
+		return readWordprocessingML(io.LimitReader(document, maxDocumentXMLSize))
+	}
+
+	return "", fmt.Errorf("docx document body not found")
+}
+
+// readWordprocessingML keeps the text runs (w:t) of the document, one paragraph (w:p) per line.
+func readWordprocessingML(r io.Reader) (string, error) {
+	decoder := xml.NewDecoder(r)
+
+	var builder strings.Builder
+	inText := false
+	for builder.Len() < maxTextSize {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return "", fmt.Errorf("failed to parse docx document: %w", err)
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "t":
+				inText = true
+			case "tab":
+				builder.WriteString("\t")
+			case "br":
+				builder.WriteString("\n")
+			}
+		case xml.EndElement:
+			switch t.Name.Local {
+			case "t":
+				inText = false
+			case "p":
+				builder.WriteString("\n")
+			}
+		case xml.CharData:
+			if inText {
+				builder.Write(t)
+			}
+		}
+	}
+
+	return builder.String(), nil
+}
+
+// IsDOCX reports whether data is a zip archive containing a Word document body.
+func IsDOCX(data []byte) bool {
+	archive, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return false
+	}
+
+	for _, file := range archive.File {
+		if file.Name == "word/document.xml" {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,249 @@
+package resume
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type section int
+
+const (
+	sectionNone section = iota
+	sectionEducation
+	sectionSkills
+	sectionExperience
+	sectionOther
+)
+
+// sectionHeadings maps the usual English and Thai resume headings to the section they start.
+var sectionHeadings = map[string]section{
+	"education":           sectionEducation,
+	"educations":          sectionEducation,
+	"academic background": sectionEducation,
+	"การศึกษา":            sectionEducation,
+	"ประวัติการศึกษา": sectionEducation,
+	"skills":                  sectionSkills,
+	"skill":                   sectionSkills,
+	"technical skills":        sectionSkills,
+	"key skills":              sectionSkills,
+	"competencies":            sectionSkills,
+	"ทักษะ":                   sectionSkills,
+	"ความสามารถ":              sectionSkills,
+	"experience":              sectionExperience,
+	"experiences":             sectionExperience,
+	"work experience":         sectionExperience,
+	"professional experience": sectionExperience,
+	"employment history":      sectionExperience,
+	"work history":            sectionExperience,
+	"ประสบการณ์":              sectionExperience,
+	"ประสบการณ์การทำงาน": sectionExperience,
+	"summary":        sectionOther,
+	"profile":        sectionOther,
+	"about me":       sectionOther,
+	"objective":      sectionOther,
+	"projects":       sectionOther,
+	"certifications": sectionOther,
+	"certificates":   sectionOther,
+	"awards":         sectionOther,
+	"languages":      sectionOther,
+	"interests":      sectionOther,
+	"references":     sectionOther,
+	"contact":        sectionOther,
+	"เกี่ยวกับฉัน":   sectionOther,
+	"ภาษา":           sectionOther,
+	"โครงการ":        sectionOther,
+	"ผลงาน":          sectionOther,
+	"ติดต่อ":         sectionOther,
+}
+
+const (
+	maxHeadingLength = 40
+	maxSkillLength   = 50
+)
+
+var (
+	monthPattern     = `(?:jan|feb|mar|apr|may|jun|jul|aug|sep|sept|oct|nov|dec)[a-z]*\.?`
+	dateRangePattern = regexp.MustCompile(`(?i)(` + monthPattern + `\s+)?(\d{4})\s*(?:-|–|—|to|ถึง)\s*(` + monthPattern + `\s+)?(\d{4}|present|current|now|ปัจจุบัน)`)
+	skillSeparators  = regexp.MustCompile(`[,;|•·\t]`)
+	bulletPrefix     = regexp.MustCompile(`^[\s\-*•·●▪◦]+`)
+)
+
+var months = map[string]time.Month{
+	"jan": time.January, "feb": time.February, "mar": time.March, "apr": time.April,
+	"may": time.May, "jun": time.June, "jul": time.July, "aug": time.August,
+	"sep": time.September, "oct": time.October, "nov": time.November, "dec": time.December,
+}
+
+type ParsedExperience struct {
+	Title       string
+	Description string
+	StartDate   time.Time
+	EndDate     time.Time
+	Currently   bool
+}
+
+// ParsedResume holds what could be recognized in a resume, to be confirmed by its owner.
+type ParsedResume struct {
+	Education   []string
+	Skills      []string
+	Experiences []ParsedExperience
+}
+
+// Parse splits the text of a resume into its sections by looking for well-known headings.
+// It is a best-effort heuristic, everything it returns is a suggestion.
+func Parse(text string) ParsedResume {
+	var parsed ParsedResume
+	var pending []string
+	var current *ParsedExperience
+	seenSkills := make(map[string]bool)
+
+	flushExperience := func() {
+		if current != nil {
+			current.Description = strings.TrimSpace(current.Description)
+			parsed.Experiences = append(parsed.Experiences, *current)
+			current = nil
+		}
+	}
+
+	active := sectionNone
+	for _, rawLine := range strings.Split(text, "\n") {
+		line := strings.TrimSpace(rawLine)
+		if line == "" {
+			continue
+		}
+
+		if heading, ok := matchHeading(line); ok {
+			flushExperience()
+			pending = nil
+			active = heading
+			continue
+		}
+
+		switch active {
+		case sectionEducation:
+			parsed.Education = append(parsed.Education, cleanLine(line))
+		case sectionSkills:
+			for _, skill := range splitSkills(line) {
+				key := strings.ToLower(skill)
+				if !seenSkills[key] {
+					seenSkills[key] = true
+					parsed.Skills = append(parsed.Skills, skill)
+				}
+			}
+		case sectionExperience:
+			experience, ok := parseExperienceHeader(line)
+			if !ok {
+				if current != nil {
+					current.Description += cleanLine(line) + "\n"
+				} else {
+					pending = append(pending, cleanLine(line))
+				}
+				continue
+			}
+
+			// A date line without a title belongs to the line above it, e.g. "Software Engineer\n2020 - Present"
+			if experience.Title == "" {
+				if current != nil {
+					if lines := strings.Split(strings.TrimSpace(current.Description), "\n"); len(lines) > 0 && lines[len(lines)-1] != "" {
+						experience.Title = lines[len(lines)-1]
+						current.Description = strings.Join(lines[:len(lines)-1], "\n")
+					}
+				} else if len(pending) > 0 {
+					experience.Title = pending[len(pending)-1]
+				}
+			}
+			flushExperience()
+			pending = nil
+			current = &experience
+		}
+	}
+	flushExperience()
+
+	return parsed
+}
+
+func matchHeading(line string) (section, bool) {
+	if len([]rune(line)) > maxHeadingLength {
+		return sectionNone, false
+	}
+
+	heading := strings.ToLower(strings.TrimRight(line, ": "))
+	s, ok := sectionHeadings[heading]
+	return s, ok
+}
+
+func cleanLine(line string) string {
+	return strings.TrimSpace(bulletPrefix.ReplaceAllString(line, ""))
+}
+
+func splitSkills(line string) []string {
+	line = cleanLine(line)
+	// "Programming: Go, Python" lists the skills after the label
+	if label, rest, ok := strings.Cut(line, ":"); ok && len([]rune(label)) <= maxHeadingLength {
+		line = rest
+	}
+
+	var skills []string
+	for _, part := range skillSeparators.Split(line, -1) {
+		skill := cleanLine(part)
+		if skill != "" && len([]rune(skill)) <= maxSkillLength {
+			skills = append(skills, skill)
+		}
+	}
+
+	return skills
+}
+
+func parseExperienceHeader(line string) (ParsedExperience, bool) {
+	match := dateRangePattern.FindStringSubmatchIndex(line)
+	if match == nil {
+		return ParsedExperience{}, false
+	}
+
+	group := func(i int) string {
+		if match[2*i] < 0 {
+			return ""
+		}
+		return line[match[2*i]:match[2*i+1]]
+	}
+
+	experience := ParsedExperience{
+		StartDate: parseDate(group(1), group(2)),
+	}
+
+	switch strings.ToLower(group(4)) {
+	case "present", "current", "now", "ปัจจุบัน":
+		experience.Currently = true
+	default:
+		experience.EndDate = parseDate(group(3), group(4))
+	}
+
+	title := line[:match[0]] + " " + line[match[1]:]
+	experience.Title = strings.Trim(cleanLine(title), " |,-–—()")
+
+	return experience, true
+}
+
+func parseDate(month string, year string) time.Time {
+	y, err := strconv.Atoi(year)
+	if err != nil {
+		return time.Time{}
+	}
+
+	// Thai resumes often use the Buddhist era
+	if y > 2400 {
+		y -= 543
+	}
+
+	m := time.January
+	month = strings.ToLower(strings.TrimSpace(month))
+	if len(month) >= 3 {
+		if parsed, ok := months[month[:3]]; ok {
+			m = parsed
+		}
+	}
+
+	return time.Date(y, m, 1, 0, 0, 0, 0, time.UTC)
+}
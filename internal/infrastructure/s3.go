@@ -7,7 +7,10 @@ import (
 	"log"
 	"mime/multipart"
 	"os"
+	"path"
 	"path/filepath"
+	"strings"
+	"time"
 
 	"github.com/DAF-Bridge/Talent-Atmos-Backend/logs"
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -164,6 +167,241 @@ func (s *S3Uploader) UploadJobBanner(ctx context.Context, file multipart.File, f
 	return fileURL, nil
 }
 
+func (s *S3Uploader) UploadExperiencePictureFile(ctx context.Context, file multipart.File, fileHeader *multipart.FileHeader, userID uuid.UUID, experienceID uuid.UUID) (string, error) {
+	fileExt := filepath.Ext(fileHeader.Filename)
+	objectKey := fmt.Sprintf("users/experiences/%s/%s%s", userID, experienceID, fileExt)
+
+	buffer := bytes.NewBuffer(nil)
+	if _, err := buffer.ReadFrom(file); err != nil {
+		logs.Error(err)
+		return "", fmt.Errorf("failed to read file: %w", err)
+	}
+
+	err := sendObject(ctx, s.client, s.bucketName, objectKey, buffer)
+	if err != nil {
+		logs.Error(err)
+		return "", fmt.Errorf("failed to upload file: %w", err)
+	}
+
+	fileURL := fmt.Sprintf("https://%s.s3.%s.amazonaws.com/%s", s.bucketName, os.Getenv("AWS_REGION"), objectKey)
+	logs.Info(fmt.Sprintf("File uploaded successfully. URL: %s", fileURL))
+	return fileURL, nil
+}
+
+func (s *S3Uploader) UploadEventSpeakerPictureFile(ctx context.Context, file multipart.File, fileHeader *multipart.FileHeader, orgID uint, eventID uint, speakerID uint) (string, error) {
+	fileExt := filepath.Ext(fileHeader.Filename)
+	objectKey := fmt.Sprintf("organizations/%v/pictures/events/%v/speakers/%v%s", orgID, eventID, speakerID, fileExt)
+
+	buffer := bytes.NewBuffer(nil)
+	if _, err := buffer.ReadFrom(file); err != nil {
+		logs.Error(err)
+		return "", fmt.Errorf("failed to read file: %w", err)
+	}
+
+	err := sendObject(ctx, s.client, s.bucketName, objectKey, buffer)
+	if err != nil {
+		logs.Error(err)
+		return "", fmt.Errorf("failed to upload file: %w", err)
+	}
+
+	fileURL := fmt.Sprintf("https://%s.s3.%s.amazonaws.com/%s", s.bucketName, os.Getenv("AWS_REGION"), objectKey)
+	logs.Info(fmt.Sprintf("File uploaded successfully. URL: %s", fileURL))
+	return fileURL, nil
+}
+
+// UploadEventMaterialFile stores a public download of an event and returns its object key and URL,
+// browsers save it under its original file name
+func (s *S3Uploader) UploadEventMaterialFile(ctx context.Context, data []byte, contentType string, fileName string, orgID uint, eventID uint) (string, string, error) {
+	objectKey := fmt.Sprintf("organizations/%v/events/%v/materials/%s%s", orgID, eventID, uuid.New(), filepath.Ext(fileName))
+
+	_, err := s.client.PutObject(ctx, &s3.PutObjectInput{
+		Bucket:             aws.String(s.bucketName),
+		Key:                aws.String(objectKey),
+		Body:               bytes.NewReader(data),
+		ContentType:        aws.String(contentType),
+		ContentDisposition: aws.String(fmt.Sprintf("attachment; filename=%q", fileName)),
+		ACL:                "public-read",
+	})
+	if err != nil {
+		logs.Error(err)
+		return "", "", fmt.Errorf("failed to upload file: %w", err)
+	}
+
+	fileURL := fmt.Sprintf("https://%s.s3.%s.amazonaws.com/%s", s.bucketName, os.Getenv("AWS_REGION"), objectKey)
+	logs.Info(fmt.Sprintf("File uploaded successfully. URL: %s", fileURL))
+	return objectKey, fileURL, nil
+}
+
+// UploadCertificateImageFile stores an image of the certificate template of an event and returns its object key and URL
+func (s *S3Uploader) UploadCertificateImageFile(ctx context.Context, data []byte, contentType string, fileExt string, orgID uint, eventID uint, image string) (string, string, error) {
+	objectKey := fmt.Sprintf("organizations/%v/events/%v/certificates/%s-%s%s", orgID, eventID, image, uuid.New(), fileExt)
+
+	_, err := s.client.PutObject(ctx, &s3.PutObjectInput{
+		Bucket:      aws.String(s.bucketName),
+		Key:         aws.String(objectKey),
+		Body:        bytes.NewReader(data),
+		ContentType: aws.String(contentType),
+		ACL:         "public-read",
+	})
+	if err != nil {
+		logs.Error(err)
+		return "", "", fmt.Errorf("failed to upload file: %w", err)
+	}
+
+	fileURL := fmt.Sprintf("https://%s.s3.%s.amazonaws.com/%s", s.bucketName, os.Getenv("AWS_REGION"), objectKey)
+	logs.Info(fmt.Sprintf("File uploaded successfully. URL: %s", fileURL))
+	return objectKey, fileURL, nil
+}
+
+// UploadCertificateFile stores the PDF certificate of a participant under a key that cannot be guessed
+// and returns its object key and URL
+func (s *S3Uploader) UploadCertificateFile(ctx context.Context, data []byte, orgID uint, eventID uint, code string) (string, string, error) {
+	objectKey := fmt.Sprintf("organizations/%v/events/%v/certificates/issued/%s.pdf", orgID, eventID, uuid.New())
+
+	_, err := s.client.PutObject(ctx, &s3.PutObjectInput{
+		Bucket:             aws.String(s.bucketName),
+		Key:                aws.String(objectKey),
+		Body:               bytes.NewReader(data),
+		ContentType:        aws.String("application/pdf"),
+		ContentDisposition: aws.String(fmt.Sprintf("inline; filename=%q", "certificate-"+code+".pdf")),
+		ACL:                "public-read",
+	})
+	if err != nil {
+		logs.Error(err)
+		return "", "", fmt.Errorf("failed to upload file: %w", err)
+	}
+
+	fileURL := fmt.Sprintf("https://%s.s3.%s.amazonaws.com/%s", s.bucketName, os.Getenv("AWS_REGION"), objectKey)
+	logs.Info(fmt.Sprintf("File uploaded successfully. URL: %s", fileURL))
+	return objectKey, fileURL, nil
+}
+
+// GetObject downloads an object of the bucket
+func (s *S3Uploader) GetObject(ctx context.Context, objectKey string) ([]byte, error) {
+	output, err := s.client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(s.bucketName),
+		Key:    aws.String(objectKey),
+	})
+	if err != nil {
+		logs.Error(err)
+		return nil, fmt.Errorf("failed to get object: %w", err)
+	}
+	defer output.Body.Close()
+
+	buffer := bytes.NewBuffer(nil)
+	if _, err := buffer.ReadFrom(output.Body); err != nil {
+		logs.Error(err)
+		return nil, fmt.Errorf("failed to read object: %w", err)
+	}
+
+	return buffer.Bytes(), nil
+}
+
+// UploadResumeFile stores a resume privately and returns its object key, the file is only reachable through PresignObjectURL
+func (s *S3Uploader) UploadResumeFile(ctx context.Context, data []byte, contentType string, fileExt string, userID uuid.UUID, version int) (string, error) {
+	objectKey := fmt.Sprintf("users/resumes/%s/v%d-%s%s", userID, version, uuid.New(), fileExt)
+
+	_, err := s.client.PutObject(ctx, &s3.PutObjectInput{
+		Bucket:      aws.String(s.bucketName),
+		Key:         aws.String(objectKey),
+		Body:        bytes.NewReader(data),
+		ContentType: aws.String(contentType),
+	})
+	if err != nil {
+		logs.Error(err)
+		return "", fmt.Errorf("failed to upload file: %w", err)
+	}
+
+	logs.Info(fmt.Sprintf("Private file uploaded successfully. Key: %s", objectKey))
+	return objectKey, nil
+}
+
+// PresignObjectURL returns a short-lived URL to download a private object under the given file name
+func (s *S3Uploader) PresignObjectURL(ctx context.Context, objectKey string, fileName string, expires time.Duration) (string, error) {
+	presignClient := s3.NewPresignClient(s.client)
+
+	req, err := presignClient.PresignGetObject(ctx, &s3.GetObjectInput{
+		Bucket:                     aws.String(s.bucketName),
+		Key:                        aws.String(objectKey),
+		ResponseContentDisposition: aws.String(fmt.Sprintf("attachment; filename=%q", fileName)),
+	}, s3.WithPresignExpires(expires))
+	if err != nil {
+		logs.Error(err)
+		return "", fmt.Errorf("failed to presign object: %w", err)
+	}
+
+	return req.URL, nil
+}
+
+func (s *S3Uploader) DeleteObject(ctx context.Context, objectKey string) error {
+	_, err := s.client.DeleteObject(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(s.bucketName),
+		Key:    aws.String(objectKey),
+	})
+	if err != nil {
+		logs.Error(err)
+		return fmt.Errorf("failed to delete object: %w", err)
+	}
+
+	return nil
+}
+
+// CopyEventPictureFile copies a picture of the bucket to the picture of an event and returns its URL
+func (s *S3Uploader) CopyEventPictureFile(ctx context.Context, sourceURL string, orgID uint, eventID uint) (string, error) {
+	objectKey := fmt.Sprintf("organizations/%v/pictures/events/%v%s", orgID, eventID, path.Ext(sourceURL))
+	return s.copyObject(ctx, sourceURL, objectKey)
+}
+
+// CopyEventSpeakerPictureFile copies a picture of the bucket to the picture of a speaker and returns its URL
+func (s *S3Uploader) CopyEventSpeakerPictureFile(ctx context.Context, sourceURL string, orgID uint, eventID uint, speakerID uint) (string, error) {
+	objectKey := fmt.Sprintf("organizations/%v/pictures/events/%v/speakers/%v%s", orgID, eventID, speakerID, path.Ext(sourceURL))
+	return s.copyObject(ctx, sourceURL, objectKey)
+}
+
+// CopyJobBanner copies a picture of the bucket to the banner of a job and returns its URL
+func (s *S3Uploader) CopyJobBanner(ctx context.Context, sourceURL string, orgID uint, jobID uint) (string, error) {
+	objectKey := fmt.Sprintf("organizations/%v/pictures/jobs/%v%s", orgID, jobID, path.Ext(sourceURL))
+	return s.copyObject(ctx, sourceURL, objectKey)
+}
+
+// CopyTemplatePictureFile copies a picture of the bucket under the templates of an organization and returns its URL,
+// the copy is kept when the item the template was saved from changes its picture
+func (s *S3Uploader) CopyTemplatePictureFile(ctx context.Context, sourceURL string, orgID uint) (string, error) {
+	objectKey := fmt.Sprintf("organizations/%v/templates/%s%s", orgID, uuid.New(), path.Ext(sourceURL))
+	return s.copyObject(ctx, sourceURL, objectKey)
+}
+
+// ObjectKeyFromURL returns the key of an object from its public URL, it reports false for files outside the bucket
+func (s *S3Uploader) ObjectKeyFromURL(fileURL string) (string, bool) {
+	prefix := fmt.Sprintf("https://%s.s3.%s.amazonaws.com/", s.bucketName, os.Getenv("AWS_REGION"))
+	objectKey, found := strings.CutPrefix(fileURL, prefix)
+	return objectKey, found && objectKey != ""
+}
+
+// copyObject copies an object inside the bucket without downloading it,
+// files hosted elsewhere are not copied and keep their URL
+func (s *S3Uploader) copyObject(ctx context.Context, sourceURL string, objectKey string) (string, error) {
+	sourceKey, ok := s.ObjectKeyFromURL(sourceURL)
+	if !ok {
+		return sourceURL, nil
+	}
+
+	_, err := s.client.CopyObject(ctx, &s3.CopyObjectInput{
+		Bucket:     aws.String(s.bucketName),
+		Key:        aws.String(objectKey),
+		CopySource: aws.String(fmt.Sprintf("%s/%s", s.bucketName, sourceKey)),
+		ACL:        "public-read",
+	})
+	if err != nil {
+		logs.Error(err)
+		return "", fmt.Errorf("failed to copy object: %w", err)
+	}
+
+	fileURL := fmt.Sprintf("https://%s.s3.%s.amazonaws.com/%s", s.bucketName, os.Getenv("AWS_REGION"), objectKey)
+	logs.Info(fmt.Sprintf("File copied successfully. URL: %s", fileURL))
+	return fileURL, nil
+}
+
 func sendObject(ctx context.Context, client *s3.Client, bucketName string, objectKey string, buffer *bytes.Buffer) error {
 	_, err := client.PutObject(ctx, &s3.PutObjectInput{
 		Bucket: aws.String(bucketName),
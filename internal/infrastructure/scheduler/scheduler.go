@@ -0,0 +1,23 @@
+package scheduler
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/logs"
+)
+
+// Every runs job in the background at each interval for the lifetime of the process.
+// A failed run is logged and does not stop the next ones.
+func Every(interval time.Duration, name string, job func() error) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for range ticker.C {
+			if err := job(); err != nil {
+				logs.Error(fmt.Sprintf("Scheduled job %s failed: %v", name, err))
+			}
+		}
+	}()
+}
@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/dto"
 	"github.com/DAF-Bridge/Talent-Atmos-Backend/logs"
@@ -74,7 +75,12 @@ func SearchEvents(client *opensearch.Client, query dto.SearchQuery, page int, of
 }
 
 func buildSearchQuery(query dto.SearchQuery) map[string]interface{} {
-	startDate, endDate := utils.GetDateRange(query.DateRange)
+	// The timezone is validated by the service, an unknown one falls back to the default zone
+	loc, err := utils.LoadTimezone(query.Timezone)
+	if err != nil {
+		loc, _ = utils.LoadTimezone("")
+	}
+	startDate, endDate := utils.GetDateRange(query.DateRange, loc)
 
 	// Construct the query map based on the filters
 	searchQuery := make(map[string]interface{})
@@ -84,8 +90,9 @@ func buildSearchQuery(query dto.SearchQuery) map[string]interface{} {
 	if query.Q != "" {
 		must = append(must, map[string]interface{}{
 			"multi_match": map[string]interface{}{
-				"query":  query.Q,
-				"fields": []string{"name", "description", "location"},
+				"query": query.Q,
+				// The texts are analyzed as Thai, their 'en' sub-fields as English, see the index definitions in sync
+				"fields": []string{"name", "name.en", "content", "content.en", "locationName", "sessions.title", "sessions.locationName", "organization.name", "coHosts.name", "translations.th.*", "translations.en.*"},
 				// "type":                 "most_fields", // Can be changed to "best_fields" / "most_fields" / "cross_fields" / "phrase" / "phrase_prefix" for optimization
 				"fuzziness":            "AUTO",
 				"operator":             "or",
@@ -119,6 +126,14 @@ func buildSearchQuery(query dto.SearchQuery) map[string]interface{} {
 			},
 		})
 	}
+	if query.OrgID != 0 {
+		// The organizer and every co-host list the event
+		must = append(must, map[string]interface{}{
+			"term": map[string]interface{}{
+				"organizationIds": query.OrgID,
+			},
+		})
+	}
 	if query.Price != "" {
 		must = append(must, map[string]interface{}{
 			"match": map[string]interface{}{
@@ -127,12 +142,21 @@ func buildSearchQuery(query dto.SearchQuery) map[string]interface{} {
 		})
 	}
 	if !startDate.IsZero() && !endDate.IsZero() {
-		must = append(must, map[string]interface{}{
-			"range": map[string]interface{}{
-				"startDate": map[string]interface{}{
-					"gte": startDate,
-					"lte": endDate,
+		// An event with sessions matches when any of its sessions starts in the range
+		startsBetween := func(field string) map[string]interface{} {
+			return map[string]interface{}{
+				"range": map[string]interface{}{
+					field: map[string]interface{}{
+						"gte": startDate.UTC().Format(time.RFC3339),
+						"lte": endDate.UTC().Format(time.RFC3339),
+					},
 				},
+			}
+		}
+		must = append(must, map[string]interface{}{
+			"bool": map[string]interface{}{
+				"should":               []map[string]interface{}{startsBetween("startAt"), startsBetween("sessions.startAt")},
+				"minimum_should_match": 1,
 			},
 		})
 	}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/dto"
 	"github.com/DAF-Bridge/Talent-Atmos-Backend/logs"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/utils"
 	"github.com/opensearch-project/opensearch-go"
 )
 
@@ -83,8 +84,9 @@ func buildSearchJobQuery(query dto.SearchJobQuery) map[string]interface{} {
 	if query.Q != "" {
 		must = append(must, map[string]interface{}{
 			"multi_match": map[string]interface{}{
-				"query":  query.Q,
-				"fields": []string{"title", "description", "location", "organization"},
+				"query": query.Q,
+				// The texts are analyzed as Thai, their 'en' sub-fields as English, see the index definitions in sync
+				"fields": []string{"title", "title.en", "description", "description.en", "location", "organization.name", "skills.name", "translations.th.*", "translations.en.*"},
 				// "type":                 "most_fields", // Can be changed to "best_fields" / "most_fields" / "cross_fields" / "phrase" / "phrase_prefix" for optimization
 				"fuzziness":            "AUTO",
 				"operator":             "or",
@@ -134,19 +136,30 @@ func buildSearchJobQuery(query dto.SearchJobQuery) map[string]interface{} {
 			},
 		})
 	}
+	// Salaries are compared in their normalized monthly THB form, a job matches if its range overlaps the requested one
 	if query.SalaryLowerBound != 0 || query.SalaryUpperBound != 0 {
-		salaryRange := make(map[string]interface{})
 		if query.SalaryLowerBound != 0 {
-			salaryRange["gte"] = query.SalaryLowerBound
+			lowerBound, ok := utils.SalaryToMonthlyTHB(query.SalaryLowerBound, query.SalaryCurrency, query.SalaryPeriod)
+			if !ok {
+				lowerBound = query.SalaryLowerBound
+			}
+			must = append(must, map[string]interface{}{
+				"range": map[string]interface{}{
+					"salaryMaxMonthlyThb": map[string]interface{}{"gte": lowerBound},
+				},
+			})
 		}
 		if query.SalaryUpperBound != 0 {
-			salaryRange["lte"] = query.SalaryUpperBound
+			upperBound, ok := utils.SalaryToMonthlyTHB(query.SalaryUpperBound, query.SalaryCurrency, query.SalaryPeriod)
+			if !ok {
+				upperBound = query.SalaryUpperBound
+			}
+			must = append(must, map[string]interface{}{
+				"range": map[string]interface{}{
+					"salaryMinMonthlyThb": map[string]interface{}{"lte": upperBound},
+				},
+			})
 		}
-		must = append(must, map[string]interface{}{
-			"range": map[string]interface{}{
-				"salary": salaryRange,
-			},
-		})
 	}
 	if query.Page != 0 {
 		searchQuery["from"] = (query.Page - 1) * query.Offset
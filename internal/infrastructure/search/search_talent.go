@@ -0,0 +1,124 @@
+package search
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/dto"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/logs"
+	"github.com/opensearch-project/opensearch-go"
+)
+
+func SearchTalents(client *opensearch.Client, query dto.SearchTalentQuery, page int, offset int) (dto.SearchTalentResponse, error) {
+	searchQuery := buildSearchTalentQuery(query, page, offset)
+
+	queryBody, err := json.Marshal(searchQuery)
+	if err != nil {
+		logs.Error(fmt.Sprintf("failed to marshal search query: %v", err))
+		return dto.SearchTalentResponse{}, err
+	}
+
+	res, err := client.Search(
+		client.Search.WithIndex("talents"),
+		client.Search.WithBody(bytes.NewReader(queryBody)),
+		client.Search.WithContext(context.Background()),
+	)
+	if err != nil {
+		logs.Error(fmt.Sprintf("failed to execute search on: %v", err))
+		return dto.SearchTalentResponse{}, err
+	}
+	defer res.Body.Close()
+
+	if res.IsError() {
+		logs.Error(fmt.Sprintf("talent search failed: %s", res.String()))
+		return dto.SearchTalentResponse{}, fmt.Errorf("talent search failed: %s", res.Status())
+	}
+
+	var result struct {
+		Hits struct {
+			Total struct {
+				Value int `json:"value"`
+			} `json:"total"`
+			Hits []struct {
+				Source dto.TalentDocument `json:"_source"`
+			} `json:"hits"`
+		} `json:"hits"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&result); err != nil {
+		logs.Error(fmt.Sprintf("failed to decode search response: %v", err))
+		return dto.SearchTalentResponse{}, err
+	}
+
+	talents := make([]dto.TalentDocument, 0, len(result.Hits.Hits))
+	for _, hit := range result.Hits.Hits {
+		talents = append(talents, hit.Source)
+	}
+
+	return dto.SearchTalentResponse{
+		TotalTalent: result.Hits.Total.Value,
+		Talents:     talents,
+	}, nil
+}
+
+func buildSearchTalentQuery(query dto.SearchTalentQuery, page int, offset int) map[string]interface{} {
+	var must []map[string]interface{}
+	filter := []map[string]interface{}{}
+
+	if query.Q != "" {
+		must = append(must, map[string]interface{}{
+			"multi_match": map[string]interface{}{
+				"query":     query.Q,
+				"fields":    []string{"firstName", "lastName", "headline^2", "focusField^2", "skills.name^3"},
+				"fuzziness": "AUTO",
+				"operator":  "or",
+			},
+		})
+	} else {
+		must = append(must, map[string]interface{}{
+			"match_all": map[string]interface{}{},
+		})
+	}
+
+	// Every requested skill is required
+	for _, skill := range strings.Split(query.Skills, ",") {
+		skill = strings.TrimSpace(skill)
+		if skill == "" {
+			continue
+		}
+		filter = append(filter, map[string]interface{}{
+			"term": map[string]interface{}{
+				"skills.slug": strings.ToLower(skill),
+			},
+		})
+	}
+
+	if query.Province != "" {
+		filter = append(filter, map[string]interface{}{
+			"match": map[string]interface{}{
+				"province": map[string]interface{}{"query": query.Province, "operator": "and"},
+			},
+		})
+	}
+
+	if query.FocusField != "" {
+		filter = append(filter, map[string]interface{}{
+			"match": map[string]interface{}{
+				"focusField": map[string]interface{}{"query": query.FocusField, "operator": "and"},
+			},
+		})
+	}
+
+	return map[string]interface{}{
+		"from": (page - 1) * offset,
+		"size": offset,
+		"query": map[string]interface{}{
+			"bool": map[string]interface{}{
+				"must":   must,
+				"filter": filter,
+			},
+		},
+	}
+}
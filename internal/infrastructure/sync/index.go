@@ -0,0 +1,371 @@
+package sync
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/logs"
+	"github.com/opensearch-project/opensearch-go"
+)
+
+// The indices of events, jobs and organizations are created from versioned definitions. Version N of
+// an index is the index '<name>_vN', read and written through the alias '<name>'. The analysis of an
+// existing index cannot be changed, so any change to a definition or to the synonyms bumps its version:
+// the next ensureIndexExists creates the new index, copies the documents of the previous one (or of
+// the index created before the indices were versioned) and moves the alias to it.
+
+type indexDefinition struct {
+	Name       string
+	Version    int
+	Properties map[string]interface{} // Fields left out are mapped dynamically
+}
+
+func (d indexDefinition) versionedName() string {
+	return fmt.Sprintf("%s_v%d", d.Name, d.Version)
+}
+
+const (
+	thaiAnalyzer    = "thai_text"
+	englishAnalyzer = "english_text"
+
+	// icuPlugin provides a dictionary based tokenizer that segments Thai better than the built-in one
+	icuPlugin = "analysis-icu"
+)
+
+// searchSynonyms are equivalent terms expanded when the documents are indexed, both in Thai and in
+// English analysis. Changing the list needs a new version of the indices.
+var searchSynonyms = []string{
+	"seminar, สัมมนา",
+	"conference, การประชุม, ประชุม",
+	"workshop, เวิร์กช็อป, เวิร์คช็อป, อบรม",
+	"competition, contest, การแข่งขัน, แข่งขัน, ประกวด",
+	"exhibition, นิทรรศการ",
+	"forum, เวทีเสวนา, เสวนา",
+	"networking, สร้างเครือข่าย",
+	"incubation, บ่มเพาะ",
+	"campaign, แคมเปญ",
+	"startup, สตาร์ทอัพ, สตาร์ตอัป",
+	"volunteer, อาสาสมัคร, จิตอาสา",
+	"environment, สิ่งแวดล้อม",
+	"sustainability, ความยั่งยืน",
+	"internship, intern, ฝึกงาน",
+	"fulltime, full-time, งานประจำ, พนักงานประจำ",
+	"parttime, part-time, พาร์ทไทม์",
+	"remote, wfh, ทำงานที่บ้าน",
+	"developer, programmer, นักพัฒนา, โปรแกรมเมอร์",
+	"free, ฟรี",
+	"bangkok, กรุงเทพ, กรุงเทพมหานคร, กทม",
+	"chiang mai, เชียงใหม่",
+}
+
+var eventIndex = indexDefinition{
+	Name:    "events",
+	Version: 1,
+	Properties: map[string]interface{}{
+		"name":         textField(),
+		"content":      textField(),
+		"locationName": textField(),
+		"sessions": objectField(map[string]interface{}{
+			"title":        textField(),
+			"locationName": textField(),
+		}),
+		"organization": objectField(map[string]interface{}{"name": textField()}),
+		"coHosts":      objectField(map[string]interface{}{"name": textField()}),
+		"translations": translationsField("name", "content"),
+	},
+}
+
+var jobIndex = indexDefinition{
+	Name:    "jobs",
+	Version: 1,
+	Properties: map[string]interface{}{
+		"title":        textField(),
+		"description":  textField(),
+		"organization": objectField(map[string]interface{}{"name": textField()}),
+		"skills": objectField(map[string]interface{}{
+			"id":   map[string]interface{}{"type": "integer"},
+			"name": textField(),
+			"slug": map[string]interface{}{"type": "keyword"},
+		}),
+		"salaryMin":           map[string]interface{}{"type": "double"},
+		"salaryMax":           map[string]interface{}{"type": "double"},
+		"salaryCurrency":      map[string]interface{}{"type": "keyword"},
+		"salaryPeriod":        map[string]interface{}{"type": "keyword"},
+		"salaryNegotiable":    map[string]interface{}{"type": "boolean"},
+		"salaryHidden":        map[string]interface{}{"type": "boolean"},
+		"salaryMinMonthlyThb": map[string]interface{}{"type": "double"},
+		"salaryMaxMonthlyThb": map[string]interface{}{"type": "double"},
+		"translations":        translationsField("title", "description"),
+	},
+}
+
+var organizationIndex = indexDefinition{
+	Name:    "organizations",
+	Version: 1,
+	Properties: map[string]interface{}{
+		"name":         textField(),
+		"description":  textField(),
+		"translations": translationsField("name", "description"),
+	},
+}
+
+// textField analyzes a text of the default locale as Thai, the sub-field 'en' analyzes the same text as
+// English for the texts written in English. The Thai analysis splits the words of other scripts on spaces.
+func textField() map[string]interface{} {
+	return map[string]interface{}{
+		"type":     "text",
+		"analyzer": thaiAnalyzer,
+		"fields": map[string]interface{}{
+			"en":      map[string]interface{}{"type": "text", "analyzer": englishAnalyzer},
+			"keyword": map[string]interface{}{"type": "keyword", "ignore_above": 256},
+		},
+	}
+}
+
+func objectField(properties map[string]interface{}) map[string]interface{} {
+	return map[string]interface{}{"properties": properties}
+}
+
+// translationsField analyzes the texts of each locale with the analyzer of its language
+func translationsField(fields ...string) map[string]interface{} {
+	locales := map[string]string{"th": thaiAnalyzer, "en": englishAnalyzer}
+
+	properties := make(map[string]interface{}, len(locales))
+	for locale, analyzer := range locales {
+		localeFields := make(map[string]interface{}, len(fields))
+		for _, field := range fields {
+			localeFields[field] = map[string]interface{}{"type": "text", "analyzer": analyzer}
+		}
+		properties[locale] = objectField(localeFields)
+	}
+
+	return objectField(properties)
+}
+
+// analysisSettings defines the Thai and English analyzers, thaiTokenizer is the ICU tokenizer when the
+// cluster has the plugin, the built-in thai tokenizer otherwise.
+func analysisSettings(thaiTokenizer string) map[string]interface{} {
+	return map[string]interface{}{
+		"filter": map[string]interface{}{
+			"search_synonyms": map[string]interface{}{
+				"type":     "synonym",
+				"synonyms": searchSynonyms,
+				"lenient":  true,
+			},
+			"thai_stop":                  map[string]interface{}{"type": "stop", "stopwords": "_thai_"},
+			"english_stop":               map[string]interface{}{"type": "stop", "stopwords": "_english_"},
+			"english_stemmer":            map[string]interface{}{"type": "stemmer", "language": "english"},
+			"english_possessive_stemmer": map[string]interface{}{"type": "stemmer", "language": "possessive_english"},
+		},
+		"analyzer": map[string]interface{}{
+			thaiAnalyzer: map[string]interface{}{
+				"type":      "custom",
+				"tokenizer": thaiTokenizer,
+				"filter":    []string{"lowercase", "decimal_digit", "search_synonyms", "thai_stop"},
+			},
+			englishAnalyzer: map[string]interface{}{
+				"type":      "custom",
+				"tokenizer": "standard",
+				"filter":    []string{"english_possessive_stemmer", "lowercase", "search_synonyms", "english_stop", "english_stemmer"},
+			},
+		},
+	}
+}
+
+// EnsureSearchIndices brings the indices of events, jobs and organizations to their current version
+func EnsureSearchIndices(client *opensearch.Client) error {
+	for _, index := range []indexDefinition{eventIndex, jobIndex, organizationIndex} {
+		if err := ensureIndexExists(client, index); err != nil {
+			return fmt.Errorf("error ensuring index '%s' exists: %v", index.Name, err)
+		}
+	}
+
+	return nil
+}
+
+func ensureIndexExists(client *opensearch.Client, index indexDefinition) error {
+	versioned := index.versionedName()
+
+	exists, err := indexExists(client, versioned)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		if err := createIndex(client, index); err != nil {
+			return err
+		}
+	}
+
+	aliased, err := aliasedIndices(client, index.Name)
+	if err != nil {
+		return err
+	}
+	if len(aliased) == 1 && aliased[0] == versioned {
+		return nil
+	}
+
+	// The documents of the previous version, the alias does not exist yet when the previous index was
+	// created before the indices were versioned and has the name of the alias
+	previous, err := indexExists(client, index.Name)
+	if err != nil {
+		return err
+	}
+	if previous {
+		if err := copyDocuments(client, index.Name, versioned); err != nil {
+			return err
+		}
+	}
+
+	replaced := aliased
+	if previous && len(aliased) == 0 {
+		replaced = []string{index.Name}
+	}
+
+	return moveAlias(client, index, replaced)
+}
+
+func indexExists(client *opensearch.Client, name string) (bool, error) {
+	res, err := client.Indices.Exists([]string{name})
+	if err != nil {
+		return false, fmt.Errorf("error checking index existence: %v", err)
+	}
+	defer res.Body.Close()
+
+	return res.StatusCode != 404, nil
+}
+
+func createIndex(client *opensearch.Client, index indexDefinition) error {
+	versioned := index.versionedName()
+	logs.Info(fmt.Sprintf("Index '%s' does not exist, creating it now...", versioned))
+
+	body, _ := json.Marshal(map[string]interface{}{
+		"settings": map[string]interface{}{
+			"number_of_shards":   1,
+			"number_of_replicas": 1,
+			"analysis":           analysisSettings(thaiTokenizer(client)),
+		},
+		"mappings": map[string]interface{}{
+			"properties": index.Properties,
+		},
+	})
+
+	res, err := client.Indices.Create(versioned, client.Indices.Create.WithBody(bytes.NewReader(body)))
+	if err != nil {
+		return fmt.Errorf("error creating index: %v", err)
+	}
+	defer res.Body.Close()
+
+	// Another instance may have created it in the meantime
+	if res.IsError() && !strings.Contains(res.String(), "resource_already_exists_exception") {
+		return fmt.Errorf("error creating index: %s", res.String())
+	}
+
+	logs.Info(fmt.Sprintf("Successfully created index '%s'", versioned))
+	return nil
+}
+
+// thaiTokenizer is the ICU tokenizer when the plugin is installed on the cluster
+func thaiTokenizer(client *opensearch.Client) string {
+	res, err := client.Cat.Plugins(client.Cat.Plugins.WithFormat("json"))
+	if err != nil {
+		logs.Warn(fmt.Sprintf("Cannot list the plugins of the cluster, using the thai tokenizer: %v", err))
+		return "thai"
+	}
+	defer res.Body.Close()
+
+	var plugins []struct {
+		Component string `json:"component"`
+	}
+	if res.IsError() || json.NewDecoder(res.Body).Decode(&plugins) != nil {
+		return "thai"
+	}
+
+	for _, plugin := range plugins {
+		if plugin.Component == icuPlugin {
+			return "icu_tokenizer"
+		}
+	}
+
+	return "thai"
+}
+
+// aliasedIndices are the indices the alias points to, none when the alias does not exist
+func aliasedIndices(client *opensearch.Client, alias string) ([]string, error) {
+	res, err := client.Indices.GetAlias(client.Indices.GetAlias.WithName(alias))
+	if err != nil {
+		return nil, fmt.Errorf("error getting alias: %v", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode == 404 {
+		return nil, nil
+	}
+	if res.IsError() {
+		return nil, fmt.Errorf("error getting alias: %s", res.String())
+	}
+
+	var indices map[string]interface{}
+	if err := json.NewDecoder(res.Body).Decode(&indices); err != nil {
+		return nil, fmt.Errorf("error decoding alias: %v", err)
+	}
+
+	names := make([]string, 0, len(indices))
+	for name := range indices {
+		names = append(names, name)
+	}
+
+	return names, nil
+}
+
+func copyDocuments(client *opensearch.Client, from string, to string) error {
+	logs.Info(fmt.Sprintf("Copying the documents of '%s' to '%s'", from, to))
+
+	body, _ := json.Marshal(map[string]interface{}{
+		"source": map[string]interface{}{"index": from},
+		"dest":   map[string]interface{}{"index": to},
+	})
+
+	res, err := client.Reindex(bytes.NewReader(body), client.Reindex.WithWaitForCompletion(true), client.Reindex.WithRefresh(true))
+	if err != nil {
+		return fmt.Errorf("error copying documents: %v", err)
+	}
+	defer res.Body.Close()
+
+	if res.IsError() {
+		return fmt.Errorf("error copying documents: %s", res.String())
+	}
+
+	return nil
+}
+
+// moveAlias points the alias to the current version and removes the indices it replaces, in a single
+// atomic change so that searches never see a missing index
+func moveAlias(client *opensearch.Client, index indexDefinition, replaced []string) error {
+	versioned := index.versionedName()
+
+	actions := []map[string]interface{}{
+		{"add": map[string]interface{}{"index": versioned, "alias": index.Name}},
+	}
+	for _, name := range replaced {
+		if name != versioned {
+			actions = append(actions, map[string]interface{}{"remove_index": map[string]interface{}{"index": name}})
+		}
+	}
+
+	body, _ := json.Marshal(map[string]interface{}{"actions": actions})
+
+	res, err := client.Indices.UpdateAliases(bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("error updating alias: %v", err)
+	}
+	defer res.Body.Close()
+
+	if res.IsError() {
+		return fmt.Errorf("error updating alias: %s", res.String())
+	}
+
+	logs.Info(fmt.Sprintf("Index '%s' now points to '%s'", index.Name, versioned))
+	return nil
+}
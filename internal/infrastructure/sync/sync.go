@@ -8,188 +8,536 @@ import (
 
 	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/models"
 	"github.com/DAF-Bridge/Talent-Atmos-Backend/logs"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/pkg/richtext"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/utils"
 	"github.com/opensearch-project/opensearch-go"
 	"gorm.io/gorm"
 )
 
 func SyncEventsToOpenSearch(db *gorm.DB, client *opensearch.Client) error {
+	if err := ensureIndexExists(client, eventIndex); err != nil {
+		logs.Error(fmt.Sprintf("Error ensuring index exists: %v", err))
+		return err
+	}
+
 	var events []models.Event
-	if err := db.Preload("Organization").Preload("Categories").Find(&events).Error; err != nil {
+	if err := eventsForIndex(db).Find(&events).Error; err != nil {
 		return fmt.Errorf("failed to fetch events: %v", err)
 	}
 
 	for _, event := range events {
-		var categories []dto.CategoryRequest
-		for _, category := range event.Categories {
-			categories = append(categories, dto.CategoryRequest{
-				Value: category.ID,
-				Label: category.Name,
-			})
+		if err := indexEvent(client, event); err != nil {
+			logs.Error(fmt.Sprintf("Error indexing event %d: %v", event.ID, err))
 		}
+	}
+
+	return nil
+}
+
+// IndexOrganization keeps the search index up to date when the organization is suspended,
+// the organization, its events and jobs are indexed again or dropped
+func IndexOrganization(db *gorm.DB, client *opensearch.Client, orgID uint) error {
+	if err := ensureIndexExists(client, organizationIndex); err != nil {
+		return err
+	}
 
-		org := dto.OrganizationShortDocument{
-			ID:     event.Organization.ID,
-			Name:   event.Organization.Name,
-			PicUrl: event.Organization.PicUrl,
+	var org models.Organization
+	if err := db.Preload("Translations").Where("id = ?", orgID).First(&org).Error; err != nil {
+		return fmt.Errorf("failed to fetch organization %d: %v", orgID, err)
+	}
+
+	if err := indexOrganization(client, org); err != nil {
+		logs.Error(fmt.Sprintf("Error indexing organization %d: %v", org.ID, err))
+	}
+
+	if err := ensureIndexExists(client, eventIndex); err != nil {
+		return err
+	}
+
+	var events []models.Event
+	if err := eventsForIndex(db).Where("organization_id = ?", orgID).Find(&events).Error; err != nil {
+		return fmt.Errorf("failed to fetch events of organization %d: %v", orgID, err)
+	}
+
+	for _, event := range events {
+		if err := indexEvent(client, event); err != nil {
+			logs.Error(fmt.Sprintf("Error indexing event %d: %v", event.ID, err))
 		}
+	}
+
+	if err := ensureIndexExists(client, jobIndex); err != nil {
+		return err
+	}
+
+	var jobs []models.OrgOpenJob
+	if err := jobsForIndex(db).Where("organization_id = ?", orgID).Find(&jobs).Error; err != nil {
+		return fmt.Errorf("failed to fetch jobs of organization %d: %v", orgID, err)
+	}
 
-		endDate := ""
-		if !event.EndDate.Time.IsZero() {
-			endDate = event.EndDate.Format("2006-01-02")
+	for _, job := range jobs {
+		if err := indexJob(client, job); err != nil {
+			logs.Error(fmt.Sprintf("Error indexing job %d: %v", job.ID, err))
 		}
+	}
 
-		doc := dto.EventDocument{
-			ID:           event.ID,
-			Name:         event.Name,
-			PicUrl:       event.PicUrl,
-			Content:      event.Content,
-			Latitude:     event.Latitude,
-			Longitude:    event.Longitude,
-			StartDate:    event.StartDate.Format("2006-01-02"),
-			EndDate:      endDate,
-			StartTime:    event.StartTime.Format("15:04:05"),
-			EndTime:      event.EndTime.Format("15:04:05"),
-			LocationName: event.LocationName,
-			Province:     event.Province,
-			Country:      event.Country,
-			LocationType: event.LocationType,
-			Audience:     event.Audience,
-			Price:        event.PriceType,
-			Categories:   categories,
-			Organization: org,
-			UpdateAt:     event.UpdatedAt.Format("2006-01-02 15:04:05"),
+	return nil
+}
+
+// IndexEvent keeps the search index up to date when the hosts of a single event change or it is moderated
+func IndexEvent(db *gorm.DB, client *opensearch.Client, eventID uint) error {
+	if err := ensureIndexExists(client, eventIndex); err != nil {
+		return err
+	}
+
+	var event models.Event
+	if err := eventsForIndex(db).Where("id = ?", eventID).First(&event).Error; err != nil {
+		return fmt.Errorf("failed to fetch event %d: %v", eventID, err)
+	}
+
+	return indexEvent(client, event)
+}
+
+// eventsForIndex loads events with what their search document shows
+func eventsForIndex(db *gorm.DB) *gorm.DB {
+	return db.Preload("Organization").
+		Preload("Categories.Translations").
+		Preload("Translations").
+		Preload("Sessions", "is_cancelled = ?", false).
+		Preload("CoHosts", "status = ?", models.CoHostAccepted).
+		Preload("CoHosts.Organization")
+}
+
+func buildEventDocument(event models.Event) dto.EventDocument {
+	translations := make(map[string]dto.EventTranslationDocument, len(event.Translations))
+	for _, t := range event.Translations {
+		translations[t.Locale] = dto.EventTranslationDocument{
+			Name:    t.Name,
+			Content: richtext.PlainText(richtext.Rendered(t.Content, t.ContentHTML, event.ContentFormat)),
 		}
+	}
 
-		jsonData, _ := json.Marshal(doc)
-		req := bytes.NewReader(jsonData)
+	org := dto.OrganizationShortDocument{
+		ID:     event.Organization.ID,
+		Name:   event.Organization.Name,
+		PicUrl: event.Organization.PicUrl,
+	}
 
-		res, err := client.Index("events", req, client.Index.WithDocumentID(fmt.Sprintf("%d", event.ID)))
-		if err != nil {
-			logs.Error(fmt.Sprintf("Error indexing event %d: %v", event.ID, err))
-			continue
+	coHosts := dto.BuildOrganizationShortDocuments(event.CoHosts)
+	orgIDs := []uint{event.OrganizationID}
+	for _, coHost := range coHosts {
+		orgIDs = append(orgIDs, coHost.ID)
+	}
+
+	sessions := make([]dto.EventSessionDocument, 0, len(event.Sessions))
+	for _, session := range event.Sessions {
+		sessions = append(sessions, dto.EventSessionDocument{
+			ID:           session.ID,
+			Title:        session.Title,
+			StartDate:    session.StartDate.Format("2006-01-02"),
+			StartTime:    session.StartTime.Format("15:04:05"),
+			StartAt:      utils.FormatUTC(session.StartAt),
+			EndAt:        utils.FormatUTC(session.EndAt),
+			LocationName: session.LocationName,
+			Latitude:     session.Latitude,
+			Longitude:    session.Longitude,
+			Province:     session.Province,
+		})
+	}
+
+	endDate := ""
+	if !event.EndDate.Time.IsZero() {
+		endDate = event.EndDate.Format("2006-01-02")
+	}
+
+	return dto.EventDocument{
+		ID:              event.ID,
+		Name:            event.Name,
+		PicUrl:          event.PicUrl,
+		Content:         richtext.PlainText(richtext.Rendered(event.Content, event.ContentHTML, event.ContentFormat)),
+		Latitude:        event.Latitude,
+		Longitude:       event.Longitude,
+		StartDate:       event.StartDate.Format("2006-01-02"),
+		EndDate:         endDate,
+		StartTime:       event.StartTime.Format("15:04:05"),
+		EndTime:         event.EndTime.Format("15:04:05"),
+		Timezone:        event.Timezone,
+		StartAt:         utils.FormatUTC(event.StartAt),
+		EndAt:           utils.FormatUTC(event.EndAt),
+		LocationName:    event.LocationName,
+		Province:        event.Province,
+		Country:         event.Country,
+		LocationType:    event.LocationType,
+		Audience:        event.Audience,
+		Price:           event.PriceType,
+		Categories:      dto.BuildListCategoryResponses(event.Categories),
+		Organization:    org,
+		CoHosts:         coHosts,
+		OrganizationIDs: orgIDs,
+		Sessions:        sessions,
+		Translations:    translations,
+		UpdateAt:        event.UpdatedAt.Format("2006-01-02 15:04:05"),
+	}
+}
+
+// indexEvent drops the events hidden by moderation or of suspended organizations from the index
+func indexEvent(client *opensearch.Client, event models.Event) error {
+	if event.Hidden || event.Organization.Suspended {
+		return deleteDocument(client, "events", event.ID)
+	}
+
+	jsonData, _ := json.Marshal(buildEventDocument(event))
+
+	res, err := client.Index("events", bytes.NewReader(jsonData), client.Index.WithDocumentID(fmt.Sprintf("%d", event.ID)))
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.IsError() {
+		return fmt.Errorf("error indexing event %d: %s", event.ID, res.String())
+	}
+
+	logs.Info(fmt.Sprintf("Indexed event %d", event.ID))
+	return nil
+}
+
+func SyncOrganizationsToOpenSearch(db *gorm.DB, client *opensearch.Client) error {
+	if err := ensureIndexExists(client, organizationIndex); err != nil {
+		logs.Error(fmt.Sprintf("Error ensuring index exists: %v", err))
+		return err
+	}
+
+	var orgs []models.Organization
+	if err := db.Preload("Translations").Find(&orgs).Error; err != nil {
+		return fmt.Errorf("failed to fetch organizations: %v", err)
+	}
+
+	for _, org := range orgs {
+		if err := indexOrganization(client, org); err != nil {
+			logs.Error(fmt.Sprintf("Error indexing organization %d: %v", org.ID, err))
 		}
-		defer res.Body.Close()
-		logs.Info(fmt.Sprintf("Indexed event %d", event.ID))
 	}
 
 	return nil
 }
 
+func buildOrganizationDocument(org models.Organization) dto.OrganizationDocument {
+	translations := make(map[string]dto.OrganizationTranslationDocument, len(org.Translations))
+	for _, t := range org.Translations {
+		translations[t.Locale] = dto.OrganizationTranslationDocument{
+			Name:        t.Name,
+			Description: richtext.PlainText(richtext.Rendered(t.Description, t.DescriptionHTML, org.DescriptionFormat)),
+		}
+	}
+
+	return dto.OrganizationDocument{
+		ID:           org.ID,
+		Name:         org.Name,
+		PicUrl:       org.PicUrl,
+		Description:  richtext.PlainText(richtext.Rendered(org.Description, org.DescriptionHTML, org.DescriptionFormat)),
+		Latitude:     org.Latitude,
+		Longitude:    org.Longitude,
+		Province:     org.Province,
+		Country:      org.Country,
+		Email:        org.Email,
+		Phone:        org.Phone,
+		Translations: translations,
+		UpdateAt:     org.UpdatedAt.Format("2006-01-02 15:04:05"),
+	}
+}
+
+// indexOrganization drops the suspended organizations from the index
+func indexOrganization(client *opensearch.Client, org models.Organization) error {
+	if org.Suspended {
+		return deleteDocument(client, "organizations", org.ID)
+	}
+
+	jsonData, _ := json.Marshal(buildOrganizationDocument(org))
+
+	res, err := client.Index("organizations", bytes.NewReader(jsonData), client.Index.WithDocumentID(fmt.Sprintf("%d", org.ID)))
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.IsError() {
+		return fmt.Errorf("error indexing organization %d: %s", org.ID, res.String())
+	}
+
+	logs.Info(fmt.Sprintf("Indexed organization %d", org.ID))
+	return nil
+}
+
 func SyncJobsToOpenSearch(db *gorm.DB, client *opensearch.Client) error {
-	if err := ensureJobIndexExists(client); err != nil {
+	if err := ensureIndexExists(client, jobIndex); err != nil {
 		logs.Error(fmt.Sprintf("Error ensuring index exists: %v", err))
 		return err
 	}
 
 	var jobs []models.OrgOpenJob
-	if err := db.Preload("Organization").Preload("Prerequisites").Preload("Categories").Find(&jobs).Error; err != nil {
+	if err := jobsForIndex(db).Find(&jobs).Error; err != nil {
 		return fmt.Errorf("failed to fetch jobs: %v", err)
 	}
 
 	for _, job := range jobs {
-		var categories []dto.CategoryRequest
-		for _, category := range job.Categories {
-			categories = append(categories, dto.CategoryRequest{
-				Value: category.ID,
-				Label: category.Name,
-			})
+		if err := indexJob(client, job); err != nil {
+			logs.Error(fmt.Sprintf("Error indexing job %d: %v", job.ID, err))
 		}
+	}
 
-		var prerequisites []dto.PrerequisiteRequest
-		for _, p := range job.Prerequisites {
-			prerequisites = append(prerequisites, dto.PrerequisiteRequest{
-				Title: p.Title,
-				Link:  p.Link,
-			})
-		}
+	return nil
+}
 
-		doc := dto.JobDocument{
-			ID:            job.ID,
-			Title:         job.Title,
-			Prerequisites: prerequisites,
-			Description:   job.Description,
-			WorkType:      string(job.WorkType),
-			Workplace:     string(job.Workplace),
-			CareerStage:   string(job.CareerStage),
-			Salary:        job.Salary,
-			Categories:    categories,
-			Organization: dto.OrganizationShortDocument{
-				ID:     uint(job.Organization.ID),
-				Name:   string(job.Organization.Name),
-				PicUrl: string(job.Organization.PicUrl),
-			},
-			Province: string(job.Province),
-			Country:  job.Country,
-			UpdateAt: job.UpdatedAt.Format("2006-01-02 15:04:05"),
+// IndexJob keeps the search index up to date when a single job is moderated
+func IndexJob(db *gorm.DB, client *opensearch.Client, jobID uint) error {
+	if err := ensureIndexExists(client, jobIndex); err != nil {
+		return err
+	}
+
+	var job models.OrgOpenJob
+	if err := jobsForIndex(db).Where("id = ?", jobID).First(&job).Error; err != nil {
+		return fmt.Errorf("failed to fetch job %d: %v", jobID, err)
+	}
+
+	return indexJob(client, job)
+}
+
+// jobsForIndex loads jobs with what their search document shows
+func jobsForIndex(db *gorm.DB) *gorm.DB {
+	return db.Preload("Organization").Preload("Prerequisites").Preload("Categories.Translations").Preload("Skills").Preload("Translations")
+}
+
+func buildJobDocument(job models.OrgOpenJob) dto.JobDocument {
+	translations := make(map[string]dto.JobTranslationDocument, len(job.Translations))
+	for _, t := range job.Translations {
+		translations[t.Locale] = dto.JobTranslationDocument{
+			Title:       t.Title,
+			Description: richtext.PlainText(richtext.Rendered(t.Description, t.DescriptionHTML, job.TextFormat)),
 		}
+	}
 
-		jsonData, _ := json.Marshal(doc)
-		req := bytes.NewReader(jsonData)
+	var prerequisites []dto.PrerequisiteRequest
+	for _, p := range job.Prerequisites {
+		prerequisites = append(prerequisites, dto.PrerequisiteRequest{
+			Title: p.Title,
+			Link:  p.Link,
+		})
+	}
 
-		res, err := client.Index("jobs", req, client.Index.WithDocumentID(fmt.Sprintf("%d", job.ID)))
+	doc := dto.JobDocument{
+		ID:               job.ID,
+		Title:            job.Title,
+		Prerequisites:    prerequisites,
+		Description:      richtext.PlainText(richtext.Rendered(job.Description, job.DescriptionHTML, job.TextFormat)),
+		WorkType:         string(job.WorkType),
+		Workplace:        string(job.Workplace),
+		CareerStage:      string(job.CareerStage),
+		SalaryCurrency:   job.SalaryCurrency,
+		SalaryPeriod:     string(job.SalaryPeriod),
+		SalaryNegotiable: job.SalaryNegotiable,
+		SalaryHidden:     job.SalaryHidden,
+		Categories:       dto.BuildListCategoryResponses(job.Categories),
+		Skills:           dto.BuildListDTO(job.Skills, dto.BuildSkillShortResponse),
+		Organization: dto.OrganizationShortDocument{
+			ID:     uint(job.Organization.ID),
+			Name:   string(job.Organization.Name),
+			PicUrl: string(job.Organization.PicUrl),
+		},
+		Province:     string(job.Province),
+		Country:      job.Country,
+		Translations: translations,
+		UpdateAt:     job.UpdatedAt.Format("2006-01-02 15:04:05"),
+	}
 
-		if err != nil {
-			logs.Error(fmt.Sprintf("Error indexing job %d: %v", job.ID, err))
-			continue
+	// Hidden salaries are neither shown in search results nor usable for range filtering
+	if !job.SalaryHidden {
+		doc.SalaryMin = job.SalaryMin
+		doc.SalaryMax = job.SalaryMax
+		setMonthlyTHBSalary(&doc, job)
+	}
+
+	return doc
+}
+
+// indexJob drops the jobs hidden by moderation or of suspended organizations from the index
+func indexJob(client *opensearch.Client, job models.OrgOpenJob) error {
+	if job.Hidden || job.Organization.Suspended {
+		return deleteDocument(client, "jobs", job.ID)
+	}
+
+	jsonData, _ := json.Marshal(buildJobDocument(job))
+
+	res, err := client.Index("jobs", bytes.NewReader(jsonData), client.Index.WithDocumentID(fmt.Sprintf("%d", job.ID)))
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.IsError() {
+		return fmt.Errorf("error indexing job %d: %s", job.ID, res.String())
+	}
+
+	logs.Info(fmt.Sprintf("Indexed job %d", job.ID))
+	return nil
+}
+
+// setMonthlyTHBSalary fills the normalized salary fields so that jobs paid per hour, month or year
+// in different currencies can be compared by a single range filter.
+func setMonthlyTHBSalary(doc *dto.JobDocument, job models.OrgOpenJob) {
+	if job.SalaryMin == 0 && job.SalaryMax == 0 {
+		return
+	}
+
+	salaryMin, salaryMax := job.SalaryMin, job.SalaryMax
+	if salaryMax == 0 {
+		// open-ended range, e.g. "from 30,000"
+		salaryMax = salaryMin
+	}
+	if salaryMin == 0 {
+		salaryMin = salaryMax
+	}
+
+	minTHB, ok := utils.SalaryToMonthlyTHB(salaryMin, job.SalaryCurrency, string(job.SalaryPeriod))
+	if !ok {
+		logs.Warn(fmt.Sprintf("Cannot normalize salary of job %d (%s per %s)", job.ID, job.SalaryCurrency, job.SalaryPeriod))
+		return
+	}
+	maxTHB, _ := utils.SalaryToMonthlyTHB(salaryMax, job.SalaryCurrency, string(job.SalaryPeriod))
+
+	doc.SalaryMinMonthlyTHB = &minTHB
+	doc.SalaryMaxMonthlyTHB = &maxTHB
+}
+
+// SyncTalentsToOpenSearch indexes every public profile and drops the profiles that are no longer public
+func SyncTalentsToOpenSearch(db *gorm.DB, client *opensearch.Client) error {
+	if err := ensureTalentIndexExists(client); err != nil {
+		logs.Error(fmt.Sprintf("Error ensuring index exists: %v", err))
+		return err
+	}
+
+	var profiles []models.Profile
+	if err := db.Preload("Skills").Where("is_public = ?", true).Find(&profiles).Error; err != nil {
+		return fmt.Errorf("failed to fetch profiles: %v", err)
+	}
+
+	ids := make([]string, 0, len(profiles))
+	for _, profile := range profiles {
+		ids = append(ids, fmt.Sprintf("%d", profile.ID))
+		if err := indexTalent(client, profile); err != nil {
+			logs.Error(fmt.Sprintf("Error indexing talent %d: %v", profile.ID, err))
 		}
-		defer res.Body.Close()
-		logs.Info(fmt.Sprintf("Indexed job %d", job.ID))
 	}
 
+	query, _ := json.Marshal(map[string]interface{}{
+		"query": map[string]interface{}{
+			"bool": map[string]interface{}{
+				"must_not": map[string]interface{}{
+					"ids": map[string]interface{}{"values": ids},
+				},
+			},
+		},
+	})
+
+	res, err := client.DeleteByQuery([]string{"talents"}, bytes.NewReader(query))
+	if err != nil {
+		return fmt.Errorf("error removing private profiles: %v", err)
+	}
+	defer res.Body.Close()
+
+	return nil
+}
+
+// IndexTalentProfile keeps the search index up to date when a single profile changes
+func IndexTalentProfile(client *opensearch.Client, profile models.Profile) error {
+	if err := ensureTalentIndexExists(client); err != nil {
+		return err
+	}
+
+	return indexTalent(client, profile)
+}
+
+func indexTalent(client *opensearch.Client, profile models.Profile) error {
+	jsonData, _ := json.Marshal(dto.BuildTalentDocument(profile))
+
+	res, err := client.Index("talents", bytes.NewReader(jsonData), client.Index.WithDocumentID(fmt.Sprintf("%d", profile.ID)))
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.IsError() {
+		return fmt.Errorf("error indexing talent %d: %s", profile.ID, res.String())
+	}
+
+	logs.Info(fmt.Sprintf("Indexed talent %d", profile.ID))
 	return nil
 }
 
-func ensureJobIndexExists(client *opensearch.Client) error {
-	res, err := client.Indices.Exists([]string{"jobs"})
+func DeleteTalentProfile(client *opensearch.Client, profileID uint) error {
+	return deleteDocument(client, "talents", profileID)
+}
+
+func deleteDocument(client *opensearch.Client, index string, id uint) error {
+	res, err := client.Delete(index, fmt.Sprintf("%d", id))
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	// the document may never have been indexed
+	if res.IsError() && res.StatusCode != 404 {
+		return fmt.Errorf("error removing %d from %s: %s", id, index, res.String())
+	}
+
+	return nil
+}
+
+func ensureTalentIndexExists(client *opensearch.Client) error {
+	res, err := client.Indices.Exists([]string{"talents"})
 	if err != nil {
 		return fmt.Errorf("error checking index existence: %v", err)
 	}
 	defer res.Body.Close()
 
-	if res.StatusCode == 404 {
-		logs.Info("Index 'jobs' does not exist, creating it now...")
+	if res.StatusCode != 404 {
+		return nil
+	}
 
-		// Define index settings and mappings
-		createIndex := `{
-			"settings": { 
-				"number_of_shards": 1, 
-				"number_of_replicas": 1 
-			},
-			"mappings": {
-				"properties": {
-					"ID": { "type": "integer" },
-					"Title": { "type": "text" },
-					"PicUrl": { "type": "text" },
-					"Description": { "type": "text" },
-					"Prerequisites": { "type": "text" },
-					"WorkType": { "type": "keyword" },
-					"Workplace": { "type": "keyword" },
-					"CareerStage": { "type": "keyword" },
-					"Salary": { "type": "integer" },
-					"categoryData": { "type": "keyword" },
-					"Organization": {
-						"type": "object",
-						"properties": {
-							"ID": { "type": "integer" },
-							"Name": { "type": "text" },
-							"PicUrl": { "type": "text" }
-						}
-					},
-					"Province": { "type": "keyword" },
-					"Country": { "type": "keyword" },
-					"UpdateAt": { "type": "date", "format": "yyyy-MM-dd HH:mm:ss" }
-				}
-			}
-		}`
+	logs.Info("Index 'talents' does not exist, creating it now...")
 
-		req := bytes.NewReader([]byte(createIndex))
-		createRes, err := client.Indices.Create("jobs", client.Indices.Create.WithBody(req))
-		if err != nil {
-			return fmt.Errorf("error creating index: %v", err)
+	createIndex := `{
+		"settings": {
+			"number_of_shards": 1,
+			"number_of_replicas": 1
+		},
+		"mappings": {
+			"properties": {
+				"id": { "type": "integer" },
+				"slug": { "type": "keyword" },
+				"firstName": { "type": "text" },
+				"lastName": { "type": "text" },
+				"picUrl": { "type": "text", "index": false },
+				"headline": { "type": "text" },
+				"focusField": { "type": "text", "fields": { "keyword": { "type": "keyword" } } },
+				"province": { "type": "text", "fields": { "keyword": { "type": "keyword" } } },
+				"skills": {
+					"properties": {
+						"id": { "type": "integer" },
+						"name": { "type": "text", "fields": { "keyword": { "type": "keyword" } } },
+						"slug": { "type": "keyword" }
+					}
+				},
+				"updatedAt": { "type": "date", "format": "yyyy-MM-dd HH:mm:ss" }
+			}
 		}
-		defer createRes.Body.Close()
+	}`
 
-		logs.Info("Successfully created index 'jobs'")
+	createRes, err := client.Indices.Create("talents", client.Indices.Create.WithBody(bytes.NewReader([]byte(createIndex))))
+	if err != nil {
+		return fmt.Errorf("error creating index: %v", err)
 	}
+	defer createRes.Body.Close()
+
+	logs.Info("Successfully created index 'talents'")
 	return nil
 }
@@ -0,0 +1,133 @@
+package repository
+
+import (
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/models"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/utils"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type calendarRepository struct {
+	db *gorm.DB
+}
+
+func NewCalendarRepository(db *gorm.DB) models.CalendarRepository {
+	return calendarRepository{db: db}
+}
+
+func (r calendarRepository) SaveEvent(userID uuid.UUID, eventID uint) error {
+	savedEvent := models.SavedEvent{UserID: userID, EventID: eventID}
+	return r.db.
+		Where("user_id = ? AND event_id = ?", userID, eventID).
+		FirstOrCreate(&savedEvent).Error
+}
+
+func (r calendarRepository) UnsaveEvent(userID uuid.UUID, eventID uint) error {
+	result := r.db.Unscoped().
+		Where("user_id = ? AND event_id = ?", userID, eventID).
+		Delete(&models.SavedEvent{})
+	return utils.GormErrorAndRowsAffected(result)
+}
+
+func (r calendarRepository) ListSavedEvents(userID uuid.UUID) ([]models.Event, error) {
+	var events []models.Event
+	if err := r.db.
+		Preload("Organization").
+		Preload("Sessions", orderSessions).
+		Preload("Categories").
+		Preload("ContactChannels").
+		Joins("JOIN saved_events ON saved_events.event_id = events.id AND saved_events.deleted_at IS NULL").
+		Where("saved_events.user_id = ?", userID).
+		Order("events.start_date asc, events.start_time asc").
+		Find(&events).Error; err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
+
+func (r calendarRepository) GetOrCreateFeed(userID uuid.UUID) (*models.CalendarFeed, error) {
+	feed := &models.CalendarFeed{}
+	if err := r.db.
+		Where("user_id = ?", userID).
+		Attrs(models.CalendarFeed{Token: uuid.New()}).
+		FirstOrCreate(feed, models.CalendarFeed{UserID: userID}).Error; err != nil {
+		return nil, err
+	}
+
+	return feed, nil
+}
+
+// ResetFeedToken replaces the token, the previous feed URL stops working.
+func (r calendarRepository) ResetFeedToken(userID uuid.UUID) (*models.CalendarFeed, error) {
+	tx := r.db.Begin()
+
+	feed := &models.CalendarFeed{}
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("user_id = ?", userID).
+		Attrs(models.CalendarFeed{Token: uuid.New()}).
+		FirstOrCreate(feed, models.CalendarFeed{UserID: userID}).Error; err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	token := uuid.New()
+	if err := tx.Model(feed).Update("token", token).Error; err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	feed.Token = token
+
+	if err := tx.Commit().Error; err != nil {
+		return nil, err
+	}
+
+	return feed, nil
+}
+
+func (r calendarRepository) GetFeedByToken(token uuid.UUID) (*models.CalendarFeed, error) {
+	feed := &models.CalendarFeed{}
+	if err := r.db.Where("token = ?", token).First(feed).Error; err != nil {
+		return nil, err
+	}
+
+	return feed, nil
+}
+
+func (r calendarRepository) ListPublishedByOrgID(orgID uint) ([]models.Event, error) {
+	var events []models.Event
+	if err := r.db.
+		Preload("Organization").
+		Preload("Sessions", orderSessions).
+		Where("organization_id = ? AND status = ?", orgID, models.Published).
+		Order("start_date asc, start_time asc").
+		Find(&events).Error; err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
+
+func (r calendarRepository) ListUserEvents(userID uuid.UUID) ([]models.Event, error) {
+	registered := r.db.Model(&models.TicketPurchased{}).
+		Select("event_id").
+		Where("user_id = ? AND status <> ?", userID, models.TicketStatusCancelled)
+	saved := r.db.Model(&models.SavedEvent{}).
+		Select("event_id").
+		Where("user_id = ?", userID)
+
+	var events []models.Event
+	if err := r.db.
+		Preload("Organization").
+		Preload("Sessions", orderSessions).
+		Where(r.db.
+			Where("id IN (?)", registered).
+			Or(r.db.Where("id IN (?)", saved).Where("status = ?", models.Published))).
+		Order("start_date asc, start_time asc").
+		Find(&events).Error; err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
@@ -0,0 +1,175 @@
+package repository
+
+import (
+	"errors"
+	"time"
+
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/models"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/utils"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type certificateRepository struct {
+	db *gorm.DB
+}
+
+func NewCertificateRepository(db *gorm.DB) models.CertificateRepository {
+	return &certificateRepository{db: db}
+}
+
+// orderFields lists preloaded certificate fields in their order
+func orderFields(db *gorm.DB) *gorm.DB {
+	return db.Order("position asc, id asc")
+}
+
+func (r certificateRepository) GetTemplate(eventID uint) (*models.CertificateTemplate, error) {
+	template := &models.CertificateTemplate{}
+	if err := r.db.
+		Preload("Fields", orderFields).
+		Where("event_id = ?", eventID).
+		First(template).Error; err != nil {
+		return nil, err
+	}
+
+	return template, nil
+}
+
+func (r certificateRepository) SaveTemplate(template *models.CertificateTemplate) error {
+	tx := r.db.Begin()
+
+	var existing models.CertificateTemplate
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("event_id = ?", template.EventID).First(&existing).Error
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		tx.Rollback()
+		return err
+	}
+
+	if err == nil {
+		if err := tx.Unscoped().Where("template_id = ?", existing.ID).Delete(&models.CertificateField{}).Error; err != nil {
+			tx.Rollback()
+			return err
+		}
+
+		template.ID = existing.ID
+		if err := tx.Model(&models.CertificateTemplate{}).
+			Where("id = ?", existing.ID).
+			Select("signature_x", "signature_y", "signature_width").
+			Updates(template).Error; err != nil {
+			tx.Rollback()
+			return err
+		}
+
+		for i := range template.Fields {
+			template.Fields[i].TemplateID = existing.ID
+		}
+		if len(template.Fields) > 0 {
+			if err := tx.Create(&template.Fields).Error; err != nil {
+				tx.Rollback()
+				return err
+			}
+		}
+	} else if err := tx.Omit("Event").Create(template).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return err
+	}
+
+	saved, err := r.GetTemplate(template.EventID)
+	if err != nil {
+		return err
+	}
+	*template = *saved
+
+	return nil
+}
+
+func (r certificateRepository) UpdateTemplateImage(eventID uint, image models.CertificateImage, objectKey string, url string) error {
+	columns := map[string]interface{}{"background_object_key": objectKey, "background_url": url}
+	if image == models.CertificateSignature {
+		columns = map[string]interface{}{"signature_object_key": objectKey, "signature_url": url}
+	}
+
+	result := r.db.Model(&models.CertificateTemplate{}).Where("event_id = ?", eventID).Updates(columns)
+	return utils.GormErrorAndRowsAffected(result)
+}
+
+func (r certificateRepository) ListUncertified(eventID uint) ([]models.EventParticipant, error) {
+	var participants []models.EventParticipant
+	if err := r.db.
+		Preload("User").
+		Where("event_id = ? AND checked_in_at IS NOT NULL", eventID).
+		Where(`NOT EXISTS (SELECT 1 FROM certificates WHERE certificates.event_id = event_participants.event_id
+			AND certificates.user_id = event_participants.user_id AND certificates.deleted_at IS NULL)`).
+		Order("id asc").
+		Find(&participants).Error; err != nil {
+		return nil, err
+	}
+
+	return participants, nil
+}
+
+func (r certificateRepository) Claim(certificate *models.Certificate) (bool, error) {
+	result := r.db.Omit("Event", "User").Clauses(clause.OnConflict{DoNothing: true}).Create(certificate)
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return result.RowsAffected == 1, nil
+}
+
+func (r certificateRepository) Release(certificateID uint) error {
+	return r.db.Unscoped().Where("id = ?", certificateID).Delete(&models.Certificate{}).Error
+}
+
+func (r certificateRepository) UpdateFile(certificateID uint, objectKey string, url string) error {
+	result := r.db.Model(&models.Certificate{}).
+		Where("id = ?", certificateID).
+		Updates(map[string]interface{}{"object_key": objectKey, "file_url": url})
+	return utils.GormErrorAndRowsAffected(result)
+}
+
+func (r certificateRepository) MarkEmailed(certificateID uint, at time.Time) error {
+	result := r.db.Model(&models.Certificate{}).Where("id = ?", certificateID).Update("emailed_at", at)
+	return utils.GormErrorAndRowsAffected(result)
+}
+
+func (r certificateRepository) ListByEventID(eventID uint) ([]models.Certificate, error) {
+	var certificates []models.Certificate
+	if err := r.db.Where("event_id = ?", eventID).Order("issued_at asc, id asc").Find(&certificates).Error; err != nil {
+		return nil, err
+	}
+
+	return certificates, nil
+}
+
+func (r certificateRepository) ListByUserID(userID uuid.UUID) ([]models.Certificate, error) {
+	var certificates []models.Certificate
+	if err := r.db.
+		Preload("Event").
+		Preload("Event.Organization").
+		Where("user_id = ? AND file_url <> ''", userID).
+		Order("issued_at desc, id desc").
+		Find(&certificates).Error; err != nil {
+		return nil, err
+	}
+
+	return certificates, nil
+}
+
+func (r certificateRepository) GetByCode(code string) (*models.Certificate, error) {
+	certificate := &models.Certificate{}
+	if err := r.db.
+		Preload("Event").
+		Preload("Event.Organization").
+		Where("code = ? AND file_url <> ''", code).
+		First(certificate).Error; err != nil {
+		return nil, err
+	}
+
+	return certificate, nil
+}
@@ -0,0 +1,123 @@
+package repository
+
+import (
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/models"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/utils"
+	"gorm.io/gorm"
+)
+
+type discussionRepository struct {
+	db *gorm.DB
+}
+
+func NewDiscussionRepository(db *gorm.DB) models.DiscussionRepository {
+	return &discussionRepository{db: db}
+}
+
+func (r discussionRepository) CreateQuestion(question *models.DiscussionQuestion) error {
+	return r.db.Create(question).Error
+}
+
+func (r discussionRepository) ListQuestions(targetType models.DiscussionTarget, targetID uint, withHidden bool) ([]models.DiscussionQuestion, error) {
+	answers := func(db *gorm.DB) *gorm.DB {
+		if !withHidden {
+			db = db.Where("hidden = ?", false)
+		}
+		return db.Order("created_at asc, id asc")
+	}
+
+	query := r.db.
+		Preload("User").
+		Preload("Answers", answers).
+		Preload("Answers.User").
+		Where("target_type = ? AND target_id = ?", targetType, targetID)
+	if !withHidden {
+		query = query.Where("hidden = ?", false)
+	}
+
+	var questions []models.DiscussionQuestion
+	if err := query.Order("created_at desc, id desc").Find(&questions).Error; err != nil {
+		return nil, err
+	}
+
+	return questions, nil
+}
+
+func (r discussionRepository) ListFAQ(targetType models.DiscussionTarget, targetID uint) ([]models.DiscussionAnswer, error) {
+	questions := r.db.Model(&models.DiscussionQuestion{}).
+		Select("id").
+		Where("target_type = ? AND target_id = ? AND hidden = ?", targetType, targetID, false)
+
+	var answers []models.DiscussionAnswer
+	if err := r.db.
+		Preload("Question").
+		Preload("User").
+		Where("question_id IN (?) AND hidden = ? AND pinned_at IS NOT NULL", questions, false).
+		Order("pinned_at asc, id asc").
+		Find(&answers).Error; err != nil {
+		return nil, err
+	}
+
+	return answers, nil
+}
+
+func (r discussionRepository) GetQuestion(targetType models.DiscussionTarget, targetID uint, id uint) (*models.DiscussionQuestion, error) {
+	var question models.DiscussionQuestion
+	if err := r.db.
+		Preload("User").
+		Where("target_type = ? AND target_id = ? AND id = ?", targetType, targetID, id).
+		First(&question).Error; err != nil {
+		return nil, err
+	}
+
+	return &question, nil
+}
+
+func (r discussionRepository) UpdateQuestion(question *models.DiscussionQuestion) error {
+	// The columns are selected so that false values are saved
+	result := r.db.Model(question).Select("hidden", "locked").Updates(question)
+	return utils.GormErrorAndRowsAffected(result)
+}
+
+func (r discussionRepository) DeleteQuestion(targetType models.DiscussionTarget, targetID uint, id uint) error {
+	tx := r.db.Begin()
+
+	result := tx.Where("target_type = ? AND target_id = ? AND id = ?", targetType, targetID, id).Delete(&models.DiscussionQuestion{})
+	if err := utils.GormErrorAndRowsAffected(result); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := tx.Where("question_id = ?", id).Delete(&models.DiscussionAnswer{}).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit().Error
+}
+
+func (r discussionRepository) CreateAnswer(answer *models.DiscussionAnswer) error {
+	return r.db.Create(answer).Error
+}
+
+func (r discussionRepository) GetAnswer(questionID uint, id uint) (*models.DiscussionAnswer, error) {
+	var answer models.DiscussionAnswer
+	if err := r.db.
+		Preload("User").
+		Where("question_id = ? AND id = ?", questionID, id).
+		First(&answer).Error; err != nil {
+		return nil, err
+	}
+
+	return &answer, nil
+}
+
+func (r discussionRepository) UpdateAnswer(answer *models.DiscussionAnswer) error {
+	result := r.db.Model(answer).Select("hidden", "pinned_at").Updates(answer)
+	return utils.GormErrorAndRowsAffected(result)
+}
+
+func (r discussionRepository) DeleteAnswer(questionID uint, id uint) error {
+	result := r.db.Where("question_id = ? AND id = ?", questionID, id).Delete(&models.DiscussionAnswer{})
+	return utils.GormErrorAndRowsAffected(result)
+}
@@ -0,0 +1,96 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/models"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/utils"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type eventCoHostRepository struct {
+	db *gorm.DB
+}
+
+func NewEventCoHostRepository(db *gorm.DB) models.EventCoHostRepository {
+	return &eventCoHostRepository{db: db}
+}
+
+// hostedBy matches the events the organization created or co-hosts
+func hostedBy(orgID uint) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Where(`(events.organization_id = ? OR EXISTS (SELECT 1 FROM event_co_hosts WHERE event_co_hosts.event_id = events.id
+			AND event_co_hosts.organization_id = ? AND event_co_hosts.status = ? AND event_co_hosts.deleted_at IS NULL))`,
+			orgID, orgID, models.CoHostAccepted)
+	}
+}
+
+// acceptedCoHosts preloads the organizations hosting an event with its organizer
+func acceptedCoHosts(db *gorm.DB) *gorm.DB {
+	return db.Where("status = ?", models.CoHostAccepted).Order("id asc")
+}
+
+func (r eventCoHostRepository) Invite(coHost *models.EventCoHost) (bool, error) {
+	result := r.db.Omit("Event", "Organization").Clauses(clause.OnConflict{DoNothing: true}).Create(coHost)
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return result.RowsAffected == 1, nil
+}
+
+func (r eventCoHostRepository) ListByEventID(eventID uint) ([]models.EventCoHost, error) {
+	var coHosts []models.EventCoHost
+	if err := r.db.
+		Preload("Organization").
+		Where("event_id = ?", eventID).
+		Order("id asc").
+		Find(&coHosts).Error; err != nil {
+		return nil, err
+	}
+
+	return coHosts, nil
+}
+
+func (r eventCoHostRepository) ListInvitations(orgID uint) ([]models.EventCoHost, error) {
+	var coHosts []models.EventCoHost
+	if err := r.db.
+		Preload("Event").
+		Preload("Event.Organization").
+		Where("organization_id = ? AND status = ?", orgID, models.CoHostPending).
+		Order("id desc").
+		Find(&coHosts).Error; err != nil {
+		return nil, err
+	}
+
+	return coHosts, nil
+}
+
+func (r eventCoHostRepository) GetInvitation(orgID uint, coHostID uint) (*models.EventCoHost, error) {
+	coHost := &models.EventCoHost{}
+	if err := r.db.
+		Preload("Event").
+		Preload("Event.Organization").
+		Preload("Organization").
+		Where("id = ? AND organization_id = ?", coHostID, orgID).
+		First(coHost).Error; err != nil {
+		return nil, err
+	}
+
+	return coHost, nil
+}
+
+func (r eventCoHostRepository) Accept(orgID uint, coHostID uint, userID uuid.UUID, at time.Time) error {
+	result := r.db.Model(&models.EventCoHost{}).
+		Where("id = ? AND organization_id = ? AND status = ?", coHostID, orgID, models.CoHostPending).
+		Updates(map[string]interface{}{"status": models.CoHostAccepted, "responded_by": userID, "responded_at": at})
+	return utils.GormErrorAndRowsAffected(result)
+}
+
+func (r eventCoHostRepository) Remove(eventID uint, orgID uint) error {
+	// Removed for good so the organization can be invited again
+	result := r.db.Unscoped().Where("event_id = ? AND organization_id = ?", eventID, orgID).Delete(&models.EventCoHost{})
+	return utils.GormErrorAndRowsAffected(result)
+}
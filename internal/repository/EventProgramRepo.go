@@ -0,0 +1,183 @@
+package repository
+
+import (
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/models"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/utils"
+	"gorm.io/gorm"
+)
+
+type eventProgramRepository struct {
+	db *gorm.DB
+}
+
+func NewEventProgramRepository(db *gorm.DB) models.EventProgramRepository {
+	return &eventProgramRepository{db: db}
+}
+
+// orderAgenda lists preloaded agenda items in their order
+func orderAgenda(db *gorm.DB) *gorm.DB {
+	return db.Order("position asc, id asc")
+}
+
+// orderSpeakers lists preloaded speakers in their order
+func orderSpeakers(db *gorm.DB) *gorm.DB {
+	return db.Order("position asc, id asc")
+}
+
+func (r eventProgramRepository) ReplaceAgenda(eventID uint, items []models.AgendaItem) ([]models.AgendaItem, error) {
+	tx := r.db.Begin()
+
+	var existing []models.AgendaItem
+	if err := tx.Where("event_id = ?", eventID).Find(&existing).Error; err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	for i := range existing {
+		if err := tx.Model(&existing[i]).Association("Speakers").Clear(); err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+	}
+
+	if err := tx.Unscoped().Where("event_id = ?", eventID).Delete(&models.AgendaItem{}).Error; err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	for i := range items {
+		items[i].EventID = eventID
+		items[i].Position = i
+	}
+
+	if len(items) > 0 {
+		// The speakers already exist, only the links to them are created
+		if err := tx.Omit("Speakers.*").Create(&items).Error; err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return nil, err
+	}
+
+	return r.ListAgenda(eventID)
+}
+
+func (r eventProgramRepository) ListAgenda(eventID uint) ([]models.AgendaItem, error) {
+	var items []models.AgendaItem
+	if err := orderAgenda(r.db.
+		Preload("Speakers", orderSpeakers).
+		Where("event_id = ?", eventID)).
+		Find(&items).Error; err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
+
+func (r eventProgramRepository) ListSpeakers(eventID uint) ([]models.EventSpeaker, error) {
+	var speakers []models.EventSpeaker
+	if err := orderSpeakers(r.db.
+		Preload("User").
+		Where("event_id = ?", eventID)).
+		Find(&speakers).Error; err != nil {
+		return nil, err
+	}
+
+	return speakers, nil
+}
+
+func (r eventProgramRepository) FindSpeakers(eventID uint, ids []uint) ([]models.EventSpeaker, error) {
+	var speakers []models.EventSpeaker
+	if err := r.db.Where("event_id = ? AND id IN ?", eventID, ids).Find(&speakers).Error; err != nil {
+		return nil, err
+	}
+
+	return speakers, nil
+}
+
+func (r eventProgramRepository) GetSpeaker(id uint, eventID uint) (*models.EventSpeaker, error) {
+	speaker := &models.EventSpeaker{}
+	if err := r.db.Preload("User").Where("id = ? AND event_id = ?", id, eventID).First(speaker).Error; err != nil {
+		return nil, err
+	}
+
+	return speaker, nil
+}
+
+func (r eventProgramRepository) CreateSpeaker(speaker *models.EventSpeaker) error {
+	if err := r.db.Omit("User").Create(speaker).Error; err != nil {
+		return err
+	}
+
+	return r.db.Preload("User").First(speaker, speaker.ID).Error
+}
+
+func (r eventProgramRepository) UpdateSpeaker(speaker *models.EventSpeaker) (*models.EventSpeaker, error) {
+	result := r.db.Model(&models.EventSpeaker{}).
+		Where("id = ? AND event_id = ?", speaker.ID, speaker.EventID).
+		Select("user_id", "name", "job_title", "company", "bio", "profile_link", "position").
+		Updates(speaker)
+	if err := utils.GormErrorAndRowsAffected(result); err != nil {
+		return nil, err
+	}
+
+	return r.GetSpeaker(speaker.ID, speaker.EventID)
+}
+
+func (r eventProgramRepository) UpdateSpeakerPicture(id uint, eventID uint, picURL string) error {
+	result := r.db.Model(&models.EventSpeaker{}).
+		Where("id = ? AND event_id = ?", id, eventID).
+		Update("pic_url", picURL)
+	return utils.GormErrorAndRowsAffected(result)
+}
+
+func (r eventProgramRepository) DeleteSpeaker(id uint, eventID uint) error {
+	tx := r.db.Begin()
+
+	if err := tx.Exec("DELETE FROM agenda_item_speakers WHERE event_speaker_id = ?", id).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	result := tx.Unscoped().Where("id = ? AND event_id = ?", id, eventID).Delete(&models.EventSpeaker{})
+	if err := utils.GormErrorAndRowsAffected(result); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r eventProgramRepository) ListMaterials(eventID uint) ([]models.EventMaterial, error) {
+	var materials []models.EventMaterial
+	if err := r.db.Where("event_id = ?", eventID).Order("created_at asc, id asc").Find(&materials).Error; err != nil {
+		return nil, err
+	}
+
+	return materials, nil
+}
+
+func (r eventProgramRepository) GetMaterial(id uint, eventID uint) (*models.EventMaterial, error) {
+	material := &models.EventMaterial{}
+	if err := r.db.Where("id = ? AND event_id = ?", id, eventID).First(material).Error; err != nil {
+		return nil, err
+	}
+
+	return material, nil
+}
+
+func (r eventProgramRepository) CreateMaterial(material *models.EventMaterial) error {
+	return r.db.Create(material).Error
+}
+
+func (r eventProgramRepository) DeleteMaterial(id uint, eventID uint) error {
+	result := r.db.Unscoped().Where("id = ? AND event_id = ?", id, eventID).Delete(&models.EventMaterial{})
+	return utils.GormErrorAndRowsAffected(result)
+}
@@ -16,6 +16,11 @@ func NewEventRepository(db *gorm.DB) EventRepository {
 	return &eventRepository{db: db}
 }
 
+// orderSessions lists the sessions of preloaded events in time order
+func orderSessions(db *gorm.DB) *gorm.DB {
+	return db.Order("start_at asc, id asc")
+}
+
 func (r eventRepository) Create(orgID uint, event *models.Event) error {
 	tx := r.db.Begin()
 
@@ -33,12 +38,64 @@ func (r eventRepository) Create(orgID uint, event *models.Event) error {
 	return nil
 }
 
+func (r eventRepository) Duplicate(orgID uint, event *models.Event) error {
+	tx := r.db.Begin()
+
+	event.OrganizationID = orgID
+	speakers, agenda := event.Speakers, event.AgendaItems
+	event.Speakers, event.AgendaItems = nil, nil
+
+	if err := tx.Omit("Categories.*").Create(event).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	speakerIDs := make(map[uint]uint, len(speakers))
+	for i := range speakers {
+		sourceID := speakers[i].ID
+		speakers[i].ID = 0
+		speakers[i].EventID = event.ID
+		if err := tx.Create(&speakers[i]).Error; err != nil {
+			tx.Rollback()
+			return err
+		}
+		speakerIDs[sourceID] = speakers[i].ID
+	}
+
+	for i := range agenda {
+		itemSpeakers := make([]models.EventSpeaker, 0, len(agenda[i].Speakers))
+		for _, speaker := range agenda[i].Speakers {
+			if id, ok := speakerIDs[speaker.ID]; ok {
+				itemSpeakers = append(itemSpeakers, models.EventSpeaker{Model: gorm.Model{ID: id}})
+			}
+		}
+
+		agenda[i].ID = 0
+		agenda[i].EventID = event.ID
+		agenda[i].Speakers = itemSpeakers
+		if err := tx.Omit("Speakers.*").Create(&agenda[i]).Error; err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return err
+	}
+
+	event.Speakers, event.AgendaItems = speakers, agenda
+	return nil
+}
+
 func (r eventRepository) GetAll() ([]models.Event, error) {
 	var events []models.Event
 	err := r.db.
 		Preload("ContactChannels").
-		Preload("Categories").
-		Preload("Organization").
+		Preload("Categories.Translations").
+		Preload("Translations").
+		Preload("Sessions", orderSessions).
+		Preload("Organization.Translations").
+		Scopes(listedEvents).
 		Find(&events).Error
 	if err != nil {
 		return nil, err
@@ -52,9 +109,13 @@ func (r eventRepository) GetAllByOrgID(orgID uint) ([]models.Event, error) {
 
 	err := r.db.
 		Preload("ContactChannels").
-		Preload("Categories").
-		Preload("Organization").
-		Where("organization_id = ?", orgID).
+		Preload("Categories.Translations").
+		Preload("Translations").
+		Preload("Sessions", orderSessions).
+		Preload("Organization.Translations").
+		Preload("CoHosts", acceptedCoHosts).
+		Preload("CoHosts.Organization").
+		Scopes(hostedBy(orgID)).
 		Find(&events).Error
 	if err != nil {
 		return nil, err
@@ -67,9 +128,18 @@ func (r eventRepository) GetByID(eventID uint) (*models.Event, error) {
 	event := models.Event{}
 
 	if err := r.db.
-		Preload("Organization").
-		Preload("Categories").
+		Preload("Organization.Translations").
+		Preload("Categories.Translations").
+		Preload("Translations").
+		Preload("Sessions", orderSessions).
+		Preload("AgendaItems", orderAgenda).
+		Preload("AgendaItems.Speakers", orderSpeakers).
+		Preload("Speakers", orderSpeakers).
+		Preload("Speakers.User").
+		Preload("Materials").
 		Preload("ContactChannels").
+		Preload("CoHosts", acceptedCoHosts).
+		Preload("CoHosts.Organization").
 		Where("id = ?", eventID).
 		First(&event).Error; err != nil {
 
@@ -83,9 +153,18 @@ func (r eventRepository) GetByIDwithOrgID(orgID uint, eventID uint) (*models.Eve
 	event := models.Event{}
 
 	err := r.db.
-		Preload("Organization").
-		Preload("Categories").
+		Preload("Organization.Translations").
+		Preload("Categories.Translations").
+		Preload("Translations").
+		Preload("Sessions", orderSessions).
+		Preload("AgendaItems", orderAgenda).
+		Preload("AgendaItems.Speakers", orderSpeakers).
+		Preload("Speakers", orderSpeakers).
+		Preload("Speakers.User").
+		Preload("Materials").
 		Preload("ContactChannels").
+		Preload("CoHosts", acceptedCoHosts).
+		Preload("CoHosts.Organization").
 		Where("organization_id = ? AND id = ?", orgID, eventID).
 		First(&event).Error
 
@@ -96,10 +175,89 @@ func (r eventRepository) GetByIDwithOrgID(orgID uint, eventID uint) (*models.Eve
 	return &event, nil
 }
 
+func (r eventRepository) GetHostedByID(orgID uint, eventID uint) (*models.Event, error) {
+	event := models.Event{}
+
+	err := r.db.
+		Preload("Organization.Translations").
+		Preload("Categories.Translations").
+		Preload("Translations").
+		Preload("Sessions", orderSessions).
+		Preload("AgendaItems", orderAgenda).
+		Preload("AgendaItems.Speakers", orderSpeakers).
+		Preload("Speakers", orderSpeakers).
+		Preload("Speakers.User").
+		Preload("Materials").
+		Preload("ContactChannels").
+		Preload("CoHosts", acceptedCoHosts).
+		Preload("CoHosts.Organization").
+		Scopes(hostedBy(orgID)).
+		Where("events.id = ?", eventID).
+		First(&event).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &event, nil
+}
+
+func (r eventRepository) GetAllListedByOrgID(orgID uint) ([]models.Event, error) {
+	var events []models.Event
+
+	err := r.db.
+		Preload("ContactChannels").
+		Preload("Categories.Translations").
+		Preload("Translations").
+		Preload("Sessions", orderSessions).
+		Preload("Organization.Translations").
+		Preload("CoHosts", acceptedCoHosts).
+		Preload("CoHosts.Organization").
+		Scopes(hostedBy(orgID), listedEvents).
+		Find(&events).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
+
+func (r eventRepository) GetListedByID(eventID uint) (*models.Event, error) {
+	return r.getListed(r.db.Where("events.id = ?", eventID))
+}
+
+func (r eventRepository) GetListedByIDwithOrgID(orgID uint, eventID uint) (*models.Event, error) {
+	return r.getListed(r.db.Scopes(hostedBy(orgID)).Where("events.id = ?", eventID))
+}
+
+func (r eventRepository) getListed(db *gorm.DB) (*models.Event, error) {
+	event := models.Event{}
+
+	if err := db.
+		Preload("Organization.Translations").
+		Preload("Categories.Translations").
+		Preload("Translations").
+		Preload("Sessions", orderSessions).
+		Preload("AgendaItems", orderAgenda).
+		Preload("AgendaItems.Speakers", orderSpeakers).
+		Preload("Speakers", orderSpeakers).
+		Preload("Speakers.User").
+		Preload("Materials").
+		Preload("ContactChannels").
+		Preload("CoHosts", acceptedCoHosts).
+		Preload("CoHosts.Organization").
+		Scopes(listedEvents).
+		First(&event).Error; err != nil {
+		return nil, err
+	}
+
+	return &event, nil
+}
+
 func (r eventRepository) GetAllCategories() ([]models.Category, error) {
 	var categories []models.Category
 
-	err := r.db.Find(&categories).Error
+	err := r.db.Preload("Translations").Find(&categories).Error
 	if err != nil {
 		return nil, err
 	}
@@ -122,9 +280,12 @@ func (r eventRepository) GetPaginate(page uint, size uint) ([]models.Event, erro
 	var events []models.Event
 	offset := int((page - 1) * size)
 
-	err := r.db.Preload("Organization").
-		Preload("Categories").
+	err := r.db.Preload("Organization.Translations").
+		Preload("Categories.Translations").
+		Preload("Translations").
+		Preload("Sessions", orderSessions).
 		Preload("ContactChannels").
+		Scopes(listedEvents).
 		Order("created_at desc").
 		Limit(int(size)).
 		Offset(offset).
@@ -141,9 +302,12 @@ func (r eventRepository) GetFirst() (*models.Event, error) {
 	event := models.Event{}
 
 	err := r.db.
-		Preload("Organization").
-		Preload("Categories").
+		Preload("Organization.Translations").
+		Preload("Categories.Translations").
+		Preload("Translations").
+		Preload("Sessions", orderSessions).
 		Preload("ContactChannels").
+		Scopes(listedEvents).
 		First(&event).Error
 
 	if err != nil {
@@ -156,7 +320,7 @@ func (r eventRepository) GetFirst() (*models.Event, error) {
 func (r eventRepository) Count() (int64, error) {
 	var count int64
 
-	err := r.db.Model(&models.Event{}).Count(&count).Error
+	err := r.db.Model(&models.Event{}).Scopes(listedEvents).Count(&count).Error
 
 	if err != nil {
 		return 0, err
@@ -169,7 +333,7 @@ func (r eventRepository) Update(orgID uint, eventID uint, event *models.Event) (
 	tx := r.db.Begin()
 
 	var existingEvent models.Event
-	if err := tx.Preload("Categories").Preload("ContactChannels").Where("organization_id = ? AND id = ?", orgID, eventID).First(&existingEvent).Error; err != nil {
+	if err := tx.Preload("Categories").Preload("ContactChannels").Scopes(hostedBy(orgID)).Where("events.id = ?", eventID).First(&existingEvent).Error; err != nil {
 		tx.Rollback()
 		return nil, err
 	}
@@ -197,15 +361,42 @@ func (r eventRepository) Update(orgID uint, eventID uint, event *models.Event) (
 		}
 	}
 
-	if err := tx.Model(&existingEvent).Save(event).Error; err != nil {
+	// Unscoped, a locale removed from the translations may be translated again later
+	if err := tx.Unscoped().Where("event_id = ?", eventID).Delete(&models.EventTranslation{}).Error; err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	for i := range event.Translations {
+		event.Translations[i].EventID = eventID
+	}
+
+	if len(event.Translations) > 0 {
+		if err := tx.Create(&event.Translations).Error; err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+	}
+
+	// Hidden is only changed by moderation
+	if err := tx.Model(&existingEvent).Omit("Hidden", "Translations").Save(event).Error; err != nil {
 		tx.Rollback()
 		return nil, err
 	}
 
 	// Fetch the updated event
-	err := tx.Preload("Organization").
+	err := tx.Preload("Organization.Translations").
 		Preload("ContactChannels").
-		Preload("Categories").
+		Preload("Categories.Translations").
+		Preload("Translations").
+		Preload("Sessions", orderSessions).
+		Preload("AgendaItems", orderAgenda).
+		Preload("AgendaItems.Speakers", orderSpeakers).
+		Preload("Speakers", orderSpeakers).
+		Preload("Speakers.User").
+		Preload("Materials").
+		Preload("CoHosts", acceptedCoHosts).
+		Preload("CoHosts.Organization").
 		Where(" id = ?", eventID).
 		First(&existingEvent).Error
 	if err != nil {
@@ -228,9 +419,14 @@ func (r eventRepository) UpdateEventPicture(orgID uint, eventID uint, picURL str
 }
 
 func (r eventRepository) Delete(orgID uint, eventID uint) error {
-	// Soft delete
+	// Soft delete, only by the organizer, co-hosts cannot delete the event
 	tx := r.db.Begin()
 
+	if err := tx.Where("organization_id = ? AND id = ?", orgID, eventID).First(&models.Event{}).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+
 	var contactChannels models.ContactChannel
 
 	if err := tx.Model(&contactChannels).Where("event_id = ?", eventID).Delete(&contactChannels).Error; err != nil {
@@ -260,7 +456,7 @@ func (r eventRepository) Delete(orgID uint, eventID uint) error {
 
 func (r eventRepository) CountsByOrgID(orgID uint) (int64, error) {
 	var count int64
-	err := r.db.Model(&models.Event{}).Where("organization_id = ?", orgID).Count(&count).Error
+	err := r.db.Model(&models.Event{}).Scopes(hostedBy(orgID)).Count(&count).Error
 	if err != nil {
 		return 0, err
 	}
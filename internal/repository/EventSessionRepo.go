@@ -0,0 +1,151 @@
+package repository
+
+import (
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/models"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/utils"
+	"gorm.io/gorm"
+)
+
+// sessionColumns are the columns an edit of a session writes, zero values included
+var sessionColumns = []string{"title", "start_date", "end_date", "start_time", "end_time", "start_at", "end_at",
+	"location_name", "latitude", "longitude", "province", "location_type", "is_detached", "is_cancelled"}
+
+type eventSessionRepository struct {
+	db *gorm.DB
+}
+
+func NewEventSessionRepository(db *gorm.DB) models.EventSessionRepository {
+	return &eventSessionRepository{db: db}
+}
+
+func (r eventSessionRepository) ListByEventID(eventID uint) ([]models.EventSession, error) {
+	var sessions []models.EventSession
+	if err := r.db.
+		Where("event_id = ?", eventID).
+		Order("start_at asc, id asc").
+		Find(&sessions).Error; err != nil {
+		return nil, err
+	}
+
+	return sessions, nil
+}
+
+func (r eventSessionRepository) GetByIDAndEventID(id uint, eventID uint) (*models.EventSession, error) {
+	session := &models.EventSession{}
+	if err := r.db.Where("id = ? AND event_id = ?", id, eventID).First(session).Error; err != nil {
+		return nil, err
+	}
+
+	return session, nil
+}
+
+func (r eventSessionRepository) Create(session *models.EventSession) error {
+	return r.db.Create(session).Error
+}
+
+func (r eventSessionRepository) Update(session *models.EventSession) error {
+	result := r.db.Model(&models.EventSession{}).
+		Where("id = ? AND event_id = ?", session.ID, session.EventID).
+		Select(sessionColumns).
+		Updates(session)
+	return utils.GormErrorAndRowsAffected(result)
+}
+
+func (r eventSessionRepository) UpdateSeries(sessions []models.EventSession) error {
+	tx := r.db.Begin()
+
+	for i := range sessions {
+		result := tx.Model(&models.EventSession{}).
+			Where("id = ? AND event_id = ?", sessions[i].ID, sessions[i].EventID).
+			Select(sessionColumns).
+			Updates(&sessions[i])
+		if err := utils.GormErrorAndRowsAffected(result); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r eventSessionRepository) Delete(id uint) error {
+	result := r.db.Unscoped().Where("id = ?", id).Delete(&models.EventSession{})
+	return utils.GormErrorAndRowsAffected(result)
+}
+
+func (r eventSessionRepository) ReplaceRule(eventID uint, rule string, sessions []models.EventSession) ([]models.EventSession, error) {
+	tx := r.db.Begin()
+
+	result := tx.Model(&models.Event{}).Where("id = ?", eventID).Update("recurrence_rule", rule)
+	if err := utils.GormErrorAndRowsAffected(result); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Unscoped().
+		Where("event_id = ? AND recurrence_id IS NOT NULL AND is_detached = ?", eventID, false).
+		Delete(&models.EventSession{}).Error; err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	var detached []models.EventSession
+	if err := tx.Where("event_id = ? AND is_detached = ?", eventID, true).Find(&detached).Error; err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	kept := make(map[int64]bool)
+	for _, session := range detached {
+		if session.RecurrenceID != nil {
+			kept[session.RecurrenceID.Unix()] = true
+		}
+	}
+
+	created := make([]models.EventSession, 0, len(sessions))
+	for _, session := range sessions {
+		if session.RecurrenceID != nil && kept[session.RecurrenceID.Unix()] {
+			continue
+		}
+		session.EventID = eventID
+		created = append(created, session)
+	}
+
+	if len(created) > 0 {
+		if err := tx.Create(&created).Error; err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+func (r eventSessionRepository) DeleteSeries(eventID uint) error {
+	tx := r.db.Begin()
+
+	result := tx.Model(&models.Event{}).Where("id = ?", eventID).Update("recurrence_rule", "")
+	if err := utils.GormErrorAndRowsAffected(result); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := tx.Unscoped().Where("event_id = ? AND recurrence_id IS NOT NULL", eventID).Delete(&models.EventSession{}).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return err
+	}
+
+	return nil
+}
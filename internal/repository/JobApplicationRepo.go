@@ -0,0 +1,72 @@
+package repository
+
+import (
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/models"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type jobApplicationRepository struct {
+	db *gorm.DB
+}
+
+func NewJobApplicationRepository(db *gorm.DB) JobApplicationRepository {
+	return jobApplicationRepository{db: db}
+}
+
+func (r jobApplicationRepository) Create(application *models.JobApplication) error {
+	if err := r.db.Create(application).Error; err != nil {
+		return err
+	}
+
+	return r.db.
+		Preload("Job").
+		Preload("User").
+		Preload("Resume").
+		First(application, application.ID).Error
+}
+
+func (r jobApplicationRepository) GetByID(applicationID uint) (*models.JobApplication, error) {
+	application := &models.JobApplication{}
+	if err := r.db.
+		Preload("Job").
+		Preload("User").
+		Preload("Resume").
+		Where("id = ?", applicationID).
+		First(application).Error; err != nil {
+		return nil, err
+	}
+
+	return application, nil
+}
+
+func (r jobApplicationRepository) ListByUserID(userID uuid.UUID) ([]models.JobApplication, error) {
+	var applications []models.JobApplication
+	if err := r.db.
+		Preload("Job").
+		Preload("User").
+		Preload("Resume").
+		Where("user_id = ?", userID).
+		Order("created_at desc").
+		Find(&applications).Error; err != nil {
+		return nil, err
+	}
+
+	return applications, nil
+}
+
+func (r jobApplicationRepository) ListByJobID(orgID uint, jobID uint) ([]models.JobApplication, error) {
+	var applications []models.JobApplication
+	if err := r.db.
+		Preload("Job").
+		Preload("User").
+		Preload("Resume").
+		Joins("JOIN org_open_jobs ON org_open_jobs.id = job_applications.job_id").
+		Where("job_applications.job_id = ? AND org_open_jobs.organization_id = ?", jobID, orgID).
+		Order("job_applications.created_at desc").
+		Find(&applications).Error; err != nil {
+		return nil, err
+	}
+
+	return applications, nil
+}
@@ -0,0 +1,266 @@
+package repository
+
+import (
+	"errors"
+	"time"
+
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/models"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	ErrOrderNotPayable    = errors.New("order is no longer waiting for a payment")
+	ErrOrderNotRefundable = errors.New("only paid orders can be refunded")
+)
+
+type orderRepository struct {
+	db *gorm.DB
+}
+
+func NewOrderRepository(db *gorm.DB) models.OrderRepository {
+	return orderRepository{db: db}
+}
+
+func (r orderRepository) Create(order *models.Order, payment *models.Payment) error {
+	tx := r.db.Begin()
+
+	if err := tx.Create(order).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	payment.OrderID = order.ID
+	if err := tx.Create(payment).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return err
+	}
+
+	return r.preload().First(order, order.ID).Error
+}
+
+func (r orderRepository) AddPayment(payment *models.Payment) error {
+	return r.db.Create(payment).Error
+}
+
+func (r orderRepository) GetByID(id uint) (*models.Order, error) {
+	order := &models.Order{}
+	if err := r.preload().Where("id = ?", id).First(order).Error; err != nil {
+		return nil, err
+	}
+
+	return order, nil
+}
+
+func (r orderRepository) GetByIDAndUserID(id uint, userID uuid.UUID) (*models.Order, error) {
+	order := &models.Order{}
+	if err := r.preload().
+		Where("id = ? AND user_id = ?", id, userID).
+		First(order).Error; err != nil {
+		return nil, err
+	}
+
+	return order, nil
+}
+
+func (r orderRepository) GetPendingByPurchaseID(purchaseID uint) (*models.Order, error) {
+	order := &models.Order{}
+	if err := r.preload().
+		Where("ticket_purchased_id = ? AND status = ?", purchaseID, models.OrderStatusPending).
+		First(order).Error; err != nil {
+		return nil, err
+	}
+
+	return order, nil
+}
+
+func (r orderRepository) GetPaymentByProviderRef(provider string, providerRef string) (*models.Payment, error) {
+	payment := &models.Payment{}
+	if err := r.db.
+		Where("provider = ? AND provider_ref = ?", provider, providerRef).
+		First(payment).Error; err != nil {
+		return nil, err
+	}
+
+	return payment, nil
+}
+
+func (r orderRepository) ListByEventID(eventID uint) ([]models.Order, error) {
+	var orders []models.Order
+	if err := r.preload().
+		Where("event_id = ?", eventID).
+		Order("created_at desc").
+		Find(&orders).Error; err != nil {
+		return nil, err
+	}
+
+	return orders, nil
+}
+
+// MarkPaid is idempotent, a webhook delivered twice only confirms the ticket once.
+func (r orderRepository) MarkPaid(paymentID uint, webhook string) (*models.Order, bool, error) {
+	tx := r.db.Begin()
+
+	payment := &models.Payment{}
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ?", paymentID).
+		First(payment).Error; err != nil {
+		tx.Rollback()
+		return nil, false, err
+	}
+
+	if payment.Status != models.PaymentStatusPending && payment.Status != models.PaymentStatusFailed {
+		tx.Rollback()
+		order, err := r.GetByID(payment.OrderID)
+		return order, false, err
+	}
+
+	if err := tx.Model(payment).Updates(map[string]interface{}{
+		"status":       models.PaymentStatusSucceeded,
+		"last_webhook": webhook,
+	}).Error; err != nil {
+		tx.Rollback()
+		return nil, false, err
+	}
+
+	order := &models.Order{}
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ?", payment.OrderID).
+		First(order).Error; err != nil {
+		tx.Rollback()
+		return nil, false, err
+	}
+
+	// The money arrived after the seat was released, keep the payment so that it can be refunded
+	if order.Status != models.OrderStatusPending {
+		if err := tx.Commit().Error; err != nil {
+			return nil, false, err
+		}
+		return order, false, ErrOrderNotPayable
+	}
+
+	now := time.Now()
+	if err := tx.Model(order).Updates(map[string]interface{}{
+		"status":  models.OrderStatusPaid,
+		"paid_at": now,
+	}).Error; err != nil {
+		tx.Rollback()
+		return nil, false, err
+	}
+
+	if err := tx.Model(&models.TicketPurchased{}).
+		Where("id = ? AND status = ?", order.TicketPurchasedID, models.TicketStatusPending).
+		Updates(map[string]interface{}{
+			"status":          models.TicketStatusConfirmed,
+			"confirmation_at": now.Format("2006-01-02 15:04:05"),
+		}).Error; err != nil {
+		tx.Rollback()
+		return nil, false, err
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return nil, false, err
+	}
+
+	order, err := r.GetByID(order.ID)
+	return order, true, err
+}
+
+func (r orderRepository) MarkFailed(paymentID uint, webhook string) error {
+	result := r.db.Model(&models.Payment{}).
+		Where("id = ? AND status = ?", paymentID, models.PaymentStatusPending).
+		Updates(map[string]interface{}{
+			"status":       models.PaymentStatusFailed,
+			"last_webhook": webhook,
+		})
+
+	return result.Error
+}
+
+func (r orderRepository) Refund(orderID uint, refundedAmount float64, manual bool, offerExpiresAt time.Time) (*models.WaitlistEntry, error) {
+	tx := r.db.Begin()
+
+	order := &models.Order{}
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ?", orderID).
+		First(order).Error; err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if order.Status != models.OrderStatusPaid {
+		tx.Rollback()
+		return nil, ErrOrderNotRefundable
+	}
+
+	now := time.Now()
+	if err := tx.Model(order).Update("status", models.OrderStatusRefunded).Error; err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Model(&models.Payment{}).
+		Where("order_id = ? AND status = ?", order.ID, models.PaymentStatusSucceeded).
+		Updates(map[string]interface{}{
+			"status":          models.PaymentStatusRefunded,
+			"refunded_amount": refundedAmount,
+			"refunded_at":     now,
+			"manual_refund":   manual,
+		}).Error; err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	purchase := &models.TicketPurchased{}
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ?", order.TicketPurchasedID).
+		First(purchase).Error; err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	var offer *models.WaitlistEntry
+	if purchase.Status != models.TicketStatusCancelled {
+		var err error
+		if offer, err = cancelPurchase(tx, purchase, offerExpiresAt); err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return nil, err
+	}
+
+	if offer == nil {
+		return nil, nil
+	}
+
+	return NewWaitlistRepository(r.db).GetByID(offer.ID)
+}
+
+func (r orderRepository) ListExpiredPendingPurchaseIDs(before time.Time) ([]uint, error) {
+	var purchaseIDs []uint
+	if err := r.db.Model(&models.TicketPurchased{}).
+		Where("status = ? AND created_at < ?", models.TicketStatusPending, before).
+		Order("created_at asc").
+		Pluck("id", &purchaseIDs).Error; err != nil {
+		return nil, err
+	}
+
+	return purchaseIDs, nil
+}
+
+func (r orderRepository) preload() *gorm.DB {
+	return r.db.
+		Preload("Event").
+		Preload("TicketPurchased").
+		Preload("Payments", func(db *gorm.DB) *gorm.DB {
+			return db.Order("created_at desc")
+		})
+}
@@ -0,0 +1,48 @@
+package repository
+
+import (
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/models"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/utils"
+	"gorm.io/gorm"
+)
+
+type orgTemplateRepository struct {
+	db *gorm.DB
+}
+
+func NewOrgTemplateRepository(db *gorm.DB) models.OrgTemplateRepository {
+	return &orgTemplateRepository{db: db}
+}
+
+func (r orgTemplateRepository) Create(template *models.OrgTemplate) error {
+	return r.db.Create(template).Error
+}
+
+func (r orgTemplateRepository) ListByOrgID(orgID uint, kind models.OrgTemplateKind) ([]models.OrgTemplate, error) {
+	var templates []models.OrgTemplate
+
+	query := r.db.Where("organization_id = ?", orgID)
+	if kind != "" {
+		query = query.Where("kind = ?", kind)
+	}
+
+	if err := query.Order("created_at desc, id desc").Find(&templates).Error; err != nil {
+		return nil, err
+	}
+
+	return templates, nil
+}
+
+func (r orgTemplateRepository) GetByID(orgID uint, id uint) (*models.OrgTemplate, error) {
+	var template models.OrgTemplate
+	if err := r.db.Where("organization_id = ? AND id = ?", orgID, id).First(&template).Error; err != nil {
+		return nil, err
+	}
+
+	return &template, nil
+}
+
+func (r orgTemplateRepository) Delete(orgID uint, id uint) error {
+	result := r.db.Where("organization_id = ? AND id = ?", orgID, id).Delete(&models.OrgTemplate{})
+	return utils.GormErrorAndRowsAffected(result)
+}
@@ -47,7 +47,7 @@ func (r organizationRepository) CreateOrganization(userId uuid.UUID, org *models
 
 func (r organizationRepository) GetAllIndustries() ([]models.Industry, error) {
 	var industries []models.Industry
-	err := r.db.Find(&industries).Error
+	err := r.db.Preload("Translations").Find(&industries).Error
 	if err != nil {
 		return nil, err
 	}
@@ -67,7 +67,8 @@ func (r organizationRepository) GetByOrgID(id uint) (*models.Organization, error
 	org := &models.Organization{}
 	if err := r.db.
 		Preload("OrganizationContacts").
-		Preload("Industries").
+		Preload("Industries.Translations").
+		Preload("Translations").
 		Where("id = ? ", id).
 		First(org).Error; err != nil {
 		return nil, err
@@ -75,13 +76,23 @@ func (r organizationRepository) GetByOrgID(id uint) (*models.Organization, error
 	return org, nil
 }
 
+func (r organizationRepository) IsSuspended(id uint) (bool, error) {
+	org := &models.Organization{}
+	if err := r.db.Select("id", "suspended").Where("id = ?", id).First(org).Error; err != nil {
+		return false, err
+	}
+
+	return org.Suspended, nil
+}
+
 func (r organizationRepository) GetOrgsPaginate(page uint, size uint) ([]models.Organization, error) {
 	var orgs []models.Organization
 	offset := int((page - 1) * size)
 
 	err := r.db.
 		Preload("OrganizationContacts").
-		Preload("Industries").
+		Preload("Industries.Translations").
+		Preload("Translations").
 		Order("created_at desc").Limit(int(size)).
 		Offset(offset).
 		Find(&orgs).Error
@@ -97,7 +108,8 @@ func (r organizationRepository) GetAllOrganizations() ([]models.Organization, er
 	var orgs []models.Organization
 	err := r.db.
 		Preload("OrganizationContacts").
-		Preload("Industries").
+		Preload("Industries.Translations").
+		Preload("Translations").
 		Find(&orgs).Error
 	if err != nil {
 		return nil, err
@@ -134,8 +146,25 @@ func (r organizationRepository) UpdateOrganization(org *models.Organization) (*m
 		return nil, err
 	}
 
+	// Unscoped, a locale removed from the translations may be translated again later
+	if err := tx.Unscoped().Where("organization_id = ?", org.ID).Delete(&models.OrganizationTranslation{}).Error; err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	for i := range org.Translations {
+		org.Translations[i].OrganizationID = org.ID
+	}
+
+	if len(org.Translations) > 0 {
+		if err := tx.Create(&org.Translations).Error; err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+	}
+
 	// Update organization
-	if err := tx.Model(&existOrg).Updates(org).Error; err != nil {
+	if err := tx.Model(&existOrg).Omit("Translations").Updates(org).Error; err != nil {
 		tx.Rollback()
 		return nil, err
 	}
@@ -143,7 +172,8 @@ func (r organizationRepository) UpdateOrganization(org *models.Organization) (*m
 	var updatedOrg models.Organization
 	if err := tx.
 		Preload("OrganizationContacts").
-		Preload("Industries").
+		Preload("Industries.Translations").
+		Preload("Translations").
 		Where("id = ? ", org.ID).
 		First(&updatedOrg).Error; err != nil {
 
@@ -392,12 +422,28 @@ func (r orgOpenJobRepository) FindCategoryByIds(catIDs []uint) ([]models.Categor
 	return categories, nil
 }
 
+func (r orgOpenJobRepository) FindSkillByIds(skillIDs []uint) ([]models.Skill, error) {
+	var skills []models.Skill
+	if len(skillIDs) == 0 {
+		return skills, nil
+	}
+
+	err := r.db.Find(&skills, skillIDs).Error
+	if err != nil {
+		return nil, err
+	}
+	return skills, nil
+}
+
 func (r orgOpenJobRepository) GetAllJobs() ([]models.OrgOpenJob, error) {
 	var orgs []models.OrgOpenJob
 	err := r.db.
-		Preload("Organization").
+		Preload("Organization.Translations").
 		Preload("Prerequisites").
-		Preload("Categories").
+		Preload("Categories.Translations").
+		Preload("Translations").
+		Preload("Skills").
+		Scopes(listedJobs).
 		Find(&orgs).Error
 	if err != nil {
 		return nil, err
@@ -409,9 +455,11 @@ func (r orgOpenJobRepository) GetAllJobs() ([]models.OrgOpenJob, error) {
 func (r orgOpenJobRepository) GetAllJobsByOrgID(OrgId uint) ([]models.OrgOpenJob, error) {
 	var orgs []models.OrgOpenJob
 	if err := r.db.
-		Preload("Organization").
+		Preload("Organization.Translations").
 		Preload("Prerequisites").
-		Preload("Categories").
+		Preload("Categories.Translations").
+		Preload("Translations").
+		Preload("Skills").
 		Where("organization_id = ?", OrgId).
 		Find(&orgs).Error; err != nil {
 		return nil, err
@@ -424,9 +472,11 @@ func (r orgOpenJobRepository) GetJobByID(jobID uint) (*models.OrgOpenJob, error)
 	job := &models.OrgOpenJob{}
 
 	if err := r.db.
-		Preload("Organization").
+		Preload("Organization.Translations").
 		Preload("Prerequisites").
-		Preload("Categories").
+		Preload("Categories.Translations").
+		Preload("Translations").
+		Preload("Skills").
 		Where("id = ?", jobID).
 		First(&job).Error; err != nil {
 		return nil, err
@@ -439,9 +489,64 @@ func (r orgOpenJobRepository) GetJobByIDWithOrgID(orgID uint, jobID uint) (*mode
 	job := &models.OrgOpenJob{}
 
 	if err := r.db.
-		Preload("Organization").
+		Preload("Organization.Translations").
 		Preload("Prerequisites").
-		Preload("Categories").
+		Preload("Categories.Translations").
+		Preload("Translations").
+		Preload("Skills").
+		Where("organization_id = ? AND id = ?", orgID, jobID).
+		First(&job).Error; err != nil {
+		return nil, err
+	}
+
+	return job, nil
+}
+
+func (r orgOpenJobRepository) GetListedJobsByOrgID(orgID uint) ([]models.OrgOpenJob, error) {
+	var jobs []models.OrgOpenJob
+	if err := r.db.
+		Preload("Organization.Translations").
+		Preload("Prerequisites").
+		Preload("Categories.Translations").
+		Preload("Translations").
+		Preload("Skills").
+		Scopes(listedJobs).
+		Where("organization_id = ?", orgID).
+		Find(&jobs).Error; err != nil {
+		return nil, err
+	}
+
+	return jobs, nil
+}
+
+func (r orgOpenJobRepository) GetListedJobByID(jobID uint) (*models.OrgOpenJob, error) {
+	job := &models.OrgOpenJob{}
+
+	if err := r.db.
+		Preload("Organization.Translations").
+		Preload("Prerequisites").
+		Preload("Categories.Translations").
+		Preload("Translations").
+		Preload("Skills").
+		Scopes(listedJobs).
+		Where("id = ?", jobID).
+		First(&job).Error; err != nil {
+		return nil, err
+	}
+
+	return job, nil
+}
+
+func (r orgOpenJobRepository) GetListedJobByIDWithOrgID(orgID uint, jobID uint) (*models.OrgOpenJob, error) {
+	job := &models.OrgOpenJob{}
+
+	if err := r.db.
+		Preload("Organization.Translations").
+		Preload("Prerequisites").
+		Preload("Categories.Translations").
+		Preload("Translations").
+		Preload("Skills").
+		Scopes(listedJobs).
 		Where("organization_id = ? AND id = ?", orgID, jobID).
 		First(&job).Error; err != nil {
 		return nil, err
@@ -454,9 +559,12 @@ func (r orgOpenJobRepository) GetJobsPaginate(page uint, size uint) ([]models.Or
 	var orgs []models.OrgOpenJob
 
 	offset := int((page - 1) * size)
-	err := r.db.Preload("Organization").
-		Preload("Categories").
+	err := r.db.Preload("Organization.Translations").
+		Preload("Categories.Translations").
+		Preload("Translations").
+		Preload("Skills").
 		Preload("Prerequisites").
+		Scopes(listedJobs).
 		Order("created_at desc").
 		Limit(int(size)).
 		Offset(offset).
@@ -476,6 +584,7 @@ func (r orgOpenJobRepository) UpdateJob(job *models.OrgOpenJob) (*models.OrgOpen
 	if err := tx.
 		Where("organization_id = ? AND id = ?", job.OrganizationID, job.ID).
 		Preload("Categories").
+		Preload("Skills").
 		Preload("Prerequisites").
 		First(&existJob).Error; err != nil {
 		tx.Rollback()
@@ -492,6 +601,11 @@ func (r orgOpenJobRepository) UpdateJob(job *models.OrgOpenJob) (*models.OrgOpen
 		return nil, err
 	}
 
+	if err := tx.Model(&existJob).Association("Skills").Replace(job.Skills); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
 	var prerequisites models.Prerequisite
 	if err := tx.Model(&prerequisites).Where("job_id = ?", job.ID).Delete(&prerequisites).Error; err != nil {
 		tx.Rollback()
@@ -505,16 +619,36 @@ func (r orgOpenJobRepository) UpdateJob(job *models.OrgOpenJob) (*models.OrgOpen
 		}
 	}
 
-	if err := tx.Model(&existJob).Save(job).Error; err != nil {
+	// Unscoped, a locale removed from the translations may be translated again later
+	if err := tx.Unscoped().Where("job_id = ?", job.ID).Delete(&models.OrgOpenJobTranslation{}).Error; err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	for i := range job.Translations {
+		job.Translations[i].JobID = job.ID
+	}
+
+	if len(job.Translations) > 0 {
+		if err := tx.Create(&job.Translations).Error; err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+	}
+
+	// Hidden is only changed by moderation
+	if err := tx.Model(&existJob).Omit("Hidden", "Translations").Save(job).Error; err != nil {
 		tx.Rollback()
 		return nil, err
 	}
 
 	var updatedJob models.OrgOpenJob
 	if err := tx.
-		Preload("Organization").
+		Preload("Organization.Translations").
 		Preload("Prerequisites").
-		Preload("Categories").
+		Preload("Categories.Translations").
+		Preload("Translations").
+		Preload("Skills").
 		Where("id = ?", job.ID).
 		First(&updatedJob).Error; err != nil {
 		tx.Rollback()
@@ -553,6 +687,12 @@ func (r orgOpenJobRepository) DeleteJob(jobID uint) error {
 		tx.Rollback()
 		return err
 	}
+
+	//clear all required skills
+	if err := tx.Exec("DELETE FROM job_skill WHERE org_open_job_id = ?", jobID).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
 	job := new(models.OrgOpenJob)
 	result := tx.Model(job).
 		Select(clause.Associations).
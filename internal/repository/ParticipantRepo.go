@@ -0,0 +1,77 @@
+package repository
+
+import (
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/models"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/utils"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type eventParticipantRepository struct {
+	db *gorm.DB
+}
+
+func NewEventParticipantRepository(db *gorm.DB) models.EventParticipantRepository {
+	return &eventParticipantRepository{db: db}
+}
+
+func (r eventParticipantRepository) ListRegistrations(eventID uint, filter models.ParticipantFilter) ([]models.TicketPurchased, error) {
+	query := r.db.
+		Preload("Event").
+		Preload("TicketAvailable").
+		Where("event_id = ?", eventID)
+
+	if filter.TicketAvailableID != 0 {
+		query = query.Where("ticket_available_id = ?", filter.TicketAvailableID)
+	}
+
+	if filter.CheckedIn != nil {
+		if *filter.CheckedIn {
+			query = query.Where("checked_in_at IS NOT NULL")
+		} else {
+			query = query.Where("checked_in_at IS NULL")
+		}
+	}
+
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	} else {
+		query = query.Where("status <> ?", models.TicketStatusCancelled)
+	}
+
+	var purchases []models.TicketPurchased
+	if err := query.Order("created_at asc, id asc").Find(&purchases).Error; err != nil {
+		return nil, err
+	}
+
+	return purchases, nil
+}
+
+func (r eventParticipantRepository) ListVisible(eventID uint) ([]models.EventParticipant, error) {
+	var participants []models.EventParticipant
+	if err := r.db.
+		Preload("User").
+		Where("event_id = ? AND is_visible = ?", eventID, true).
+		Order("created_at asc, id asc").
+		Find(&participants).Error; err != nil {
+		return nil, err
+	}
+
+	return participants, nil
+}
+
+func (r eventParticipantRepository) GetByUserAndEvent(userID uuid.UUID, eventID uint) (*models.EventParticipant, error) {
+	participant := &models.EventParticipant{}
+	if err := r.db.Where("user_id = ? AND event_id = ?", userID, eventID).First(participant).Error; err != nil {
+		return nil, err
+	}
+
+	return participant, nil
+}
+
+func (r eventParticipantRepository) UpdateVisibility(userID uuid.UUID, eventID uint, isVisible bool) error {
+	result := r.db.Model(&models.EventParticipant{}).
+		Where("user_id = ? AND event_id = ?", userID, eventID).
+		Update("is_visible", isVisible)
+	return utils.GormErrorAndRowsAffected(result)
+}
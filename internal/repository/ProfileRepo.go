@@ -7,6 +7,9 @@ import (
 	"gorm.io/gorm"
 )
 
+// editableProfileFields are the profile columns a user can change, fields left empty are cleared
+var editableProfileFields = []string{"first_name", "last_name", "head_line", "phone", "bio", "skill", "language", "education", "focus_field", "province"}
+
 type ProfileRepository struct {
 	db *gorm.DB
 }
@@ -20,17 +23,74 @@ func (r *ProfileRepository) Create(profile *models.Profile) error {
 }
 
 func (r *ProfileRepository) Update(profile *models.Profile) error {
-	return r.db.Updates(profile).Error
+	result := r.db.Model(&models.Profile{}).
+		Where("id = ?", profile.ID).
+		Select(editableProfileFields).
+		Updates(profile)
+	return utils.GormErrorAndRowsAffected(result)
 }
 
 func (r *ProfileRepository) GetByUserID(userID uuid.UUID) (*models.Profile, error) {
 	var profile models.Profile
-	if err := r.db.Preload("User").Where("User_ID = ?", userID).First(&profile).Error; err != nil {
+	if err := r.db.
+		Preload("User").
+		Preload("Skills").
+		Preload("Experiences", orderExperiences).
+		Where("user_id = ?", userID).
+		First(&profile).Error; err != nil {
 		return nil, err
 	}
 	return &profile, nil
 }
 
+func (r *ProfileRepository) GetPublicBySlug(slug string) (*models.Profile, error) {
+	var profile models.Profile
+	if err := r.db.
+		Preload("Skills").
+		Preload("Experiences", orderExperiences).
+		Where("public_slug = ? AND is_public = ?", slug, true).
+		First(&profile).Error; err != nil {
+		return nil, err
+	}
+	return &profile, nil
+}
+
+func (r *ProfileRepository) GetAllPublic() ([]models.Profile, error) {
+	var profiles []models.Profile
+	if err := r.db.
+		Preload("Skills").
+		Where("is_public = ?", true).
+		Find(&profiles).Error; err != nil {
+		return nil, err
+	}
+	return profiles, nil
+}
+
+func (r *ProfileRepository) UpdateVisibility(profile *models.Profile) error {
+	result := r.db.Model(&models.Profile{}).
+		Where("id = ?", profile.ID).
+		Select("is_public", "public_slug", "hide_email", "hide_phone", "hide_experiences").
+		Updates(profile)
+	return utils.GormErrorAndRowsAffected(result)
+}
+
+func (r *ProfileRepository) CreateView(view *models.ProfileView) error {
+	return r.db.Create(view).Error
+}
+
+func (r *ProfileRepository) ListViewsByUserID(userID uuid.UUID) ([]models.ProfileView, error) {
+	var views []models.ProfileView
+	if err := r.db.
+		Preload("Organization").
+		Joins("JOIN profiles ON profiles.id = profile_views.profile_id").
+		Where("profiles.user_id = ?", userID).
+		Order("profile_views.created_at desc").
+		Find(&views).Error; err != nil {
+		return nil, err
+	}
+	return views, nil
+}
+
 type ExperienceRepository struct {
 	db *gorm.DB
 }
@@ -39,15 +99,35 @@ func NewExperienceRepository(db *gorm.DB) *ExperienceRepository {
 	return &ExperienceRepository{db: db}
 }
 
+// orderExperiences lists the current roles first, then the most recent ones
+func orderExperiences(db *gorm.DB) *gorm.DB {
+	return db.Order("experiences.currently desc").Order("experiences.start_date desc")
+}
+
 func (r *ExperienceRepository) GetByUserID(userID uuid.UUID) ([]models.Experience, error) {
 	var experiences []models.Experience
-	err := r.db.Where("User_ID = ?", userID).Find(&experiences).Error
+	err := r.db.
+		Joins("JOIN profiles ON profiles.id = experiences.profile_id").
+		Where("profiles.user_id = ?", userID).
+		Scopes(orderExperiences).
+		Find(&experiences).Error
 	return experiences, err
 }
 
 func (r *ExperienceRepository) GetByID(experienceID uuid.UUID) (*models.Experience, error) {
 	var experience models.Experience
-	if err := r.db.Where("ID = ?", experienceID).First(&experience).Error; err != nil {
+	if err := r.db.Where("uuid = ?", experienceID).First(&experience).Error; err != nil {
+		return nil, err
+	}
+	return &experience, nil
+}
+
+func (r *ExperienceRepository) GetByIDAndUserID(experienceID uuid.UUID, userID uuid.UUID) (*models.Experience, error) {
+	var experience models.Experience
+	if err := r.db.
+		Joins("JOIN profiles ON profiles.id = experiences.profile_id").
+		Where("experiences.uuid = ? AND profiles.user_id = ?", experienceID, userID).
+		First(&experience).Error; err != nil {
 		return nil, err
 	}
 	return &experience, nil
@@ -58,11 +138,19 @@ func (r *ExperienceRepository) Create(experience *models.Experience) error {
 }
 
 func (r *ExperienceRepository) Update(experience *models.Experience) error {
-	return r.db.Save(experience).Error
+	result := r.db.Model(&models.Experience{}).
+		Where("uuid = ?", experience.UUID).
+		Select("title", "description", "start_date", "end_date", "currently").
+		Updates(experience)
+	return utils.GormErrorAndRowsAffected(result)
 }
 
-func (r *ExperienceRepository) Delete(experienceID uuid.UUID) error {
-	result := r.db.Delete(&models.Experience{}, experienceID)
+func (r *ExperienceRepository) UpdatePicture(experienceID uuid.UUID, picURL string) error {
+	result := r.db.Model(&models.Experience{}).Where("uuid = ?", experienceID).Update("pic_url", picURL)
 	return utils.GormErrorAndRowsAffected(result)
+}
 
+func (r *ExperienceRepository) Delete(experienceID uuid.UUID) error {
+	result := r.db.Where("uuid = ?", experienceID).Delete(&models.Experience{})
+	return utils.GormErrorAndRowsAffected(result)
 }
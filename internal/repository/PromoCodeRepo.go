@@ -0,0 +1,297 @@
+package repository
+
+import (
+	"errors"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/models"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/utils"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	ErrPromoCodeInvalid       = errors.New("promo code is not valid")
+	ErrPromoCodeNotStarted    = errors.New("promo code is not valid yet")
+	ErrPromoCodeExpired       = errors.New("promo code has expired")
+	ErrPromoCodeNotApplicable = errors.New("promo code does not apply to this ticket")
+	ErrPromoCodeUsedUp        = errors.New("promo code has been fully redeemed")
+	ErrPromoCodeUserLimit     = errors.New("you have reached the usage limit of this promo code")
+	ErrPromoCodeFreeTicket    = errors.New("promo codes only apply to paid tickets")
+	ErrPromoCodeRedeemed      = errors.New("promo code has been redeemed, deactivate it instead")
+)
+
+type promoCodeRepository struct {
+	db *gorm.DB
+}
+
+func NewPromoCodeRepository(db *gorm.DB) models.PromoCodeRepository {
+	return promoCodeRepository{db: db}
+}
+
+// NormalizePromoCode is the stored form of a code.
+func NormalizePromoCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+// PromoDiscount is the amount taken off the price, rounded to satang and never more than the price.
+func PromoDiscount(discountType models.DiscountType, value float64, price float64) float64 {
+	var discount float64
+	switch discountType {
+	case models.DiscountPercent:
+		discount = price * value / 100
+	case models.DiscountFixed:
+		discount = value
+	}
+
+	discount = math.Round(discount*100) / 100
+	if discount < 0 {
+		return 0
+	}
+	if discount > price {
+		return price
+	}
+
+	return discount
+}
+
+func (r promoCodeRepository) Create(promo *models.PromoCode) error {
+	return r.db.Create(promo).Error
+}
+
+func (r promoCodeRepository) GetByIDAndEventID(id uint, eventID uint) (*models.PromoCode, error) {
+	promo := &models.PromoCode{}
+	if err := r.db.
+		Preload("Tickets").
+		Where("id = ? AND event_id = ?", id, eventID).
+		First(promo).Error; err != nil {
+		return nil, err
+	}
+
+	return promo, nil
+}
+
+func (r promoCodeRepository) ListByEventID(eventID uint) ([]models.PromoCode, error) {
+	var promos []models.PromoCode
+	if err := r.db.
+		Preload("Tickets").
+		Where("event_id = ?", eventID).
+		Order("created_at desc").
+		Find(&promos).Error; err != nil {
+		return nil, err
+	}
+
+	return promos, nil
+}
+
+func (r promoCodeRepository) Update(promo *models.PromoCode) (*models.PromoCode, error) {
+	tx := r.db.Begin()
+
+	existPromo := &models.PromoCode{}
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ? AND event_id = ?", promo.ID, promo.EventID).
+		First(existPromo).Error; err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Model(existPromo).
+		Select("code", "discount_type", "discount_value", "max_uses", "max_uses_per_user", "starts_at", "expires_at", "is_active").
+		Updates(promo).Error; err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	// Ticket types are replaced as a whole
+	if err := tx.Model(existPromo).Association("Tickets").Replace(promo.Tickets); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	updatedPromo := &models.PromoCode{}
+	if err := tx.Preload("Tickets").Where("id = ?", promo.ID).First(updatedPromo).Error; err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return nil, err
+	}
+
+	return updatedPromo, nil
+}
+
+func (r promoCodeRepository) Delete(id uint) error {
+	tx := r.db.Begin()
+
+	var redemptions int64
+	if err := tx.Model(&models.PromoRedemption{}).Where("promo_code_id = ?", id).Count(&redemptions).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if redemptions > 0 {
+		tx.Rollback()
+		return ErrPromoCodeRedeemed
+	}
+
+	if err := tx.Exec("DELETE FROM promo_code_tickets WHERE promo_code_id = ?", id).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	// Removed for good so that the code can be created again
+	result := tx.Unscoped().Delete(&models.PromoCode{}, id)
+	if err := utils.GormErrorAndRowsAffected(result); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit().Error
+}
+
+func (r promoCodeRepository) Quote(eventID uint, ticketAvailableID uint, userID uuid.UUID, code string, price float64) (*models.PromoCode, float64, error) {
+	return checkPromoCode(r.db, eventID, ticketAvailableID, userID, code, price)
+}
+
+func (r promoCodeRepository) ListRedemptionsByEventID(eventID uint) ([]models.PromoRedemption, error) {
+	var redemptions []models.PromoRedemption
+	if err := r.db.
+		Preload("PromoCode").
+		Preload("User").
+		Preload("TicketPurchased").
+		Where("event_id = ?", eventID).
+		Order("created_at desc").
+		Find(&redemptions).Error; err != nil {
+		return nil, err
+	}
+
+	return redemptions, nil
+}
+
+// CountUsageByEventID sums the redemptions of every code of the event, released uses are left out of the totals.
+func (r promoCodeRepository) CountUsageByEventID(eventID uint) ([]models.PromoCodeUsage, error) {
+	var usages []models.PromoCodeUsage
+	if err := r.db.
+		Model(&models.PromoRedemption{}).
+		Select(`promo_code_id,
+			COUNT(*) FILTER (WHERE released_at IS NULL) AS redemptions,
+			COUNT(*) FILTER (WHERE released_at IS NOT NULL) AS released,
+			COALESCE(SUM(discount_amount) FILTER (WHERE released_at IS NULL), 0) AS total_discount,
+			COALESCE(SUM(original_price - discount_amount) FILTER (WHERE released_at IS NULL), 0) AS revenue`).
+		Where("event_id = ?", eventID).
+		Group("promo_code_id").
+		Scan(&usages).Error; err != nil {
+		return nil, err
+	}
+
+	return usages, nil
+}
+
+// checkPromoCode validates the code for the user and the ticket type. Inside a transaction the code is locked,
+// so that its usage limits hold until the redemption is committed.
+func checkPromoCode(tx *gorm.DB, eventID uint, ticketAvailableID uint, userID uuid.UUID, code string, price float64) (*models.PromoCode, float64, error) {
+	if price <= 0 {
+		return nil, 0, ErrPromoCodeFreeTicket
+	}
+
+	promo := &models.PromoCode{}
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("event_id = ? AND code = ?", eventID, NormalizePromoCode(code)).
+		First(promo).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, 0, ErrPromoCodeInvalid
+		}
+		return nil, 0, err
+	}
+
+	if !promo.IsActive {
+		return nil, 0, ErrPromoCodeInvalid
+	}
+
+	now := time.Now()
+	if promo.StartsAt != nil && now.Before(*promo.StartsAt) {
+		return nil, 0, ErrPromoCodeNotStarted
+	}
+
+	if promo.ExpiresAt != nil && now.After(*promo.ExpiresAt) {
+		return nil, 0, ErrPromoCodeExpired
+	}
+
+	var ticketTypes, matching int64
+	if err := tx.Table("promo_code_tickets").
+		Where("promo_code_id = ?", promo.ID).
+		Count(&ticketTypes).Error; err != nil {
+		return nil, 0, err
+	}
+
+	if ticketTypes > 0 {
+		if err := tx.Table("promo_code_tickets").
+			Where("promo_code_id = ? AND ticket_available_id = ?", promo.ID, ticketAvailableID).
+			Count(&matching).Error; err != nil {
+			return nil, 0, err
+		}
+
+		if matching == 0 {
+			return nil, 0, ErrPromoCodeNotApplicable
+		}
+	}
+
+	if promo.MaxUses != nil && promo.UsedCount >= *promo.MaxUses {
+		return nil, 0, ErrPromoCodeUsedUp
+	}
+
+	if promo.MaxUsesPerUser > 0 {
+		var used int64
+		if err := tx.Model(&models.PromoRedemption{}).
+			Where("promo_code_id = ? AND user_id = ? AND released_at IS NULL", promo.ID, userID).
+			Count(&used).Error; err != nil {
+			return nil, 0, err
+		}
+
+		if used >= int64(promo.MaxUsesPerUser) {
+			return nil, 0, ErrPromoCodeUserLimit
+		}
+	}
+
+	return promo, PromoDiscount(promo.DiscountType, promo.DiscountValue, price), nil
+}
+
+// redeemPromoCode discounts the purchase being registered and records the use of the code.
+// It has to run in the registration transaction, after the purchase is created.
+func redeemPromoCode(tx *gorm.DB, promo *models.PromoCode, purchase *models.TicketPurchased, originalPrice float64) error {
+	if err := tx.Model(promo).Update("used_count", gorm.Expr("used_count + ?", 1)).Error; err != nil {
+		return err
+	}
+
+	return tx.Create(&models.PromoRedemption{
+		PromoCodeID:       promo.ID,
+		EventID:           purchase.EventID,
+		UserID:            purchase.UserID,
+		TicketPurchasedID: purchase.ID,
+		OriginalPrice:     originalPrice,
+		DiscountAmount:    purchase.DiscountAmount,
+	}).Error
+}
+
+// releasePromoCode gives back the use of a code redeemed for a cancelled purchase.
+func releasePromoCode(tx *gorm.DB, purchaseID uint) error {
+	redemption := &models.PromoRedemption{}
+	if err := tx.Where("ticket_purchased_id = ? AND released_at IS NULL", purchaseID).First(redemption).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	if err := tx.Model(redemption).Update("released_at", time.Now()).Error; err != nil {
+		return err
+	}
+
+	return tx.Model(&models.PromoCode{}).
+		Where("id = ? AND used_count > 0", redemption.PromoCodeID).
+		Update("used_count", gorm.Expr("used_count - ?", 1)).Error
+}
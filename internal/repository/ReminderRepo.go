@@ -0,0 +1,226 @@
+package repository
+
+import (
+	"errors"
+	"time"
+
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/models"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/utils"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var ErrAnnouncementNotScheduled = errors.New("only scheduled announcements can be cancelled")
+
+//---------------------------------------------------------------------------
+// NotificationPreferenceRepository
+//---------------------------------------------------------------------------
+
+type notificationPreferenceRepository struct {
+	db *gorm.DB
+}
+
+func NewNotificationPreferenceRepository(db *gorm.DB) models.NotificationPreferenceRepository {
+	return &notificationPreferenceRepository{db: db}
+}
+
+func (r notificationPreferenceRepository) GetByUserID(userID uuid.UUID) (*models.NotificationPreference, error) {
+	preference := &models.NotificationPreference{}
+	err := r.db.Where("user_id = ?", userID).First(preference).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return &models.NotificationPreference{UserID: userID, EventReminders: true, EventAnnouncements: true, DiscussionReplies: true}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return preference, nil
+}
+
+func (r notificationPreferenceRepository) Upsert(preference *models.NotificationPreference) error {
+	// The columns are selected so a false value is saved rather than replaced by the column default
+	if err := r.db.
+		Select("user_id", "event_reminders", "event_announcements", "discussion_replies", "created_at", "updated_at").
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "user_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"event_reminders", "event_announcements", "discussion_replies", "updated_at"}),
+		}).
+		Create(preference).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+//---------------------------------------------------------------------------
+// EventReminderRepository
+//---------------------------------------------------------------------------
+
+type eventReminderRepository struct {
+	db *gorm.DB
+}
+
+func NewEventReminderRepository(db *gorm.DB) models.EventReminderRepository {
+	return &eventReminderRepository{db: db}
+}
+
+func (r eventReminderRepository) ListDue(kind models.ReminderKind, from time.Time, to time.Time) ([]models.TicketPurchased, error) {
+	var purchases []models.TicketPurchased
+	if err := r.db.
+		Preload("Event").
+		Preload("Event.Organization").
+		Preload("Event.Sessions", orderSessions).
+		Joins("JOIN events ON events.id = ticket_purchaseds.event_id AND events.deleted_at IS NULL").
+		Joins(`LEFT JOIN notification_preferences ON notification_preferences.user_id = ticket_purchaseds.user_id
+			AND notification_preferences.deleted_at IS NULL`).
+		Where("ticket_purchaseds.status = ?", models.TicketStatusConfirmed).
+		Where("events.status = ?", models.Published).
+		Where("events.start_at > ? AND events.start_at <= ?", from, to).
+		Where("(notification_preferences.id IS NULL OR notification_preferences.event_reminders)").
+		Where(`NOT EXISTS (SELECT 1 FROM event_reminders WHERE event_reminders.ticket_purchased_id = ticket_purchaseds.id
+			AND event_reminders.kind = ?)`, kind).
+		Order("ticket_purchaseds.id asc").
+		Find(&purchases).Error; err != nil {
+		return nil, err
+	}
+
+	return purchases, nil
+}
+
+func (r eventReminderRepository) Claim(ticketPurchasedID uint, kind models.ReminderKind) (bool, error) {
+	reminder := models.EventReminder{TicketPurchasedID: ticketPurchasedID, Kind: kind}
+	result := r.db.Clauses(clause.OnConflict{DoNothing: true}).Create(&reminder)
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return result.RowsAffected == 1, nil
+}
+
+func (r eventReminderRepository) Release(ticketPurchasedID uint, kind models.ReminderKind) error {
+	return r.db.Unscoped().
+		Where("ticket_purchased_id = ? AND kind = ?", ticketPurchasedID, kind).
+		Delete(&models.EventReminder{}).Error
+}
+
+//---------------------------------------------------------------------------
+// EventAnnouncementRepository
+//---------------------------------------------------------------------------
+
+type eventAnnouncementRepository struct {
+	db *gorm.DB
+}
+
+func NewEventAnnouncementRepository(db *gorm.DB) models.EventAnnouncementRepository {
+	return &eventAnnouncementRepository{db: db}
+}
+
+func (r eventAnnouncementRepository) Create(announcement *models.EventAnnouncement) error {
+	return r.db.Omit("Event").Create(announcement).Error
+}
+
+func (r eventAnnouncementRepository) ListByEventID(eventID uint) ([]models.EventAnnouncement, error) {
+	var announcements []models.EventAnnouncement
+	if err := r.db.Where("event_id = ?", eventID).Order("scheduled_at desc, id desc").Find(&announcements).Error; err != nil {
+		return nil, err
+	}
+
+	return announcements, nil
+}
+
+func (r eventAnnouncementRepository) Cancel(id uint, eventID uint) error {
+	tx := r.db.Begin()
+
+	announcement := &models.EventAnnouncement{}
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ? AND event_id = ?", id, eventID).
+		First(announcement).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if announcement.Status != models.AnnouncementStatusScheduled {
+		tx.Rollback()
+		return ErrAnnouncementNotScheduled
+	}
+
+	if err := tx.Model(announcement).Update("status", models.AnnouncementStatusCancelled).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit().Error
+}
+
+func (r eventAnnouncementRepository) ClaimDue(now time.Time) ([]models.EventAnnouncement, error) {
+	tx := r.db.Begin()
+
+	var announcements []models.EventAnnouncement
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+		Where("status = ? AND scheduled_at <= ?", models.AnnouncementStatusScheduled, now).
+		Order("scheduled_at asc, id asc").
+		Find(&announcements).Error; err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if len(announcements) == 0 {
+		tx.Rollback()
+		return announcements, nil
+	}
+
+	ids := make([]uint, 0, len(announcements))
+	for _, announcement := range announcements {
+		ids = append(ids, announcement.ID)
+	}
+
+	if err := tx.Model(&models.EventAnnouncement{}).
+		Where("id IN ?", ids).
+		Update("status", models.AnnouncementStatusSending).Error; err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return nil, err
+	}
+
+	var claimed []models.EventAnnouncement
+	if err := r.db.
+		Preload("Event").
+		Preload("Event.Organization").
+		Where("id IN ?", ids).
+		Order("scheduled_at asc, id asc").
+		Find(&claimed).Error; err != nil {
+		return nil, err
+	}
+
+	return claimed, nil
+}
+
+func (r eventAnnouncementRepository) MarkSent(id uint, recipients int, sentAt time.Time) error {
+	result := r.db.Model(&models.EventAnnouncement{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"status":     models.AnnouncementStatusSent,
+			"recipients": recipients,
+			"sent_at":    sentAt,
+		})
+	return utils.GormErrorAndRowsAffected(result)
+}
+
+func (r eventAnnouncementRepository) ListRecipients(eventID uint) ([]models.TicketPurchased, error) {
+	var purchases []models.TicketPurchased
+	if err := r.db.
+		Joins(`LEFT JOIN notification_preferences ON notification_preferences.user_id = ticket_purchaseds.user_id
+			AND notification_preferences.deleted_at IS NULL`).
+		Where("ticket_purchaseds.event_id = ? AND ticket_purchaseds.status <> ?", eventID, models.TicketStatusCancelled).
+		Where("(notification_preferences.id IS NULL OR notification_preferences.event_announcements)").
+		Order("ticket_purchaseds.created_at asc, ticket_purchaseds.id asc").
+		Find(&purchases).Error; err != nil {
+		return nil, err
+	}
+
+	return purchases, nil
+}
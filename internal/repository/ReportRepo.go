@@ -0,0 +1,192 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/models"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/utils"
+	"gorm.io/gorm"
+)
+
+type contentReportRepository struct {
+	db *gorm.DB
+}
+
+func NewContentReportRepository(db *gorm.DB) models.ContentReportRepository {
+	return &contentReportRepository{db: db}
+}
+
+func (r contentReportRepository) Create(report *models.ContentReport) (int64, error) {
+	if err := r.db.Create(report).Error; err != nil {
+		return 0, err
+	}
+
+	var pending int64
+	if err := r.db.Model(&models.ContentReport{}).
+		Where("target_type = ? AND target_id = ? AND status = ?", report.TargetType, report.TargetID, models.ReportPending).
+		Count(&pending).Error; err != nil {
+		return 0, err
+	}
+
+	return pending, nil
+}
+
+func (r contentReportRepository) GetContent(targetType models.ReportTarget, targetID uint) (*models.ModeratedContent, error) {
+	var content models.ModeratedContent
+	var err error
+
+	switch targetType {
+	case models.ReportEvent:
+		content.Event = &models.Event{}
+		err = r.db.Preload("Organization").Where("id = ?", targetID).First(content.Event).Error
+	case models.ReportJob:
+		content.Job = &models.OrgOpenJob{}
+		err = r.db.Preload("Organization").Where("id = ?", targetID).First(content.Job).Error
+	case models.ReportOrganization:
+		content.Organization = &models.Organization{}
+		err = r.db.Where("id = ?", targetID).First(content.Organization).Error
+	case models.ReportQuestion:
+		content.Question = &models.DiscussionQuestion{}
+		err = r.db.Preload("User").Where("id = ?", targetID).First(content.Question).Error
+	case models.ReportAnswer:
+		content.Answer = &models.DiscussionAnswer{}
+		err = r.db.Preload("User").Preload("Question").Where("id = ?", targetID).First(content.Answer).Error
+	default:
+		err = gorm.ErrRecordNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &content, nil
+}
+
+func (r contentReportRepository) ListQueue(targetType models.ReportTarget) ([]models.ReportedContent, error) {
+	query := r.db.Model(&models.ContentReport{}).
+		Select("target_type, target_id, COUNT(*) AS reports, MAX(created_at) AS last_reported_at").
+		Where("status = ?", models.ReportPending)
+	if targetType != "" {
+		query = query.Where("target_type = ?", targetType)
+	}
+
+	var queue []models.ReportedContent
+	if err := query.
+		Group("target_type, target_id").
+		Order("reports desc, last_reported_at desc").
+		Scan(&queue).Error; err != nil {
+		return nil, err
+	}
+
+	return queue, nil
+}
+
+func (r contentReportRepository) ListPending(targetType models.ReportTarget, targetID uint) ([]models.ContentReport, error) {
+	var reports []models.ContentReport
+	if err := r.db.
+		Preload("Reporter").
+		Where("target_type = ? AND target_id = ? AND status = ?", targetType, targetID, models.ReportPending).
+		Order("created_at asc, id asc").
+		Find(&reports).Error; err != nil {
+		return nil, err
+	}
+
+	return reports, nil
+}
+
+func (r contentReportRepository) ListLogs(targetType models.ReportTarget, targetID uint) ([]models.ModerationLog, error) {
+	var logs []models.ModerationLog
+	if err := r.db.
+		Where("target_type = ? AND target_id = ?", targetType, targetID).
+		Order("created_at desc, id desc").
+		Find(&logs).Error; err != nil {
+		return nil, err
+	}
+
+	return logs, nil
+}
+
+func (r contentReportRepository) Moderate(log *models.ModerationLog, change models.ModerationChange, status models.ReportStatus) ([]models.ContentReport, error) {
+	tx := r.db.Begin()
+
+	if change.Hidden != nil {
+		result := tx.Model(hideableModel(log.TargetType)).Where("id = ?", log.TargetID).Update("hidden", *change.Hidden)
+		if err := utils.GormErrorAndRowsAffected(result); err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+	}
+
+	if change.SuspendOrganizationID != 0 {
+		result := tx.Model(&models.Organization{}).Where("id = ?", change.SuspendOrganizationID).Update("suspended", true)
+		if err := utils.GormErrorAndRowsAffected(result); err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+	}
+
+	var reports []models.ContentReport
+	if status != "" {
+		if err := tx.
+			Preload("Reporter").
+			Where("target_type = ? AND target_id = ? AND status = ?", log.TargetType, log.TargetID, models.ReportPending).
+			Find(&reports).Error; err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+
+		if len(reports) > 0 {
+			now := time.Now()
+			ids := make([]uint, 0, len(reports))
+			for i := range reports {
+				ids = append(ids, reports[i].ID)
+				reports[i].Status = status
+				reports[i].ResolvedAt = &now
+			}
+
+			if err := tx.Model(&models.ContentReport{}).
+				Where("id IN ?", ids).
+				Updates(map[string]interface{}{"status": status, "resolved_at": now}).Error; err != nil {
+				tx.Rollback()
+				return nil, err
+			}
+		}
+		log.Reports = len(reports)
+	}
+
+	if err := tx.Create(log).Error; err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return nil, err
+	}
+
+	return reports, nil
+}
+
+// hideableModel is the model of the contents moderation hides, organizations are suspended instead.
+func hideableModel(targetType models.ReportTarget) interface{} {
+	switch targetType {
+	case models.ReportEvent:
+		return &models.Event{}
+	case models.ReportJob:
+		return &models.OrgOpenJob{}
+	case models.ReportQuestion:
+		return &models.DiscussionQuestion{}
+	case models.ReportAnswer:
+		return &models.DiscussionAnswer{}
+	}
+
+	return nil
+}
+
+// listedEvents leaves out the events hidden by moderation and those of suspended organizations
+func listedEvents(db *gorm.DB) *gorm.DB {
+	return db.Where("events.hidden = ? AND events.organization_id NOT IN (SELECT id FROM organizations WHERE suspended)", false)
+}
+
+// listedJobs leaves out the jobs hidden by moderation and those of suspended organizations
+func listedJobs(db *gorm.DB) *gorm.DB {
+	return db.Where("org_open_jobs.hidden = ? AND org_open_jobs.organization_id NOT IN (SELECT id FROM organizations WHERE suspended)", false)
+}
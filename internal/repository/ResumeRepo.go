@@ -0,0 +1,119 @@
+package repository
+
+import (
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/models"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/utils"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type resumeRepository struct {
+	db *gorm.DB
+}
+
+func NewResumeRepository(db *gorm.DB) ResumeRepository {
+	return resumeRepository{db: db}
+}
+
+func (r resumeRepository) Create(resume *models.Resume) error {
+	return r.db.Create(resume).Error
+}
+
+// GetNextVersion counts deleted versions as well so that version numbers are never reused.
+func (r resumeRepository) GetNextVersion(userID uuid.UUID) (int, error) {
+	var latest int
+	if err := r.db.Unscoped().
+		Model(&models.Resume{}).
+		Select("COALESCE(MAX(version), 0)").
+		Where("user_id = ?", userID).
+		Scan(&latest).Error; err != nil {
+		return 0, err
+	}
+
+	return latest + 1, nil
+}
+
+func (r resumeRepository) GetByIDAndUserID(resumeID uint, userID uuid.UUID) (*models.Resume, error) {
+	resume := &models.Resume{}
+	if err := r.db.
+		Where("id = ? AND user_id = ?", resumeID, userID).
+		First(resume).Error; err != nil {
+		return nil, err
+	}
+
+	return resume, nil
+}
+
+func (r resumeRepository) ListByUserID(userID uuid.UUID) ([]models.Resume, error) {
+	var resumes []models.Resume
+	if err := r.db.
+		Where("user_id = ?", userID).
+		Order("version desc").
+		Find(&resumes).Error; err != nil {
+		return nil, err
+	}
+
+	return resumes, nil
+}
+
+func (r resumeRepository) IsAttachedToApplication(resumeID uint) (bool, error) {
+	var count int64
+	if err := r.db.
+		Model(&models.JobApplication{}).
+		Where("resume_id = ?", resumeID).
+		Count(&count).Error; err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+func (r resumeRepository) Delete(resumeID uint) error {
+	result := r.db.Delete(&models.Resume{}, resumeID)
+	return utils.GormErrorAndRowsAffected(result)
+}
+
+// ApplyToProfile saves the confirmed resume suggestions: education and skill text are overwritten when given,
+// skills are added to the existing ones and experiences are created.
+func (r resumeRepository) ApplyToProfile(userID uuid.UUID, profile *models.Profile, skills []models.Skill, experiences []models.Experience) error {
+	tx := r.db.Begin()
+
+	existProfile := &models.Profile{}
+	if err := tx.Where("user_id = ?", userID).First(existProfile).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	updates := map[string]interface{}{}
+	if profile.Education != "" {
+		updates["education"] = profile.Education
+	}
+	if profile.Skill != "" {
+		updates["skill"] = profile.Skill
+	}
+	if len(updates) > 0 {
+		if err := tx.Model(existProfile).Updates(updates).Error; err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	if len(skills) > 0 {
+		if err := tx.Model(existProfile).Association("Skills").Append(skills); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	if len(experiences) > 0 {
+		for i := range experiences {
+			experiences[i].ProfileID = existProfile.ID
+		}
+		if err := tx.Create(&experiences).Error; err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	return tx.Commit().Error
+}
@@ -0,0 +1,219 @@
+package repository
+
+import (
+	"strings"
+
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/models"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/utils"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type skillRepository struct {
+	db *gorm.DB
+}
+
+func NewSkillRepository(db *gorm.DB) SkillRepository {
+	return skillRepository{db: db}
+}
+
+func (r skillRepository) Create(skill *models.Skill) error {
+	return r.db.Create(skill).Error
+}
+
+func (r skillRepository) GetByID(skillID uint) (*models.Skill, error) {
+	skill := &models.Skill{}
+	if err := r.db.
+		Preload("Synonyms").
+		Where("id = ?", skillID).
+		First(skill).Error; err != nil {
+		return nil, err
+	}
+
+	return skill, nil
+}
+
+func (r skillRepository) GetAll() ([]models.Skill, error) {
+	var skills []models.Skill
+	if err := r.db.
+		Preload("Synonyms").
+		Order("name asc").
+		Find(&skills).Error; err != nil {
+		return nil, err
+	}
+
+	return skills, nil
+}
+
+// Autocomplete matches active skills whose name, slug or one of the synonyms contains the query.
+func (r skillRepository) Autocomplete(query string, limit int) ([]models.Skill, error) {
+	pattern := "%" + strings.ToLower(query) + "%"
+	synonyms := r.db.Model(&models.SkillSynonym{}).
+		Select("skill_id").
+		Where("LOWER(name) LIKE ?", pattern)
+
+	var skills []models.Skill
+	if err := r.db.
+		Where("is_active = ?", true).
+		Where(r.db.
+			Where("LOWER(name) LIKE ?", pattern).
+			Or("LOWER(slug) LIKE ?", pattern).
+			Or("id IN (?)", synonyms)).
+		Order("name asc").
+		Limit(limit).
+		Find(&skills).Error; err != nil {
+		return nil, err
+	}
+
+	return skills, nil
+}
+
+func (r skillRepository) FindByIds(skillIDs []uint) ([]models.Skill, error) {
+	var skills []models.Skill
+	if len(skillIDs) == 0 {
+		return skills, nil
+	}
+
+	if err := r.db.Find(&skills, skillIDs).Error; err != nil {
+		return nil, err
+	}
+
+	return skills, nil
+}
+
+// FindByNames matches active skills by name, slug or synonym, case-insensitively.
+func (r skillRepository) FindByNames(names []string) ([]models.Skill, error) {
+	var skills []models.Skill
+	if len(names) == 0 {
+		return skills, nil
+	}
+
+	lowerNames := make([]string, 0, len(names))
+	for _, name := range names {
+		lowerNames = append(lowerNames, strings.ToLower(name))
+	}
+
+	synonyms := r.db.Model(&models.SkillSynonym{}).
+		Select("skill_id").
+		Where("LOWER(name) IN ?", lowerNames)
+
+	if err := r.db.
+		Where("is_active = ?", true).
+		Where(r.db.
+			Where("LOWER(name) IN ?", lowerNames).
+			Or("LOWER(slug) IN ?", lowerNames).
+			Or("id IN (?)", synonyms)).
+		Order("name asc").
+		Find(&skills).Error; err != nil {
+		return nil, err
+	}
+
+	return skills, nil
+}
+
+func (r skillRepository) Update(skill *models.Skill) (*models.Skill, error) {
+	tx := r.db.Begin()
+
+	existSkill := &models.Skill{}
+	if err := tx.Where("id = ?", skill.ID).First(existSkill).Error; err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	// Synonyms are replaced as a whole
+	if err := tx.Unscoped().Where("skill_id = ?", skill.ID).Delete(&models.SkillSynonym{}).Error; err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Model(existSkill).Select("name", "slug", "parent_id", "is_active").Updates(skill).Error; err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if len(skill.Synonyms) > 0 {
+		for i := range skill.Synonyms {
+			skill.Synonyms[i].SkillID = skill.ID
+		}
+		if err := tx.Create(&skill.Synonyms).Error; err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+	}
+
+	updatedSkill := &models.Skill{}
+	if err := tx.Preload("Synonyms").Where("id = ?", skill.ID).First(updatedSkill).Error; err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return nil, err
+	}
+
+	return updatedSkill, nil
+}
+
+func (r skillRepository) Delete(skillID uint) error {
+	tx := r.db.Begin()
+
+	// Detach the skill from profiles, jobs and sub skills before removing it
+	if err := tx.Exec("DELETE FROM profile_skill WHERE skill_id = ?", skillID).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := tx.Exec("DELETE FROM job_skill WHERE skill_id = ?", skillID).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := tx.Model(&models.Skill{}).Where("parent_id = ?", skillID).Update("parent_id", nil).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := tx.Where("skill_id = ?", skillID).Delete(&models.SkillSynonym{}).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	result := tx.Delete(&models.Skill{}, skillID)
+	if err := utils.GormErrorAndRowsAffected(result); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit().Error
+}
+
+func (r skillRepository) GetByUserID(userID uuid.UUID) ([]models.Skill, error) {
+	var skills []models.Skill
+	if err := r.db.
+		Joins("JOIN profile_skill ON profile_skill.skill_id = skills.id").
+		Joins("JOIN profiles ON profiles.id = profile_skill.profile_id").
+		Where("profiles.user_id = ?", userID).
+		Order("skills.name asc").
+		Find(&skills).Error; err != nil {
+		return nil, err
+	}
+
+	return skills, nil
+}
+
+func (r skillRepository) ReplaceUserSkills(userID uuid.UUID, skills []models.Skill) error {
+	tx := r.db.Begin()
+
+	profile := &models.Profile{}
+	if err := tx.Where("user_id = ?", userID).First(profile).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := tx.Model(profile).Association("Skills").Replace(skills); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit().Error
+}
@@ -0,0 +1,268 @@
+package repository
+
+import (
+	"errors"
+	"time"
+
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/models"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/utils"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type eventSurveyRepository struct {
+	db *gorm.DB
+}
+
+func NewEventSurveyRepository(db *gorm.DB) models.EventSurveyRepository {
+	return &eventSurveyRepository{db: db}
+}
+
+// orderQuestions lists preloaded survey questions in their order
+func orderQuestions(db *gorm.DB) *gorm.DB {
+	return db.Order("position asc, id asc")
+}
+
+// orderOptions lists preloaded question options in their order
+func orderOptions(db *gorm.DB) *gorm.DB {
+	return db.Order("position asc, id asc")
+}
+
+func (r eventSurveyRepository) GetByEventID(eventID uint) (*models.EventSurvey, error) {
+	survey := &models.EventSurvey{}
+	if err := r.db.
+		Preload("Questions", orderQuestions).
+		Preload("Questions.Options", orderOptions).
+		Where("event_id = ?", eventID).
+		First(survey).Error; err != nil {
+		return nil, err
+	}
+
+	return survey, nil
+}
+
+func (r eventSurveyRepository) Save(survey *models.EventSurvey) error {
+	tx := r.db.Begin()
+
+	var existing models.EventSurvey
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("event_id = ?", survey.EventID).First(&existing).Error
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		tx.Rollback()
+		return err
+	}
+
+	if err == nil {
+		// Options go with their questions through the foreign key
+		if err := tx.Unscoped().Where("survey_id = ?", existing.ID).Delete(&models.SurveyQuestion{}).Error; err != nil {
+			tx.Rollback()
+			return err
+		}
+
+		survey.ID = existing.ID
+		if err := tx.Model(&models.EventSurvey{}).
+			Where("id = ?", existing.ID).
+			Select("title", "description", "show_rating_publicly").
+			Updates(survey).Error; err != nil {
+			tx.Rollback()
+			return err
+		}
+
+		for i := range survey.Questions {
+			survey.Questions[i].SurveyID = existing.ID
+		}
+		if len(survey.Questions) > 0 {
+			if err := tx.Create(&survey.Questions).Error; err != nil {
+				tx.Rollback()
+				return err
+			}
+		}
+	} else if err := tx.Omit("Event").Create(survey).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return err
+	}
+
+	saved, err := r.GetByEventID(survey.EventID)
+	if err != nil {
+		return err
+	}
+	*survey = *saved
+
+	return nil
+}
+
+func (r eventSurveyRepository) UpdateDetails(survey *models.EventSurvey) error {
+	result := r.db.Model(&models.EventSurvey{}).
+		Where("event_id = ?", survey.EventID).
+		Select("title", "description", "show_rating_publicly").
+		Updates(survey)
+	if err := utils.GormErrorAndRowsAffected(result); err != nil {
+		return err
+	}
+
+	saved, err := r.GetByEventID(survey.EventID)
+	if err != nil {
+		return err
+	}
+	*survey = *saved
+
+	return nil
+}
+
+func (r eventSurveyRepository) Delete(eventID uint) error {
+	// Questions, options, responses and answers go with the survey through their foreign keys
+	result := r.db.Unscoped().Where("event_id = ?", eventID).Delete(&models.EventSurvey{})
+	return utils.GormErrorAndRowsAffected(result)
+}
+
+func (r eventSurveyRepository) CountResponses(surveyID uint) (int64, error) {
+	var count int64
+	if err := r.db.Model(&models.SurveyResponse{}).Where("survey_id = ?", surveyID).Count(&count).Error; err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (r eventSurveyRepository) ClaimEnded(now time.Time) ([]models.EventSurvey, error) {
+	tx := r.db.Begin()
+
+	var surveys []models.EventSurvey
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE", Table: clause.Table{Name: clause.CurrentTable}, Options: "SKIP LOCKED"}).
+		Joins("JOIN events ON events.id = event_surveys.event_id AND events.deleted_at IS NULL").
+		Where("event_surveys.sent_at IS NULL AND events.end_at <= ?", now).
+		Find(&surveys).Error; err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if len(surveys) == 0 {
+		tx.Rollback()
+		return surveys, nil
+	}
+
+	ids := make([]uint, 0, len(surveys))
+	for _, survey := range surveys {
+		ids = append(ids, survey.ID)
+	}
+
+	if err := tx.Model(&models.EventSurvey{}).Where("id IN ?", ids).Update("sent_at", now).Error; err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return nil, err
+	}
+
+	var claimed []models.EventSurvey
+	if err := r.db.
+		Preload("Event").
+		Preload("Event.Organization").
+		Where("id IN ?", ids).
+		Order("id asc").
+		Find(&claimed).Error; err != nil {
+		return nil, err
+	}
+
+	return claimed, nil
+}
+
+func (r eventSurveyRepository) ListCheckedIn(eventID uint) ([]models.EventParticipant, error) {
+	var participants []models.EventParticipant
+	if err := r.db.
+		Preload("User").
+		Where("event_id = ? AND checked_in_at IS NOT NULL", eventID).
+		Order("id asc").
+		Find(&participants).Error; err != nil {
+		return nil, err
+	}
+
+	return participants, nil
+}
+
+func (r eventSurveyRepository) IsCheckedIn(userID uuid.UUID, eventID uint) (bool, error) {
+	var count int64
+	if err := r.db.Model(&models.EventParticipant{}).
+		Where("user_id = ? AND event_id = ? AND checked_in_at IS NOT NULL", userID, eventID).
+		Count(&count).Error; err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+func (r eventSurveyRepository) CreateResponse(response *models.SurveyResponse) error {
+	return r.db.Omit("Survey", "User").Create(response).Error
+}
+
+func (r eventSurveyRepository) GetResponse(surveyID uint, userID uuid.UUID) (*models.SurveyResponse, error) {
+	response := &models.SurveyResponse{}
+	if err := r.db.
+		Preload("Answers").
+		Where("survey_id = ? AND user_id = ?", surveyID, userID).
+		First(response).Error; err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+func (r eventSurveyRepository) ListResponses(surveyID uint) ([]models.SurveyResponse, error) {
+	var responses []models.SurveyResponse
+	if err := r.db.
+		Preload("Answers").
+		Where("survey_id = ?", surveyID).
+		Order("created_at asc, id asc").
+		Find(&responses).Error; err != nil {
+		return nil, err
+	}
+
+	return responses, nil
+}
+
+// ratings averages the rating answers per event, over events that have a survey.
+func (r eventSurveyRepository) ratings() *gorm.DB {
+	return r.db.
+		Model(&models.EventSurvey{}).
+		Select(`events.id AS event_id,
+			events.name AS event_name,
+			COUNT(DISTINCT survey_responses.id) AS responses,
+			COUNT(survey_answers.rating) AS ratings,
+			COALESCE(AVG(survey_answers.rating), 0) AS average`).
+		Joins("JOIN events ON events.id = event_surveys.event_id AND events.deleted_at IS NULL").
+		Joins("LEFT JOIN survey_responses ON survey_responses.survey_id = event_surveys.id AND survey_responses.deleted_at IS NULL").
+		Joins("LEFT JOIN survey_answers ON survey_answers.response_id = survey_responses.id AND survey_answers.deleted_at IS NULL").
+		Group("events.id, events.name")
+}
+
+func (r eventSurveyRepository) RatingsByOrgID(orgID uint, publicOnly bool) ([]models.SurveyRating, error) {
+	query := r.ratings().Where("events.organization_id = ?", orgID)
+	if publicOnly {
+		query = query.Where("event_surveys.show_rating_publicly = ?", true)
+	}
+
+	var ratings []models.SurveyRating
+	if err := query.Order("events.id desc").Scan(&ratings).Error; err != nil {
+		return nil, err
+	}
+
+	return ratings, nil
+}
+
+func (r eventSurveyRepository) RatingByEventID(eventID uint) (*models.SurveyRating, error) {
+	var ratings []models.SurveyRating
+	if err := r.ratings().Where("events.id = ?", eventID).Scan(&ratings).Error; err != nil {
+		return nil, err
+	}
+
+	if len(ratings) == 0 {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	return &ratings[0], nil
+}
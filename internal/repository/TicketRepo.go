@@ -0,0 +1,438 @@
+package repository
+
+import (
+	"errors"
+	"time"
+
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/models"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/utils"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	ErrTicketSoldOut          = errors.New("ticket is sold out")
+	ErrTicketAlreadyCheckedIn = errors.New("ticket is already checked in")
+	ErrTicketAlreadyCancelled = errors.New("ticket is already cancelled")
+	ErrAlreadyRegistered      = errors.New("user is already registered for this event")
+)
+
+type ticketAvailableRepository struct {
+	db *gorm.DB
+}
+
+func NewTicketAvailableRepository(db *gorm.DB) models.TicketAvailableRepository {
+	return ticketAvailableRepository{db: db}
+}
+
+func (r ticketAvailableRepository) GetByID(id uint) (*models.TicketAvailable, error) {
+	ticket := &models.TicketAvailable{}
+	if err := r.db.Where("id = ?", id).First(ticket).Error; err != nil {
+		return nil, err
+	}
+
+	return ticket, nil
+}
+
+func (r ticketAvailableRepository) GetByIDAndEventID(id uint, eventID uint) (*models.TicketAvailable, error) {
+	ticket := &models.TicketAvailable{}
+	if err := r.db.
+		Where("id = ? AND event_id = ?", id, eventID).
+		First(ticket).Error; err != nil {
+		return nil, err
+	}
+
+	return ticket, nil
+}
+
+func (r ticketAvailableRepository) GetAll() ([]models.TicketAvailable, error) {
+	var tickets []models.TicketAvailable
+	if err := r.db.Order("id asc").Find(&tickets).Error; err != nil {
+		return nil, err
+	}
+
+	return tickets, nil
+}
+
+func (r ticketAvailableRepository) GetAllByEventID(eventID uint) ([]models.TicketAvailable, error) {
+	var tickets []models.TicketAvailable
+	if err := r.db.
+		Where("event_id = ?", eventID).
+		Order("price asc, id asc").
+		Find(&tickets).Error; err != nil {
+		return nil, err
+	}
+
+	return tickets, nil
+}
+
+func (r ticketAvailableRepository) Create(ticketAvailable *models.TicketAvailable) error {
+	return r.db.Create(ticketAvailable).Error
+}
+
+// Update locks the ticket type so that a change of quantity never races with a registration.
+func (r ticketAvailableRepository) Update(ticketAvailable *models.TicketAvailable) error {
+	tx := r.db.Begin()
+
+	existTicket := &models.TicketAvailable{}
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ? AND event_id = ?", ticketAvailable.ID, ticketAvailable.EventID).
+		First(existTicket).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := tx.Model(existTicket).
+		Select("title", "description", "quantity", "price").
+		Updates(ticketAvailable).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit().Error
+}
+
+func (r ticketAvailableRepository) Delete(id uint) error {
+	result := r.db.Delete(&models.TicketAvailable{}, id)
+	return utils.GormErrorAndRowsAffected(result)
+}
+
+func (r ticketAvailableRepository) CountPurchases(id uint) (int64, error) {
+	var count int64
+	if err := r.db.
+		Model(&models.TicketPurchased{}).
+		Where("ticket_available_id = ?", id).
+		Count(&count).Error; err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+type ticketPurchasedRepository struct {
+	db *gorm.DB
+}
+
+func NewTicketPurchasedRepository(db *gorm.DB) models.TicketPurchasedRepository {
+	return ticketPurchasedRepository{db: db}
+}
+
+// Register locks the ticket type row (SELECT ... FOR UPDATE) before taking a seat, concurrent registrations
+// wait for each other so the quantity never goes below zero.
+func (r ticketPurchasedRepository) Register(purchase *models.TicketPurchased, promoCode string) error {
+	tx := r.db.Begin()
+
+	ticket := &models.TicketAvailable{}
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ? AND event_id = ?", purchase.TicketAvailableID, purchase.EventID).
+		First(ticket).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if ticket.Quantity < 1 {
+		tx.Rollback()
+		return ErrTicketSoldOut
+	}
+
+	if err := checkNotRegistered(tx, purchase.UserID, purchase.EventID); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := tx.Model(ticket).
+		Update("quantity", gorm.Expr("quantity - ?", 1)).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	purchase.TicketTitle = ticket.Title
+	purchase.Price = ticket.Price
+
+	var promo *models.PromoCode
+	if promoCode != "" {
+		var discount float64
+		var err error
+		promo, discount, err = checkPromoCode(tx, purchase.EventID, ticket.ID, purchase.UserID, promoCode, ticket.Price)
+		if err != nil {
+			tx.Rollback()
+			return err
+		}
+
+		purchase.DiscountAmount = discount
+		purchase.Price = ticket.Price - discount
+		// A full discount leaves nothing to pay
+		if purchase.Price == 0 && purchase.Status == models.TicketStatusPending {
+			purchase.Status = models.TicketStatusConfirmed
+			purchase.ConfirmationAt = time.Now().Format("2006-01-02 15:04:05")
+		}
+	}
+
+	if err := tx.Create(purchase).Error; err != nil {
+		tx.Rollback()
+		return registrationError(err)
+	}
+
+	if promo != nil {
+		if err := redeemPromoCode(tx, promo, purchase, ticket.Price); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	participant := models.EventParticipant{UserId: purchase.UserID, EventId: purchase.EventID}
+	if err := tx.
+		Where("user_id = ? AND event_id = ?", purchase.UserID, purchase.EventID).
+		FirstOrCreate(&participant).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return err
+	}
+
+	return r.db.
+		Preload("Event").
+		Preload("TicketAvailable").
+		First(purchase, purchase.ID).Error
+}
+
+// checkNotRegistered repeats the service check inside the transaction. Registrations for other ticket types
+// of the event are not serialized by the ticket lock, those are left to the idx_ticket_purchased_active index.
+func checkNotRegistered(tx *gorm.DB, userID uuid.UUID, eventID uint) error {
+	err := tx.
+		Where("user_id = ? AND event_id = ? AND status <> ?", userID, eventID, models.TicketStatusCancelled).
+		First(&models.TicketPurchased{}).Error
+	if err == nil {
+		return ErrAlreadyRegistered
+	}
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil
+	}
+
+	return err
+}
+
+// registrationError maps the violation of the one active registration per event index to ErrAlreadyRegistered.
+func registrationError(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == "23505" && pgErr.ConstraintName == "idx_ticket_purchased_active" {
+		return ErrAlreadyRegistered
+	}
+
+	return err
+}
+
+func (r ticketPurchasedRepository) GetByID(id uint) (*models.TicketPurchased, error) {
+	purchase := &models.TicketPurchased{}
+	if err := r.db.
+		Preload("Event").
+		Preload("TicketAvailable").
+		Where("id = ?", id).
+		First(purchase).Error; err != nil {
+		return nil, err
+	}
+
+	return purchase, nil
+}
+
+func (r ticketPurchasedRepository) GetByIDAndUserID(id uint, userID uuid.UUID) (*models.TicketPurchased, error) {
+	purchase := &models.TicketPurchased{}
+	if err := r.db.
+		Preload("Event").
+		Preload("TicketAvailable").
+		Where("id = ? AND user_id = ?", id, userID).
+		First(purchase).Error; err != nil {
+		return nil, err
+	}
+
+	return purchase, nil
+}
+
+func (r ticketPurchasedRepository) GetActiveByUserAndEvent(userID uuid.UUID, eventID uint) (*models.TicketPurchased, error) {
+	purchase := &models.TicketPurchased{}
+	if err := r.db.
+		Where("user_id = ? AND event_id = ? AND status <> ?", userID, eventID, models.TicketStatusCancelled).
+		First(purchase).Error; err != nil {
+		return nil, err
+	}
+
+	return purchase, nil
+}
+
+func (r ticketPurchasedRepository) ListByUserID(userID uuid.UUID) ([]models.TicketPurchased, error) {
+	var purchases []models.TicketPurchased
+	if err := r.db.
+		Preload("Event").
+		Preload("TicketAvailable").
+		Where("user_id = ?", userID).
+		Order("created_at desc").
+		Find(&purchases).Error; err != nil {
+		return nil, err
+	}
+
+	return purchases, nil
+}
+
+func (r ticketPurchasedRepository) ListByEventID(eventID uint) ([]models.TicketPurchased, error) {
+	var purchases []models.TicketPurchased
+	if err := r.db.
+		Preload("Event").
+		Preload("TicketAvailable").
+		Where("event_id = ?", eventID).
+		Order("created_at asc").
+		Find(&purchases).Error; err != nil {
+		return nil, err
+	}
+
+	return purchases, nil
+}
+
+func (r ticketPurchasedRepository) GetByQrcode(qrcode string) (*models.TicketPurchased, error) {
+	purchase := &models.TicketPurchased{}
+	if err := r.db.
+		Preload("Event").
+		Preload("TicketAvailable").
+		Where("qrcode = ?", qrcode).
+		First(purchase).Error; err != nil {
+		return nil, err
+	}
+
+	return purchase, nil
+}
+
+func (r ticketPurchasedRepository) UpdateQrcode(id uint, qrcode string) error {
+	result := r.db.Model(&models.TicketPurchased{}).Where("id = ?", id).Update("qrcode", qrcode)
+	return utils.GormErrorAndRowsAffected(result)
+}
+
+// CheckIn locks the ticket so that two scanners reading the same code at once cannot both let it in.
+func (r ticketPurchasedRepository) CheckIn(id uint) (*models.TicketPurchased, error) {
+	tx := r.db.Begin()
+
+	purchase := &models.TicketPurchased{}
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ?", id).
+		First(purchase).Error; err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if purchase.CheckedInAt != nil {
+		tx.Rollback()
+		return purchase, ErrTicketAlreadyCheckedIn
+	}
+
+	now := time.Now()
+	if err := tx.Model(purchase).Update("checked_in_at", now).Error; err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Model(&models.EventParticipant{}).
+		Where("user_id = ? AND event_id = ? AND checked_in_at IS NULL", purchase.UserID, purchase.EventID).
+		Update("checked_in_at", now).Error; err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return nil, err
+	}
+
+	return r.GetByID(id)
+}
+
+func (r ticketPurchasedRepository) CountCheckInsByEventID(eventID uint) ([]models.TicketCheckInCount, error) {
+	var counts []models.TicketCheckInCount
+	if err := r.db.
+		Model(&models.TicketAvailable{}).
+		Select(`ticket_availables.id AS ticket_available_id,
+			ticket_availables.title AS ticket_title,
+			COUNT(ticket_purchaseds.id) AS registered,
+			COUNT(ticket_purchaseds.checked_in_at) AS checked_in`).
+		Joins(`LEFT JOIN ticket_purchaseds ON ticket_purchaseds.ticket_available_id = ticket_availables.id
+			AND ticket_purchaseds.status = ? AND ticket_purchaseds.deleted_at IS NULL`, models.TicketStatusConfirmed).
+		Where("ticket_availables.event_id = ?", eventID).
+		Group("ticket_availables.id, ticket_availables.title").
+		Order("ticket_availables.id asc").
+		Scan(&counts).Error; err != nil {
+		return nil, err
+	}
+
+	return counts, nil
+}
+
+func (r ticketPurchasedRepository) Cancel(id uint, offerExpiresAt time.Time) (*models.WaitlistEntry, error) {
+	tx := r.db.Begin()
+
+	purchase := &models.TicketPurchased{}
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ?", id).
+		First(purchase).Error; err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	offer, err := cancelPurchase(tx, purchase, offerExpiresAt)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return nil, err
+	}
+
+	if offer == nil {
+		return nil, nil
+	}
+
+	return NewWaitlistRepository(r.db).GetByID(offer.ID)
+}
+
+// cancelPurchase cancels a locked ticket and its unpaid order, then frees its seat. It must run in a transaction.
+func cancelPurchase(tx *gorm.DB, purchase *models.TicketPurchased, offerExpiresAt time.Time) (*models.WaitlistEntry, error) {
+	if purchase.Status == models.TicketStatusCancelled {
+		return nil, ErrTicketAlreadyCancelled
+	}
+
+	if purchase.CheckedInAt != nil {
+		return nil, ErrTicketAlreadyCheckedIn
+	}
+
+	if err := tx.Model(purchase).Update("status", models.TicketStatusCancelled).Error; err != nil {
+		return nil, err
+	}
+
+	if err := tx.Model(&models.Order{}).
+		Where("ticket_purchased_id = ? AND status = ?", purchase.ID, models.OrderStatusPending).
+		Update("status", models.OrderStatusCancelled).Error; err != nil {
+		return nil, err
+	}
+
+	if err := releasePromoCode(tx, purchase.ID); err != nil {
+		return nil, err
+	}
+
+	// The user stays a participant while another ticket of the event is active
+	var activeTickets int64
+	if err := tx.Model(&models.TicketPurchased{}).
+		Where("user_id = ? AND event_id = ? AND status <> ?", purchase.UserID, purchase.EventID, models.TicketStatusCancelled).
+		Count(&activeTickets).Error; err != nil {
+		return nil, err
+	}
+
+	if activeTickets == 0 {
+		if err := tx.
+			Where("user_id = ? AND event_id = ?", purchase.UserID, purchase.EventID).
+			Delete(&models.EventParticipant{}).Error; err != nil {
+			return nil, err
+		}
+	}
+
+	return releaseSeat(tx, purchase.TicketAvailableID, offerExpiresAt)
+}
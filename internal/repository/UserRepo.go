@@ -66,7 +66,7 @@ func (r userRepository) GetAll() ([]models.User, error) {
 
 func (r userRepository) GetProfileByUserID(userId uuid.UUID) (*models.Profile, error) {
 	var userProfile models.Profile
-	if err := r.db.Preload("User").Where("User_ID = ?", userId).First(&userProfile).Error; err != nil {
+	if err := r.db.Preload("User").Preload("Skills").Where("User_ID = ?", userId).First(&userProfile).Error; err != nil {
 		return nil, err
 	}
 	return &userProfile, nil
@@ -0,0 +1,437 @@
+package repository
+
+import (
+	"errors"
+	"time"
+
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/models"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	ErrTicketStillAvailable  = errors.New("ticket is not sold out")
+	ErrWaitlistEntryClosed   = errors.New("waitlist entry is no longer active")
+	ErrWaitlistOfferExpired  = errors.New("waitlist offer has expired")
+	ErrWaitlistOrderMismatch = errors.New("the new order must list every active entry of the waitlist once")
+)
+
+var activeWaitlistStatuses = []models.WaitlistStatus{models.WaitlistStatusWaiting, models.WaitlistStatusOffered}
+
+type waitlistRepository struct {
+	db *gorm.DB
+}
+
+func NewWaitlistRepository(db *gorm.DB) models.WaitlistRepository {
+	return waitlistRepository{db: db}
+}
+
+func (r waitlistRepository) Join(entry *models.WaitlistEntry) error {
+	tx := r.db.Begin()
+
+	ticket := &models.TicketAvailable{}
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ? AND event_id = ?", entry.TicketAvailableID, entry.EventID).
+		First(ticket).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if ticket.Quantity > 0 {
+		tx.Rollback()
+		return ErrTicketStillAvailable
+	}
+
+	var last int
+	if err := tx.Model(&models.WaitlistEntry{}).
+		Select("COALESCE(MAX(position), 0)").
+		Where("ticket_available_id = ?", entry.TicketAvailableID).
+		Scan(&last).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	entry.Position = last + 1
+	entry.Status = models.WaitlistStatusWaiting
+	if err := tx.Create(entry).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return err
+	}
+
+	return r.preload().First(entry, entry.ID).Error
+}
+
+func (r waitlistRepository) GetByID(id uint) (*models.WaitlistEntry, error) {
+	entry := &models.WaitlistEntry{}
+	if err := r.preload().Where("id = ?", id).First(entry).Error; err != nil {
+		return nil, err
+	}
+
+	return entry, nil
+}
+
+func (r waitlistRepository) GetByIDAndUserID(id uint, userID uuid.UUID) (*models.WaitlistEntry, error) {
+	entry := &models.WaitlistEntry{}
+	if err := r.preload().
+		Where("id = ? AND user_id = ?", id, userID).
+		First(entry).Error; err != nil {
+		return nil, err
+	}
+
+	return entry, nil
+}
+
+func (r waitlistRepository) GetByOfferToken(token uuid.UUID) (*models.WaitlistEntry, error) {
+	entry := &models.WaitlistEntry{}
+	if err := r.preload().Where("offer_token = ?", token).First(entry).Error; err != nil {
+		return nil, err
+	}
+
+	return entry, nil
+}
+
+func (r waitlistRepository) GetActiveByUserAndTicket(userID uuid.UUID, ticketAvailableID uint) (*models.WaitlistEntry, error) {
+	entry := &models.WaitlistEntry{}
+	if err := r.db.
+		Where("user_id = ? AND ticket_available_id = ? AND status IN ?", userID, ticketAvailableID, activeWaitlistStatuses).
+		First(entry).Error; err != nil {
+		return nil, err
+	}
+
+	return entry, nil
+}
+
+func (r waitlistRepository) ListActiveByTicketID(ticketAvailableID uint) ([]models.WaitlistEntry, error) {
+	var entries []models.WaitlistEntry
+	if err := r.preload().
+		Where("ticket_available_id = ? AND status IN ?", ticketAvailableID, activeWaitlistStatuses).
+		Order("position asc, id asc").
+		Find(&entries).Error; err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+func (r waitlistRepository) ListByUserID(userID uuid.UUID) ([]models.WaitlistEntry, error) {
+	var entries []models.WaitlistEntry
+	if err := r.preload().
+		Where("user_id = ?", userID).
+		Order("created_at desc").
+		Find(&entries).Error; err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+func (r waitlistRepository) Leave(id uint, offerExpiresAt time.Time) (*models.WaitlistEntry, error) {
+	tx := r.db.Begin()
+
+	entry := &models.WaitlistEntry{}
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ?", id).
+		First(entry).Error; err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if entry.Status != models.WaitlistStatusWaiting && entry.Status != models.WaitlistStatusOffered {
+		tx.Rollback()
+		return nil, ErrWaitlistEntryClosed
+	}
+
+	if err := tx.Model(entry).Update("status", models.WaitlistStatusLeft).Error; err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	var offer *models.WaitlistEntry
+	if entry.Status == models.WaitlistStatusOffered {
+		var err error
+		if offer, err = releaseSeat(tx, entry.TicketAvailableID, offerExpiresAt); err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return nil, err
+	}
+
+	return r.reload(offer)
+}
+
+func (r waitlistRepository) Reorder(ticketAvailableID uint, entryIDs []uint) error {
+	tx := r.db.Begin()
+
+	var entries []models.WaitlistEntry
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("ticket_available_id = ? AND status IN ?", ticketAvailableID, activeWaitlistStatuses).
+		Find(&entries).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	active := make(map[uint]bool, len(entries))
+	for _, entry := range entries {
+		active[entry.ID] = true
+	}
+
+	if len(entryIDs) != len(active) {
+		tx.Rollback()
+		return ErrWaitlistOrderMismatch
+	}
+
+	for i, entryID := range entryIDs {
+		if !active[entryID] {
+			tx.Rollback()
+			return ErrWaitlistOrderMismatch
+		}
+		// Each entry is listed once
+		delete(active, entryID)
+
+		if err := tx.Model(&models.WaitlistEntry{}).
+			Where("id = ?", entryID).
+			Update("position", i+1).Error; err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	return tx.Commit().Error
+}
+
+func (r waitlistRepository) Promote(id uint, offerExpiresAt time.Time) (*models.WaitlistEntry, error) {
+	tx := r.db.Begin()
+
+	entry := &models.WaitlistEntry{}
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ?", id).
+		First(entry).Error; err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if entry.Status != models.WaitlistStatusWaiting {
+		tx.Rollback()
+		return nil, ErrWaitlistEntryClosed
+	}
+
+	ticket := &models.TicketAvailable{}
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ?", entry.TicketAvailableID).
+		First(ticket).Error; err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	// A free seat is taken for the offer, otherwise the organizer adds one to the capacity
+	if ticket.Quantity > 0 {
+		if err := tx.Model(ticket).
+			Update("quantity", gorm.Expr("quantity - ?", 1)).Error; err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+	}
+
+	if err := offerSeat(tx, entry, offerExpiresAt); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return nil, err
+	}
+
+	return r.reload(entry)
+}
+
+// Claim turns the seat held by an offer into a ticket, the seat was already taken from the quantity when it was offered.
+func (r waitlistRepository) Claim(token uuid.UUID, userID uuid.UUID, purchase *models.TicketPurchased) error {
+	tx := r.db.Begin()
+
+	entry := &models.WaitlistEntry{}
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("offer_token = ? AND user_id = ?", token, userID).
+		First(entry).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if entry.Status != models.WaitlistStatusOffered {
+		tx.Rollback()
+		return ErrWaitlistEntryClosed
+	}
+
+	if entry.OfferExpiresAt != nil && entry.OfferExpiresAt.Before(time.Now()) {
+		tx.Rollback()
+		return ErrWaitlistOfferExpired
+	}
+
+	ticket := &models.TicketAvailable{}
+	if err := tx.Where("id = ?", entry.TicketAvailableID).First(ticket).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	purchase.EventID = entry.EventID
+	purchase.TicketAvailableID = entry.TicketAvailableID
+	purchase.TicketTitle = ticket.Title
+	purchase.Price = ticket.Price
+
+	if err := checkNotRegistered(tx, userID, entry.EventID); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := tx.Create(purchase).Error; err != nil {
+		tx.Rollback()
+		return registrationError(err)
+	}
+
+	participant := models.EventParticipant{UserId: purchase.UserID, EventId: purchase.EventID}
+	if err := tx.
+		Where("user_id = ? AND event_id = ?", purchase.UserID, purchase.EventID).
+		FirstOrCreate(&participant).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := tx.Model(entry).Updates(map[string]interface{}{
+		"status":              models.WaitlistStatusClaimed,
+		"ticket_purchased_id": purchase.ID,
+	}).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return err
+	}
+
+	return r.db.
+		Preload("Event").
+		Preload("TicketAvailable").
+		First(purchase, purchase.ID).Error
+}
+
+func (r waitlistRepository) ExpireOffers(now time.Time, offerExpiresAt time.Time) ([]models.WaitlistEntry, error) {
+	var expiredIDs []uint
+	if err := r.db.Model(&models.WaitlistEntry{}).
+		Where("status = ? AND offer_expires_at < ?", models.WaitlistStatusOffered, now).
+		Order("offer_expires_at asc").
+		Pluck("id", &expiredIDs).Error; err != nil {
+		return nil, err
+	}
+
+	var offers []models.WaitlistEntry
+	for _, id := range expiredIDs {
+		offer, err := r.expireOffer(id, now, offerExpiresAt)
+		if err != nil {
+			return offers, err
+		}
+
+		if offer != nil {
+			offers = append(offers, *offer)
+		}
+	}
+
+	return offers, nil
+}
+
+func (r waitlistRepository) expireOffer(id uint, now time.Time, offerExpiresAt time.Time) (*models.WaitlistEntry, error) {
+	tx := r.db.Begin()
+
+	entry := &models.WaitlistEntry{}
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ?", id).
+		First(entry).Error; err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	// Claimed or expired by another instance in the meantime
+	if entry.Status != models.WaitlistStatusOffered || entry.OfferExpiresAt == nil || !entry.OfferExpiresAt.Before(now) {
+		tx.Rollback()
+		return nil, nil
+	}
+
+	if err := tx.Model(entry).Update("status", models.WaitlistStatusExpired).Error; err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	offer, err := releaseSeat(tx, entry.TicketAvailableID, offerExpiresAt)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return nil, err
+	}
+
+	return r.reload(offer)
+}
+
+func (r waitlistRepository) preload() *gorm.DB {
+	return r.db.
+		Preload("User").
+		Preload("Event.Organization").
+		Preload("TicketAvailable")
+}
+
+func (r waitlistRepository) reload(entry *models.WaitlistEntry) (*models.WaitlistEntry, error) {
+	if entry == nil {
+		return nil, nil
+	}
+
+	return r.GetByID(entry.ID)
+}
+
+// releaseSeat gives a freed seat of the ticket type to the first waiting entry, or back to the quantity when nobody waits.
+// It must run in the transaction that freed the seat.
+func releaseSeat(tx *gorm.DB, ticketAvailableID uint, offerExpiresAt time.Time) (*models.WaitlistEntry, error) {
+	ticket := &models.TicketAvailable{}
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ?", ticketAvailableID).
+		First(ticket).Error; err != nil {
+		return nil, err
+	}
+
+	next := &models.WaitlistEntry{}
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("ticket_available_id = ? AND status = ?", ticketAvailableID, models.WaitlistStatusWaiting).
+		Order("position asc, id asc").
+		First(next).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, tx.Model(ticket).Update("quantity", gorm.Expr("quantity + ?", 1)).Error
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := offerSeat(tx, next, offerExpiresAt); err != nil {
+		return nil, err
+	}
+
+	return next, nil
+}
+
+func offerSeat(tx *gorm.DB, entry *models.WaitlistEntry, offerExpiresAt time.Time) error {
+	token := uuid.New()
+	now := time.Now()
+
+	entry.Status = models.WaitlistStatusOffered
+	entry.OfferToken = &token
+	entry.OfferedAt = &now
+	entry.OfferExpiresAt = &offerExpiresAt
+
+	return tx.Model(entry).Select("status", "offer_token", "offered_at", "offer_expires_at").Updates(entry).Error
+}
@@ -6,10 +6,22 @@ import (
 
 type EventRepository interface {
 	Create(orgID uint, event *models.Event) error
+	// Duplicate creates a copy of an event with its own speakers, the agenda items of the copy
+	// refer to its speakers by the IDs they have in the event copied
+	Duplicate(orgID uint, event *models.Event) error
 	GetAll() ([]models.Event, error)
 	GetAllByOrgID(orgID uint) ([]models.Event, error)
 	GetByID(eventID uint) (*models.Event, error)
+	// GetByIDwithOrgID finds the event only among those the organization created,
+	// it guards tickets, orders and participant data the co-hosts have no access to
 	GetByIDwithOrgID(orgID uint, eventID uint) (*models.Event, error)
+	// GetHostedByID finds the event among those the organization created or co-hosts
+	GetHostedByID(orgID uint, eventID uint) (*models.Event, error)
+	// GetAllListedByOrgID, GetListedByID and GetListedByIDwithOrgID leave out the events hidden
+	// by moderation and those of suspended organizations, for the public pages and registrations
+	GetAllListedByOrgID(orgID uint) ([]models.Event, error)
+	GetListedByID(eventID uint) (*models.Event, error)
+	GetListedByIDwithOrgID(orgID uint, eventID uint) (*models.Event, error)
 	FindCategoryByIds(catIDs []uint) ([]models.Category, error)
 	GetAllCategories() ([]models.Category, error)
 	GetPaginate(page uint, size uint) ([]models.Event, error)
@@ -16,3 +16,163 @@ type InviteMailBody struct {
 type MailRepository interface {
 	SendInvitedMail(InviteMailConfig) error
 }
+
+type TicketMailConfig struct {
+	ToEmail string
+	Subject string
+	Body    TicketMailBody
+}
+
+type TicketMailBody struct {
+	AttendeeName     string
+	EventName        string
+	TicketTitle      string
+	EventDate        string
+	Location         string
+	OrganizationName string
+}
+
+type WaitlistOfferMailConfig struct {
+	ToEmail string
+	Subject string
+	Body    WaitlistOfferMailBody
+}
+
+type WaitlistOfferMailBody struct {
+	AttendeeName     string
+	EventName        string
+	TicketTitle      string
+	ExpiresAt        string
+	Token            string
+	OrganizationName string
+}
+
+type AnnouncementMailConfig struct {
+	ToEmail string
+	Subject string
+	Body    AnnouncementMailBody
+}
+
+type AnnouncementMailBody struct {
+	AttendeeName     string
+	EventName        string
+	EventID          uint
+	Message          string
+	OrganizationName string
+}
+
+type ReminderMailConfig struct {
+	ToEmail      string
+	Subject      string
+	Body         ReminderMailBody
+	Calendar     []byte // iCalendar of the event, attached as event.ics
+	TicketQRCode []byte // PNG shown in the mail, empty when the ticket has none
+}
+
+type ReminderMailBody struct {
+	AttendeeName     string
+	EventName        string
+	EventID          uint
+	TicketTitle      string
+	EventDate        string
+	Location         string
+	StartsIn         string
+	OrganizationName string
+}
+
+type SurveyInvitationMailConfig struct {
+	ToEmail string
+	Subject string
+	Body    SurveyInvitationMailBody
+}
+
+type SurveyInvitationMailBody struct {
+	AttendeeName     string
+	EventName        string
+	EventID          uint
+	SurveyTitle      string
+	OrganizationName string
+}
+
+type CertificateMailConfig struct {
+	ToEmail     string
+	Subject     string
+	Body        CertificateMailBody
+	Certificate []byte // PDF, attached to the mail
+}
+
+type CertificateMailBody struct {
+	AttendeeName     string
+	EventName        string
+	Code             string
+	VerifyURL        string
+	OrganizationName string
+}
+
+type CoHostInvitationMailConfig struct {
+	ToEmail string
+	Subject string
+	Body    CoHostInvitationMailBody
+}
+
+type CoHostInvitationMailBody struct {
+	OrganizationName string // Invited organization
+	OrganizerName    string
+	EventName        string
+	EventID          uint
+	OrganizationID   uint
+}
+
+type DiscussionReplyMailConfig struct {
+	ToEmail string
+	Subject string
+	Body    DiscussionReplyMailBody
+}
+
+type DiscussionReplyMailBody struct {
+	AuthorName       string // Author of the question
+	Title            string // Name of the event or title of the job
+	Question         string
+	Answer           string
+	Official         bool
+	Path             string // Page of the event or the job, e.g. /events/1
+	OrganizationName string
+}
+
+type ReportOutcomeMailConfig struct {
+	ToEmail string
+	Subject string
+	Body    ReportOutcomeMailBody
+}
+
+type ReportOutcomeMailBody struct {
+	ReporterName string
+	Title        string // Name, title or excerpt of the reported content
+	Actioned     bool   // Action was taken, otherwise the report was dismissed
+}
+
+type ModerationWarningMailConfig struct {
+	ToEmail string
+	Subject string
+	Body    ModerationWarningMailBody
+}
+
+type ModerationWarningMailBody struct {
+	OwnerName string // Organization or author of the content
+	Title     string
+	Path      string // Page of the content, e.g. /events/1
+	Note      string // Explanation of the moderator
+}
+
+type EventMailRepository interface {
+	SendTicketConfirmationMail(TicketMailConfig) error
+	SendWaitlistOfferMail(WaitlistOfferMailConfig) error
+	SendAnnouncementMail(AnnouncementMailConfig) error
+	SendReminderMail(ReminderMailConfig) error
+	SendSurveyInvitationMail(SurveyInvitationMailConfig) error
+	SendCertificateMail(CertificateMailConfig) error
+	SendCoHostInvitationMail(CoHostInvitationMailConfig) error
+	SendDiscussionReplyMail(DiscussionReplyMailConfig) error
+	SendReportOutcomeMail(ReportOutcomeMailConfig) error
+	SendModerationWarningMail(ModerationWarningMailConfig) error
+}
@@ -2,7 +2,9 @@ package repository
 
 import (
 	"bytes"
+	"fmt"
 	"html/template"
+	"io"
 
 	"gopkg.in/gomail.v2"
 )
@@ -55,3 +57,259 @@ func (i *InviteMailRepository) makeHtmlInviteBody(Body InviteMailBody) (string,
 	return tpl.String(), nil
 
 }
+
+const (
+	ticketConfirmationTemplate = "Ticket_confirmation_email_template.html"
+	waitlistOfferTemplate      = "Waitlist_offer_email_template.html"
+	announcementTemplate       = "Event_announcement_email_template.html"
+	reminderTemplate           = "Event_reminder_email_template.html"
+	surveyInvitationTemplate   = "Survey_invitation_email_template.html"
+	certificateTemplate        = "Certificate_email_template.html"
+	coHostInvitationTemplate   = "CoHost_invitation_email_template.html"
+	discussionReplyTemplate    = "Discussion_reply_email_template.html"
+	reportOutcomeTemplate      = "Report_outcome_email_template.html"
+	moderationWarningTemplate  = "Moderation_warning_email_template.html"
+)
+
+type EventAttendeeMailRepository struct {
+	mailserver *gomail.Dialer
+	tmpl       *template.Template
+	baseURL    string
+}
+
+func NewEventAttendeeMailRepository(mailserver *gomail.Dialer, tmpl *template.Template, baseURL string) EventMailRepository {
+	return &EventAttendeeMailRepository{
+		mailserver: mailserver,
+		tmpl:       tmpl,
+		baseURL:    baseURL}
+}
+
+func (e *EventAttendeeMailRepository) SendTicketConfirmationMail(config TicketMailConfig) error {
+	dataInTmpl := struct {
+		User     string
+		Event    string
+		Ticket   string
+		Date     string
+		Location string
+		URL      string
+		ORG      string
+	}{
+		User:     config.Body.AttendeeName,
+		Event:    config.Body.EventName,
+		Ticket:   config.Body.TicketTitle,
+		Date:     config.Body.EventDate,
+		Location: config.Body.Location,
+		URL:      e.baseURL + "/my-tickets",
+		ORG:      config.Body.OrganizationName,
+	}
+
+	return e.send(config.ToEmail, config.Subject, ticketConfirmationTemplate, dataInTmpl)
+}
+
+func (e *EventAttendeeMailRepository) SendWaitlistOfferMail(config WaitlistOfferMailConfig) error {
+	dataInTmpl := struct {
+		User      string
+		Event     string
+		Ticket    string
+		ExpiresAt string
+		URL       string
+		ORG       string
+	}{
+		User:      config.Body.AttendeeName,
+		Event:     config.Body.EventName,
+		Ticket:    config.Body.TicketTitle,
+		ExpiresAt: config.Body.ExpiresAt,
+		URL:       e.baseURL + "/waitlist-claim?token=" + config.Body.Token,
+		ORG:       config.Body.OrganizationName,
+	}
+
+	return e.send(config.ToEmail, config.Subject, waitlistOfferTemplate, dataInTmpl)
+}
+
+func (e *EventAttendeeMailRepository) SendAnnouncementMail(config AnnouncementMailConfig) error {
+	dataInTmpl := struct {
+		User    string
+		Event   string
+		Message string
+		URL     string
+		ORG     string
+	}{
+		User:    config.Body.AttendeeName,
+		Event:   config.Body.EventName,
+		Message: config.Body.Message,
+		URL:     fmt.Sprintf("%s/events/%d", e.baseURL, config.Body.EventID),
+		ORG:     config.Body.OrganizationName,
+	}
+
+	return e.send(config.ToEmail, config.Subject, announcementTemplate, dataInTmpl)
+}
+
+func (e *EventAttendeeMailRepository) SendReminderMail(config ReminderMailConfig) error {
+	dataInTmpl := struct {
+		User     string
+		Event    string
+		Ticket   string
+		Date     string
+		Location string
+		StartsIn string
+		QRCode   bool
+		URL      string
+		ORG      string
+	}{
+		User:     config.Body.AttendeeName,
+		Event:    config.Body.EventName,
+		Ticket:   config.Body.TicketTitle,
+		Date:     config.Body.EventDate,
+		Location: config.Body.Location,
+		StartsIn: config.Body.StartsIn,
+		QRCode:   len(config.TicketQRCode) > 0,
+		URL:      fmt.Sprintf("%s/events/%d", e.baseURL, config.Body.EventID),
+		ORG:      config.Body.OrganizationName,
+	}
+
+	return e.send(config.ToEmail, config.Subject, reminderTemplate, dataInTmpl, func(m *gomail.Message) {
+		if len(config.TicketQRCode) > 0 {
+			// Shown in the mail through cid:ticket.png
+			m.Embed("ticket.png", copyBytes(config.TicketQRCode))
+		}
+		if len(config.Calendar) > 0 {
+			m.Attach("event.ics", copyBytes(config.Calendar),
+				gomail.SetHeader(map[string][]string{"Content-Type": {"text/calendar; charset=utf-8; method=PUBLISH"}}))
+		}
+	})
+}
+
+func (e *EventAttendeeMailRepository) SendSurveyInvitationMail(config SurveyInvitationMailConfig) error {
+	dataInTmpl := struct {
+		User   string
+		Event  string
+		Survey string
+		URL    string
+		ORG    string
+	}{
+		User:   config.Body.AttendeeName,
+		Event:  config.Body.EventName,
+		Survey: config.Body.SurveyTitle,
+		URL:    fmt.Sprintf("%s/events/%d/survey", e.baseURL, config.Body.EventID),
+		ORG:    config.Body.OrganizationName,
+	}
+
+	return e.send(config.ToEmail, config.Subject, surveyInvitationTemplate, dataInTmpl)
+}
+
+func (e *EventAttendeeMailRepository) SendCertificateMail(config CertificateMailConfig) error {
+	dataInTmpl := struct {
+		User      string
+		Event     string
+		Code      string
+		VerifyURL string
+		ORG       string
+	}{
+		User:      config.Body.AttendeeName,
+		Event:     config.Body.EventName,
+		Code:      config.Body.Code,
+		VerifyURL: config.Body.VerifyURL,
+		ORG:       config.Body.OrganizationName,
+	}
+
+	return e.send(config.ToEmail, config.Subject, certificateTemplate, dataInTmpl, func(m *gomail.Message) {
+		if len(config.Certificate) > 0 {
+			m.Attach("certificate-"+config.Body.Code+".pdf", copyBytes(config.Certificate),
+				gomail.SetHeader(map[string][]string{"Content-Type": {"application/pdf"}}))
+		}
+	})
+}
+
+func (e *EventAttendeeMailRepository) SendCoHostInvitationMail(config CoHostInvitationMailConfig) error {
+	dataInTmpl := struct {
+		Organization string
+		Event        string
+		URL          string
+		ORG          string
+	}{
+		Organization: config.Body.OrganizationName,
+		Event:        config.Body.EventName,
+		URL:          fmt.Sprintf("%s/orgs/%d/co-host-invitations", e.baseURL, config.Body.OrganizationID),
+		ORG:          config.Body.OrganizerName,
+	}
+
+	return e.send(config.ToEmail, config.Subject, coHostInvitationTemplate, dataInTmpl)
+}
+
+func (e *EventAttendeeMailRepository) SendDiscussionReplyMail(config DiscussionReplyMailConfig) error {
+	dataInTmpl := struct {
+		User     string
+		Title    string
+		Question string
+		Answer   string
+		Official bool
+		URL      string
+		ORG      string
+	}{
+		User:     config.Body.AuthorName,
+		Title:    config.Body.Title,
+		Question: config.Body.Question,
+		Answer:   config.Body.Answer,
+		Official: config.Body.Official,
+		URL:      e.baseURL + config.Body.Path,
+		ORG:      config.Body.OrganizationName,
+	}
+
+	return e.send(config.ToEmail, config.Subject, discussionReplyTemplate, dataInTmpl)
+}
+
+func (e *EventAttendeeMailRepository) SendReportOutcomeMail(config ReportOutcomeMailConfig) error {
+	dataInTmpl := struct {
+		User     string
+		Title    string
+		Actioned bool
+	}{
+		User:     config.Body.ReporterName,
+		Title:    config.Body.Title,
+		Actioned: config.Body.Actioned,
+	}
+
+	return e.send(config.ToEmail, config.Subject, reportOutcomeTemplate, dataInTmpl)
+}
+
+func (e *EventAttendeeMailRepository) SendModerationWarningMail(config ModerationWarningMailConfig) error {
+	dataInTmpl := struct {
+		Owner string
+		Title string
+		Note  string
+		URL   string
+	}{
+		Owner: config.Body.OwnerName,
+		Title: config.Body.Title,
+		Note:  config.Body.Note,
+		URL:   e.baseURL + config.Body.Path,
+	}
+
+	return e.send(config.ToEmail, config.Subject, moderationWarningTemplate, dataInTmpl)
+}
+
+// copyBytes lets gomail embed or attach content held in memory rather than a file.
+func copyBytes(data []byte) gomail.FileSetting {
+	return gomail.SetCopyFunc(func(w io.Writer) error {
+		_, err := w.Write(data)
+		return err
+	})
+}
+
+// send renders the template into a mail, extras add its attachments.
+func (e *EventAttendeeMailRepository) send(toEmail string, subject string, templateName string, data interface{}, extras ...func(*gomail.Message)) error {
+	var tpl bytes.Buffer
+	if err := e.tmpl.ExecuteTemplate(&tpl, templateName, data); err != nil {
+		return err
+	}
+
+	m := gomail.NewMessage()
+	m.SetHeader("From", e.mailserver.Username)
+	m.SetHeader("To", toEmail)
+	m.SetHeader("Subject", subject)
+	m.SetBody("text/html", tpl.String())
+	for _, extra := range extras {
+		extra(m)
+	}
+	return e.mailserver.DialAndSend(m)
+}
@@ -10,6 +10,8 @@ type OrganizationRepository interface {
 	FindIndustryByIds(industryIDs []uint) ([]models.Industry, error)
 	GetAllIndustries() ([]models.Industry, error)
 	GetByOrgID(id uint) (*models.Organization, error)
+	// IsSuspended tells whether moderation suspended the organization
+	IsSuspended(id uint) (bool, error)
 	//GetByOrgID(userID uuid.UUID, id uint) (*models.Organization, error)
 	GetAllOrganizations() ([]models.Organization, error)
 	//GetAllOrganizations(userID uuid.UUID) ([]models.Organization, error)
@@ -35,11 +37,17 @@ type OrgOpenJobRepository interface {
 	CreateJob(orgID uint, job *models.OrgOpenJob) error
 	FindPreqByJobID(jobID uint) ([]models.Prerequisite, error)
 	FindCategoryByIds(catIDs []uint) ([]models.Category, error)
+	FindSkillByIds(skillIDs []uint) ([]models.Skill, error)
 	GetJobByID(jobID uint) (*models.OrgOpenJob, error)
 	GetJobByIDWithOrgID(orgID uint, jobID uint) (*models.OrgOpenJob, error)
 	GetAllJobs() ([]models.OrgOpenJob, error)
 	GetAllJobsByOrgID(OrgId uint) ([]models.OrgOpenJob, error)
 	GetJobsPaginate(page uint, size uint) ([]models.OrgOpenJob, error)
+	// GetListedJobsByOrgID, GetListedJobByID and GetListedJobByIDWithOrgID leave out the jobs hidden
+	// by moderation and those of suspended organizations, for the public pages
+	GetListedJobsByOrgID(orgID uint) ([]models.OrgOpenJob, error)
+	GetListedJobByID(jobID uint) (*models.OrgOpenJob, error)
+	GetListedJobByIDWithOrgID(orgID uint, jobID uint) (*models.OrgOpenJob, error)
 	UpdateJob(job *models.OrgOpenJob) (*models.OrgOpenJob, error)
 	UpdateJobPicture(orgID uint, jobID uint, picURL string) error
 	DeleteJob(jobID uint) error
@@ -101,7 +101,10 @@ func NewOrgOpenJobRepositoryMock() OrgOpenJobRepository {
 		Description:    "This is a description",
 		Qualifications: "Bachelor's degree in Computer Science",
 		Quantity:       1,
-		Salary:         30000,
+		SalaryMin:      30000,
+		SalaryMax:      30000,
+		SalaryCurrency: "THB",
+		SalaryPeriod:   models.SalaryPeriodMonthly,
 		RegisterLink:   "https://example.com",
 		Province:       "Chiang Mai",
 		Country:        "TH",
@@ -174,6 +177,13 @@ func (r organizationRepositoryMock) GetByOrgID(id uint) (*models.Organization, e
 	return nil, nil
 }
 
+func (r organizationRepositoryMock) IsSuspended(id uint) (bool, error) {
+	if r.org.ID == id {
+		return r.org.Suspended, nil
+	}
+	return false, nil
+}
+
 func (r organizationRepositoryMock) GetAllOrganizations() ([]models.Organization, error) {
 	return nil, nil
 }
@@ -259,6 +269,10 @@ func (r orgOpenJobRepositoryMock) FindCategoryByIds(catIDs []uint) ([]models.Cat
 	return nil, nil
 }
 
+func (r orgOpenJobRepositoryMock) FindSkillByIds(skillIDs []uint) ([]models.Skill, error) {
+	return nil, nil
+}
+
 func (r orgOpenJobRepositoryMock) GetJobByID(jobID uint) (*models.OrgOpenJob, error) {
 	if r.job.ID == jobID {
 		return r.job, nil
@@ -273,6 +287,18 @@ func (r orgOpenJobRepositoryMock) GetJobByIDWithOrgID(orgID uint, jobID uint) (*
 	return nil, nil
 }
 
+func (r orgOpenJobRepositoryMock) GetListedJobsByOrgID(orgID uint) ([]models.OrgOpenJob, error) {
+	return nil, nil
+}
+
+func (r orgOpenJobRepositoryMock) GetListedJobByID(jobID uint) (*models.OrgOpenJob, error) {
+	return r.GetJobByID(jobID)
+}
+
+func (r orgOpenJobRepositoryMock) GetListedJobByIDWithOrgID(orgID uint, jobID uint) (*models.OrgOpenJob, error) {
+	return r.GetJobByIDWithOrgID(orgID, jobID)
+}
+
 func (r orgOpenJobRepositoryMock) GetAllJobs() ([]models.OrgOpenJob, error) {
 	return nil, nil
 }
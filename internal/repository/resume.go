@@ -0,0 +1,23 @@
+package repository
+
+import (
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/models"
+	"github.com/google/uuid"
+)
+
+type ResumeRepository interface {
+	Create(resume *models.Resume) error
+	GetNextVersion(userID uuid.UUID) (int, error)
+	GetByIDAndUserID(resumeID uint, userID uuid.UUID) (*models.Resume, error)
+	ListByUserID(userID uuid.UUID) ([]models.Resume, error)
+	IsAttachedToApplication(resumeID uint) (bool, error)
+	Delete(resumeID uint) error
+	ApplyToProfile(userID uuid.UUID, profile *models.Profile, skills []models.Skill, experiences []models.Experience) error
+}
+
+type JobApplicationRepository interface {
+	Create(application *models.JobApplication) error
+	GetByID(applicationID uint) (*models.JobApplication, error)
+	ListByUserID(userID uuid.UUID) ([]models.JobApplication, error)
+	ListByJobID(orgID uint, jobID uint) ([]models.JobApplication, error)
+}
@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/models"
+	"github.com/google/uuid"
+)
+
+type SkillRepository interface {
+	Create(skill *models.Skill) error
+	GetByID(skillID uint) (*models.Skill, error)
+	GetAll() ([]models.Skill, error)
+	Autocomplete(query string, limit int) ([]models.Skill, error)
+	FindByIds(skillIDs []uint) ([]models.Skill, error)
+	FindByNames(names []string) ([]models.Skill, error)
+	Update(skill *models.Skill) (*models.Skill, error)
+	Delete(skillID uint) error
+	GetByUserID(userID uuid.UUID) ([]models.Skill, error)
+	ReplaceUserSkills(userID uuid.UUID, skills []models.Skill) error
+}
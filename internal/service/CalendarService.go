@@ -0,0 +1,184 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/errs"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/dto"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/models"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/infrastructure/calendar"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/repository"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/logs"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type calendarService struct {
+	calendarRepo models.CalendarRepository
+	eventRepo    repository.EventRepository
+	orgRepo      repository.OrganizationRepository
+	baseEventURL string
+	baseFeedURL  string
+}
+
+func NewCalendarService(calendarRepo models.CalendarRepository, eventRepo repository.EventRepository, orgRepo repository.OrganizationRepository, baseEventURL string, baseFeedURL string) CalendarService {
+	return calendarService{
+		calendarRepo: calendarRepo,
+		eventRepo:    eventRepo,
+		orgRepo:      orgRepo,
+		baseEventURL: baseEventURL,
+		baseFeedURL:  strings.TrimRight(baseFeedURL, "/"),
+	}
+}
+
+func (s calendarService) EventCalendar(eventID uint) ([]byte, error) {
+	event, err := s.eventRepo.GetListedByID(eventID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.NewNotFoundError("event not found")
+		}
+
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	if event.Status != string(models.Published) {
+		return nil, errs.NewNotFoundError("event not found")
+	}
+
+	return calendar.Render(event.Name, ConvertToCalendarEvents(*event, s.baseEventURL), time.Now()), nil
+}
+
+func (s calendarService) OrganizationCalendar(orgID uint) ([]byte, error) {
+	org, err := s.orgRepo.GetByOrgID(orgID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.NewNotFoundError("organization not found")
+		}
+
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	events, err := s.calendarRepo.ListPublishedByOrgID(orgID)
+	if err != nil {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	return calendar.Render(org.Name+" events", s.calendarEvents(events), time.Now()), nil
+}
+
+func (s calendarService) UserCalendar(token string) ([]byte, error) {
+	feedToken, err := uuid.Parse(token)
+	if err != nil {
+		return nil, errs.NewNotFoundError("calendar not found")
+	}
+
+	feed, err := s.calendarRepo.GetFeedByToken(feedToken)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.NewNotFoundError("calendar not found")
+		}
+
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	events, err := s.calendarRepo.ListUserEvents(feed.UserID)
+	if err != nil {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	return calendar.Render("My Talent Atmos events", s.calendarEvents(events), time.Now()), nil
+}
+
+func (s calendarService) GetMyCalendarFeed(userID uuid.UUID) (*dto.CalendarFeedResponse, error) {
+	feed, err := s.calendarRepo.GetOrCreateFeed(userID)
+	if err != nil {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	res := BuildCalendarFeedResponse(s.feedURL(*feed))
+	return &res, nil
+}
+
+func (s calendarService) ResetMyCalendarFeed(userID uuid.UUID) (*dto.CalendarFeedResponse, error) {
+	feed, err := s.calendarRepo.ResetFeedToken(userID)
+	if err != nil {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	res := BuildCalendarFeedResponse(s.feedURL(*feed))
+	return &res, nil
+}
+
+func (s calendarService) ListSavedEvents(userID uuid.UUID) ([]dto.EventResponses, error) {
+	events, err := s.calendarRepo.ListSavedEvents(userID)
+	if err != nil {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	res := dto.BuildListDTO(events, ConvertToEventResponse)
+	if res == nil {
+		res = []dto.EventResponses{}
+	}
+
+	return res, nil
+}
+
+func (s calendarService) SaveEvent(userID uuid.UUID, eventID uint) error {
+	event, err := s.eventRepo.GetListedByID(eventID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errs.NewNotFoundError("event not found")
+		}
+
+		logs.Error(err)
+		return errs.NewUnexpectedError()
+	}
+
+	if event.Status != string(models.Published) {
+		return errs.NewNotFoundError("event not found")
+	}
+
+	if err := s.calendarRepo.SaveEvent(userID, eventID); err != nil {
+		logs.Error(err)
+		return errs.NewUnexpectedError()
+	}
+
+	return nil
+}
+
+func (s calendarService) UnsaveEvent(userID uuid.UUID, eventID uint) error {
+	if err := s.calendarRepo.UnsaveEvent(userID, eventID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errs.NewNotFoundError("saved event not found")
+		}
+
+		logs.Error(err)
+		return errs.NewUnexpectedError()
+	}
+
+	return nil
+}
+
+func (s calendarService) calendarEvents(events []models.Event) []calendar.Event {
+	calendarEvents := make([]calendar.Event, 0, len(events))
+	for _, event := range events {
+		calendarEvents = append(calendarEvents, ConvertToCalendarEvents(event, s.baseEventURL)...)
+	}
+
+	return calendarEvents
+}
+
+func (s calendarService) feedURL(feed models.CalendarFeed) string {
+	return fmt.Sprintf("%s/calendar/users/%s.ics", s.baseFeedURL, feed.Token)
+}
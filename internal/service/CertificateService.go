@@ -0,0 +1,357 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"time"
+
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/errs"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/dto"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/models"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/infrastructure"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/infrastructure/certificate"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/repository"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/logs"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+const maxCertificateImageSize = 10 << 20
+
+type certificateService struct {
+	certificateRepo models.CertificateRepository
+	eventRepo       repository.EventRepository
+	mailRepo        repository.EventMailRepository
+	S3              *infrastructure.S3Uploader
+	font            *certificate.Font
+	baseEventURL    string
+}
+
+// NewCertificateService draws the certificates with the font when one is given, Helvetica otherwise.
+func NewCertificateService(certificateRepo models.CertificateRepository, eventRepo repository.EventRepository, mailRepo repository.EventMailRepository, s3 *infrastructure.S3Uploader, font *certificate.Font, baseEventURL string) CertificateService {
+	return certificateService{
+		certificateRepo: certificateRepo,
+		eventRepo:       eventRepo,
+		mailRepo:        mailRepo,
+		S3:              s3,
+		font:            font,
+		baseEventURL:    baseEventURL,
+	}
+}
+
+func (s certificateService) GetTemplate(orgID uint, eventID uint) (*dto.CertificateTemplateResponse, error) {
+	if _, err := s.orgEvent(orgID, eventID); err != nil {
+		return nil, err
+	}
+
+	template, err := s.template(eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	res := dto.BuildCertificateTemplateResponse(*template)
+	return &res, nil
+}
+
+func (s certificateService) SaveTemplate(orgID uint, eventID uint, req dto.CertificateTemplateRequest) (*dto.CertificateTemplateResponse, error) {
+	if _, err := s.orgEvent(orgID, eventID); err != nil {
+		return nil, err
+	}
+
+	template := ConvertToCertificateTemplate(eventID, req)
+	if err := s.certificateRepo.SaveTemplate(&template); err != nil {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	res := dto.BuildCertificateTemplateResponse(template)
+	return &res, nil
+}
+
+func (s certificateService) UploadTemplateImage(ctx context.Context, orgID uint, eventID uint, image models.CertificateImage, file multipart.File, fileHeader *multipart.FileHeader) (*dto.CertificateTemplateResponse, error) {
+	if _, err := s.orgEvent(orgID, eventID); err != nil {
+		return nil, err
+	}
+
+	template, err := s.template(eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	if fileHeader.Size > maxCertificateImageSize {
+		return nil, errs.NewBadRequestError("image must not exceed 10 MB")
+	}
+	data, err := io.ReadAll(io.LimitReader(file, maxCertificateImageSize+1))
+	if err != nil {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+	if len(data) > maxCertificateImageSize {
+		return nil, errs.NewBadRequestError("image must not exceed 10 MB")
+	}
+
+	// The image is decoded now, rather than when certificates are issued, so a broken file is refused here
+	if _, err := certificate.LoadImage(data); err != nil {
+		return nil, errs.NewBadRequestError(err.Error())
+	}
+
+	contentType := http.DetectContentType(data)
+	fileExt := ".png"
+	if contentType == "image/jpeg" {
+		fileExt = ".jpg"
+	}
+
+	objectKey, fileURL, err := s.S3.UploadCertificateImageFile(ctx, data, contentType, fileExt, orgID, eventID, string(image))
+	if err != nil {
+		return nil, errs.NewUnexpectedError()
+	}
+
+	if err := s.certificateRepo.UpdateTemplateImage(eventID, image, objectKey, fileURL); err != nil {
+		logs.Error(err)
+		if err := s.S3.DeleteObject(ctx, objectKey); err != nil {
+			logs.Warn(fmt.Sprintf("Failed to remove certificate image %s after a failed save: %v", objectKey, err))
+		}
+		return nil, errs.NewUnexpectedError()
+	}
+
+	previousKey := template.BackgroundObjectKey
+	if image == models.CertificateSignature {
+		previousKey = template.SignatureObjectKey
+	}
+	if previousKey != "" {
+		if err := s.S3.DeleteObject(ctx, previousKey); err != nil {
+			logs.Warn(fmt.Sprintf("Failed to remove replaced certificate image %s: %v", previousKey, err))
+		}
+	}
+
+	return s.GetTemplate(orgID, eventID)
+}
+
+func (s certificateService) PreviewCertificate(ctx context.Context, orgID uint, eventID uint) (*CertificateFile, error) {
+	event, err := s.orgEvent(orgID, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	template, err := s.template(eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	images, err := s.loadImages(ctx, *template)
+	if err != nil {
+		return nil, err
+	}
+
+	values := ConvertToCertificateValues(*event, "Participant Name", "XXXX-XXXX-XXXX", s.baseEventURL)
+	data, err := certificate.RenderPDF(BuildCertificatePage(*template, images, values), s.font)
+	if err != nil {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	return &CertificateFile{FileName: fmt.Sprintf("event-%d-certificate-preview.pdf", eventID), Data: data}, nil
+}
+
+func (s certificateService) IssueCertificates(ctx context.Context, orgID uint, eventID uint) (*dto.CertificateIssueResponse, error) {
+	event, err := s.orgEvent(orgID, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	template, err := s.template(eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	images, err := s.loadImages(ctx, *template)
+	if err != nil {
+		return nil, err
+	}
+
+	participants, err := s.certificateRepo.ListUncertified(eventID)
+	if err != nil {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	if len(participants) > 0 {
+		// Rendering and mailing every certificate takes longer than the request should, failures are only logged
+		go s.issue(*event, *template, images, participants)
+	}
+
+	return &dto.CertificateIssueResponse{Pending: len(participants)}, nil
+}
+
+// issue certifies each participant: the certificate is claimed first so concurrent runs issue it once,
+// and released when its PDF cannot be stored so the next run tries again.
+func (s certificateService) issue(event models.Event, template models.CertificateTemplate, images CertificateImages, participants []models.EventParticipant) {
+	ctx := context.Background()
+	issued := 0
+
+	for _, participant := range participants {
+		code, err := NewCertificateCode()
+		if err != nil {
+			logs.Error(err)
+			continue
+		}
+
+		cert := models.Certificate{
+			EventID:       event.ID,
+			UserID:        participant.UserId,
+			Code:          code,
+			RecipientName: participant.User.Name,
+			IssuedAt:      time.Now(),
+		}
+		claimed, err := s.certificateRepo.Claim(&cert)
+		if err != nil {
+			logs.Error(err)
+			continue
+		}
+		if !claimed {
+			continue
+		}
+
+		pdf, err := certificate.RenderPDF(BuildCertificatePage(template, images, ConvertToCertificateValues(event, cert.RecipientName, code, s.baseEventURL)), s.font)
+		if err == nil {
+			cert.ObjectKey, cert.FileUrl, err = s.S3.UploadCertificateFile(ctx, pdf, event.OrganizationID, event.ID, code)
+		}
+		if err == nil {
+			err = s.certificateRepo.UpdateFile(cert.ID, cert.ObjectKey, cert.FileUrl)
+		}
+		if err != nil {
+			logs.Error(fmt.Sprintf("Failed to issue certificate %s: %v", code, err))
+			if err := s.certificateRepo.Release(cert.ID); err != nil {
+				logs.Error(err)
+			}
+			continue
+		}
+		issued++
+
+		if participant.User.Email == "" {
+			continue
+		}
+		if err := s.mailRepo.SendCertificateMail(ConvertToCertificateMail(cert, event, participant.User.Email, pdf, s.baseEventURL)); err != nil {
+			logs.Error(fmt.Sprintf("Failed to send certificate email: %v", err))
+			continue
+		}
+		if err := s.certificateRepo.MarkEmailed(cert.ID, time.Now()); err != nil {
+			logs.Error(err)
+		}
+	}
+
+	if issued < len(participants) {
+		logs.Warn(fmt.Sprintf("Issued %d of %d certificates of event %d", issued, len(participants), event.ID))
+	}
+}
+
+func (s certificateService) ListCertificates(orgID uint, eventID uint) ([]dto.CertificateResponse, error) {
+	if _, err := s.orgEvent(orgID, eventID); err != nil {
+		return nil, err
+	}
+
+	certificates, err := s.certificateRepo.ListByEventID(eventID)
+	if err != nil {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	return s.buildCertificateResponses(certificates), nil
+}
+
+func (s certificateService) ListMyCertificates(userID uuid.UUID) ([]dto.CertificateResponse, error) {
+	certificates, err := s.certificateRepo.ListByUserID(userID)
+	if err != nil {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	return s.buildCertificateResponses(certificates), nil
+}
+
+func (s certificateService) VerifyCertificate(code string) (*dto.CertificateVerificationResponse, error) {
+	cert, err := s.certificateRepo.GetByCode(NormalizeCertificateCode(code))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.NewNotFoundError("certificate not found")
+		}
+
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	res := BuildCertificateVerificationResponse(*cert)
+	return &res, nil
+}
+
+func (s certificateService) buildCertificateResponses(certificates []models.Certificate) []dto.CertificateResponse {
+	res := make([]dto.CertificateResponse, 0, len(certificates))
+	for _, cert := range certificates {
+		res = append(res, BuildCertificateResponse(cert, s.baseEventURL))
+	}
+
+	return res
+}
+
+// loadImages downloads and decodes the images of the template once for every certificate drawn with it.
+func (s certificateService) loadImages(ctx context.Context, template models.CertificateTemplate) (CertificateImages, error) {
+	var images CertificateImages
+
+	for _, image := range []struct {
+		objectKey string
+		target    **certificate.Image
+	}{
+		{template.BackgroundObjectKey, &images.Background},
+		{template.SignatureObjectKey, &images.Signature},
+	} {
+		if image.objectKey == "" {
+			continue
+		}
+
+		data, err := s.S3.GetObject(ctx, image.objectKey)
+		if err != nil {
+			return images, errs.NewUnexpectedError()
+		}
+
+		decoded, err := certificate.LoadImage(data)
+		if err != nil {
+			logs.Error(err)
+			return images, errs.NewUnexpectedError()
+		}
+		*image.target = decoded
+	}
+
+	return images, nil
+}
+
+func (s certificateService) template(eventID uint) (*models.CertificateTemplate, error) {
+	template, err := s.certificateRepo.GetTemplate(eventID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.NewNotFoundError("certificate template not found")
+		}
+
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	return template, nil
+}
+
+func (s certificateService) orgEvent(orgID uint, eventID uint) (*models.Event, error) {
+	event, err := s.eventRepo.GetByIDwithOrgID(orgID, eventID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.NewNotFoundError("event not found")
+		}
+
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	return event, nil
+}
@@ -0,0 +1,217 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/errs"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/dto"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/models"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/infrastructure/sync"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/repository"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/logs"
+	"github.com/google/uuid"
+	"github.com/opensearch-project/opensearch-go"
+	"gorm.io/gorm"
+)
+
+type coHostService struct {
+	coHostRepo models.EventCoHostRepository
+	eventRepo  repository.EventRepository
+	orgRepo    repository.OrganizationRepository
+	mailRepo   repository.EventMailRepository
+	DB         *gorm.DB
+	OS         *opensearch.Client
+}
+
+func NewCoHostService(coHostRepo models.EventCoHostRepository, eventRepo repository.EventRepository, orgRepo repository.OrganizationRepository, mailRepo repository.EventMailRepository, db *gorm.DB, os *opensearch.Client) CoHostService {
+	return coHostService{
+		coHostRepo: coHostRepo,
+		eventRepo:  eventRepo,
+		orgRepo:    orgRepo,
+		mailRepo:   mailRepo,
+		DB:         db,
+		OS:         os,
+	}
+}
+
+func (s coHostService) InviteCoHost(userID uuid.UUID, orgID uint, eventID uint, req dto.CoHostInviteRequest) (*dto.EventCoHostResponse, error) {
+	event, err := s.hostedEvent(orgID, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := CheckCoHostInvite(*event, orgID, req.OrganizationID); err != nil {
+		return nil, err
+	}
+
+	invited, err := s.orgRepo.GetByOrgID(req.OrganizationID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.NewNotFoundError("organization not found")
+		}
+
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	coHost := models.EventCoHost{
+		EventID:        eventID,
+		OrganizationID: invited.ID,
+		Status:         models.CoHostPending,
+		InvitedBy:      userID,
+	}
+	created, err := s.coHostRepo.Invite(&coHost)
+	if err != nil {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+	if !created {
+		return nil, errs.NewConflictError("the organization is already invited to co-host the event")
+	}
+	coHost.Organization = *invited
+
+	if invited.Email != "" {
+		go func() {
+			if err := s.mailRepo.SendCoHostInvitationMail(ConvertToCoHostInvitationMail(*event, *invited)); err != nil {
+				logs.Error(fmt.Sprintf("Failed to send co-host invitation email: %v", err))
+			}
+		}()
+	}
+
+	res := dto.BuildEventCoHostResponse(coHost)
+	return &res, nil
+}
+
+func (s coHostService) ListCoHosts(orgID uint, eventID uint) ([]dto.EventCoHostResponse, error) {
+	if _, err := s.hostedEvent(orgID, eventID); err != nil {
+		return nil, err
+	}
+
+	coHosts, err := s.coHostRepo.ListByEventID(eventID)
+	if err != nil {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	res := make([]dto.EventCoHostResponse, 0, len(coHosts))
+	for _, coHost := range coHosts {
+		res = append(res, dto.BuildEventCoHostResponse(coHost))
+	}
+
+	return res, nil
+}
+
+func (s coHostService) RemoveCoHost(orgID uint, eventID uint, coHostOrgID uint) error {
+	event, err := s.hostedEvent(orgID, eventID)
+	if err != nil {
+		return err
+	}
+
+	if err := CheckCoHostRemoval(*event, orgID, coHostOrgID); err != nil {
+		return err
+	}
+
+	if err := s.coHostRepo.Remove(eventID, coHostOrgID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errs.NewNotFoundError("co-host not found")
+		}
+
+		logs.Error(err)
+		return errs.NewUnexpectedError()
+	}
+
+	go s.reindex(eventID)
+	return nil
+}
+
+func (s coHostService) ListInvitations(orgID uint) ([]dto.CoHostInvitationResponse, error) {
+	invitations, err := s.coHostRepo.ListInvitations(orgID)
+	if err != nil {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	res := make([]dto.CoHostInvitationResponse, 0, len(invitations))
+	for _, invitation := range invitations {
+		res = append(res, dto.BuildCoHostInvitationResponse(invitation))
+	}
+
+	return res, nil
+}
+
+func (s coHostService) AcceptInvitation(userID uuid.UUID, orgID uint, invitationID uint) (*dto.EventCoHostResponse, error) {
+	if err := s.coHostRepo.Accept(orgID, invitationID, userID, time.Now()); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.NewNotFoundError("invitation not found")
+		}
+
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	coHost, err := s.coHostRepo.GetInvitation(orgID, invitationID)
+	if err != nil {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	go s.reindex(coHost.EventID)
+
+	res := dto.BuildEventCoHostResponse(*coHost)
+	return &res, nil
+}
+
+func (s coHostService) DeclineInvitation(orgID uint, invitationID uint) error {
+	invitation, err := s.coHostRepo.GetInvitation(orgID, invitationID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errs.NewNotFoundError("invitation not found")
+		}
+
+		logs.Error(err)
+		return errs.NewUnexpectedError()
+	}
+	if invitation.Status != models.CoHostPending {
+		return errs.NewNotFoundError("invitation not found")
+	}
+
+	if err := s.coHostRepo.Remove(invitation.EventID, orgID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errs.NewNotFoundError("invitation not found")
+		}
+
+		logs.Error(err)
+		return errs.NewUnexpectedError()
+	}
+
+	return nil
+}
+
+// reindex lists the event under its current hosts in search.
+// A failure only delays the change in search results until the next sync, so it is not returned.
+func (s coHostService) reindex(eventID uint) {
+	if s.OS == nil {
+		return
+	}
+
+	if err := sync.IndexEvent(s.DB, s.OS, eventID); err != nil {
+		logs.Warn(fmt.Sprintf("Failed to update event %d in search index: %v", eventID, err))
+	}
+}
+
+// hostedEvent finds the event among the events the organization created or co-hosts.
+func (s coHostService) hostedEvent(orgID uint, eventID uint) (*models.Event, error) {
+	event, err := s.eventRepo.GetHostedByID(orgID, eventID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.NewNotFoundError("event not found")
+		}
+
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	return event, nil
+}
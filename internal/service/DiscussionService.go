@@ -0,0 +1,337 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/errs"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/dto"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/models"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/repository"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/logs"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type discussionService struct {
+	discussionRepo models.DiscussionRepository
+	eventRepo      repository.EventRepository
+	jobRepo        repository.OrgOpenJobRepository
+	preferenceRepo models.NotificationPreferenceRepository
+	mailRepo       repository.EventMailRepository
+}
+
+func NewDiscussionService(discussionRepo models.DiscussionRepository, eventRepo repository.EventRepository, jobRepo repository.OrgOpenJobRepository, preferenceRepo models.NotificationPreferenceRepository, mailRepo repository.EventMailRepository) DiscussionService {
+	return discussionService{
+		discussionRepo: discussionRepo,
+		eventRepo:      eventRepo,
+		jobRepo:        jobRepo,
+		preferenceRepo: preferenceRepo,
+		mailRepo:       mailRepo,
+	}
+}
+
+// discussionTarget is what the mails tell about the event or the job under discussion.
+type discussionTarget struct {
+	Title            string
+	OrganizationName string
+}
+
+func (s discussionService) ListQuestions(targetType models.DiscussionTarget, targetID uint) ([]dto.DiscussionQuestionResponse, error) {
+	if _, err := s.publicTarget(targetType, targetID); err != nil {
+		return nil, err
+	}
+
+	return s.listQuestions(targetType, targetID, false)
+}
+
+func (s discussionService) ListFAQ(targetType models.DiscussionTarget, targetID uint) ([]dto.DiscussionFAQResponse, error) {
+	if _, err := s.publicTarget(targetType, targetID); err != nil {
+		return nil, err
+	}
+
+	answers, err := s.discussionRepo.ListFAQ(targetType, targetID)
+	if err != nil {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	res := make([]dto.DiscussionFAQResponse, 0, len(answers))
+	for _, answer := range answers {
+		res = append(res, dto.BuildDiscussionFAQResponse(answer))
+	}
+
+	return res, nil
+}
+
+func (s discussionService) AskQuestion(userID uuid.UUID, targetType models.DiscussionTarget, targetID uint, req dto.DiscussionPostRequest) (*dto.DiscussionQuestionResponse, error) {
+	if _, err := s.publicTarget(targetType, targetID); err != nil {
+		return nil, err
+	}
+
+	question := models.DiscussionQuestion{
+		TargetType: targetType,
+		TargetID:   targetID,
+		UserID:     userID,
+		Body:       req.Body,
+	}
+	if err := s.discussionRepo.CreateQuestion(&question); err != nil {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	created, err := s.question(targetType, targetID, question.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	res := dto.BuildDiscussionQuestionResponse(*created)
+	return &res, nil
+}
+
+func (s discussionService) Reply(userID uuid.UUID, targetType models.DiscussionTarget, targetID uint, questionID uint, req dto.DiscussionPostRequest) (*dto.DiscussionAnswerResponse, error) {
+	target, err := s.publicTarget(targetType, targetID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.answer(userID, *target, targetType, targetID, questionID, req, false)
+}
+
+func (s discussionService) ListOrgQuestions(orgID uint, targetType models.DiscussionTarget, targetID uint) ([]dto.DiscussionQuestionResponse, error) {
+	if _, err := s.orgTarget(orgID, targetType, targetID); err != nil {
+		return nil, err
+	}
+
+	return s.listQuestions(targetType, targetID, true)
+}
+
+func (s discussionService) AnswerQuestion(userID uuid.UUID, orgID uint, targetType models.DiscussionTarget, targetID uint, questionID uint, req dto.DiscussionPostRequest) (*dto.DiscussionAnswerResponse, error) {
+	target, err := s.orgTarget(orgID, targetType, targetID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.answer(userID, *target, targetType, targetID, questionID, req, true)
+}
+
+func (s discussionService) ModerateQuestion(orgID uint, targetType models.DiscussionTarget, targetID uint, questionID uint, req dto.DiscussionQuestionModerationRequest) (*dto.DiscussionQuestionResponse, error) {
+	if _, err := s.orgTarget(orgID, targetType, targetID); err != nil {
+		return nil, err
+	}
+
+	question, err := s.question(targetType, targetID, questionID)
+	if err != nil {
+		return nil, err
+	}
+
+	ApplyQuestionModeration(question, req)
+	if err := s.discussionRepo.UpdateQuestion(question); err != nil {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	res := dto.BuildDiscussionQuestionResponse(*question)
+	return &res, nil
+}
+
+func (s discussionService) DeleteQuestion(orgID uint, targetType models.DiscussionTarget, targetID uint, questionID uint) error {
+	if _, err := s.orgTarget(orgID, targetType, targetID); err != nil {
+		return err
+	}
+
+	if err := s.discussionRepo.DeleteQuestion(targetType, targetID, questionID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errs.NewNotFoundError("question not found")
+		}
+
+		logs.Error(err)
+		return errs.NewUnexpectedError()
+	}
+
+	return nil
+}
+
+func (s discussionService) ModerateAnswer(orgID uint, targetType models.DiscussionTarget, targetID uint, questionID uint, answerID uint, req dto.DiscussionAnswerModerationRequest) (*dto.DiscussionAnswerResponse, error) {
+	if _, err := s.orgTarget(orgID, targetType, targetID); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.question(targetType, targetID, questionID); err != nil {
+		return nil, err
+	}
+
+	answer, err := s.discussionRepo.GetAnswer(questionID, answerID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.NewNotFoundError("answer not found")
+		}
+
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	ApplyAnswerModeration(answer, req, time.Now())
+	if err := s.discussionRepo.UpdateAnswer(answer); err != nil {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	res := dto.BuildDiscussionAnswerResponse(*answer)
+	return &res, nil
+}
+
+func (s discussionService) DeleteAnswer(orgID uint, targetType models.DiscussionTarget, targetID uint, questionID uint, answerID uint) error {
+	if _, err := s.orgTarget(orgID, targetType, targetID); err != nil {
+		return err
+	}
+
+	if _, err := s.question(targetType, targetID, questionID); err != nil {
+		return err
+	}
+
+	if err := s.discussionRepo.DeleteAnswer(questionID, answerID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errs.NewNotFoundError("answer not found")
+		}
+
+		logs.Error(err)
+		return errs.NewUnexpectedError()
+	}
+
+	return nil
+}
+
+func (s discussionService) listQuestions(targetType models.DiscussionTarget, targetID uint, withHidden bool) ([]dto.DiscussionQuestionResponse, error) {
+	questions, err := s.discussionRepo.ListQuestions(targetType, targetID, withHidden)
+	if err != nil {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	res := make([]dto.DiscussionQuestionResponse, 0, len(questions))
+	for _, question := range questions {
+		res = append(res, dto.BuildDiscussionQuestionResponse(question))
+	}
+
+	return res, nil
+}
+
+// answer adds the answer to the question and lets its author know.
+func (s discussionService) answer(userID uuid.UUID, target discussionTarget, targetType models.DiscussionTarget, targetID uint, questionID uint, req dto.DiscussionPostRequest, official bool) (*dto.DiscussionAnswerResponse, error) {
+	question, err := s.question(targetType, targetID, questionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := CheckDiscussionReply(*question, official); err != nil {
+		return nil, err
+	}
+
+	answer := models.DiscussionAnswer{
+		QuestionID: question.ID,
+		UserID:     userID,
+		Body:       req.Body,
+		Official:   official,
+	}
+	if err := s.discussionRepo.CreateAnswer(&answer); err != nil {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	created, err := s.discussionRepo.GetAnswer(question.ID, answer.ID)
+	if err != nil {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	go s.notifyReply(*question, *created, target)
+
+	res := dto.BuildDiscussionAnswerResponse(*created)
+	return &res, nil
+}
+
+func (s discussionService) notifyReply(question models.DiscussionQuestion, answer models.DiscussionAnswer, target discussionTarget) {
+	preference, err := s.preferenceRepo.GetByUserID(question.UserID)
+	if err != nil {
+		logs.Error(err)
+		return
+	}
+
+	if !ShouldNotifyDiscussionReply(question, answer, *preference) {
+		return
+	}
+
+	config := ConvertToDiscussionReplyMail(question, answer, target.Title, target.OrganizationName)
+	if err := s.mailRepo.SendDiscussionReplyMail(config); err != nil {
+		logs.Error(fmt.Sprintf("Failed to send discussion reply email: %v", err))
+	}
+}
+
+func (s discussionService) question(targetType models.DiscussionTarget, targetID uint, questionID uint) (*models.DiscussionQuestion, error) {
+	question, err := s.discussionRepo.GetQuestion(targetType, targetID, questionID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.NewNotFoundError("question not found")
+		}
+
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	return question, nil
+}
+
+// publicTarget finds the published event or job the discussion is about.
+func (s discussionService) publicTarget(targetType models.DiscussionTarget, targetID uint) (*discussionTarget, error) {
+	if targetType == models.DiscussionJob {
+		job, err := s.jobRepo.GetListedJobByID(targetID)
+		if err != nil {
+			return nil, s.targetError(targetType, err)
+		}
+		if job.Status != string(models.JobStatusPublished) {
+			return nil, errs.NewNotFoundError("job not found")
+		}
+
+		return &discussionTarget{Title: job.Title, OrganizationName: job.Organization.Name}, nil
+	}
+
+	event, err := s.eventRepo.GetListedByID(targetID)
+	if err != nil {
+		return nil, s.targetError(targetType, err)
+	}
+	if event.Status != string(models.Published) {
+		return nil, errs.NewNotFoundError("event not found")
+	}
+
+	return &discussionTarget{Title: event.Name, OrganizationName: event.Organization.Name}, nil
+}
+
+// orgTarget finds the event or the job the discussion is about among those of the organization.
+func (s discussionService) orgTarget(orgID uint, targetType models.DiscussionTarget, targetID uint) (*discussionTarget, error) {
+	if targetType == models.DiscussionJob {
+		job, err := s.jobRepo.GetJobByIDWithOrgID(orgID, targetID)
+		if err != nil {
+			return nil, s.targetError(targetType, err)
+		}
+
+		return &discussionTarget{Title: job.Title, OrganizationName: job.Organization.Name}, nil
+	}
+
+	event, err := s.eventRepo.GetByIDwithOrgID(orgID, targetID)
+	if err != nil {
+		return nil, s.targetError(targetType, err)
+	}
+
+	return &discussionTarget{Title: event.Name, OrganizationName: event.Organization.Name}, nil
+}
+
+func (s discussionService) targetError(targetType models.DiscussionTarget, err error) error {
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return errs.NewNotFoundError(string(targetType) + " not found")
+	}
+
+	logs.Error(err)
+	return errs.NewUnexpectedError()
+}
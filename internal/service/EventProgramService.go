@@ -0,0 +1,346 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"path/filepath"
+	"strings"
+
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/errs"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/dto"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/models"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/infrastructure"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/repository"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/logs"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type eventProgramService struct {
+	programRepo models.EventProgramRepository
+	eventRepo   repository.EventRepository
+	userRepo    repository.UserRepository
+	S3          *infrastructure.S3Uploader
+}
+
+func NewEventProgramService(programRepo models.EventProgramRepository, eventRepo repository.EventRepository, userRepo repository.UserRepository, s3 *infrastructure.S3Uploader) EventProgramService {
+	return eventProgramService{
+		programRepo: programRepo,
+		eventRepo:   eventRepo,
+		userRepo:    userRepo,
+		S3:          s3,
+	}
+}
+
+func (s eventProgramService) UpdateAgenda(orgID uint, eventID uint, req dto.AgendaRequest) ([]dto.AgendaItemResponse, error) {
+	event, err := s.orgEvent(orgID, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := ConvertToAgendaItems(*event, req, event.Speakers)
+	if err != nil {
+		return nil, err
+	}
+
+	agenda, err := s.programRepo.ReplaceAgenda(eventID, items)
+	if err != nil {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	res := dto.BuildListDTO(agenda, dto.BuildAgendaItemResponse)
+	if res == nil {
+		res = []dto.AgendaItemResponse{}
+	}
+
+	return res, nil
+}
+
+func (s eventProgramService) ListSpeakers(orgID uint, eventID uint) ([]dto.EventSpeakerResponse, error) {
+	event, err := s.orgEvent(orgID, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	res := dto.BuildListDTO(event.Speakers, dto.BuildEventSpeakerResponse)
+	if res == nil {
+		res = []dto.EventSpeakerResponse{}
+	}
+
+	return res, nil
+}
+
+func (s eventProgramService) CreateSpeaker(orgID uint, eventID uint, req dto.EventSpeakerRequest) (*dto.EventSpeakerResponse, error) {
+	if _, err := s.orgEvent(orgID, eventID); err != nil {
+		return nil, err
+	}
+
+	speaker, err := s.convertToSpeaker(eventID, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.programRepo.CreateSpeaker(&speaker); err != nil {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	res := dto.BuildEventSpeakerResponse(speaker)
+	return &res, nil
+}
+
+func (s eventProgramService) UpdateSpeaker(orgID uint, eventID uint, speakerID uint, req dto.EventSpeakerRequest) (*dto.EventSpeakerResponse, error) {
+	if _, err := s.orgEvent(orgID, eventID); err != nil {
+		return nil, err
+	}
+
+	speaker, err := s.convertToSpeaker(eventID, req)
+	if err != nil {
+		return nil, err
+	}
+	speaker.ID = speakerID
+
+	updatedSpeaker, err := s.programRepo.UpdateSpeaker(&speaker)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.NewNotFoundError("speaker not found")
+		}
+
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	res := dto.BuildEventSpeakerResponse(*updatedSpeaker)
+	return &res, nil
+}
+
+func (s eventProgramService) UploadSpeakerPicture(ctx context.Context, orgID uint, eventID uint, speakerID uint, file multipart.File, fileHeader *multipart.FileHeader) (*dto.EventSpeakerResponse, error) {
+	if _, err := s.orgEvent(orgID, eventID); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.speaker(eventID, speakerID); err != nil {
+		return nil, err
+	}
+
+	picURL, err := s.S3.UploadEventSpeakerPictureFile(ctx, file, fileHeader, orgID, eventID, speakerID)
+	if err != nil {
+		return nil, errs.NewUnexpectedError()
+	}
+
+	if err := s.programRepo.UpdateSpeakerPicture(speakerID, eventID, picURL); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.NewNotFoundError("speaker not found")
+		}
+
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	speaker, err := s.speaker(eventID, speakerID)
+	if err != nil {
+		return nil, err
+	}
+
+	res := dto.BuildEventSpeakerResponse(*speaker)
+	return &res, nil
+}
+
+func (s eventProgramService) DeleteSpeaker(orgID uint, eventID uint, speakerID uint) error {
+	if _, err := s.orgEvent(orgID, eventID); err != nil {
+		return err
+	}
+
+	if err := s.programRepo.DeleteSpeaker(speakerID, eventID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errs.NewNotFoundError("speaker not found")
+		}
+
+		logs.Error(err)
+		return errs.NewUnexpectedError()
+	}
+
+	return nil
+}
+
+func (s eventProgramService) ListMaterials(orgID uint, eventID uint) ([]dto.EventMaterialResponse, error) {
+	event, err := s.orgEvent(orgID, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	res := dto.BuildListDTO(event.Materials, dto.BuildEventMaterialResponse)
+	if res == nil {
+		res = []dto.EventMaterialResponse{}
+	}
+
+	return res, nil
+}
+
+func (s eventProgramService) UploadMaterial(ctx context.Context, orgID uint, eventID uint, title string, file multipart.File, fileHeader *multipart.FileHeader) (*dto.EventMaterialResponse, error) {
+	if _, err := s.orgEvent(orgID, eventID); err != nil {
+		return nil, err
+	}
+
+	if fileHeader.Size > maxMaterialSize {
+		return nil, errs.NewBadRequestError("material must not exceed 20 MB")
+	}
+
+	data, err := io.ReadAll(io.LimitReader(file, maxMaterialSize+1))
+	if err != nil {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+	if len(data) > maxMaterialSize {
+		return nil, errs.NewBadRequestError("material must not exceed 20 MB")
+	}
+	if len(data) == 0 {
+		return nil, errs.NewBadRequestError("material file is empty")
+	}
+
+	fileName := filepath.Base(fileHeader.Filename)
+	contentType, err := detectMaterialContentType(data, strings.ToLower(filepath.Ext(fileName)))
+	if err != nil {
+		return nil, err
+	}
+
+	title = strings.TrimSpace(title)
+	if title == "" {
+		title = strings.TrimSuffix(fileName, filepath.Ext(fileName))
+	}
+	if len(title) > 255 {
+		return nil, errs.NewBadRequestError("title must not exceed 255 characters")
+	}
+
+	objectKey, fileURL, err := s.S3.UploadEventMaterialFile(ctx, data, contentType, fileName, orgID, eventID)
+	if err != nil {
+		return nil, errs.NewUnexpectedError()
+	}
+
+	material := models.EventMaterial{
+		EventID:     eventID,
+		Title:       title,
+		FileName:    fileName,
+		ContentType: contentType,
+		Size:        int64(len(data)),
+		ObjectKey:   objectKey,
+		FileUrl:     fileURL,
+	}
+	if err := s.programRepo.CreateMaterial(&material); err != nil {
+		logs.Error(err)
+		// The record could not be saved, the upload would otherwise stay in the bucket without an owner
+		if err := s.S3.DeleteObject(ctx, objectKey); err != nil {
+			logs.Warn(fmt.Sprintf("Failed to remove material %s after a failed save: %v", objectKey, err))
+		}
+		return nil, errs.NewUnexpectedError()
+	}
+
+	res := dto.BuildEventMaterialResponse(material)
+	return &res, nil
+}
+
+func (s eventProgramService) DeleteMaterial(ctx context.Context, orgID uint, eventID uint, materialID uint) error {
+	if _, err := s.orgEvent(orgID, eventID); err != nil {
+		return err
+	}
+
+	material, err := s.programRepo.GetMaterial(materialID, eventID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errs.NewNotFoundError("material not found")
+		}
+
+		logs.Error(err)
+		return errs.NewUnexpectedError()
+	}
+
+	if err := s.programRepo.DeleteMaterial(materialID, eventID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errs.NewNotFoundError("material not found")
+		}
+
+		logs.Error(err)
+		return errs.NewUnexpectedError()
+	}
+
+	// The record is gone, a file left behind is only logged
+	if err := s.S3.DeleteObject(ctx, material.ObjectKey); err != nil {
+		logs.Warn(fmt.Sprintf("Failed to remove material %s: %v", material.ObjectKey, err))
+	}
+
+	return nil
+}
+
+// convertToSpeaker builds the speaker from the request, a speaker linked to a user takes the user's name when none is given.
+func (s eventProgramService) convertToSpeaker(eventID uint, req dto.EventSpeakerRequest) (models.EventSpeaker, error) {
+	speaker := models.EventSpeaker{
+		EventID:     eventID,
+		Name:        strings.TrimSpace(req.Name),
+		JobTitle:    req.JobTitle,
+		Company:     req.Company,
+		Bio:         req.Bio,
+		ProfileLink: req.ProfileLink,
+		Position:    req.Position,
+	}
+
+	if req.UserID != "" {
+		userID, err := uuid.Parse(req.UserID)
+		if err != nil {
+			return speaker, errs.NewBadRequestError("invalid userId")
+		}
+
+		user, err := s.userRepo.FindByID(userID)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return speaker, errs.NewNotFoundError("user not found")
+			}
+
+			logs.Error(err)
+			return speaker, errs.NewUnexpectedError()
+		}
+
+		speaker.UserID = &user.ID
+		if speaker.Name == "" {
+			speaker.Name = user.Name
+		}
+	}
+
+	if speaker.Name == "" {
+		return speaker, errs.NewBadRequestError("name is required")
+	}
+
+	return speaker, nil
+}
+
+// orgEvent loads the event the organization created or co-hosts, with its program.
+func (s eventProgramService) orgEvent(orgID uint, eventID uint) (*models.Event, error) {
+	event, err := s.eventRepo.GetHostedByID(orgID, eventID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.NewNotFoundError("event not found")
+		}
+
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	return event, nil
+}
+
+func (s eventProgramService) speaker(eventID uint, speakerID uint) (*models.EventSpeaker, error) {
+	speaker, err := s.programRepo.GetSpeaker(speakerID, eventID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.NewNotFoundError("speaker not found")
+		}
+
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	return speaker, nil
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/DAF-Bridge/Talent-Atmos-Backend/errs"
 	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/repository"
 	"github.com/DAF-Bridge/Talent-Atmos-Backend/logs"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/utils"
 	"gorm.io/gorm"
 )
 
@@ -54,6 +55,10 @@ func (s eventService) SyncEvents() error {
 }
 
 func (s eventService) SearchEvents(query dto.SearchQuery, page int, Offset int) (dto.SearchEventResponse, error) {
+	if _, err := utils.LoadTimezone(query.Timezone); err != nil {
+		return dto.SearchEventResponse{}, errs.NewBadRequestError(err.Error())
+	}
+
 	eventsRes, err := search.SearchEvents(s.OS, query, page, Offset)
 	if err != nil {
 		if len(eventsRes.Events) == 0 {
@@ -90,6 +95,9 @@ func (s eventService) NewEvent(orgID uint, req dto.NewEventRequest, ctx context.
 	}
 
 	event := requestConvertToEvent(orgID, req, categories, contacts)
+	if err := ScheduleEvent(&event); err != nil {
+		return errs.NewBadRequestError(err.Error())
+	}
 
 	err = s.eventRepo.Create(orgID, &event)
 	if err != nil {
@@ -162,8 +170,38 @@ func (s eventService) GetAllEventsByOrgID(orgID uint) ([]dto.EventResponses, err
 	return EventResponses, nil
 }
 
+func (s eventService) GetListedEventsByOrgID(orgID uint) ([]dto.EventResponses, error) {
+	events, err := s.eventRepo.GetAllListedByOrgID(orgID)
+	if err != nil {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	EventResponses := make([]dto.EventResponses, 0, len(events))
+	for _, event := range events {
+		EventResponses = append(EventResponses, ConvertToEventResponse(event))
+	}
+
+	return EventResponses, nil
+}
+
 func (s eventService) GetEventByID(eventID uint) (*dto.EventResponses, error) {
-	event, err := s.eventRepo.GetByID(eventID)
+	event, err := s.eventRepo.GetListedByID(eventID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.NewNotFoundError("event not found")
+		}
+
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	eventResponse := ConvertToEventResponse(*event)
+	return &eventResponse, nil
+}
+
+func (s eventService) GetListedEventByIDwithOrgID(orgID uint, eventID uint) (*dto.EventResponses, error) {
+	event, err := s.eventRepo.GetListedByIDwithOrgID(orgID, eventID)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, errs.NewNotFoundError("event not found")
@@ -178,7 +216,7 @@ func (s eventService) GetEventByID(eventID uint) (*dto.EventResponses, error) {
 }
 
 func (s eventService) GetEventByIDwithOrgID(orgID uint, eventID uint) (*dto.EventResponses, error) {
-	event, err := s.eventRepo.GetByIDwithOrgID(orgID, eventID)
+	event, err := s.eventRepo.GetHostedByID(orgID, eventID)
 	if err != nil {
 
 		if errors.Is(err, gorm.ErrRecordNotFound) {
@@ -204,12 +242,8 @@ func (s eventService) ListAllCategories() (*dto.CategoryListResponse, error) {
 		return nil, err
 	}
 
-	var responses dto.CategoryListResponse
-	for _, category := range categories {
-		responses.Categories = append(responses.Categories, dto.CategoryResponses{
-			Value: category.ID,
-			Label: category.Name,
-		})
+	responses := dto.CategoryListResponse{
+		Categories: dto.BuildListCategoryResponses(categories),
 	}
 
 	return &responses, nil
@@ -301,11 +335,17 @@ func (s eventService) UpdateEvent(orgID uint, eventID uint, req dto.NewEventRequ
 	}
 
 	// Convert request to Event
-	event := requestConvertToEvent(orgID, req, categories, contacts)
+	// A co-host edits the event through its own organization, the event stays with its organizer
+	event := requestConvertToEvent(existingEvent.OrganizationID, req, categories, contacts)
 	event.ID = eventID
+	// Sessions and their rule are edited through their own routes
+	event.RecurrenceRule = existingEvent.RecurrenceRule
+	if err := ScheduleEvent(&event); err != nil {
+		return nil, errs.NewBadRequestError(err.Error())
+	}
 
 	if file != nil {
-		picURL, err := s.S3.UploadEventPictureFile(ctx, file, fileHeader, orgID, eventID)
+		picURL, err := s.S3.UploadEventPictureFile(ctx, file, fileHeader, existingEvent.OrganizationID, eventID)
 		if err != nil {
 			logs.Error(err)
 			return nil, errs.NewUnexpectedError()
@@ -0,0 +1,256 @@
+package service
+
+import (
+	"errors"
+
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/errs"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/dto"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/models"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/infrastructure/calendar"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/repository"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/logs"
+	"gorm.io/gorm"
+)
+
+type eventSessionService struct {
+	sessionRepo models.EventSessionRepository
+	eventRepo   repository.EventRepository
+}
+
+func NewEventSessionService(sessionRepo models.EventSessionRepository, eventRepo repository.EventRepository) EventSessionService {
+	return eventSessionService{
+		sessionRepo: sessionRepo,
+		eventRepo:   eventRepo,
+	}
+}
+
+func (s eventSessionService) ListSessions(eventID uint) (*dto.EventSessionsResponse, error) {
+	event, err := s.eventRepo.GetListedByID(eventID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.NewNotFoundError("event not found")
+		}
+
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	res := BuildEventSessionsResponse(event.RecurrenceRule, event.Sessions)
+	return &res, nil
+}
+
+func (s eventSessionService) ListEventSessions(orgID uint, eventID uint) (*dto.EventSessionsResponse, error) {
+	event, err := s.orgEvent(orgID, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	res := BuildEventSessionsResponse(event.RecurrenceRule, event.Sessions)
+	return &res, nil
+}
+
+func (s eventSessionService) CreateSession(orgID uint, eventID uint, req dto.EventSessionRequest) (*dto.EventSessionResponse, error) {
+	event, err := s.orgEvent(orgID, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	session, err := ConvertToEventSession(*event, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.sessionRepo.Create(&session); err != nil {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	res := dto.BuildEventSessionResponse(session)
+	return &res, nil
+}
+
+func (s eventSessionService) SetRecurrence(orgID uint, eventID uint, req dto.EventRecurrenceRequest) (*dto.EventSessionsResponse, error) {
+	event, err := s.orgEvent(orgID, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Rule == "" {
+		if err := s.sessionRepo.DeleteSeries(eventID); err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, errs.NewNotFoundError("event not found")
+			}
+
+			logs.Error(err)
+			return nil, errs.NewUnexpectedError()
+		}
+
+		return s.ListEventSessions(orgID, eventID)
+	}
+
+	rule, err := calendar.ParseRule(req.Rule)
+	if err != nil {
+		return nil, errs.NewBadRequestError(err.Error())
+	}
+
+	sessions, err := ExpandRecurrence(*event, rule)
+	if err != nil {
+		return nil, errs.NewBadRequestError(err.Error())
+	}
+
+	if len(sessions) == 0 {
+		return nil, errs.NewBadRequestError("recurrence rule has no occurrence from the start of the event")
+	}
+
+	if _, err := s.sessionRepo.ReplaceRule(eventID, rule.String(), sessions); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.NewNotFoundError("event not found")
+		}
+
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	return s.ListEventSessions(orgID, eventID)
+}
+
+func (s eventSessionService) UpdateSession(orgID uint, eventID uint, sessionID uint, scope string, req dto.EventSessionRequest) ([]dto.EventSessionResponse, error) {
+	event, err := s.orgEvent(orgID, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	original, err := s.eventSession(eventID, sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	edited, err := ConvertToEventSession(*event, req)
+	if err != nil {
+		return nil, err
+	}
+	edited.ID = original.ID
+	edited.RecurrenceID = original.RecurrenceID
+	edited.IsCancelled = original.IsCancelled
+
+	switch scope {
+	case "", dto.SessionScopeThis:
+		// An occurrence of the series edited on its own no longer follows the series
+		edited.IsDetached = original.RecurrenceID != nil
+		if err := s.sessionRepo.Update(&edited); err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, errs.NewNotFoundError("session not found")
+			}
+
+			logs.Error(err)
+			return nil, errs.NewUnexpectedError()
+		}
+
+		return []dto.EventSessionResponse{dto.BuildEventSessionResponse(edited)}, nil
+	case dto.SessionScopeAll:
+		if original.RecurrenceID == nil {
+			return nil, errs.NewBadRequestError("session is not part of a recurring series")
+		}
+
+		series := make([]models.EventSession, 0)
+		for _, session := range event.Sessions {
+			if session.RecurrenceID == nil || session.IsCancelled {
+				continue
+			}
+			// Occurrences edited on their own keep their changes, except the one being edited
+			if session.IsDetached && session.ID != original.ID {
+				continue
+			}
+
+			shifted, err := ShiftSession(session, edited, *original, event.Timezone)
+			if err != nil {
+				return nil, errs.NewBadRequestError(err.Error())
+			}
+			shifted.IsDetached = false
+			series = append(series, shifted)
+		}
+
+		if err := s.sessionRepo.UpdateSeries(series); err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, errs.NewNotFoundError("session not found")
+			}
+
+			logs.Error(err)
+			return nil, errs.NewUnexpectedError()
+		}
+
+		return dto.BuildListDTO(series, dto.BuildEventSessionResponse), nil
+	default:
+		return nil, errs.NewBadRequestError("scope must be this or all")
+	}
+}
+
+func (s eventSessionService) DeleteSession(orgID uint, eventID uint, sessionID uint, scope string) error {
+	if _, err := s.orgEvent(orgID, eventID); err != nil {
+		return err
+	}
+
+	session, err := s.eventSession(eventID, sessionID)
+	if err != nil {
+		return err
+	}
+
+	switch scope {
+	case "", dto.SessionScopeThis:
+		if session.RecurrenceID == nil {
+			err = s.sessionRepo.Delete(session.ID)
+		} else {
+			// The occurrence is kept as cancelled, so that the rule does not create it again
+			session.IsCancelled = true
+			session.IsDetached = true
+			err = s.sessionRepo.Update(session)
+		}
+	case dto.SessionScopeAll:
+		if session.RecurrenceID == nil {
+			return errs.NewBadRequestError("session is not part of a recurring series")
+		}
+		err = s.sessionRepo.DeleteSeries(eventID)
+	default:
+		return errs.NewBadRequestError("scope must be this or all")
+	}
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errs.NewNotFoundError("session not found")
+		}
+
+		logs.Error(err)
+		return errs.NewUnexpectedError()
+	}
+
+	return nil
+}
+
+// orgEvent loads the event the organization created or co-hosts, with its sessions.
+func (s eventSessionService) orgEvent(orgID uint, eventID uint) (*models.Event, error) {
+	event, err := s.eventRepo.GetHostedByID(orgID, eventID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.NewNotFoundError("event not found")
+		}
+
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	return event, nil
+}
+
+func (s eventSessionService) eventSession(eventID uint, sessionID uint) (*models.EventSession, error) {
+	session, err := s.sessionRepo.GetByIDAndEventID(sessionID, eventID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.NewNotFoundError("session not found")
+		}
+
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	return session, nil
+}
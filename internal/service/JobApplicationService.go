@@ -0,0 +1,141 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/errs"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/dto"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/models"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/infrastructure"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/repository"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/logs"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type jobApplicationService struct {
+	applicationRepo repository.JobApplicationRepository
+	resumeRepo      repository.ResumeRepository
+	jobRepo         repository.OrgOpenJobRepository
+	S3Uploader      *infrastructure.S3Uploader
+}
+
+func NewJobApplicationService(applicationRepo repository.JobApplicationRepository, resumeRepo repository.ResumeRepository, jobRepo repository.OrgOpenJobRepository, s3Uploader *infrastructure.S3Uploader) JobApplicationService {
+	return jobApplicationService{
+		applicationRepo: applicationRepo,
+		resumeRepo:      resumeRepo,
+		jobRepo:         jobRepo,
+		S3Uploader:      s3Uploader,
+	}
+}
+
+func (s jobApplicationService) Apply(userID uuid.UUID, jobID uint, req dto.JobApplicationRequest) (*dto.JobApplicationResponse, error) {
+	job, err := s.jobRepo.GetListedJobByID(jobID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.NewNotFoundError("job not found")
+		}
+
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	if job.Status != string(models.JobStatusPublished) {
+		return nil, errs.NewBadRequestError("job is not open for applications")
+	}
+
+	if req.ResumeID != nil {
+		if _, err := s.resumeRepo.GetByIDAndUserID(*req.ResumeID, userID); err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, errs.NewNotFoundError("resume not found")
+			}
+
+			logs.Error(err)
+			return nil, errs.NewUnexpectedError()
+		}
+	}
+
+	application := models.JobApplication{
+		JobID:       jobID,
+		UserID:      userID,
+		ResumeID:    req.ResumeID,
+		CoverLetter: req.CoverLetter,
+		Status:      models.ApplicationStatusSubmitted,
+	}
+
+	if err := s.applicationRepo.Create(&application); err != nil {
+		if isDuplicateKeyError(err) {
+			return nil, errs.NewConflictError("you have already applied to this job")
+		}
+
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	res := dto.BuildJobApplicationResponse(application)
+	return &res, nil
+}
+
+func (s jobApplicationService) ListMyApplications(userID uuid.UUID) ([]dto.JobApplicationResponse, error) {
+	applications, err := s.applicationRepo.ListByUserID(userID)
+	if err != nil {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	res := dto.BuildListDTO(applications, dto.BuildJobApplicationResponse)
+	if res == nil {
+		res = []dto.JobApplicationResponse{}
+	}
+
+	return res, nil
+}
+
+func (s jobApplicationService) ListJobApplications(orgID uint, jobID uint) ([]dto.JobApplicationResponse, error) {
+	if _, err := s.jobRepo.GetJobByIDWithOrgID(orgID, jobID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.NewNotFoundError("job not found")
+		}
+
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	applications, err := s.applicationRepo.ListByJobID(orgID, jobID)
+	if err != nil {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	res := dto.BuildListDTO(applications, dto.BuildJobApplicationResponse)
+	if res == nil {
+		res = []dto.JobApplicationResponse{}
+	}
+
+	return res, nil
+}
+
+// GetApplicationResumeURL is the only way for an organization to read a resume: the resume must be attached
+// to an application for one of its own jobs.
+func (s jobApplicationService) GetApplicationResumeURL(ctx context.Context, orgID uint, applicationID uint) (*dto.ResumeURLResponse, error) {
+	application, err := s.applicationRepo.GetByID(applicationID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.NewNotFoundError("application not found")
+		}
+
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	if application.Job.OrganizationID != orgID {
+		return nil, errs.NewNotFoundError("application not found")
+	}
+
+	if application.Resume == nil {
+		return nil, errs.NewNotFoundError("no resume is attached to this application")
+	}
+
+	return presignResume(ctx, s.S3Uploader, application.Resume)
+}
@@ -67,7 +67,7 @@ func (l locationService) GetAllEventLocation() ([]models.Event, error) {
 }
 
 func (l locationService) GetEventLocationByEventID(eventID uint) (*models.Event, error) {
-	event, err := l.eventRepo.GetByID(eventID)
+	event, err := l.eventRepo.GetListedByID(eventID)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, errs.NewNotFoundError("Event not found")
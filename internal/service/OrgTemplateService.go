@@ -0,0 +1,376 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/errs"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/dto"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/models"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/infrastructure"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/repository"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/logs"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type orgTemplateService struct {
+	templateRepo models.OrgTemplateRepository
+	eventRepo    repository.EventRepository
+	ticketRepo   models.TicketAvailableRepository
+	programRepo  models.EventProgramRepository
+	jobRepo      repository.OrgOpenJobRepository
+	S3           *infrastructure.S3Uploader
+}
+
+func NewOrgTemplateService(templateRepo models.OrgTemplateRepository, eventRepo repository.EventRepository, ticketRepo models.TicketAvailableRepository, programRepo models.EventProgramRepository, jobRepo repository.OrgOpenJobRepository, s3 *infrastructure.S3Uploader) OrgTemplateService {
+	return orgTemplateService{
+		templateRepo: templateRepo,
+		eventRepo:    eventRepo,
+		ticketRepo:   ticketRepo,
+		programRepo:  programRepo,
+		jobRepo:      jobRepo,
+		S3:           s3,
+	}
+}
+
+func (s orgTemplateService) DuplicateEvent(ctx context.Context, orgID uint, eventID uint) (*dto.EventResponses, error) {
+	source, err := s.sourceEvent(orgID, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.createEvent(ctx, orgID, CopyEventDraft(*source))
+}
+
+func (s orgTemplateService) DuplicateJob(ctx context.Context, orgID uint, jobID uint) (*dto.JobResponses, error) {
+	source, err := s.sourceJob(orgID, jobID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.createJob(ctx, orgID, CopyJobDraft(*source))
+}
+
+func (s orgTemplateService) SaveTemplate(ctx context.Context, userID uuid.UUID, orgID uint, req dto.OrgTemplateRequest) (*dto.OrgTemplateResponse, error) {
+	template := models.OrgTemplate{
+		OrganizationID: orgID,
+		Kind:           models.OrgTemplateKind(req.Kind),
+		Name:           req.Name,
+		CreatedBy:      userID,
+	}
+
+	var content any
+	switch template.Kind {
+	case models.EventTemplate:
+		source, err := s.sourceEvent(orgID, req.SourceID)
+		if err != nil {
+			return nil, err
+		}
+
+		draft := CopyEventDraft(*source)
+		draft.PicUrl = s.templatePicture(ctx, orgID, draft.PicUrl)
+		for i := range draft.Speakers {
+			draft.Speakers[i].PicUrl = s.templatePicture(ctx, orgID, draft.Speakers[i].PicUrl)
+		}
+
+		template.Title, template.PicUrl, content = draft.Name, draft.PicUrl, draft
+	case models.JobTemplate:
+		source, err := s.sourceJob(orgID, req.SourceID)
+		if err != nil {
+			return nil, err
+		}
+
+		draft := CopyJobDraft(*source)
+		draft.PicUrl = s.templatePicture(ctx, orgID, draft.PicUrl)
+
+		template.Title, template.PicUrl, content = draft.Title, draft.PicUrl, draft
+	default:
+		return nil, errs.NewBadRequestError("kind must be event or job")
+	}
+
+	data, err := json.Marshal(content)
+	if err != nil {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+	template.Content = string(data)
+
+	if err := s.templateRepo.Create(&template); err != nil {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	res := dto.BuildOrgTemplateResponse(template)
+	return &res, nil
+}
+
+func (s orgTemplateService) ListTemplates(orgID uint, kind string) ([]dto.OrgTemplateResponse, error) {
+	templateKind := models.OrgTemplateKind(kind)
+	if templateKind != "" && templateKind != models.EventTemplate && templateKind != models.JobTemplate {
+		return nil, errs.NewBadRequestError("kind must be event or job")
+	}
+
+	templates, err := s.templateRepo.ListByOrgID(orgID, templateKind)
+	if err != nil {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	res := make([]dto.OrgTemplateResponse, 0, len(templates))
+	for _, template := range templates {
+		res = append(res, dto.BuildOrgTemplateResponse(template))
+	}
+
+	return res, nil
+}
+
+func (s orgTemplateService) DeleteTemplate(ctx context.Context, orgID uint, templateID uint) error {
+	template, err := s.template(orgID, templateID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.templateRepo.Delete(orgID, templateID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errs.NewNotFoundError("template not found")
+		}
+
+		logs.Error(err)
+		return errs.NewUnexpectedError()
+	}
+
+	// The pictures copied for the template are no longer used by anything
+	pictures := []string{template.PicUrl}
+	if template.Kind == models.EventTemplate {
+		var event models.Event
+		if err := json.Unmarshal([]byte(template.Content), &event); err == nil {
+			for _, speaker := range event.Speakers {
+				pictures = append(pictures, speaker.PicUrl)
+			}
+		}
+	}
+
+	prefix := fmt.Sprintf("organizations/%v/templates/", orgID)
+	for _, picture := range pictures {
+		objectKey, ok := s.S3.ObjectKeyFromURL(picture)
+		if !ok || !strings.HasPrefix(objectKey, prefix) {
+			continue
+		}
+
+		if err := s.S3.DeleteObject(ctx, objectKey); err != nil {
+			logs.Warn(fmt.Sprintf("Failed to delete picture %s of template %d: %v", objectKey, templateID, err))
+		}
+	}
+
+	return nil
+}
+
+func (s orgTemplateService) CreateDraft(ctx context.Context, orgID uint, templateID uint) (*dto.OrgTemplateDraftResponse, error) {
+	template, err := s.template(orgID, templateID)
+	if err != nil {
+		return nil, err
+	}
+
+	res := dto.OrgTemplateDraftResponse{Kind: string(template.Kind)}
+	switch template.Kind {
+	case models.EventTemplate:
+		var event models.Event
+		if err := json.Unmarshal([]byte(template.Content), &event); err != nil {
+			logs.Error(err)
+			return nil, errs.NewUnexpectedError()
+		}
+
+		res.Event, err = s.createEvent(ctx, orgID, CopyEventDraft(event))
+	case models.JobTemplate:
+		var job models.OrgOpenJob
+		if err := json.Unmarshal([]byte(template.Content), &job); err != nil {
+			logs.Error(err)
+			return nil, errs.NewUnexpectedError()
+		}
+
+		res.Job, err = s.createJob(ctx, orgID, CopyJobDraft(job))
+	default:
+		logs.Error(fmt.Sprintf("template %d has an unknown kind %q", template.ID, template.Kind))
+		return nil, errs.NewUnexpectedError()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// createEvent creates the draft with copies of its pictures made for it.
+// A picture that cannot be copied is left out, the draft is kept and the picture can be uploaded again.
+func (s orgTemplateService) createEvent(ctx context.Context, orgID uint, draft models.Event) (*dto.EventResponses, error) {
+	if len(draft.Categories) > 0 {
+		categories, err := s.eventRepo.FindCategoryByIds(categoryIDs(draft.Categories))
+		if err != nil {
+			logs.Error(err)
+			return nil, errs.NewUnexpectedError()
+		}
+		draft.Categories = categories
+	}
+
+	if err := ScheduleEvent(&draft); err != nil {
+		return nil, errs.NewBadRequestError(err.Error())
+	}
+
+	picURL := draft.PicUrl
+	draft.PicUrl = ""
+	speakerPicURLs := make([]string, len(draft.Speakers))
+	for i := range draft.Speakers {
+		speakerPicURLs[i] = draft.Speakers[i].PicUrl
+		draft.Speakers[i].PicUrl = ""
+	}
+
+	if err := s.eventRepo.Duplicate(orgID, &draft); err != nil {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	if picURL != "" {
+		if url, err := s.S3.CopyEventPictureFile(ctx, picURL, orgID, draft.ID); err != nil {
+			logs.Warn(fmt.Sprintf("Failed to copy the picture of event %d: %v", draft.ID, err))
+		} else if err := s.eventRepo.UpdateEventPicture(orgID, draft.ID, url); err != nil {
+			logs.Error(err)
+		}
+	}
+
+	for i, speaker := range draft.Speakers {
+		if speakerPicURLs[i] == "" {
+			continue
+		}
+
+		if url, err := s.S3.CopyEventSpeakerPictureFile(ctx, speakerPicURLs[i], orgID, draft.ID, speaker.ID); err != nil {
+			logs.Warn(fmt.Sprintf("Failed to copy the picture of speaker %d: %v", speaker.ID, err))
+		} else if err := s.programRepo.UpdateSpeakerPicture(speaker.ID, draft.ID, url); err != nil {
+			logs.Error(err)
+		}
+	}
+
+	event, err := s.eventRepo.GetByIDwithOrgID(orgID, draft.ID)
+	if err != nil {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	res := ConvertToEventResponse(*event)
+	return &res, nil
+}
+
+// createJob creates the draft with a copy of its banner made for it, see createEvent.
+func (s orgTemplateService) createJob(ctx context.Context, orgID uint, draft models.OrgOpenJob) (*dto.JobResponses, error) {
+	if len(draft.Categories) > 0 {
+		categories, err := s.jobRepo.FindCategoryByIds(categoryIDs(draft.Categories))
+		if err != nil {
+			logs.Error(err)
+			return nil, errs.NewUnexpectedError()
+		}
+		draft.Categories = categories
+	}
+
+	skillIDs := make([]uint, 0, len(draft.Skills))
+	for _, skill := range draft.Skills {
+		skillIDs = append(skillIDs, skill.ID)
+	}
+	skills, err := s.jobRepo.FindSkillByIds(skillIDs)
+	if err != nil {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+	draft.Skills = skills
+
+	picURL := draft.PicUrl
+	draft.PicUrl = ""
+
+	if err := s.jobRepo.CreateJob(orgID, &draft); err != nil {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	if picURL != "" {
+		if url, err := s.S3.CopyJobBanner(ctx, picURL, orgID, draft.ID); err != nil {
+			logs.Warn(fmt.Sprintf("Failed to copy the banner of job %d: %v", draft.ID, err))
+		} else if err := s.jobRepo.UpdateJobPicture(orgID, draft.ID, url); err != nil {
+			logs.Error(err)
+		}
+	}
+
+	job, err := s.jobRepo.GetJobByIDWithOrgID(orgID, draft.ID)
+	if err != nil {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	res := ConvertToJobResponse(*job)
+	return &res, nil
+}
+
+// templatePicture copies a picture for a template, it is left out when it cannot be copied.
+func (s orgTemplateService) templatePicture(ctx context.Context, orgID uint, picURL string) string {
+	if picURL == "" {
+		return ""
+	}
+
+	url, err := s.S3.CopyTemplatePictureFile(ctx, picURL, orgID)
+	if err != nil {
+		logs.Warn(fmt.Sprintf("Failed to copy picture %s for a template: %v", picURL, err))
+		return ""
+	}
+
+	return url
+}
+
+// sourceEvent finds an event the organization hosts with its ticket types.
+func (s orgTemplateService) sourceEvent(orgID uint, eventID uint) (*models.Event, error) {
+	event, err := s.eventRepo.GetByIDwithOrgID(orgID, eventID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.NewNotFoundError("event not found")
+		}
+
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	event.TicketAvailable, err = s.ticketRepo.GetAllByEventID(eventID)
+	if err != nil {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	return event, nil
+}
+
+func (s orgTemplateService) sourceJob(orgID uint, jobID uint) (*models.OrgOpenJob, error) {
+	job, err := s.jobRepo.GetJobByIDWithOrgID(orgID, jobID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.NewNotFoundError("job not found")
+		}
+
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	return job, nil
+}
+
+func (s orgTemplateService) template(orgID uint, templateID uint) (*models.OrgTemplate, error) {
+	template, err := s.templateRepo.GetByID(orgID, templateID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.NewNotFoundError("template not found")
+		}
+
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	return template, nil
+}
@@ -237,10 +237,7 @@ func (s organizationService) ListAllIndustries() (dto.IndustryListResponse, erro
 
 	var industriesResponse dto.IndustryListResponse
 	for _, industry := range industries {
-		industriesResponse.Industries = append(industriesResponse.Industries, dto.IndustryResponses{
-			ID:   industry.ID,
-			Name: industry.Industry,
-		})
+		industriesResponse.Industries = append(industriesResponse.Industries, dto.BuildIndustryResponses(industry))
 	}
 
 	return industriesResponse, nil
@@ -535,6 +532,11 @@ func NewOrgOpenJobService(jobRepo repository.OrgOpenJobRepository, OrgRepo repos
 }
 
 func (s orgOpenJobService) SyncJobs() error {
+	if err := sync.SyncOrganizationsToOpenSearch(s.DB, s.OS); err != nil {
+		logs.Error(err)
+		return errs.NewUnexpectedError()
+	}
+
 	err := sync.SyncJobsToOpenSearch(s.DB, s.OS)
 	if err != nil {
 		logs.Error(err)
@@ -582,7 +584,17 @@ func (s orgOpenJobService) NewJob(orgID uint, req dto.JobRequest) error {
 		return errs.NewUnexpectedError()
 	}
 
-	job := ConvertToJobRequest(orgID, req, categories)
+	skills, err := s.jobRepo.FindSkillByIds(req.SkillIDs)
+	if err != nil {
+		logs.Error(err)
+		return errs.NewUnexpectedError()
+	}
+
+	if len(skills) != len(uniqueIDs(req.SkillIDs)) {
+		return errs.NewBadRequestError("some skills do not exist")
+	}
+
+	job := ConvertToJobRequest(orgID, req, categories, skills)
 	if err = s.jobRepo.CreateJob(orgID, &job); err != nil {
 		logs.Error(err)
 		return errs.NewUnexpectedError()
@@ -749,6 +761,7 @@ func (s orgOpenJobService) ListAllJobs() ([]dto.JobResponses, error) {
 
 	for _, job := range jobs {
 		jobResponse := ConvertToJobResponse(job)
+		maskHiddenSalary(&jobResponse)
 		jobsResponse = append(jobsResponse, jobResponse)
 	}
 
@@ -776,8 +789,25 @@ func (s orgOpenJobService) GetAllJobsByOrgID(OrgId uint) ([]dto.JobResponses, er
 	return jobsResponse, nil
 }
 
+func (s orgOpenJobService) GetListedJobsByOrgID(orgID uint) ([]dto.JobResponses, error) {
+	jobs, err := s.jobRepo.GetListedJobsByOrgID(orgID)
+	if err != nil {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	jobsResponse := make([]dto.JobResponses, 0, len(jobs))
+	for _, job := range jobs {
+		jobResponse := ConvertToJobResponse(job)
+		maskHiddenSalary(&jobResponse)
+		jobsResponse = append(jobsResponse, jobResponse)
+	}
+
+	return jobsResponse, nil
+}
+
 func (s orgOpenJobService) GetJobByID(jobID uint) (*dto.JobResponses, error) {
-	job, err := s.jobRepo.GetJobByID(jobID)
+	job, err := s.jobRepo.GetListedJobByID(jobID)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, errs.NewNotFoundError("job not found")
@@ -788,6 +818,24 @@ func (s orgOpenJobService) GetJobByID(jobID uint) (*dto.JobResponses, error) {
 	}
 
 	JobResponse := ConvertToJobResponse(*job)
+	maskHiddenSalary(&JobResponse)
+
+	return &JobResponse, nil
+}
+
+func (s orgOpenJobService) GetListedJobByIDwithOrgID(orgID uint, jobID uint) (*dto.JobResponses, error) {
+	job, err := s.jobRepo.GetListedJobByIDWithOrgID(orgID, jobID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.NewNotFoundError("job not found")
+		}
+
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	JobResponse := ConvertToJobResponse(*job)
+	maskHiddenSalary(&JobResponse)
 
 	return &JobResponse, nil
 }
@@ -860,7 +908,17 @@ func (s orgOpenJobService) UpdateJob(orgID uint, jobID uint, dto dto.JobRequest)
 		}
 	}
 
-	job := ConvertToJobRequest(orgID, dto, categories)
+	skills, err := s.jobRepo.FindSkillByIds(dto.SkillIDs)
+	if err != nil {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	if len(skills) != len(uniqueIDs(dto.SkillIDs)) {
+		return nil, errs.NewBadRequestError("some skills do not exist")
+	}
+
+	job := ConvertToJobRequest(orgID, dto, categories, skills)
 	job.ID = existJob.ID
 
 	// if file != nil {
@@ -0,0 +1,255 @@
+package service
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/errs"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/dto"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/models"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/infrastructure/export"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/repository"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/logs"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type participantService struct {
+	participantRepo  models.EventParticipantRepository
+	announcementRepo models.EventAnnouncementRepository
+	eventRepo        repository.EventRepository
+	mailRepo         repository.EventMailRepository
+}
+
+func NewParticipantService(participantRepo models.EventParticipantRepository, announcementRepo models.EventAnnouncementRepository, eventRepo repository.EventRepository, mailRepo repository.EventMailRepository) ParticipantService {
+	return participantService{
+		participantRepo:  participantRepo,
+		announcementRepo: announcementRepo,
+		eventRepo:        eventRepo,
+		mailRepo:         mailRepo,
+	}
+}
+
+func (s participantService) ListParticipants(orgID uint, eventID uint, query dto.ParticipantQuery) ([]dto.TicketPurchasedResponse, error) {
+	if _, err := s.orgEvent(orgID, eventID); err != nil {
+		return nil, err
+	}
+
+	purchases, err := s.participantRepo.ListRegistrations(eventID, ConvertToParticipantFilter(query))
+	if err != nil {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	res := dto.BuildListDTO(purchases, dto.BuildTicketPurchasedResponse)
+	if res == nil {
+		res = []dto.TicketPurchasedResponse{}
+	}
+
+	return res, nil
+}
+
+func (s participantService) ExportParticipants(orgID uint, eventID uint, query dto.ParticipantQuery) (*ParticipantExport, error) {
+	format, err := export.ParseFormat(query.Format)
+	if err != nil {
+		return nil, errs.NewBadRequestError("format must be csv or xlsx")
+	}
+
+	event, err := s.orgEvent(orgID, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	purchases, err := s.participantRepo.ListRegistrations(eventID, ConvertToParticipantFilter(query))
+	if err != nil {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	var buf bytes.Buffer
+	if err := format.Write(&buf, "Participants", BuildParticipantTable(purchases)); err != nil {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	return &ParticipantExport{
+		FileName:    fmt.Sprintf("event-%d-participants.%s", event.ID, format),
+		ContentType: format.ContentType(),
+		Data:        buf.Bytes(),
+	}, nil
+}
+
+func (s participantService) SendAnnouncement(orgID uint, eventID uint, req dto.EventAnnouncementRequest) (*dto.EventAnnouncementResponse, error) {
+	event, err := s.orgEvent(orgID, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	announcement, err := ConvertToEventAnnouncement(eventID, req, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	var configs []repository.AnnouncementMailConfig
+	if announcement.Status == models.AnnouncementStatusSending {
+		recipients, err := s.announcementRepo.ListRecipients(eventID)
+		if err != nil {
+			logs.Error(err)
+			return nil, errs.NewUnexpectedError()
+		}
+
+		configs = BuildAnnouncementMails(*event, recipients, announcement)
+		announcement.Recipients = len(configs)
+	}
+
+	if err := s.announcementRepo.Create(&announcement); err != nil {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	if announcement.Status == models.AnnouncementStatusSending {
+		// Mailing every registrant takes longer than the request should, failures are only logged
+		go s.deliverAnnouncement(announcement.ID, configs)
+	}
+
+	res := dto.BuildEventAnnouncementResponse(announcement)
+	return &res, nil
+}
+
+func (s participantService) ListAnnouncements(orgID uint, eventID uint) ([]dto.EventAnnouncementResponse, error) {
+	if _, err := s.orgEvent(orgID, eventID); err != nil {
+		return nil, err
+	}
+
+	announcements, err := s.announcementRepo.ListByEventID(eventID)
+	if err != nil {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	res := dto.BuildListDTO(announcements, dto.BuildEventAnnouncementResponse)
+	if res == nil {
+		res = []dto.EventAnnouncementResponse{}
+	}
+
+	return res, nil
+}
+
+func (s participantService) CancelAnnouncement(orgID uint, eventID uint, announcementID uint) error {
+	if _, err := s.orgEvent(orgID, eventID); err != nil {
+		return err
+	}
+
+	if err := s.announcementRepo.Cancel(announcementID, eventID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errs.NewNotFoundError("announcement not found")
+		}
+		if errors.Is(err, repository.ErrAnnouncementNotScheduled) {
+			return errs.NewConflictError(err.Error())
+		}
+
+		logs.Error(err)
+		return errs.NewUnexpectedError()
+	}
+
+	return nil
+}
+
+func (s participantService) SendScheduledAnnouncements() error {
+	announcements, err := s.announcementRepo.ClaimDue(time.Now())
+	if err != nil {
+		return err
+	}
+
+	for _, announcement := range announcements {
+		recipients, err := s.announcementRepo.ListRecipients(announcement.EventID)
+		if err != nil {
+			// It stays sending and is not picked up again, so nobody receives it twice
+			logs.Error(err)
+			continue
+		}
+
+		s.deliverAnnouncement(announcement.ID, BuildAnnouncementMails(announcement.Event, recipients, announcement))
+	}
+
+	return nil
+}
+
+// deliverAnnouncement mails the announcement and marks it sent, mails that fail are only logged.
+func (s participantService) deliverAnnouncement(announcementID uint, configs []repository.AnnouncementMailConfig) {
+	sendAnnouncementMails(s.mailRepo, configs)
+
+	if err := s.announcementRepo.MarkSent(announcementID, len(configs), time.Now()); err != nil {
+		logs.Error(err)
+	}
+}
+
+func (s participantService) ListPublicParticipants(eventID uint) (*dto.PublicParticipantsResponse, error) {
+	if _, err := s.eventRepo.GetListedByID(eventID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.NewNotFoundError("event not found")
+		}
+
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	participants, err := s.participantRepo.ListVisible(eventID)
+	if err != nil {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	res := dto.PublicParticipantsResponse{
+		Total:        len(participants),
+		Participants: make([]dto.PublicParticipantResponse, 0, len(participants)),
+	}
+	for _, participant := range participants {
+		res.Participants = append(res.Participants, dto.BuildPublicParticipantResponse(participant))
+	}
+
+	return &res, nil
+}
+
+func (s participantService) UpdateMyVisibility(userID uuid.UUID, eventID uint, req dto.ParticipantVisibilityRequest) error {
+	if err := s.participantRepo.UpdateVisibility(userID, eventID, *req.IsVisible); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errs.NewNotFoundError("you are not a participant of this event")
+		}
+
+		logs.Error(err)
+		return errs.NewUnexpectedError()
+	}
+
+	return nil
+}
+
+func (s participantService) orgEvent(orgID uint, eventID uint) (*models.Event, error) {
+	event, err := s.eventRepo.GetByIDwithOrgID(orgID, eventID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.NewNotFoundError("event not found")
+		}
+
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	return event, nil
+}
+
+func sendAnnouncementMails(mailRepo repository.EventMailRepository, configs []repository.AnnouncementMailConfig) {
+	failed := 0
+	for _, config := range configs {
+		if err := mailRepo.SendAnnouncementMail(config); err != nil {
+			failed++
+			logs.Error(fmt.Sprintf("Failed to send event announcement email: %v", err))
+		}
+	}
+
+	if failed > 0 {
+		logs.Warn(fmt.Sprintf("Event announcement reached %d of %d registrants", len(configs)-failed, len(configs)))
+	}
+}
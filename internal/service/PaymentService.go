@@ -0,0 +1,406 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/errs"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/dto"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/models"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/infrastructure/payment"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/infrastructure/ticket"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/repository"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/logs"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type paymentService struct {
+	orderRepo    models.OrderRepository
+	purchaseRepo models.TicketPurchasedRepository
+	eventRepo    repository.EventRepository
+	mailRepo     repository.EventMailRepository
+	provider     payment.PaymentProvider
+}
+
+func NewPaymentService(orderRepo models.OrderRepository, purchaseRepo models.TicketPurchasedRepository, eventRepo repository.EventRepository, mailRepo repository.EventMailRepository, provider payment.PaymentProvider) PaymentService {
+	return paymentService{
+		orderRepo:    orderRepo,
+		purchaseRepo: purchaseRepo,
+		eventRepo:    eventRepo,
+		mailRepo:     mailRepo,
+		provider:     provider,
+	}
+}
+
+// Checkout returns the pending order of a ticket waiting for its payment, with a new charge when the last one failed.
+func (s paymentService) Checkout(ctx context.Context, userID uuid.UUID, purchaseID uint) (*dto.OrderResponse, error) {
+	purchase, err := s.purchaseRepo.GetByIDAndUserID(purchaseID, userID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.NewNotFoundError("ticket not found")
+		}
+
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	if purchase.Status != models.TicketStatusPending {
+		return nil, errs.NewBadRequestError("ticket is not waiting for a payment")
+	}
+
+	expiresAt := purchase.CreatedAt.Add(paymentWindow)
+	if time.Now().After(expiresAt) {
+		return nil, errs.NewBadRequestError("the payment window of this ticket has passed")
+	}
+
+	order, err := s.orderRepo.GetPendingByPurchaseID(purchaseID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	if order != nil && len(order.Payments) > 0 && order.Payments[0].Status == models.PaymentStatusPending {
+		res := dto.BuildOrderResponse(*order)
+		return &res, nil
+	}
+
+	charge, err := s.provider.CreateCharge(ctx, payment.ChargeRequest{
+		Reference:   fmt.Sprintf("ticket-%d-%d", purchase.ID, time.Now().Unix()),
+		Amount:      purchase.Price,
+		Currency:    payment.CurrencyTHB,
+		Description: fmt.Sprintf("%s - %s", purchase.Event.Name, purchase.TicketTitle),
+		ExpiresAt:   expiresAt,
+	})
+	if err != nil {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	newPayment := models.Payment{
+		Provider:    s.provider.Name(),
+		ProviderRef: charge.ProviderRef,
+		Amount:      purchase.Price,
+		Status:      models.PaymentStatusPending,
+		QRPayload:   charge.QRPayload,
+	}
+
+	if order != nil {
+		newPayment.OrderID = order.ID
+		if err := s.orderRepo.AddPayment(&newPayment); err != nil {
+			logs.Error(err)
+			return nil, errs.NewUnexpectedError()
+		}
+	} else {
+		order = &models.Order{
+			UserID:            userID,
+			EventID:           purchase.EventID,
+			TicketPurchasedID: purchase.ID,
+			Amount:            purchase.Price,
+			Currency:          payment.CurrencyTHB,
+			Status:            models.OrderStatusPending,
+			ExpiresAt:         expiresAt,
+		}
+		if err := s.orderRepo.Create(order, &newPayment); err != nil {
+			logs.Error(err)
+			return nil, errs.NewUnexpectedError()
+		}
+	}
+
+	return s.buildOrder(order.ID)
+}
+
+func (s paymentService) GetMyOrder(userID uuid.UUID, orderID uint) (*dto.OrderResponse, error) {
+	order, err := s.orderRepo.GetByIDAndUserID(orderID, userID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.NewNotFoundError("order not found")
+		}
+
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	res := dto.BuildOrderResponse(*order)
+	return &res, nil
+}
+
+func (s paymentService) GetMyOrderQRCode(userID uuid.UUID, orderID uint) ([]byte, error) {
+	order, err := s.orderRepo.GetByIDAndUserID(orderID, userID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.NewNotFoundError("order not found")
+		}
+
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	if order.Status != models.OrderStatusPending || len(order.Payments) == 0 || order.Payments[0].QRPayload == "" {
+		return nil, errs.NewBadRequestError("order has no payment QR code to pay")
+	}
+
+	png, err := ticket.RenderPNG(order.Payments[0].QRPayload)
+	if err != nil {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	return png, nil
+}
+
+// HandleWebhook applies a verified provider notification. Unknown or repeated notifications are acknowledged
+// without effect so that the provider stops retrying them.
+func (s paymentService) HandleWebhook(provider string, body []byte, signature string) error {
+	if provider != s.provider.Name() {
+		return errs.NewNotFoundError("unknown payment provider")
+	}
+
+	event, err := s.provider.ParseWebhook(body, signature)
+	if err != nil {
+		if errors.Is(err, payment.ErrInvalidSignature) {
+			return errs.NewUnauthorizedError(err.Error())
+		}
+
+		return errs.NewBadRequestError(err.Error())
+	}
+
+	paymentRecord, err := s.orderRepo.GetPaymentByProviderRef(provider, event.ProviderRef)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errs.NewNotFoundError("payment not found")
+		}
+
+		logs.Error(err)
+		return errs.NewUnexpectedError()
+	}
+
+	switch event.Status {
+	case payment.WebhookStatusPaid:
+		return s.applyPaid(*paymentRecord, *event, string(body))
+	case payment.WebhookStatusFailed:
+		if err := s.orderRepo.MarkFailed(paymentRecord.ID, string(body)); err != nil {
+			logs.Error(err)
+			return errs.NewUnexpectedError()
+		}
+	case payment.WebhookStatusRefunded:
+		offer, err := s.orderRepo.Refund(paymentRecord.OrderID, event.Amount, false, time.Now().Add(waitlistOfferTTL))
+		if err != nil && !errors.Is(err, repository.ErrOrderNotRefundable) {
+			logs.Error(err)
+			return errs.NewUnexpectedError()
+		}
+
+		if offer != nil {
+			sendWaitlistOffer(s.mailRepo, *offer)
+		}
+	}
+
+	return nil
+}
+
+func (s paymentService) SimulatePayment(userID uuid.UUID, orderID uint) (*dto.OrderResponse, error) {
+	fake, ok := s.provider.(*payment.FakeProvider)
+	if !ok {
+		return nil, errs.NewForbiddenError("payments can only be simulated with the fake provider")
+	}
+
+	order, err := s.orderRepo.GetByIDAndUserID(orderID, userID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.NewNotFoundError("order not found")
+		}
+
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	if len(order.Payments) == 0 {
+		return nil, errs.NewBadRequestError("order has no payment")
+	}
+
+	body, signature, err := fake.Simulate(payment.WebhookEvent{
+		ProviderRef: order.Payments[0].ProviderRef,
+		Status:      payment.WebhookStatusPaid,
+		Amount:      order.Payments[0].Amount,
+	})
+	if err != nil {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	if err := s.HandleWebhook(fake.Name(), body, signature); err != nil {
+		return nil, err
+	}
+
+	return s.buildOrder(order.ID)
+}
+
+func (s paymentService) ListEventOrders(orgID uint, eventID uint) ([]dto.OrderResponse, error) {
+	if _, err := s.eventRepo.GetByIDwithOrgID(orgID, eventID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.NewNotFoundError("event not found")
+		}
+
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	orders, err := s.orderRepo.ListByEventID(eventID)
+	if err != nil {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	res := dto.BuildListDTO(orders, dto.BuildOrderResponse)
+	if res == nil {
+		res = []dto.OrderResponse{}
+	}
+
+	return res, nil
+}
+
+// RefundOrder sends the money back through the provider and cancels the ticket, its seat returns to the waitlist or the quantity.
+func (s paymentService) RefundOrder(ctx context.Context, orgID uint, eventID uint, orderID uint) (*dto.OrderResponse, error) {
+	if _, err := s.eventRepo.GetByIDwithOrgID(orgID, eventID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.NewNotFoundError("event not found")
+		}
+
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	order, err := s.orderRepo.GetByID(orderID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.NewNotFoundError("order not found")
+		}
+
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	if order.EventID != eventID {
+		return nil, errs.NewNotFoundError("order not found")
+	}
+
+	if order.Status != models.OrderStatusPaid {
+		return nil, errs.NewBadRequestError(repository.ErrOrderNotRefundable.Error())
+	}
+
+	var paid *models.Payment
+	for i := range order.Payments {
+		if order.Payments[i].Status == models.PaymentStatusSucceeded {
+			paid = &order.Payments[i]
+			break
+		}
+	}
+
+	if paid == nil {
+		return nil, errs.NewBadRequestError("order has no succeeded payment to refund")
+	}
+
+	refund, err := s.provider.Refund(ctx, paid.ProviderRef, paid.Amount)
+	if err != nil {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	offer, err := s.orderRepo.Refund(order.ID, refund.Amount, refund.Manual, time.Now().Add(waitlistOfferTTL))
+	if err != nil {
+		if errors.Is(err, repository.ErrOrderNotRefundable) {
+			return nil, errs.NewBadRequestError(err.Error())
+		}
+
+		if errors.Is(err, repository.ErrTicketAlreadyCheckedIn) {
+			return nil, errs.NewBadRequestError("a checked in ticket cannot be refunded")
+		}
+
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	if offer != nil {
+		sendWaitlistOffer(s.mailRepo, *offer)
+	}
+
+	return s.buildOrder(order.ID)
+}
+
+// ExpirePendingTickets is run periodically, the seats of tickets left unpaid past the payment window are released.
+func (s paymentService) ExpirePendingTickets() error {
+	purchaseIDs, err := s.orderRepo.ListExpiredPendingPurchaseIDs(time.Now().Add(-paymentWindow))
+	if err != nil {
+		return err
+	}
+
+	for _, purchaseID := range purchaseIDs {
+		offer, err := s.purchaseRepo.Cancel(purchaseID, time.Now().Add(waitlistOfferTTL))
+		if err != nil {
+			if errors.Is(err, repository.ErrTicketAlreadyCancelled) {
+				continue
+			}
+			return err
+		}
+
+		if offer != nil {
+			sendWaitlistOffer(s.mailRepo, *offer)
+		}
+	}
+
+	return nil
+}
+
+func (s paymentService) applyPaid(paymentRecord models.Payment, event payment.WebhookEvent, body string) error {
+	if event.Amount < paymentRecord.Amount {
+		logs.Error(fmt.Sprintf("Payment %s underpaid: received %.2f of %.2f", paymentRecord.ProviderRef, event.Amount, paymentRecord.Amount))
+		if err := s.orderRepo.MarkFailed(paymentRecord.ID, body); err != nil {
+			logs.Error(err)
+			return errs.NewUnexpectedError()
+		}
+		return nil
+	}
+
+	order, confirmed, err := s.orderRepo.MarkPaid(paymentRecord.ID, body)
+	if err != nil {
+		if errors.Is(err, repository.ErrOrderNotPayable) {
+			logs.Error(fmt.Sprintf("Payment %s received for order %d which is %s, it has to be refunded", paymentRecord.ProviderRef, order.ID, order.Status))
+			return nil
+		}
+
+		logs.Error(err)
+		return errs.NewUnexpectedError()
+	}
+
+	if !confirmed {
+		return nil
+	}
+
+	purchase, err := s.purchaseRepo.GetByID(order.TicketPurchasedID)
+	if err != nil {
+		logs.Error(err)
+		return nil
+	}
+
+	eventRecord, err := s.eventRepo.GetByID(order.EventID)
+	if err != nil {
+		logs.Error(err)
+		return nil
+	}
+
+	sendTicketConfirmation(s.mailRepo, *eventRecord, *purchase)
+	return nil
+}
+
+func (s paymentService) buildOrder(orderID uint) (*dto.OrderResponse, error) {
+	order, err := s.orderRepo.GetByID(orderID)
+	if err != nil {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	res := dto.BuildOrderResponse(*order)
+	return &res, nil
+}
@@ -0,0 +1,224 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"mime/multipart"
+	"strings"
+
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/errs"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/dto"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/models"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/infrastructure"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/logs"
+	"github.com/google/uuid"
+	"github.com/opensearch-project/opensearch-go"
+	"gorm.io/gorm"
+)
+
+type profileService struct {
+	profileRepo models.ProfileRepository
+	OS          *opensearch.Client
+}
+
+func NewProfileService(profileRepo models.ProfileRepository, os *opensearch.Client) ProfileService {
+	return profileService{
+		profileRepo: profileRepo,
+		OS:          os,
+	}
+}
+
+func (s profileService) GetMyProfile(userID uuid.UUID) (*dto.ProfileDetailResponse, error) {
+	profile, err := s.profileRepo.GetByUserID(userID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.NewNotFoundError("profile not found")
+		}
+
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	res := dto.BuildProfileDetailResponse(*profile)
+	return &res, nil
+}
+
+func (s profileService) UpdateMyProfile(userID uuid.UUID, req dto.ProfileUpdateRequest) (*dto.ProfileDetailResponse, error) {
+	profile, err := s.profileRepo.GetByUserID(userID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.NewNotFoundError("profile not found")
+		}
+
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	profile.FirstName = strings.TrimSpace(req.FirstName)
+	profile.LastName = strings.TrimSpace(req.LastName)
+	profile.HeadLine = strings.TrimSpace(req.HeadLine)
+	profile.Phone = strings.TrimSpace(req.Phone)
+	profile.Bio = req.Bio
+	profile.Skill = strings.TrimSpace(req.Skill)
+	profile.Language = strings.TrimSpace(req.Language)
+	profile.Education = strings.TrimSpace(req.Education)
+	profile.FocusField = strings.TrimSpace(req.FocusField)
+	profile.Province = strings.TrimSpace(req.Province)
+
+	if err := s.profileRepo.Update(profile); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.NewNotFoundError("profile not found")
+		}
+
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	if profile.IsPublic {
+		reindexTalent(s.OS, *profile)
+	}
+
+	return s.GetMyProfile(userID)
+}
+
+type experienceService struct {
+	experienceRepo models.ExperienceRepository
+	profileRepo    models.ProfileRepository
+	S3Uploader     *infrastructure.S3Uploader
+}
+
+func NewExperienceService(experienceRepo models.ExperienceRepository, profileRepo models.ProfileRepository, s3Uploader *infrastructure.S3Uploader) ExperienceService {
+	return experienceService{
+		experienceRepo: experienceRepo,
+		profileRepo:    profileRepo,
+		S3Uploader:     s3Uploader,
+	}
+}
+
+func (s experienceService) getUserExperience(userID uuid.UUID, experienceID uuid.UUID) (*models.Experience, error) {
+	experience, err := s.experienceRepo.GetByIDAndUserID(experienceID, userID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.NewNotFoundError("experience not found")
+		}
+
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	return experience, nil
+}
+
+func (s experienceService) ListExperiences(userID uuid.UUID) ([]dto.ExperienceResponse, error) {
+	experiences, err := s.experienceRepo.GetByUserID(userID)
+	if err != nil {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	res := dto.BuildListDTO(experiences, dto.BuildExperienceResponse)
+	if res == nil {
+		res = []dto.ExperienceResponse{}
+	}
+
+	return res, nil
+}
+
+func (s experienceService) GetExperience(userID uuid.UUID, experienceID uuid.UUID) (*dto.ExperienceResponse, error) {
+	experience, err := s.getUserExperience(userID, experienceID)
+	if err != nil {
+		return nil, err
+	}
+
+	res := dto.BuildExperienceResponse(*experience)
+	return &res, nil
+}
+
+func (s experienceService) CreateExperience(userID uuid.UUID, req dto.ExperienceRequest) (*dto.ExperienceResponse, error) {
+	profile, err := s.profileRepo.GetByUserID(userID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.NewNotFoundError("profile not found")
+		}
+
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	experience, err := ConvertToExperienceModel(req)
+	if err != nil {
+		return nil, err
+	}
+	experience.ProfileID = profile.ID
+
+	if err := s.experienceRepo.Create(&experience); err != nil {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	res := dto.BuildExperienceResponse(experience)
+	return &res, nil
+}
+
+func (s experienceService) UpdateExperience(userID uuid.UUID, experienceID uuid.UUID, req dto.ExperienceRequest) (*dto.ExperienceResponse, error) {
+	existExperience, err := s.getUserExperience(userID, experienceID)
+	if err != nil {
+		return nil, err
+	}
+
+	experience, err := ConvertToExperienceModel(req)
+	if err != nil {
+		return nil, err
+	}
+	experience.UUID = existExperience.UUID
+
+	if err := s.experienceRepo.Update(&experience); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.NewNotFoundError("experience not found")
+		}
+
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	return s.GetExperience(userID, experienceID)
+}
+
+func (s experienceService) UpdateExperiencePicture(ctx context.Context, userID uuid.UUID, experienceID uuid.UUID, file multipart.File, fileHeader *multipart.FileHeader) (string, error) {
+	if _, err := s.getUserExperience(userID, experienceID); err != nil {
+		return "", err
+	}
+
+	picURL, err := s.S3Uploader.UploadExperiencePictureFile(ctx, file, fileHeader, userID, experienceID)
+	if err != nil {
+		return "", errs.NewUnexpectedError()
+	}
+
+	if err := s.experienceRepo.UpdatePicture(experienceID, picURL); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return "", errs.NewNotFoundError("experience not found")
+		}
+
+		logs.Error(err)
+		return "", errs.NewUnexpectedError()
+	}
+
+	return picURL, nil
+}
+
+func (s experienceService) DeleteExperience(userID uuid.UUID, experienceID uuid.UUID) error {
+	if _, err := s.getUserExperience(userID, experienceID); err != nil {
+		return err
+	}
+
+	if err := s.experienceRepo.Delete(experienceID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errs.NewNotFoundError("experience not found")
+		}
+
+		logs.Error(err)
+		return errs.NewUnexpectedError()
+	}
+
+	return nil
+}
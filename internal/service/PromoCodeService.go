@@ -0,0 +1,236 @@
+package service
+
+import (
+	"errors"
+
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/errs"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/dto"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/models"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/repository"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/logs"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type promoCodeService struct {
+	promoRepo  models.PromoCodeRepository
+	ticketRepo models.TicketAvailableRepository
+	eventRepo  repository.EventRepository
+}
+
+func NewPromoCodeService(promoRepo models.PromoCodeRepository, ticketRepo models.TicketAvailableRepository, eventRepo repository.EventRepository) PromoCodeService {
+	return promoCodeService{
+		promoRepo:  promoRepo,
+		ticketRepo: ticketRepo,
+		eventRepo:  eventRepo,
+	}
+}
+
+func (s promoCodeService) ListPromoCodes(orgID uint, eventID uint) ([]dto.PromoCodeResponse, error) {
+	if err := s.checkOrgEvent(orgID, eventID); err != nil {
+		return nil, err
+	}
+
+	promos, err := s.promoRepo.ListByEventID(eventID)
+	if err != nil {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	res := dto.BuildListDTO(promos, dto.BuildPromoCodeResponse)
+	if res == nil {
+		res = []dto.PromoCodeResponse{}
+	}
+
+	return res, nil
+}
+
+func (s promoCodeService) CreatePromoCode(orgID uint, eventID uint, req dto.PromoCodeRequest) (*dto.PromoCodeResponse, error) {
+	if err := s.checkOrgEvent(orgID, eventID); err != nil {
+		return nil, err
+	}
+
+	tickets, err := s.eventTickets(eventID, req.TicketIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	promo, err := ConvertToPromoCode(eventID, req, tickets)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.promoRepo.Create(&promo); err != nil {
+		if isDuplicateKeyError(err) {
+			return nil, errs.NewConflictError("promo code already exists for this event")
+		}
+
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	res := dto.BuildPromoCodeResponse(promo)
+	return &res, nil
+}
+
+func (s promoCodeService) UpdatePromoCode(orgID uint, eventID uint, promoID uint, req dto.PromoCodeRequest) (*dto.PromoCodeResponse, error) {
+	if err := s.checkOrgEvent(orgID, eventID); err != nil {
+		return nil, err
+	}
+
+	tickets, err := s.eventTickets(eventID, req.TicketIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	promo, err := ConvertToPromoCode(eventID, req, tickets)
+	if err != nil {
+		return nil, err
+	}
+	promo.ID = promoID
+
+	updatedPromo, err := s.promoRepo.Update(&promo)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.NewNotFoundError("promo code not found")
+		}
+
+		if isDuplicateKeyError(err) {
+			return nil, errs.NewConflictError("promo code already exists for this event")
+		}
+
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	res := dto.BuildPromoCodeResponse(*updatedPromo)
+	return &res, nil
+}
+
+func (s promoCodeService) DeletePromoCode(orgID uint, eventID uint, promoID uint) error {
+	if err := s.checkOrgEvent(orgID, eventID); err != nil {
+		return err
+	}
+
+	if _, err := s.promoRepo.GetByIDAndEventID(promoID, eventID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errs.NewNotFoundError("promo code not found")
+		}
+
+		logs.Error(err)
+		return errs.NewUnexpectedError()
+	}
+
+	if err := s.promoRepo.Delete(promoID); err != nil {
+		if errors.Is(err, repository.ErrPromoCodeRedeemed) {
+			return errs.NewConflictError(err.Error())
+		}
+
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errs.NewNotFoundError("promo code not found")
+		}
+
+		logs.Error(err)
+		return errs.NewUnexpectedError()
+	}
+
+	return nil
+}
+
+func (s promoCodeService) GetPromoCodeReport(orgID uint, eventID uint) (*dto.PromoCodeReportResponse, error) {
+	if err := s.checkOrgEvent(orgID, eventID); err != nil {
+		return nil, err
+	}
+
+	promos, err := s.promoRepo.ListByEventID(eventID)
+	if err != nil {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	usages, err := s.promoRepo.CountUsageByEventID(eventID)
+	if err != nil {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	redemptions, err := s.promoRepo.ListRedemptionsByEventID(eventID)
+	if err != nil {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	res := dto.BuildPromoCodeReportResponse(promos, usages, redemptions)
+	return &res, nil
+}
+
+func (s promoCodeService) QuotePromoCode(userID uuid.UUID, eventID uint, ticketID uint, req dto.PromoCodeQuoteRequest) (*dto.PromoCodeQuoteResponse, error) {
+	ticketType, err := s.ticketRepo.GetByIDAndEventID(ticketID, eventID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.NewNotFoundError("ticket not found")
+		}
+
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	promo, discount, err := s.promoRepo.Quote(eventID, ticketID, userID, req.Code, ticketType.Price)
+	if err != nil {
+		if promoErr, ok := promoCodeError(err); ok {
+			return nil, promoErr
+		}
+
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	return &dto.PromoCodeQuoteResponse{
+		Code:           promo.Code,
+		OriginalPrice:  ticketType.Price,
+		DiscountAmount: discount,
+		FinalPrice:     ticketType.Price - discount,
+	}, nil
+}
+
+func (s promoCodeService) checkOrgEvent(orgID uint, eventID uint) error {
+	if _, err := s.eventRepo.GetByIDwithOrgID(orgID, eventID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errs.NewNotFoundError("event not found")
+		}
+
+		logs.Error(err)
+		return errs.NewUnexpectedError()
+	}
+
+	return nil
+}
+
+// eventTickets returns the requested ticket types, each of them has to belong to the event.
+func (s promoCodeService) eventTickets(eventID uint, ticketIDs []uint) ([]models.TicketAvailable, error) {
+	if len(ticketIDs) == 0 {
+		return []models.TicketAvailable{}, nil
+	}
+
+	eventTickets, err := s.ticketRepo.GetAllByEventID(eventID)
+	if err != nil {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	ticketsByID := make(map[uint]models.TicketAvailable, len(eventTickets))
+	for _, ticket := range eventTickets {
+		ticketsByID[ticket.ID] = ticket
+	}
+
+	tickets := make([]models.TicketAvailable, 0, len(ticketIDs))
+	for _, id := range uniqueIDs(ticketIDs) {
+		ticket, ok := ticketsByID[id]
+		if !ok {
+			return nil, errs.NewBadRequestError("ticket types must belong to the event")
+		}
+		tickets = append(tickets, ticket)
+	}
+
+	return tickets, nil
+}
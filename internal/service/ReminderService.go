@@ -0,0 +1,145 @@
+package service
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/errs"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/dto"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/models"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/infrastructure/calendar"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/infrastructure/ticket"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/repository"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/logs"
+	"github.com/google/uuid"
+)
+
+type reminderService struct {
+	reminderRepo models.EventReminderRepository
+	purchaseRepo models.TicketPurchasedRepository
+	mailRepo     repository.EventMailRepository
+	qrSigner     ticket.QRSigner
+	baseEventURL string
+}
+
+func NewReminderService(reminderRepo models.EventReminderRepository, purchaseRepo models.TicketPurchasedRepository, mailRepo repository.EventMailRepository, qrSigner ticket.QRSigner, baseEventURL string) ReminderService {
+	return reminderService{
+		reminderRepo: reminderRepo,
+		purchaseRepo: purchaseRepo,
+		mailRepo:     mailRepo,
+		qrSigner:     qrSigner,
+		baseEventURL: baseEventURL,
+	}
+}
+
+func (s reminderService) SendDueReminders() error {
+	now := time.Now()
+
+	for _, kind := range []models.ReminderKind{models.ReminderDayBefore, models.ReminderHourBefore} {
+		from, to := ReminderWindow(kind, now)
+		purchases, err := s.reminderRepo.ListDue(kind, from, to)
+		if err != nil {
+			return err
+		}
+
+		for _, purchase := range purchases {
+			s.sendReminder(kind, purchase, now)
+		}
+	}
+
+	return nil
+}
+
+// sendReminder claims the reminder before mailing it, so concurrent runs send it once. A failed mail
+// is released and tried again on the next run while the event is still ahead.
+func (s reminderService) sendReminder(kind models.ReminderKind, purchase models.TicketPurchased, now time.Time) {
+	claimed, err := s.reminderRepo.Claim(purchase.ID, kind)
+	if err != nil {
+		logs.Error(err)
+		return
+	}
+	if !claimed {
+		return
+	}
+
+	calendarFile := calendar.Render(purchase.Event.Name, ConvertToCalendarEvents(purchase.Event, s.baseEventURL), now)
+	config := ConvertToReminderMail(kind, purchase, calendarFile, s.ticketQRCode(purchase))
+
+	if err := s.mailRepo.SendReminderMail(config); err != nil {
+		logs.Error(fmt.Sprintf("Failed to send %s event reminder email: %v", kind, err))
+		if err := s.reminderRepo.Release(purchase.ID, kind); err != nil {
+			logs.Error(err)
+		}
+	}
+}
+
+// ticketQRCode renders the QR code of the ticket, tickets registered before QR codes existed get one here.
+// The reminder is still worth sending without it.
+func (s reminderService) ticketQRCode(purchase models.TicketPurchased) []byte {
+	if purchase.Qrcode == "" {
+		qrcode, err := s.qrSigner.NewPayload(purchase.EventID)
+		if err != nil {
+			logs.Error(err)
+			return nil
+		}
+
+		if err := s.purchaseRepo.UpdateQrcode(purchase.ID, qrcode); err != nil {
+			logs.Error(err)
+			return nil
+		}
+		purchase.Qrcode = qrcode
+	}
+
+	png, err := ticket.RenderPNG(purchase.Qrcode)
+	if err != nil {
+		logs.Error(err)
+		return nil
+	}
+
+	return png
+}
+
+type notificationPreferenceService struct {
+	preferenceRepo models.NotificationPreferenceRepository
+}
+
+func NewNotificationPreferenceService(preferenceRepo models.NotificationPreferenceRepository) NotificationPreferenceService {
+	return notificationPreferenceService{preferenceRepo: preferenceRepo}
+}
+
+func (s notificationPreferenceService) GetMyNotificationPreferences(userID uuid.UUID) (*dto.NotificationPreferenceResponse, error) {
+	preference, err := s.preferenceRepo.GetByUserID(userID)
+	if err != nil {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	res := BuildNotificationPreferenceResponse(*preference)
+	return &res, nil
+}
+
+func (s notificationPreferenceService) UpdateMyNotificationPreferences(userID uuid.UUID, req dto.NotificationPreferenceRequest) (*dto.NotificationPreferenceResponse, error) {
+	current, err := s.preferenceRepo.GetByUserID(userID)
+	if err != nil {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	preference := models.NotificationPreference{
+		UserID:             userID,
+		EventReminders:     *req.EventReminders,
+		EventAnnouncements: *req.EventAnnouncements,
+		DiscussionReplies:  current.DiscussionReplies,
+	}
+	if req.DiscussionReplies != nil {
+		preference.DiscussionReplies = *req.DiscussionReplies
+	}
+
+	if err := s.preferenceRepo.Upsert(&preference); err != nil {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	res := BuildNotificationPreferenceResponse(preference)
+	return &res, nil
+}
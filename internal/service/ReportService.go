@@ -0,0 +1,284 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/errs"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/dto"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/models"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/infrastructure/sync"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/repository"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/logs"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/utils"
+	"github.com/google/uuid"
+	"github.com/opensearch-project/opensearch-go"
+	"gorm.io/gorm"
+)
+
+type reportService struct {
+	reportRepo        models.ContentReportRepository
+	mailRepo          repository.EventMailRepository
+	autoHideThreshold int
+	DB                *gorm.DB
+	OS                *opensearch.Client
+}
+
+func NewReportService(reportRepo models.ContentReportRepository, mailRepo repository.EventMailRepository, autoHideThreshold int, db *gorm.DB, os *opensearch.Client) ReportService {
+	return reportService{
+		reportRepo:        reportRepo,
+		mailRepo:          mailRepo,
+		autoHideThreshold: autoHideThreshold,
+		DB:                db,
+		OS:                os,
+	}
+}
+
+func (s reportService) Report(userID uuid.UUID, req dto.ContentReportRequest) (*dto.ContentReportResponse, error) {
+	targetType, err := ParseReportTarget(req.TargetType)
+	if err != nil {
+		return nil, err
+	}
+
+	summary, err := s.content(targetType, req.TargetID)
+	if err != nil {
+		return nil, err
+	}
+
+	report := models.ContentReport{
+		TargetType: targetType,
+		TargetID:   req.TargetID,
+		ReporterID: userID,
+		Reason:     models.ReportReason(req.Reason),
+		Details:    req.Details,
+		Status:     models.ReportPending,
+	}
+	pending, err := s.reportRepo.Create(&report)
+	if err != nil {
+		if isDuplicateKeyError(err) {
+			return nil, errs.NewConflictError("you already reported this content")
+		}
+
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	if ShouldAutoHide(targetType, *summary, pending, s.autoHideThreshold) {
+		s.autoHide(targetType, req.TargetID, pending)
+	}
+
+	res := dto.BuildContentReportResponse(report)
+	return &res, nil
+}
+
+// autoHide hides the content until a moderator reviews it, the report itself is recorded either way.
+func (s reportService) autoHide(targetType models.ReportTarget, targetID uint, pending int64) {
+	hidden := true
+	log := models.ModerationLog{
+		TargetType: targetType,
+		TargetID:   targetID,
+		Action:     models.ActionAutoHide,
+		Note:       fmt.Sprintf("Hidden after %d reports", pending),
+	}
+
+	change := models.ModerationChange{Hidden: &hidden}
+	if _, err := s.reportRepo.Moderate(&log, change, ""); err != nil {
+		logs.Error(err)
+		return
+	}
+
+	go s.reindex(targetType, targetID, change)
+}
+
+func (s reportService) ListQueue(targetType string) ([]dto.ReportedContentResponse, error) {
+	var filter models.ReportTarget
+	if targetType != "" {
+		parsed, err := ParseReportTarget(targetType)
+		if err != nil {
+			return nil, err
+		}
+		filter = parsed
+	}
+
+	queue, err := s.reportRepo.ListQueue(filter)
+	if err != nil {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	res := make([]dto.ReportedContentResponse, 0, len(queue))
+	for _, item := range queue {
+		summary, err := s.reportedContent(item.TargetType, item.TargetID)
+		if err != nil {
+			return nil, err
+		}
+
+		response := BuildReportedContentResponse(item.TargetType, item.TargetID, *summary)
+		response.Reports = item.Reports
+		response.LastReportedAt = utils.FormatUTC(&item.LastReportedAt)
+		res = append(res, response)
+	}
+
+	return res, nil
+}
+
+func (s reportService) GetReportedContent(targetType string, targetID uint) (*dto.ReportedContentDetailResponse, error) {
+	parsed, err := ParseReportTarget(targetType)
+	if err != nil {
+		return nil, err
+	}
+
+	summary, err := s.reportedContent(parsed, targetID)
+	if err != nil {
+		return nil, err
+	}
+
+	reports, err := s.reportRepo.ListPending(parsed, targetID)
+	if err != nil {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	moderationLogs, err := s.reportRepo.ListLogs(parsed, targetID)
+	if err != nil {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	res := dto.ReportedContentDetailResponse{
+		ReportedContentResponse: BuildReportedContentResponse(parsed, targetID, *summary),
+		PendingReports:          make([]dto.ContentReportResponse, 0, len(reports)),
+		Logs:                    make([]dto.ModerationLogResponse, 0, len(moderationLogs)),
+	}
+	res.Reports = int64(len(reports))
+	for _, report := range reports {
+		res.PendingReports = append(res.PendingReports, dto.BuildContentReportResponse(report))
+		res.LastReportedAt = utils.FormatUTC(&report.CreatedAt)
+	}
+	for _, log := range moderationLogs {
+		res.Logs = append(res.Logs, dto.BuildModerationLogResponse(log))
+	}
+
+	return &res, nil
+}
+
+func (s reportService) TakeAction(moderatorID uuid.UUID, targetType string, targetID uint, req dto.ModerationActionRequest) (*dto.ModerationLogResponse, error) {
+	parsed, err := ParseReportTarget(targetType)
+	if err != nil {
+		return nil, err
+	}
+
+	action := models.ModerationAction(req.Action)
+
+	// Only the reports on content deleted since are dismissed, there is nothing left to act on
+	summary, err := s.reportedContent(parsed, targetID)
+	if err != nil {
+		return nil, err
+	}
+	if summary.Path == "" && action != models.ActionDismiss {
+		return nil, errs.NewNotFoundError(string(parsed) + " not found")
+	}
+
+	moderationLogs, err := s.reportRepo.ListLogs(parsed, targetID)
+	if err != nil {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+	autoHidden := len(moderationLogs) > 0 && moderationLogs[0].Action == models.ActionAutoHide
+
+	change, status, err := PlanModeration(action, parsed, *summary, autoHidden)
+	if err != nil {
+		return nil, err
+	}
+
+	log := models.ModerationLog{
+		TargetType:  parsed,
+		TargetID:    targetID,
+		Action:      action,
+		ModeratorID: &moderatorID,
+		Note:        req.Note,
+	}
+	reports, err := s.reportRepo.Moderate(&log, change, status)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.NewNotFoundError(string(parsed) + " not found")
+		}
+
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	go s.reindex(parsed, targetID, change)
+	go s.notify(*summary, action, req.Note, reports)
+
+	res := dto.BuildModerationLogResponse(log)
+	return &res, nil
+}
+
+// notify warns the owner of the content when asked and tells the reporters the outcome of their reports.
+func (s reportService) notify(summary ContentSummary, action models.ModerationAction, note string, reports []models.ContentReport) {
+	if action == models.ActionWarn {
+		if err := s.mailRepo.SendModerationWarningMail(ConvertToModerationWarningMail(summary, note)); err != nil {
+			logs.Error(fmt.Sprintf("Failed to send moderation warning email: %v", err))
+		}
+	}
+
+	for _, report := range reports {
+		if err := s.mailRepo.SendReportOutcomeMail(ConvertToReportOutcomeMail(report, summary)); err != nil {
+			logs.Error(fmt.Sprintf("Failed to send report outcome email: %v", err))
+		}
+	}
+}
+
+// reindex updates search results after the change, hidden events and jobs and those of suspended
+// organizations are dropped. A failure only delays it until the next sync, so it is not returned.
+func (s reportService) reindex(targetType models.ReportTarget, targetID uint, change models.ModerationChange) {
+	if s.OS == nil {
+		return
+	}
+
+	var err error
+	switch {
+	case change.SuspendOrganizationID != 0:
+		err = sync.IndexOrganization(s.DB, s.OS, change.SuspendOrganizationID)
+	case change.Hidden != nil && targetType == models.ReportEvent:
+		err = sync.IndexEvent(s.DB, s.OS, targetID)
+	case change.Hidden != nil && targetType == models.ReportJob:
+		err = sync.IndexJob(s.DB, s.OS, targetID)
+	}
+	if err != nil {
+		logs.Warn(fmt.Sprintf("Failed to update %s %d in search index: %v", targetType, targetID, err))
+	}
+}
+
+// reportedContent describes the content of the reports, content deleted since it was reported has an
+// empty summary so that its reports can still be reviewed and dismissed.
+func (s reportService) reportedContent(targetType models.ReportTarget, targetID uint) (*ContentSummary, error) {
+	content, err := s.reportRepo.GetContent(targetType, targetID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return &ContentSummary{}, nil
+		}
+
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	summary := DescribeContent(*content)
+	return &summary, nil
+}
+
+func (s reportService) content(targetType models.ReportTarget, targetID uint) (*ContentSummary, error) {
+	content, err := s.reportRepo.GetContent(targetType, targetID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.NewNotFoundError(string(targetType) + " not found")
+		}
+
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	summary := DescribeContent(*content)
+	return &summary, nil
+}
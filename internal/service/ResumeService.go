@@ -0,0 +1,288 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/errs"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/dto"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/models"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/infrastructure"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/infrastructure/resume"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/repository"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/logs"
+	"github.com/google/uuid"
+	"github.com/opensearch-project/opensearch-go"
+	"gorm.io/gorm"
+)
+
+const (
+	maxResumeSize   = 5 << 20 // 5 MB
+	resumeURLExpiry = 5 * time.Minute
+)
+
+type resumeService struct {
+	resumeRepo  repository.ResumeRepository
+	skillRepo   repository.SkillRepository
+	profileRepo models.ProfileRepository
+	S3Uploader  *infrastructure.S3Uploader
+	OS          *opensearch.Client
+}
+
+func NewResumeService(resumeRepo repository.ResumeRepository, skillRepo repository.SkillRepository, profileRepo models.ProfileRepository, s3Uploader *infrastructure.S3Uploader, os *opensearch.Client) ResumeService {
+	return resumeService{
+		resumeRepo:  resumeRepo,
+		skillRepo:   skillRepo,
+		profileRepo: profileRepo,
+		S3Uploader:  s3Uploader,
+		OS:          os,
+	}
+}
+
+// detectResumeContentType checks the file content rather than trusting the extension or the client's header.
+func detectResumeContentType(data []byte, fileExt string) (string, error) {
+	detected := http.DetectContentType(data)
+
+	switch fileExt {
+	case ".pdf":
+		if detected == resume.ContentTypePDF {
+			return resume.ContentTypePDF, nil
+		}
+	case ".docx":
+		if detected == "application/zip" && resume.IsDOCX(data) {
+			return resume.ContentTypeDOCX, nil
+		}
+	default:
+		return "", errs.NewBadRequestError("resume must be a PDF or DOCX file")
+	}
+
+	return "", errs.NewBadRequestError("resume content does not match its file type")
+}
+
+func (s resumeService) UploadResume(ctx context.Context, userID uuid.UUID, file multipart.File, fileHeader *multipart.FileHeader) (*dto.ResumeUploadResponse, error) {
+	if fileHeader.Size > maxResumeSize {
+		return nil, errs.NewBadRequestError("resume must not exceed 5 MB")
+	}
+
+	data, err := io.ReadAll(io.LimitReader(file, maxResumeSize+1))
+	if err != nil {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+	if len(data) > maxResumeSize {
+		return nil, errs.NewBadRequestError("resume must not exceed 5 MB")
+	}
+	if len(data) == 0 {
+		return nil, errs.NewBadRequestError("resume file is empty")
+	}
+
+	fileExt := strings.ToLower(filepath.Ext(fileHeader.Filename))
+	contentType, err := detectResumeContentType(data, fileExt)
+	if err != nil {
+		return nil, err
+	}
+
+	// A resume that cannot be read is still stored, the user just gets no suggestions
+	text, err := resume.ExtractText(data, contentType)
+	if err != nil {
+		logs.Warn(fmt.Sprintf("Failed to extract resume text of user %s: %v", userID, err))
+	}
+
+	version, err := s.resumeRepo.GetNextVersion(userID)
+	if err != nil {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	objectKey, err := s.S3Uploader.UploadResumeFile(ctx, data, contentType, fileExt, userID, version)
+	if err != nil {
+		return nil, errs.NewUnexpectedError()
+	}
+
+	userResume := models.Resume{
+		UserID:        userID,
+		Version:       version,
+		FileName:      filepath.Base(fileHeader.Filename),
+		ContentType:   contentType,
+		Size:          int64(len(data)),
+		ObjectKey:     objectKey,
+		ExtractedText: strings.ToValidUTF8(strings.ReplaceAll(text, "\x00", ""), ""),
+	}
+
+	if err := s.resumeRepo.Create(&userResume); err != nil {
+		_ = s.S3Uploader.DeleteObject(ctx, objectKey)
+		if isDuplicateKeyError(err) {
+			return nil, errs.NewConflictError("another resume is being uploaded, please try again")
+		}
+
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	suggestions, err := s.buildSuggestions(userResume.ExtractedText)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.ResumeUploadResponse{
+		Resume:      dto.BuildResumeResponse(userResume),
+		Suggestions: *suggestions,
+	}, nil
+}
+
+func (s resumeService) ListResumes(userID uuid.UUID) ([]dto.ResumeResponse, error) {
+	resumes, err := s.resumeRepo.ListByUserID(userID)
+	if err != nil {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	res := dto.BuildListDTO(resumes, dto.BuildResumeResponse)
+	if res == nil {
+		res = []dto.ResumeResponse{}
+	}
+
+	return res, nil
+}
+
+func (s resumeService) getUserResume(userID uuid.UUID, resumeID uint) (*models.Resume, error) {
+	userResume, err := s.resumeRepo.GetByIDAndUserID(resumeID, userID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.NewNotFoundError("resume not found")
+		}
+
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	return userResume, nil
+}
+
+func (s resumeService) GetResumeURL(ctx context.Context, userID uuid.UUID, resumeID uint) (*dto.ResumeURLResponse, error) {
+	userResume, err := s.getUserResume(userID, resumeID)
+	if err != nil {
+		return nil, err
+	}
+
+	return presignResume(ctx, s.S3Uploader, userResume)
+}
+
+func (s resumeService) GetResumeSuggestions(userID uuid.UUID, resumeID uint) (*dto.ResumeSuggestionResponse, error) {
+	userResume, err := s.getUserResume(userID, resumeID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.buildSuggestions(userResume.ExtractedText)
+}
+
+func (s resumeService) buildSuggestions(text string) (*dto.ResumeSuggestionResponse, error) {
+	parsed := resume.Parse(text)
+	suggestion := ConvertToResumeSuggestion(parsed)
+
+	skills, err := s.skillRepo.FindByNames(parsed.Skills)
+	if err != nil {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+	if len(skills) > 0 {
+		suggestion.Skills = dto.BuildListDTO(skills, dto.BuildSkillShortResponse)
+	}
+
+	return &suggestion, nil
+}
+
+func (s resumeService) ConfirmResumeSuggestions(userID uuid.UUID, resumeID uint, req dto.ResumeConfirmRequest) error {
+	if _, err := s.getUserResume(userID, resumeID); err != nil {
+		return err
+	}
+
+	skills, err := s.skillRepo.FindByIds(req.SkillIDs)
+	if err != nil {
+		logs.Error(err)
+		return errs.NewUnexpectedError()
+	}
+	if len(skills) != len(uniqueIDs(req.SkillIDs)) {
+		return errs.NewBadRequestError("some skills do not exist")
+	}
+
+	experiences := make([]models.Experience, 0, len(req.Experiences))
+	for _, experienceReq := range req.Experiences {
+		experience, err := ConvertToExperienceModel(experienceReq)
+		if err != nil {
+			return err
+		}
+		experiences = append(experiences, experience)
+	}
+
+	profile := models.Profile{
+		Education: strings.TrimSpace(req.Education),
+		Skill:     strings.TrimSpace(req.Skill),
+	}
+
+	if err := s.resumeRepo.ApplyToProfile(userID, &profile, skills, experiences); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errs.NewNotFoundError("profile not found")
+		}
+
+		logs.Error(err)
+		return errs.NewUnexpectedError()
+	}
+
+	reindexTalentOfUser(s.profileRepo, s.OS, userID)
+
+	return nil
+}
+
+func (s resumeService) DeleteResume(ctx context.Context, userID uuid.UUID, resumeID uint) error {
+	userResume, err := s.getUserResume(userID, resumeID)
+	if err != nil {
+		return err
+	}
+
+	// Organizations must keep access to the resume that was sent with an application
+	attached, err := s.resumeRepo.IsAttachedToApplication(resumeID)
+	if err != nil {
+		logs.Error(err)
+		return errs.NewUnexpectedError()
+	}
+	if attached {
+		return errs.NewConflictError("resume is attached to a job application")
+	}
+
+	if err := s.resumeRepo.Delete(resumeID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errs.NewNotFoundError("resume not found")
+		}
+
+		logs.Error(err)
+		return errs.NewUnexpectedError()
+	}
+
+	if err := s.S3Uploader.DeleteObject(ctx, userResume.ObjectKey); err != nil {
+		logs.Warn(fmt.Sprintf("Resume %d was deleted but its file was not: %v", resumeID, err))
+	}
+
+	return nil
+}
+
+func presignResume(ctx context.Context, s3Uploader *infrastructure.S3Uploader, userResume *models.Resume) (*dto.ResumeURLResponse, error) {
+	expiresAt := time.Now().Add(resumeURLExpiry)
+	url, err := s3Uploader.PresignObjectURL(ctx, userResume.ObjectKey, userResume.FileName, resumeURLExpiry)
+	if err != nil {
+		return nil, errs.NewUnexpectedError()
+	}
+
+	return &dto.ResumeURLResponse{
+		URL:       url,
+		ExpiresAt: expiresAt.Format("2006-01-02 15:04:05"),
+	}, nil
+}
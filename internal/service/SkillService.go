@@ -0,0 +1,241 @@
+package service
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/errs"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/dto"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/models"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/repository"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/logs"
+	"github.com/google/uuid"
+	"github.com/opensearch-project/opensearch-go"
+	"gorm.io/gorm"
+)
+
+const maxAutocompleteSkills = 20
+
+type skillService struct {
+	skillRepo   repository.SkillRepository
+	profileRepo models.ProfileRepository
+	OS          *opensearch.Client
+}
+
+func NewSkillService(skillRepo repository.SkillRepository, profileRepo models.ProfileRepository, os *opensearch.Client) SkillService {
+	return skillService{
+		skillRepo:   skillRepo,
+		profileRepo: profileRepo,
+		OS:          os,
+	}
+}
+
+func (s skillService) validateParent(skillID uint, parentID *uint) error {
+	if parentID == nil {
+		return nil
+	}
+
+	if *parentID == skillID {
+		return errs.NewBadRequestError("a skill cannot be its own parent")
+	}
+
+	if _, err := s.skillRepo.GetByID(*parentID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errs.NewNotFoundError("parent skill not found")
+		}
+
+		logs.Error(err)
+		return errs.NewUnexpectedError()
+	}
+
+	return nil
+}
+
+func (s skillService) CreateSkill(req dto.SkillRequest) (*dto.SkillResponse, error) {
+	if err := s.validateParent(0, req.ParentID); err != nil {
+		return nil, err
+	}
+
+	skill := ConvertToSkillModel(req)
+	if skill.Slug == "" {
+		return nil, errs.NewBadRequestError("skill slug cannot be empty")
+	}
+
+	if err := s.skillRepo.Create(&skill); err != nil {
+		if isDuplicateKeyError(err) {
+			return nil, errs.NewConflictError("skill slug already exists")
+		}
+
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	res := dto.BuildSkillResponse(skill)
+	return &res, nil
+}
+
+func (s skillService) ListSkills() (*dto.SkillListResponse, error) {
+	skills, err := s.skillRepo.GetAll()
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.NewNotFoundError("skills not found")
+		}
+
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	return &dto.SkillListResponse{Skills: dto.BuildListDTO(skills, dto.BuildSkillResponse)}, nil
+}
+
+func (s skillService) GetSkillByID(skillID uint) (*dto.SkillResponse, error) {
+	skill, err := s.skillRepo.GetByID(skillID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.NewNotFoundError("skill not found")
+		}
+
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	res := dto.BuildSkillResponse(*skill)
+	return &res, nil
+}
+
+func (s skillService) AutocompleteSkills(query string, limit int) ([]dto.SkillShortResponse, error) {
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return []dto.SkillShortResponse{}, nil
+	}
+
+	if limit < 1 || limit > maxAutocompleteSkills {
+		limit = maxAutocompleteSkills
+	}
+
+	skills, err := s.skillRepo.Autocomplete(query, limit)
+	if err != nil {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	res := dto.BuildListDTO(skills, dto.BuildSkillShortResponse)
+	if res == nil {
+		res = []dto.SkillShortResponse{}
+	}
+
+	return res, nil
+}
+
+func (s skillService) UpdateSkill(skillID uint, req dto.SkillRequest) (*dto.SkillResponse, error) {
+	if err := s.validateParent(skillID, req.ParentID); err != nil {
+		return nil, err
+	}
+
+	skill := ConvertToSkillModel(req)
+	skill.ID = skillID
+
+	updatedSkill, err := s.skillRepo.Update(&skill)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.NewNotFoundError("skill not found")
+		}
+		if isDuplicateKeyError(err) {
+			return nil, errs.NewConflictError("skill slug already exists")
+		}
+
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	res := dto.BuildSkillResponse(*updatedSkill)
+	return &res, nil
+}
+
+func (s skillService) DeleteSkill(skillID uint) error {
+	if err := s.skillRepo.Delete(skillID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errs.NewNotFoundError("skill not found")
+		}
+
+		logs.Error(err)
+		return errs.NewUnexpectedError()
+	}
+
+	return nil
+}
+
+func (s skillService) GetUserSkills(userID uuid.UUID) ([]dto.SkillShortResponse, error) {
+	skills, err := s.skillRepo.GetByUserID(userID)
+	if err != nil {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	res := dto.BuildListDTO(skills, dto.BuildSkillShortResponse)
+	if res == nil {
+		res = []dto.SkillShortResponse{}
+	}
+
+	return res, nil
+}
+
+func (s skillService) UpdateUserSkills(userID uuid.UUID, req dto.UserSkillsRequest) ([]dto.SkillShortResponse, error) {
+	skills, err := s.skillRepo.FindByIds(req.SkillIDs)
+	if err != nil {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	if len(skills) != len(uniqueIDs(req.SkillIDs)) {
+		return nil, errs.NewBadRequestError("some skills do not exist")
+	}
+
+	if err := s.skillRepo.ReplaceUserSkills(userID, skills); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.NewNotFoundError("profile not found")
+		}
+
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	// The talent search filters on the skills of public profiles
+	reindexTalentOfUser(s.profileRepo, s.OS, userID)
+
+	return s.GetUserSkills(userID)
+}
+
+func (s skillService) MatchSkills(userID uuid.UUID, requiredSkills [][]dto.SkillShortResponse) ([]*dto.SkillMatchResponse, error) {
+	skills, err := s.skillRepo.GetByUserID(userID)
+	if err != nil {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	candidateSkills := CandidateSkillSet(skills)
+
+	matches := make([]*dto.SkillMatchResponse, len(requiredSkills))
+	for i, required := range requiredSkills {
+		matches[i] = ComputeSkillMatch(candidateSkills, required)
+	}
+
+	return matches, nil
+}
+
+func uniqueIDs(ids []uint) []uint {
+	seen := make(map[uint]bool, len(ids))
+	var unique []uint
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			unique = append(unique, id)
+		}
+	}
+	return unique
+}
+
+// isDuplicateKeyError also recognizes the raw Postgres error since the connection does not translate errors.
+func isDuplicateKeyError(err error) bool {
+	return errors.Is(err, gorm.ErrDuplicatedKey) || strings.Contains(err.Error(), "duplicate key")
+}
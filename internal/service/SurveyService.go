@@ -0,0 +1,300 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/errs"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/dto"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/models"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/repository"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/logs"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type surveyService struct {
+	surveyRepo models.EventSurveyRepository
+	eventRepo  repository.EventRepository
+	mailRepo   repository.EventMailRepository
+}
+
+func NewSurveyService(surveyRepo models.EventSurveyRepository, eventRepo repository.EventRepository, mailRepo repository.EventMailRepository) SurveyService {
+	return surveyService{
+		surveyRepo: surveyRepo,
+		eventRepo:  eventRepo,
+		mailRepo:   mailRepo,
+	}
+}
+
+func (s surveyService) GetEventSurvey(orgID uint, eventID uint) (*dto.EventSurveyResponse, error) {
+	survey, err := s.orgSurvey(orgID, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	res := dto.BuildEventSurveyResponse(*survey)
+	return &res, nil
+}
+
+func (s surveyService) SaveEventSurvey(orgID uint, eventID uint, req dto.EventSurveyRequest) (*dto.EventSurveyResponse, error) {
+	if err := s.orgEvent(orgID, eventID); err != nil {
+		return nil, err
+	}
+
+	survey, err := ConvertToEventSurvey(eventID, req)
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := s.surveyRepo.GetByEventID(eventID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	switch {
+	case current != nil && req.Questions == nil:
+		survey.ID = current.ID
+		if err := s.surveyRepo.UpdateDetails(&survey); err != nil {
+			logs.Error(err)
+			return nil, errs.NewUnexpectedError()
+		}
+	case len(survey.Questions) == 0:
+		return nil, errs.NewBadRequestError("a survey needs at least one question")
+	default:
+		if current != nil {
+			responses, err := s.surveyRepo.CountResponses(current.ID)
+			if err != nil {
+				logs.Error(err)
+				return nil, errs.NewUnexpectedError()
+			}
+			if responses > 0 {
+				return nil, errs.NewConflictError("the questions cannot change once participants have answered the survey")
+			}
+		}
+
+		if err := s.surveyRepo.Save(&survey); err != nil {
+			logs.Error(err)
+			return nil, errs.NewUnexpectedError()
+		}
+	}
+
+	res := dto.BuildEventSurveyResponse(survey)
+	return &res, nil
+}
+
+func (s surveyService) DeleteEventSurvey(orgID uint, eventID uint) error {
+	if err := s.orgEvent(orgID, eventID); err != nil {
+		return err
+	}
+
+	if err := s.surveyRepo.Delete(eventID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errs.NewNotFoundError("survey not found")
+		}
+
+		logs.Error(err)
+		return errs.NewUnexpectedError()
+	}
+
+	return nil
+}
+
+func (s surveyService) GetSurveyResults(orgID uint, eventID uint) (*dto.SurveyResultsResponse, error) {
+	survey, err := s.orgSurvey(orgID, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	responses, err := s.surveyRepo.ListResponses(survey.ID)
+	if err != nil {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	res := BuildSurveyResults(*survey, responses)
+	return &res, nil
+}
+
+func (s surveyService) GetOrganizationSurveyResults(orgID uint) (*dto.OrganizationRatingResponse, error) {
+	return s.organizationRating(orgID, false)
+}
+
+func (s surveyService) GetMySurvey(userID uuid.UUID, eventID uint) (*dto.ParticipantSurveyResponse, error) {
+	survey, err := s.openSurvey(userID, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	answered := true
+	if _, err := s.surveyRepo.GetResponse(survey.ID, userID); err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			logs.Error(err)
+			return nil, errs.NewUnexpectedError()
+		}
+		answered = false
+	}
+
+	return &dto.ParticipantSurveyResponse{
+		EventSurveyResponse: dto.BuildEventSurveyResponse(*survey),
+		Answered:            answered,
+	}, nil
+}
+
+func (s surveyService) SubmitSurvey(userID uuid.UUID, eventID uint, req dto.SurveySubmitRequest) error {
+	survey, err := s.openSurvey(userID, eventID)
+	if err != nil {
+		return err
+	}
+
+	response, err := ConvertToSurveyResponse(*survey, userID, req)
+	if err != nil {
+		return err
+	}
+
+	if err := s.surveyRepo.CreateResponse(&response); err != nil {
+		if isDuplicateKeyError(err) {
+			return errs.NewConflictError("you have already answered this survey")
+		}
+
+		logs.Error(err)
+		return errs.NewUnexpectedError()
+	}
+
+	return nil
+}
+
+func (s surveyService) GetEventRating(eventID uint) (*dto.EventRatingResponse, error) {
+	survey, err := s.surveyRepo.GetByEventID(eventID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.NewNotFoundError("rating not found")
+		}
+
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+	if !survey.ShowRatingPublicly {
+		return nil, errs.NewNotFoundError("rating not found")
+	}
+
+	rating, err := s.surveyRepo.RatingByEventID(eventID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.NewNotFoundError("rating not found")
+		}
+
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	res := BuildEventRatingResponse(*rating)
+	return &res, nil
+}
+
+func (s surveyService) GetOrganizationRating(orgID uint) (*dto.OrganizationRatingResponse, error) {
+	return s.organizationRating(orgID, true)
+}
+
+func (s surveyService) organizationRating(orgID uint, publicOnly bool) (*dto.OrganizationRatingResponse, error) {
+	ratings, err := s.surveyRepo.RatingsByOrgID(orgID, publicOnly)
+	if err != nil {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	res := BuildOrganizationRatingResponse(orgID, ratings)
+	return &res, nil
+}
+
+func (s surveyService) SendEndedSurveys() error {
+	surveys, err := s.surveyRepo.ClaimEnded(time.Now())
+	if err != nil {
+		return err
+	}
+
+	for _, survey := range surveys {
+		participants, err := s.surveyRepo.ListCheckedIn(survey.EventID)
+		if err != nil {
+			// The survey is open already, participants can still find it on the event page
+			logs.Error(err)
+			continue
+		}
+
+		configs := BuildSurveyInvitationMails(survey, participants)
+		failed := 0
+		for _, config := range configs {
+			if err := s.mailRepo.SendSurveyInvitationMail(config); err != nil {
+				failed++
+				logs.Error(fmt.Sprintf("Failed to send survey invitation email: %v", err))
+			}
+		}
+
+		if failed > 0 {
+			logs.Warn(fmt.Sprintf("Survey invitation reached %d of %d participants", len(configs)-failed, len(configs)))
+		}
+	}
+
+	return nil
+}
+
+// openSurvey is the survey a participant may answer: they were checked in and the event has ended.
+func (s surveyService) openSurvey(userID uuid.UUID, eventID uint) (*models.EventSurvey, error) {
+	survey, err := s.surveyRepo.GetByEventID(eventID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.NewNotFoundError("survey not found")
+		}
+
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	checkedIn, err := s.surveyRepo.IsCheckedIn(userID, eventID)
+	if err != nil {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+	if !checkedIn {
+		return nil, errs.NewForbiddenError("only participants checked in at the event can answer its survey")
+	}
+
+	if survey.SentAt == nil {
+		return nil, errs.NewCannotBeProcessedError("the survey opens once the event has ended")
+	}
+
+	return survey, nil
+}
+
+func (s surveyService) orgSurvey(orgID uint, eventID uint) (*models.EventSurvey, error) {
+	if err := s.orgEvent(orgID, eventID); err != nil {
+		return nil, err
+	}
+
+	survey, err := s.surveyRepo.GetByEventID(eventID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.NewNotFoundError("survey not found")
+		}
+
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	return survey, nil
+}
+
+func (s surveyService) orgEvent(orgID uint, eventID uint) error {
+	if _, err := s.eventRepo.GetByIDwithOrgID(orgID, eventID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errs.NewNotFoundError("event not found")
+		}
+
+		logs.Error(err)
+		return errs.NewUnexpectedError()
+	}
+
+	return nil
+}
@@ -0,0 +1,248 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/errs"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/dto"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/models"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/infrastructure/search"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/infrastructure/sync"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/repository"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/logs"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/utils"
+	"github.com/google/uuid"
+	"github.com/opensearch-project/opensearch-go"
+	"gorm.io/gorm"
+)
+
+type talentService struct {
+	profileRepo models.ProfileRepository
+	orgRepo     repository.OrganizationRepository
+	DB          *gorm.DB
+	OS          *opensearch.Client
+}
+
+func NewTalentService(profileRepo models.ProfileRepository, orgRepo repository.OrganizationRepository, db *gorm.DB, os *opensearch.Client) TalentService {
+	return talentService{
+		profileRepo: profileRepo,
+		orgRepo:     orgRepo,
+		DB:          db,
+		OS:          os,
+	}
+}
+
+// reindexTalent adds a public profile to the talent search or removes a private one.
+// A failure only delays the profile in search results until the next sync, so it is not returned.
+func reindexTalent(client *opensearch.Client, profile models.Profile) {
+	if client == nil {
+		return
+	}
+
+	var err error
+	if profile.IsPublic {
+		err = sync.IndexTalentProfile(client, profile)
+	} else {
+		err = sync.DeleteTalentProfile(client, profile.ID)
+	}
+
+	if err != nil {
+		logs.Warn(fmt.Sprintf("Failed to update talent %d in search index: %v", profile.ID, err))
+	}
+}
+
+// reindexTalentOfUser reloads the profile of the user with its skills and reindexes it when it is public,
+// after a change made outside of the profile itself.
+func reindexTalentOfUser(profileRepo models.ProfileRepository, client *opensearch.Client, userID uuid.UUID) {
+	if client == nil {
+		return
+	}
+
+	profile, err := profileRepo.GetByUserID(userID)
+	if err != nil {
+		logs.Warn(fmt.Sprintf("Failed to load profile of user %s for search index: %v", userID, err))
+		return
+	}
+
+	if profile.IsPublic {
+		reindexTalent(client, *profile)
+	}
+}
+
+func (s talentService) getProfile(userID uuid.UUID) (*models.Profile, error) {
+	profile, err := s.profileRepo.GetByUserID(userID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.NewNotFoundError("profile not found")
+		}
+
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	return profile, nil
+}
+
+func (s talentService) GetVisibility(userID uuid.UUID) (*dto.ProfileVisibilityResponse, error) {
+	profile, err := s.getProfile(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	res := dto.BuildProfileVisibilityResponse(*profile)
+	return &res, nil
+}
+
+func (s talentService) UpdateVisibility(userID uuid.UUID, req dto.ProfileVisibilityRequest) (*dto.ProfileVisibilityResponse, error) {
+	profile, err := s.getProfile(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Slug != "" {
+		slug := utils.Slugify(req.Slug)
+		if slug == "" {
+			return nil, errs.NewBadRequestError("invalid slug")
+		}
+		profile.PublicSlug = &slug
+	} else if profile.PublicSlug == nil && req.IsPublic {
+		slug := defaultPublicSlug(*profile)
+		profile.PublicSlug = &slug
+	}
+
+	profile.IsPublic = req.IsPublic
+	profile.HideEmail = req.HideEmail
+	profile.HidePhone = req.HidePhone
+	profile.HideExperiences = req.HideExperiences
+
+	if err := s.profileRepo.UpdateVisibility(profile); err != nil {
+		if isDuplicateKeyError(err) {
+			return nil, errs.NewConflictError("slug is already taken")
+		}
+
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	reindexTalent(s.OS, *profile)
+
+	res := dto.BuildProfileVisibilityResponse(*profile)
+	return &res, nil
+}
+
+// defaultPublicSlug is made from the name, the user ID suffix keeps it unique
+func defaultPublicSlug(profile models.Profile) string {
+	base := utils.Slugify(strings.TrimSpace(profile.FirstName + " " + profile.LastName))
+	if base == "" {
+		base = "talent"
+	}
+
+	return fmt.Sprintf("%s-%s", base, profile.UserID.String()[:8])
+}
+
+func (s talentService) getPublicProfile(slug string) (*models.Profile, error) {
+	profile, err := s.profileRepo.GetPublicBySlug(slug)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.NewNotFoundError("profile not found")
+		}
+
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	return profile, nil
+}
+
+func (s talentService) GetPublicProfile(slug string) (*dto.PublicProfileResponse, error) {
+	profile, err := s.getPublicProfile(slug)
+	if err != nil {
+		return nil, err
+	}
+
+	res := dto.BuildPublicProfileResponse(*profile)
+	return &res, nil
+}
+
+func (s talentService) ViewProfileAsOrganization(orgID uint, viewerID uuid.UUID, slug string) (*dto.PublicProfileResponse, error) {
+	// Views are shown to the candidate, so only approved organizations record them
+	if err := s.checkApprovedOrganization(orgID); err != nil {
+		return nil, err
+	}
+
+	profile, err := s.getPublicProfile(slug)
+	if err != nil {
+		return nil, err
+	}
+
+	view := models.ProfileView{
+		ProfileID:      profile.ID,
+		OrganizationID: orgID,
+		ViewerID:       viewerID,
+	}
+	if err := s.profileRepo.CreateView(&view); err != nil {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	res := dto.BuildPublicProfileResponse(*profile)
+	return &res, nil
+}
+
+func (s talentService) ListMyProfileViews(userID uuid.UUID) ([]dto.ProfileViewResponse, error) {
+	views, err := s.profileRepo.ListViewsByUserID(userID)
+	if err != nil {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	res := dto.BuildListDTO(views, dto.BuildProfileViewResponse)
+	if res == nil {
+		res = []dto.ProfileViewResponse{}
+	}
+
+	return res, nil
+}
+
+func (s talentService) SearchTalents(orgID uint, query dto.SearchTalentQuery, page int, offset int) (dto.SearchTalentResponse, error) {
+	if err := s.checkApprovedOrganization(orgID); err != nil {
+		return dto.SearchTalentResponse{}, err
+	}
+
+	talents, err := search.SearchTalents(s.OS, query, page, offset)
+	if err != nil {
+		return dto.SearchTalentResponse{}, errs.NewUnexpectedError()
+	}
+
+	return talents, nil
+}
+
+// checkApprovedOrganization keeps the talent pool to organizations that passed review.
+func (s talentService) checkApprovedOrganization(orgID uint) error {
+	org, err := s.orgRepo.GetByOrgID(orgID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errs.NewNotFoundError("organization not found")
+		}
+
+		logs.Error(err)
+		return errs.NewUnexpectedError()
+	}
+
+	if org.Status != string(models.OrganizationStatusApproved) {
+		return errs.NewForbiddenError("only approved organizations can search talents")
+	}
+
+	return nil
+}
+
+func (s talentService) SyncTalents() error {
+	if err := sync.SyncTalentsToOpenSearch(s.DB, s.OS); err != nil {
+		logs.Error(err)
+		return errs.NewUnexpectedError()
+	}
+
+	return nil
+}
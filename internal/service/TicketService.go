@@ -0,0 +1,466 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/errs"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/dto"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/models"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/infrastructure/ticket"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/repository"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/logs"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type ticketService struct {
+	ticketRepo   models.TicketAvailableRepository
+	purchaseRepo models.TicketPurchasedRepository
+	eventRepo    repository.EventRepository
+	userRepo     repository.UserRepository
+	mailRepo     repository.EventMailRepository
+	qrSigner     ticket.QRSigner
+}
+
+func NewTicketService(ticketRepo models.TicketAvailableRepository, purchaseRepo models.TicketPurchasedRepository, eventRepo repository.EventRepository, userRepo repository.UserRepository, mailRepo repository.EventMailRepository, qrSigner ticket.QRSigner) TicketService {
+	return ticketService{
+		ticketRepo:   ticketRepo,
+		purchaseRepo: purchaseRepo,
+		eventRepo:    eventRepo,
+		userRepo:     userRepo,
+		mailRepo:     mailRepo,
+		qrSigner:     qrSigner,
+	}
+}
+
+func (s ticketService) ListEventTickets(eventID uint) ([]dto.TicketAvailableResponse, error) {
+	if _, err := s.eventRepo.GetListedByID(eventID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.NewNotFoundError("event not found")
+		}
+
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	return s.listTickets(eventID)
+}
+
+func (s ticketService) ListTicketTypes(orgID uint, eventID uint) ([]dto.TicketAvailableResponse, error) {
+	if err := s.checkEventOfOrg(orgID, eventID); err != nil {
+		return nil, err
+	}
+
+	return s.listTickets(eventID)
+}
+
+func (s ticketService) CreateTicketType(orgID uint, eventID uint, req dto.TicketAvailableRequest) (*dto.TicketAvailableResponse, error) {
+	if err := s.checkEventOfOrg(orgID, eventID); err != nil {
+		return nil, err
+	}
+
+	ticket := ConvertToTicketAvailable(eventID, req)
+	if err := s.ticketRepo.Create(&ticket); err != nil {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	res := dto.BuildTicketAvailableResponse(ticket)
+	return &res, nil
+}
+
+func (s ticketService) UpdateTicketType(orgID uint, eventID uint, ticketID uint, req dto.TicketAvailableRequest) (*dto.TicketAvailableResponse, error) {
+	if err := s.checkEventOfOrg(orgID, eventID); err != nil {
+		return nil, err
+	}
+
+	ticket := ConvertToTicketAvailable(eventID, req)
+	ticket.ID = ticketID
+	if err := s.ticketRepo.Update(&ticket); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.NewNotFoundError("ticket not found")
+		}
+
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	updatedTicket, err := s.ticketRepo.GetByID(ticketID)
+	if err != nil {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	res := dto.BuildTicketAvailableResponse(*updatedTicket)
+	return &res, nil
+}
+
+// DeleteTicketType only removes ticket types nobody registered for, registrations keep their ticket type.
+func (s ticketService) DeleteTicketType(orgID uint, eventID uint, ticketID uint) error {
+	if err := s.checkEventOfOrg(orgID, eventID); err != nil {
+		return err
+	}
+
+	if _, err := s.ticketRepo.GetByIDAndEventID(ticketID, eventID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errs.NewNotFoundError("ticket not found")
+		}
+
+		logs.Error(err)
+		return errs.NewUnexpectedError()
+	}
+
+	purchases, err := s.ticketRepo.CountPurchases(ticketID)
+	if err != nil {
+		logs.Error(err)
+		return errs.NewUnexpectedError()
+	}
+
+	if purchases > 0 {
+		return errs.NewConflictError("ticket already has registrations, set its quantity to 0 instead")
+	}
+
+	if err := s.ticketRepo.Delete(ticketID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errs.NewNotFoundError("ticket not found")
+		}
+
+		logs.Error(err)
+		return errs.NewUnexpectedError()
+	}
+
+	return nil
+}
+
+func (s ticketService) Register(userID uuid.UUID, eventID uint, ticketID uint, req dto.TicketRegisterRequest) (*dto.TicketPurchasedResponse, error) {
+	event, err := s.eventRepo.GetListedByID(eventID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.NewNotFoundError("event not found")
+		}
+
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	if event.Status != string(models.Published) {
+		return nil, errs.NewBadRequestError("event is not open for registration")
+	}
+
+	if _, err := s.purchaseRepo.GetActiveByUserAndEvent(userID, eventID); err == nil {
+		return nil, errs.NewConflictError("you are already registered for this event")
+	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	user, err := s.userRepo.FindByID(userID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.NewNotFoundError("user not found")
+		}
+
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	ticketType, err := s.ticketRepo.GetByIDAndEventID(ticketID, eventID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.NewNotFoundError("ticket not found")
+		}
+
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	qrcode, err := s.qrSigner.NewPayload(eventID)
+	if err != nil {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	username := req.Username
+	if username == "" {
+		username = user.Name
+	}
+
+	purchase := models.TicketPurchased{
+		UserID:            userID,
+		EventID:           eventID,
+		TicketAvailableID: ticketID,
+		Username:          username,
+		Email:             user.Email,
+		Phone:             req.Phone,
+		Status:            InitialTicketStatus(ticketType.Price),
+		Qrcode:            qrcode,
+	}
+	if purchase.Status == models.TicketStatusConfirmed {
+		purchase.ConfirmationAt = time.Now().Format("2006-01-02 15:04:05")
+	}
+
+	if err := s.purchaseRepo.Register(&purchase, req.PromoCode); err != nil {
+		if errors.Is(err, repository.ErrTicketSoldOut) {
+			return nil, errs.NewConflictError("ticket is sold out")
+		}
+
+		if errors.Is(err, repository.ErrAlreadyRegistered) {
+			return nil, errs.NewConflictError("you are already registered for this event")
+		}
+
+		if promoErr, ok := promoCodeError(err); ok {
+			return nil, promoErr
+		}
+
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.NewNotFoundError("ticket not found")
+		}
+
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	if purchase.Status == models.TicketStatusConfirmed {
+		sendTicketConfirmation(s.mailRepo, *event, purchase)
+	}
+
+	res := dto.BuildTicketPurchasedResponse(purchase)
+	return &res, nil
+}
+
+func (s ticketService) ListMyTickets(userID uuid.UUID) ([]dto.TicketPurchasedResponse, error) {
+	purchases, err := s.purchaseRepo.ListByUserID(userID)
+	if err != nil {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	res := dto.BuildListDTO(purchases, dto.BuildTicketPurchasedResponse)
+	if res == nil {
+		res = []dto.TicketPurchasedResponse{}
+	}
+
+	return res, nil
+}
+
+func (s ticketService) GetMyTicket(userID uuid.UUID, purchaseID uint) (*dto.TicketPurchasedResponse, error) {
+	purchase, err := s.purchaseRepo.GetByIDAndUserID(purchaseID, userID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.NewNotFoundError("ticket not found")
+		}
+
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	res := dto.BuildTicketPurchasedResponse(*purchase)
+	return &res, nil
+}
+
+// CancelMyTicket frees the seat, it is offered to the first person on the waitlist of the ticket type if there is one.
+func (s ticketService) CancelMyTicket(userID uuid.UUID, purchaseID uint) error {
+	if _, err := s.purchaseRepo.GetByIDAndUserID(purchaseID, userID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errs.NewNotFoundError("ticket not found")
+		}
+
+		logs.Error(err)
+		return errs.NewUnexpectedError()
+	}
+
+	offer, err := s.purchaseRepo.Cancel(purchaseID, time.Now().Add(waitlistOfferTTL))
+	if err != nil {
+		if errors.Is(err, repository.ErrTicketAlreadyCancelled) {
+			return errs.NewBadRequestError(err.Error())
+		}
+
+		if errors.Is(err, repository.ErrTicketAlreadyCheckedIn) {
+			return errs.NewBadRequestError("a checked in ticket cannot be cancelled")
+		}
+
+		logs.Error(err)
+		return errs.NewUnexpectedError()
+	}
+
+	if offer != nil {
+		sendWaitlistOffer(s.mailRepo, *offer)
+	}
+
+	return nil
+}
+
+func (s ticketService) ListEventRegistrations(orgID uint, eventID uint) ([]dto.TicketPurchasedResponse, error) {
+	if err := s.checkEventOfOrg(orgID, eventID); err != nil {
+		return nil, err
+	}
+
+	purchases, err := s.purchaseRepo.ListByEventID(eventID)
+	if err != nil {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	res := dto.BuildListDTO(purchases, dto.BuildTicketPurchasedResponse)
+	if res == nil {
+		res = []dto.TicketPurchasedResponse{}
+	}
+
+	return res, nil
+}
+
+// GetMyTicketQRCode renders the QR code of a confirmed ticket, tickets registered before QR codes existed get one on first use.
+func (s ticketService) GetMyTicketQRCode(userID uuid.UUID, purchaseID uint) ([]byte, error) {
+	purchase, err := s.purchaseRepo.GetByIDAndUserID(purchaseID, userID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.NewNotFoundError("ticket not found")
+		}
+
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	if purchase.Status != models.TicketStatusConfirmed {
+		return nil, errs.NewBadRequestError("ticket is not confirmed")
+	}
+
+	if purchase.Qrcode == "" {
+		qrcode, err := s.qrSigner.NewPayload(purchase.EventID)
+		if err != nil {
+			logs.Error(err)
+			return nil, errs.NewUnexpectedError()
+		}
+
+		if err := s.purchaseRepo.UpdateQrcode(purchase.ID, qrcode); err != nil {
+			logs.Error(err)
+			return nil, errs.NewUnexpectedError()
+		}
+		purchase.Qrcode = qrcode
+	}
+
+	png, err := ticket.RenderPNG(purchase.Qrcode)
+	if err != nil {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	return png, nil
+}
+
+func (s ticketService) CheckIn(orgID uint, eventID uint, req dto.TicketCheckInRequest) (*dto.TicketPurchasedResponse, error) {
+	if err := s.checkEventOfOrg(orgID, eventID); err != nil {
+		return nil, err
+	}
+
+	qrEventID, err := s.qrSigner.Verify(req.Code)
+	if err != nil {
+		return nil, errs.NewBadRequestError(err.Error())
+	}
+
+	if qrEventID != eventID {
+		return nil, errs.NewBadRequestError("ticket is for another event")
+	}
+
+	purchase, err := s.purchaseRepo.GetByQrcode(req.Code)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.NewNotFoundError("ticket not found")
+		}
+
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	if purchase.EventID != eventID {
+		return nil, errs.NewBadRequestError("ticket is for another event")
+	}
+
+	if purchase.Status != models.TicketStatusConfirmed {
+		return nil, errs.NewBadRequestError(fmt.Sprintf("ticket is %s", purchase.Status))
+	}
+
+	checkedIn, err := s.purchaseRepo.CheckIn(purchase.ID)
+	if err != nil {
+		if errors.Is(err, repository.ErrTicketAlreadyCheckedIn) {
+			return nil, errs.NewConflictError(fmt.Sprintf("ticket was already checked in at %s", checkedIn.CheckedInAt.Format("2006-01-02 15:04:05")))
+		}
+
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	res := dto.BuildTicketPurchasedResponse(*checkedIn)
+	return &res, nil
+}
+
+func (s ticketService) GetCheckInSummary(orgID uint, eventID uint) (*dto.TicketCheckInSummaryResponse, error) {
+	if err := s.checkEventOfOrg(orgID, eventID); err != nil {
+		return nil, err
+	}
+
+	counts, err := s.purchaseRepo.CountCheckInsByEventID(eventID)
+	if err != nil {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	res := dto.BuildTicketCheckInSummaryResponse(counts)
+	return &res, nil
+}
+
+func (s ticketService) listTickets(eventID uint) ([]dto.TicketAvailableResponse, error) {
+	tickets, err := s.ticketRepo.GetAllByEventID(eventID)
+	if err != nil {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	res := dto.BuildListDTO(tickets, dto.BuildTicketAvailableResponse)
+	if res == nil {
+		res = []dto.TicketAvailableResponse{}
+	}
+
+	return res, nil
+}
+
+func (s ticketService) checkEventOfOrg(orgID uint, eventID uint) error {
+	if _, err := s.eventRepo.GetByIDwithOrgID(orgID, eventID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errs.NewNotFoundError("event not found")
+		}
+
+		logs.Error(err)
+		return errs.NewUnexpectedError()
+	}
+
+	return nil
+}
+
+// sendTicketConfirmation mails the attendee once the ticket is confirmed. The registration is already saved,
+// so a mail failure is only logged.
+func sendTicketConfirmation(mailRepo repository.EventMailRepository, event models.Event, purchase models.TicketPurchased) {
+	location := event.LocationName
+	if location == "" {
+		location = event.LocationType
+	}
+
+	config := repository.TicketMailConfig{
+		ToEmail: purchase.Email,
+		Subject: "Your ticket for " + event.Name,
+		Body: repository.TicketMailBody{
+			AttendeeName:     purchase.Username,
+			EventName:        event.Name,
+			TicketTitle:      purchase.TicketTitle,
+			EventDate:        event.StartDate.Format("2006-01-02") + " " + event.StartTime.Format("15:04"),
+			Location:         location,
+			OrganizationName: event.Organization.Name,
+		},
+	}
+
+	if err := mailRepo.SendTicketConfirmationMail(config); err != nil {
+		logs.Error(fmt.Sprintf("Failed to send ticket confirmation email: %v", err))
+	}
+}
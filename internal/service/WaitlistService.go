@@ -0,0 +1,340 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/errs"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/dto"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/models"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/infrastructure/ticket"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/repository"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/logs"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type waitlistService struct {
+	waitlistRepo models.WaitlistRepository
+	ticketRepo   models.TicketAvailableRepository
+	purchaseRepo models.TicketPurchasedRepository
+	eventRepo    repository.EventRepository
+	mailRepo     repository.EventMailRepository
+	qrSigner     ticket.QRSigner
+}
+
+func NewWaitlistService(waitlistRepo models.WaitlistRepository, ticketRepo models.TicketAvailableRepository, purchaseRepo models.TicketPurchasedRepository, eventRepo repository.EventRepository, mailRepo repository.EventMailRepository, qrSigner ticket.QRSigner) WaitlistService {
+	return waitlistService{
+		waitlistRepo: waitlistRepo,
+		ticketRepo:   ticketRepo,
+		purchaseRepo: purchaseRepo,
+		eventRepo:    eventRepo,
+		mailRepo:     mailRepo,
+		qrSigner:     qrSigner,
+	}
+}
+
+func (s waitlistService) JoinWaitlist(userID uuid.UUID, eventID uint, ticketID uint) (*dto.WaitlistEntryResponse, error) {
+	event, err := s.eventRepo.GetListedByID(eventID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.NewNotFoundError("event not found")
+		}
+
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	if event.Status != string(models.Published) {
+		return nil, errs.NewBadRequestError("event is not open for registration")
+	}
+
+	if _, err := s.purchaseRepo.GetActiveByUserAndEvent(userID, eventID); err == nil {
+		return nil, errs.NewConflictError("you are already registered for this event")
+	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	if _, err := s.waitlistRepo.GetActiveByUserAndTicket(userID, ticketID); err == nil {
+		return nil, errs.NewConflictError("you are already on the waitlist of this ticket")
+	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	entry := models.WaitlistEntry{
+		TicketAvailableID: ticketID,
+		EventID:           eventID,
+		UserID:            userID,
+	}
+
+	if err := s.waitlistRepo.Join(&entry); err != nil {
+		if errors.Is(err, repository.ErrTicketStillAvailable) {
+			return nil, errs.NewBadRequestError("ticket is not sold out, register for it instead")
+		}
+
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.NewNotFoundError("ticket not found")
+		}
+
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	res := dto.BuildWaitlistEntryResponse(entry)
+	return &res, nil
+}
+
+func (s waitlistService) ListMyWaitlists(userID uuid.UUID) ([]dto.WaitlistEntryResponse, error) {
+	entries, err := s.waitlistRepo.ListByUserID(userID)
+	if err != nil {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	res := dto.BuildListDTO(entries, dto.BuildWaitlistEntryResponse)
+	if res == nil {
+		res = []dto.WaitlistEntryResponse{}
+	}
+
+	return res, nil
+}
+
+func (s waitlistService) LeaveWaitlist(userID uuid.UUID, entryID uint) error {
+	if _, err := s.waitlistRepo.GetByIDAndUserID(entryID, userID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errs.NewNotFoundError("waitlist entry not found")
+		}
+
+		logs.Error(err)
+		return errs.NewUnexpectedError()
+	}
+
+	offer, err := s.waitlistRepo.Leave(entryID, time.Now().Add(waitlistOfferTTL))
+	if err != nil {
+		if errors.Is(err, repository.ErrWaitlistEntryClosed) {
+			return errs.NewBadRequestError(err.Error())
+		}
+
+		logs.Error(err)
+		return errs.NewUnexpectedError()
+	}
+
+	if offer != nil {
+		sendWaitlistOffer(s.mailRepo, *offer)
+	}
+
+	return nil
+}
+
+func (s waitlistService) ClaimOffer(userID uuid.UUID, req dto.WaitlistClaimRequest) (*dto.TicketPurchasedResponse, error) {
+	token, err := uuid.Parse(req.Token)
+	if err != nil {
+		return nil, errs.NewBadRequestError("invalid offer token")
+	}
+
+	entry, err := s.waitlistRepo.GetByOfferToken(token)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.NewNotFoundError("offer not found")
+		}
+
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	// Offers are personal, someone else's link behaves as an unknown one
+	if entry.UserID != userID {
+		return nil, errs.NewNotFoundError("offer not found")
+	}
+
+	// Events hidden by moderation take no registration, even from offers sent before
+	if _, err := s.eventRepo.GetListedByID(entry.EventID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.NewNotFoundError("event not found")
+		}
+
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	qrcode, err := s.qrSigner.NewPayload(entry.EventID)
+	if err != nil {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	purchase := models.TicketPurchased{
+		UserID:   userID,
+		Username: entry.User.Name,
+		Email:    entry.User.Email,
+		Status:   InitialTicketStatus(entry.TicketAvailable.Price),
+		Qrcode:   qrcode,
+	}
+	if purchase.Status == models.TicketStatusConfirmed {
+		purchase.ConfirmationAt = time.Now().Format("2006-01-02 15:04:05")
+	}
+
+	if err := s.waitlistRepo.Claim(token, userID, &purchase); err != nil {
+		if errors.Is(err, repository.ErrWaitlistOfferExpired) {
+			return nil, errs.NewBadRequestError("this offer has expired")
+		}
+
+		if errors.Is(err, repository.ErrWaitlistEntryClosed) {
+			return nil, errs.NewConflictError("this offer is no longer available")
+		}
+
+		if errors.Is(err, repository.ErrAlreadyRegistered) {
+			return nil, errs.NewConflictError("you are already registered for this event")
+		}
+
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.NewNotFoundError("offer not found")
+		}
+
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	if purchase.Status == models.TicketStatusConfirmed {
+		sendTicketConfirmation(s.mailRepo, entry.Event, purchase)
+	}
+
+	res := dto.BuildTicketPurchasedResponse(purchase)
+	return &res, nil
+}
+
+func (s waitlistService) ListWaitlist(orgID uint, eventID uint, ticketID uint) ([]dto.WaitlistEntryResponse, error) {
+	if err := checkTicketOfOrgEvent(s.eventRepo, s.ticketRepo, orgID, eventID, ticketID); err != nil {
+		return nil, err
+	}
+
+	return s.listWaitlist(ticketID)
+}
+
+func (s waitlistService) ReorderWaitlist(orgID uint, eventID uint, ticketID uint, req dto.WaitlistReorderRequest) ([]dto.WaitlistEntryResponse, error) {
+	if err := checkTicketOfOrgEvent(s.eventRepo, s.ticketRepo, orgID, eventID, ticketID); err != nil {
+		return nil, err
+	}
+
+	if err := s.waitlistRepo.Reorder(ticketID, req.EntryIDs); err != nil {
+		if errors.Is(err, repository.ErrWaitlistOrderMismatch) {
+			return nil, errs.NewBadRequestError(err.Error())
+		}
+
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	return s.listWaitlist(ticketID)
+}
+
+func (s waitlistService) PromoteEntry(orgID uint, eventID uint, ticketID uint, entryID uint) (*dto.WaitlistEntryResponse, error) {
+	if err := checkTicketOfOrgEvent(s.eventRepo, s.ticketRepo, orgID, eventID, ticketID); err != nil {
+		return nil, err
+	}
+
+	entry, err := s.waitlistRepo.GetByID(entryID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.NewNotFoundError("waitlist entry not found")
+		}
+
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	if entry.TicketAvailableID != ticketID {
+		return nil, errs.NewNotFoundError("waitlist entry not found")
+	}
+
+	offer, err := s.waitlistRepo.Promote(entryID, time.Now().Add(waitlistOfferTTL))
+	if err != nil {
+		if errors.Is(err, repository.ErrWaitlistEntryClosed) {
+			return nil, errs.NewBadRequestError("only waiting entries can be promoted")
+		}
+
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	sendWaitlistOffer(s.mailRepo, *offer)
+
+	res := dto.BuildWaitlistEntryResponse(*offer)
+	return &res, nil
+}
+
+// ExpireOffers is run periodically, the seats of unclaimed offers pass to the next people in line.
+func (s waitlistService) ExpireOffers() error {
+	now := time.Now()
+	offers, err := s.waitlistRepo.ExpireOffers(now, now.Add(waitlistOfferTTL))
+	for _, offer := range offers {
+		sendWaitlistOffer(s.mailRepo, offer)
+	}
+
+	return err
+}
+
+func (s waitlistService) listWaitlist(ticketID uint) ([]dto.WaitlistEntryResponse, error) {
+	entries, err := s.waitlistRepo.ListActiveByTicketID(ticketID)
+	if err != nil {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	res := dto.BuildListDTO(entries, dto.BuildWaitlistEntryResponse)
+	if res == nil {
+		res = []dto.WaitlistEntryResponse{}
+	}
+
+	return res, nil
+}
+
+func checkTicketOfOrgEvent(eventRepo repository.EventRepository, ticketRepo models.TicketAvailableRepository, orgID uint, eventID uint, ticketID uint) error {
+	if _, err := eventRepo.GetByIDwithOrgID(orgID, eventID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errs.NewNotFoundError("event not found")
+		}
+
+		logs.Error(err)
+		return errs.NewUnexpectedError()
+	}
+
+	if _, err := ticketRepo.GetByIDAndEventID(ticketID, eventID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errs.NewNotFoundError("ticket not found")
+		}
+
+		logs.Error(err)
+		return errs.NewUnexpectedError()
+	}
+
+	return nil
+}
+
+// sendWaitlistOffer mails the claim link of an offer, the entry must be loaded with its user, event and ticket.
+// A mail failure is only logged, the offer is still listed in the user's waitlists.
+func sendWaitlistOffer(mailRepo repository.EventMailRepository, entry models.WaitlistEntry) {
+	if entry.OfferToken == nil || entry.OfferExpiresAt == nil {
+		return
+	}
+
+	config := repository.WaitlistOfferMailConfig{
+		ToEmail: entry.User.Email,
+		Subject: "A seat is available for " + entry.Event.Name,
+		Body: repository.WaitlistOfferMailBody{
+			AttendeeName:     entry.User.Name,
+			EventName:        entry.Event.Name,
+			TicketTitle:      entry.TicketAvailable.Title,
+			ExpiresAt:        entry.OfferExpiresAt.Format("2006-01-02 15:04"),
+			Token:            entry.OfferToken.String(),
+			OrganizationName: entry.Event.Organization.Name,
+		},
+	}
+
+	if err := mailRepo.SendWaitlistOfferMail(config); err != nil {
+		logs.Error(fmt.Sprintf("Failed to send waitlist offer email: %v", err))
+	}
+}
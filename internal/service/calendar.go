@@ -0,0 +1,122 @@
+package service
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/dto"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/models"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/infrastructure/calendar"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/utils"
+	"github.com/google/uuid"
+)
+
+type CalendarService interface {
+	EventCalendar(eventID uint) ([]byte, error)
+	OrganizationCalendar(orgID uint) ([]byte, error)
+	// UserCalendar is the personal feed behind a secret token, it needs no session
+	UserCalendar(token string) ([]byte, error)
+	GetMyCalendarFeed(userID uuid.UUID) (*dto.CalendarFeedResponse, error)
+	ResetMyCalendarFeed(userID uuid.UUID) (*dto.CalendarFeedResponse, error)
+	ListSavedEvents(userID uuid.UUID) ([]dto.EventResponses, error)
+	SaveEvent(userID uuid.UUID, eventID uint) error
+	UnsaveEvent(userID uuid.UUID, eventID uint) error
+}
+
+// EventTimes places the stored dates and wall clock times of an event in its zone, Asia/Bangkok when unknown.
+// An event without times is an all day event, its end is the day after the last day.
+func EventTimes(event models.Event) (time.Time, time.Time, bool) {
+	loc, err := utils.LoadTimezone(event.Timezone)
+	if err != nil {
+		loc = calendar.Bangkok
+	}
+
+	return utils.EventInstants(event.StartDate.Time, event.EndDate.Time, event.StartTime.Time, event.EndTime.Time, loc)
+}
+
+// ConvertToCalendarEvent builds the calendar entry of an event, the event must be loaded with its organization.
+func ConvertToCalendarEvent(event models.Event, baseEventURL string) calendar.Event {
+	start, end, allDay := EventTimes(event)
+	eventURL := fmt.Sprintf("%s/events/%d", strings.TrimRight(baseEventURL, "/"), event.ID)
+
+	description := eventURL
+	if event.Organization.Name != "" {
+		description = "Organized by " + event.Organization.Name + "\n\n" + eventURL
+	}
+
+	location := event.LocationName
+	if event.LocationType == string(models.Online) && location == "" {
+		location = "Online"
+	}
+
+	return calendar.Event{
+		UID:         fmt.Sprintf("event-%d@%s", event.ID, calendarHost(baseEventURL)),
+		Summary:     event.Name,
+		Description: description,
+		Location:    location,
+		URL:         eventURL,
+		Start:       start,
+		End:         end,
+		AllDay:      allDay,
+		Latitude:    event.Latitude,
+		Longitude:   event.Longitude,
+		Cancelled:   event.Status == string(models.Deleted),
+		Updated:     event.UpdatedAt,
+	}
+}
+
+// ConvertToCalendarEvents gives an entry per session of an event that has sessions, and a single entry otherwise.
+func ConvertToCalendarEvents(event models.Event, baseEventURL string) []calendar.Event {
+	entry := ConvertToCalendarEvent(event, baseEventURL)
+	if len(event.Sessions) == 0 {
+		return []calendar.Event{entry}
+	}
+
+	loc, err := utils.LoadTimezone(event.Timezone)
+	if err != nil {
+		loc = calendar.Bangkok
+	}
+
+	entries := make([]calendar.Event, 0, len(event.Sessions))
+	for _, session := range event.Sessions {
+		sessionEntry := entry
+		sessionEntry.UID = fmt.Sprintf("event-%d-session-%d@%s", event.ID, session.ID, calendarHost(baseEventURL))
+		if session.Title != "" {
+			sessionEntry.Summary = event.Name + ": " + session.Title
+		}
+		sessionEntry.Start, sessionEntry.End, sessionEntry.AllDay = utils.EventInstants(session.StartDate.Time, session.EndDate.Time,
+			session.StartTime.Time, session.EndTime.Time, loc)
+		if session.LocationName != "" {
+			sessionEntry.Location = session.LocationName
+		}
+		sessionEntry.Latitude = session.Latitude
+		sessionEntry.Longitude = session.Longitude
+		sessionEntry.Cancelled = entry.Cancelled || session.IsCancelled
+		if session.UpdatedAt.After(entry.Updated) {
+			sessionEntry.Updated = session.UpdatedAt
+		}
+		entries = append(entries, sessionEntry)
+	}
+
+	return entries
+}
+
+// BuildCalendarFeedResponse gives the https URL of a feed and the webcal URL that subscribes to it.
+func BuildCalendarFeedResponse(feedURL string) dto.CalendarFeedResponse {
+	webcalURL := feedURL
+	if i := strings.Index(feedURL, "://"); i >= 0 {
+		webcalURL = "webcal" + feedURL[i:]
+	}
+
+	return dto.CalendarFeedResponse{FeedURL: feedURL, WebcalURL: webcalURL}
+}
+
+func calendarHost(baseURL string) string {
+	if parsed, err := url.Parse(baseURL); err == nil && parsed.Host != "" {
+		return parsed.Host
+	}
+
+	return "talent-atmos"
+}
@@ -0,0 +1,281 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"mime/multipart"
+	"strconv"
+	"strings"
+
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/dto"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/models"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/infrastructure/certificate"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/repository"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/utils"
+	"github.com/google/uuid"
+)
+
+type CertificateService interface {
+	GetTemplate(orgID uint, eventID uint) (*dto.CertificateTemplateResponse, error)
+	// SaveTemplate creates or replaces the layout of the certificates of the event, its images stay
+	SaveTemplate(orgID uint, eventID uint, req dto.CertificateTemplateRequest) (*dto.CertificateTemplateResponse, error)
+	UploadTemplateImage(ctx context.Context, orgID uint, eventID uint, image models.CertificateImage, file multipart.File, fileHeader *multipart.FileHeader) (*dto.CertificateTemplateResponse, error)
+	// PreviewCertificate renders the template with a sample participant
+	PreviewCertificate(ctx context.Context, orgID uint, eventID uint) (*CertificateFile, error)
+	// IssueCertificates generates, stores and mails the certificates of the participants checked in who have none yet,
+	// in the background
+	IssueCertificates(ctx context.Context, orgID uint, eventID uint) (*dto.CertificateIssueResponse, error)
+	ListCertificates(orgID uint, eventID uint) ([]dto.CertificateResponse, error)
+	ListMyCertificates(userID uuid.UUID) ([]dto.CertificateResponse, error)
+	VerifyCertificate(code string) (*dto.CertificateVerificationResponse, error)
+}
+
+type CertificateFile struct {
+	FileName string
+	Data     []byte
+}
+
+// CertificateImages are the decoded images of a template, either may be missing.
+type CertificateImages struct {
+	Background *certificate.Image
+	Signature  *certificate.Image
+}
+
+// CertificateValues fill in the placeholders of the fields of a template.
+type CertificateValues struct {
+	Name         string
+	Event        string
+	Date         string
+	Organization string
+	Code         string
+	VerifyURL    string
+}
+
+const (
+	defaultCertificateColor     = "#1d1d39"
+	defaultSignatureWidth       = 20
+	certificateCodeAlphabet     = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789" // Without 0, O, 1 and I, which are read one for another
+	certificateCodeGroups       = 3
+	certificateCodeGroupLength  = 4
+	certificateFooterFontSize   = 8
+	certificateFooterFromBottom = 4 // Percent of the page height
+)
+
+// DefaultCertificateFields is the layout of a template created without fields.
+func DefaultCertificateFields() []models.CertificateField {
+	return []models.CertificateField{
+		{Text: "CERTIFICATE OF PARTICIPATION", X: 50, Y: 24, FontSize: 30, Bold: true},
+		{Text: "This certifies that", X: 50, Y: 36, FontSize: 14},
+		{Text: "{name}", X: 50, Y: 48, FontSize: 34, Bold: true},
+		{Text: "has participated in {event}", X: 50, Y: 57, FontSize: 16},
+		{Text: "organized by {organization} on {date}", X: 50, Y: 63, FontSize: 14},
+	}
+}
+
+func ConvertToCertificateTemplate(eventID uint, req dto.CertificateTemplateRequest) models.CertificateTemplate {
+	template := models.CertificateTemplate{
+		EventID:        eventID,
+		SignatureX:     req.SignatureX,
+		SignatureY:     req.SignatureY,
+		SignatureWidth: req.SignatureWidth,
+	}
+	if template.SignatureWidth == 0 {
+		template.SignatureWidth = defaultSignatureWidth
+	}
+
+	for _, fieldReq := range req.Fields {
+		template.Fields = append(template.Fields, models.CertificateField{
+			Text:     fieldReq.Text,
+			X:        fieldReq.X,
+			Y:        fieldReq.Y,
+			FontSize: fieldReq.FontSize,
+			Color:    fieldReq.Color,
+			Bold:     fieldReq.Bold,
+			Align:    fieldReq.Align,
+		})
+	}
+	if len(template.Fields) == 0 {
+		template.Fields = DefaultCertificateFields()
+	}
+
+	for i := range template.Fields {
+		template.Fields[i].Position = i
+		if template.Fields[i].Color == "" {
+			template.Fields[i].Color = defaultCertificateColor
+		}
+		if template.Fields[i].Align == "" {
+			template.Fields[i].Align = string(certificate.AlignCenter)
+		}
+	}
+
+	return template
+}
+
+// NewCertificateCode draws a random verification code such as "K7QX-2M9P-VH4D".
+func NewCertificateCode() (string, error) {
+	random := make([]byte, certificateCodeGroups*certificateCodeGroupLength)
+	if _, err := rand.Read(random); err != nil {
+		return "", fmt.Errorf("failed to generate certificate code: %w", err)
+	}
+
+	var code strings.Builder
+	for i, b := range random {
+		if i > 0 && i%certificateCodeGroupLength == 0 {
+			code.WriteByte('-')
+		}
+		// The alphabet has 32 letters, so every byte maps to one evenly
+		code.WriteByte(certificateCodeAlphabet[int(b)%len(certificateCodeAlphabet)])
+	}
+
+	return code.String(), nil
+}
+
+// NormalizeCertificateCode accepts a code typed in lower case or with spaces around it.
+func NormalizeCertificateCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+func CertificateVerifyURL(baseURL string, code string) string {
+	return fmt.Sprintf("%s/certificates/%s", strings.TrimRight(baseURL, "/"), code)
+}
+
+// FormatCertificateDate writes the dates of an event as printed on its certificates, "25 January 2025"
+// or "24 - 26 January 2025" for an event over several days.
+func FormatCertificateDate(event models.Event) string {
+	start, end := event.StartDate.Time, event.EndDate.Time
+	switch {
+	case end.IsZero() || !end.After(start):
+		return start.Format("2 January 2006")
+	case start.Year() != end.Year():
+		return start.Format("2 January 2006") + " - " + end.Format("2 January 2006")
+	case start.Month() != end.Month():
+		return start.Format("2 January") + " - " + end.Format("2 January 2006")
+	default:
+		return start.Format("2") + " - " + end.Format("2 January 2006")
+	}
+}
+
+// ConvertToCertificateValues fills in a certificate of the event, the event must be loaded with its organization.
+func ConvertToCertificateValues(event models.Event, name string, code string, baseURL string) CertificateValues {
+	return CertificateValues{
+		Name:         name,
+		Event:        event.Name,
+		Date:         FormatCertificateDate(event),
+		Organization: event.Organization.Name,
+		Code:         code,
+		VerifyURL:    CertificateVerifyURL(baseURL, code),
+	}
+}
+
+// BuildCertificatePage lays out a certificate: the background over the page, the signature, the fields
+// of the template and a footer with the verification code and URL.
+func BuildCertificatePage(template models.CertificateTemplate, images CertificateImages, values CertificateValues) certificate.Page {
+	replacer := strings.NewReplacer(
+		"{name}", values.Name,
+		"{event}", values.Event,
+		"{date}", values.Date,
+		"{organization}", values.Organization,
+		"{code}", values.Code,
+	)
+
+	page := certificate.Page{
+		Title:      values.Event + " - " + values.Name,
+		Background: images.Background,
+	}
+
+	if images.Signature != nil {
+		width := template.SignatureWidth * certificate.PageWidth / 100
+		page.Images = append(page.Images, certificate.Placement{
+			Image: images.Signature,
+			X:     template.SignatureX*certificate.PageWidth/100 - width/2,
+			Y:     certificate.PageHeight - template.SignatureY*certificate.PageHeight/100,
+			Width: width,
+		})
+	}
+
+	for _, field := range template.Fields {
+		page.Texts = append(page.Texts, certificate.Text{
+			Value: replacer.Replace(field.Text),
+			X:     field.X * certificate.PageWidth / 100,
+			Y:     certificate.PageHeight - field.Y*certificate.PageHeight/100,
+			Size:  field.FontSize,
+			Bold:  field.Bold,
+			Color: parseHexColor(field.Color),
+			Align: certificate.Align(field.Align),
+		})
+	}
+
+	page.Texts = append(page.Texts, certificate.Text{
+		Value: fmt.Sprintf("Certificate %s - verify at %s", values.Code, values.VerifyURL),
+		X:     certificate.PageWidth / 2,
+		Y:     certificateFooterFromBottom * certificate.PageHeight / 100,
+		Size:  certificateFooterFontSize,
+		Color: [3]float64{0.4, 0.4, 0.4},
+		Align: certificate.AlignCenter,
+	})
+
+	return page
+}
+
+// parseHexColor reads a "#RRGGBB" color, the default color when it is not one.
+func parseHexColor(hex string) [3]float64 {
+	if len(hex) != 7 || hex[0] != '#' {
+		hex = defaultCertificateColor
+	}
+
+	var rgb [3]float64
+	for i := range rgb {
+		v, err := strconv.ParseUint(hex[1+i*2:3+i*2], 16, 8)
+		if err != nil {
+			return parseHexColor(defaultCertificateColor)
+		}
+		rgb[i] = float64(v) / 255
+	}
+
+	return rgb
+}
+
+func BuildCertificateResponse(cert models.Certificate, baseURL string) dto.CertificateResponse {
+	return dto.CertificateResponse{
+		ID:               cert.ID,
+		EventID:          cert.EventID,
+		EventName:        cert.Event.Name,
+		OrganizationName: cert.Event.Organization.Name,
+		UserID:           cert.UserID.String(),
+		RecipientName:    cert.RecipientName,
+		Code:             cert.Code,
+		VerifyUrl:        CertificateVerifyURL(baseURL, cert.Code),
+		FileUrl:          cert.FileUrl,
+		IssuedAt:         utils.FormatUTC(&cert.IssuedAt),
+		EmailedAt:        utils.FormatUTC(cert.EmailedAt),
+	}
+}
+
+func BuildCertificateVerificationResponse(cert models.Certificate) dto.CertificateVerificationResponse {
+	return dto.CertificateVerificationResponse{
+		Code:             cert.Code,
+		RecipientName:    cert.RecipientName,
+		EventID:          cert.EventID,
+		EventName:        cert.Event.Name,
+		EventDate:        FormatCertificateDate(cert.Event),
+		OrganizationName: cert.Event.Organization.Name,
+		FileUrl:          cert.FileUrl,
+		IssuedAt:         utils.FormatUTC(&cert.IssuedAt),
+	}
+}
+
+func ConvertToCertificateMail(cert models.Certificate, event models.Event, email string, pdf []byte, baseURL string) repository.CertificateMailConfig {
+	return repository.CertificateMailConfig{
+		ToEmail: email,
+		Subject: "Your certificate for " + event.Name,
+		Body: repository.CertificateMailBody{
+			AttendeeName:     cert.RecipientName,
+			EventName:        event.Name,
+			Code:             cert.Code,
+			VerifyURL:        CertificateVerifyURL(baseURL, cert.Code),
+			OrganizationName: event.Organization.Name,
+		},
+		Certificate: pdf,
+	}
+}
@@ -0,0 +1,59 @@
+package service
+
+import (
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/errs"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/dto"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/models"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/repository"
+	"github.com/google/uuid"
+)
+
+type CoHostService interface {
+	// InviteCoHost invites another organization to host the event, only its organizer invites
+	InviteCoHost(userID uuid.UUID, orgID uint, eventID uint, req dto.CoHostInviteRequest) (*dto.EventCoHostResponse, error)
+	ListCoHosts(orgID uint, eventID uint) ([]dto.EventCoHostResponse, error)
+	// RemoveCoHost lets the organizer remove a co-host or withdraw an invitation, and a co-host leave the event
+	RemoveCoHost(orgID uint, eventID uint, coHostOrgID uint) error
+	// ListInvitations lists the invitations the organization has not answered yet
+	ListInvitations(orgID uint) ([]dto.CoHostInvitationResponse, error)
+	AcceptInvitation(userID uuid.UUID, orgID uint, invitationID uint) (*dto.EventCoHostResponse, error)
+	DeclineInvitation(orgID uint, invitationID uint) error
+}
+
+// CheckCoHostInvite refuses to invite the organizer of the event to co-host it, or an organization that is not its own.
+func CheckCoHostInvite(event models.Event, orgID uint, invitedOrgID uint) error {
+	if event.OrganizationID != orgID {
+		return errs.NewForbiddenError("only the organizer of the event can invite co-hosts")
+	}
+	if invitedOrgID == event.OrganizationID {
+		return errs.NewBadRequestError("the organizer of the event cannot co-host it")
+	}
+
+	return nil
+}
+
+// CheckCoHostRemoval lets the organizer remove any co-host, and a co-host only remove itself.
+func CheckCoHostRemoval(event models.Event, orgID uint, coHostOrgID uint) error {
+	if event.OrganizationID != orgID && coHostOrgID != orgID {
+		return errs.NewForbiddenError("only the organizer of the event can remove other co-hosts")
+	}
+	if coHostOrgID == event.OrganizationID {
+		return errs.NewBadRequestError("the organizer of the event cannot be removed")
+	}
+
+	return nil
+}
+
+func ConvertToCoHostInvitationMail(event models.Event, invited models.Organization) repository.CoHostInvitationMailConfig {
+	return repository.CoHostInvitationMailConfig{
+		ToEmail: invited.Email,
+		Subject: event.Organization.Name + " invites you to co-host " + event.Name,
+		Body: repository.CoHostInvitationMailBody{
+			OrganizationName: invited.Name,
+			OrganizerName:    event.Organization.Name,
+			EventName:        event.Name,
+			EventID:          event.ID,
+			OrganizationID:   invited.ID,
+		},
+	}
+}
@@ -0,0 +1,102 @@
+package service
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/errs"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/dto"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/models"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/repository"
+	"github.com/google/uuid"
+)
+
+type DiscussionService interface {
+	// ListQuestions lists the visible questions about the event or the job with their visible answers
+	ListQuestions(targetType models.DiscussionTarget, targetID uint) ([]dto.DiscussionQuestionResponse, error)
+	ListFAQ(targetType models.DiscussionTarget, targetID uint) ([]dto.DiscussionFAQResponse, error)
+	AskQuestion(userID uuid.UUID, targetType models.DiscussionTarget, targetID uint, req dto.DiscussionPostRequest) (*dto.DiscussionQuestionResponse, error)
+	// Reply answers a question as any user, it is refused once the question is locked
+	Reply(userID uuid.UUID, targetType models.DiscussionTarget, targetID uint, questionID uint, req dto.DiscussionPostRequest) (*dto.DiscussionAnswerResponse, error)
+
+	// ListOrgQuestions lists all the questions about an event or a job of the organization, hidden ones included
+	ListOrgQuestions(orgID uint, targetType models.DiscussionTarget, targetID uint) ([]dto.DiscussionQuestionResponse, error)
+	// AnswerQuestion answers a question on behalf of the organization, the answer is official
+	AnswerQuestion(userID uuid.UUID, orgID uint, targetType models.DiscussionTarget, targetID uint, questionID uint, req dto.DiscussionPostRequest) (*dto.DiscussionAnswerResponse, error)
+	ModerateQuestion(orgID uint, targetType models.DiscussionTarget, targetID uint, questionID uint, req dto.DiscussionQuestionModerationRequest) (*dto.DiscussionQuestionResponse, error)
+	DeleteQuestion(orgID uint, targetType models.DiscussionTarget, targetID uint, questionID uint) error
+	ModerateAnswer(orgID uint, targetType models.DiscussionTarget, targetID uint, questionID uint, answerID uint, req dto.DiscussionAnswerModerationRequest) (*dto.DiscussionAnswerResponse, error)
+	DeleteAnswer(orgID uint, targetType models.DiscussionTarget, targetID uint, questionID uint, answerID uint) error
+}
+
+// DiscussionPath is the page of the event or the job the discussion is about.
+func DiscussionPath(targetType models.DiscussionTarget, targetID uint) string {
+	if targetType == models.DiscussionJob {
+		return fmt.Sprintf("/jobs/%d", targetID)
+	}
+
+	return fmt.Sprintf("/events/%d", targetID)
+}
+
+// CheckDiscussionReply refuses replies from users to hidden or locked questions, the organization still answers locked ones.
+func CheckDiscussionReply(question models.DiscussionQuestion, official bool) error {
+	if question.Hidden && !official {
+		return errs.NewNotFoundError("question not found")
+	}
+	if question.Locked && !official {
+		return errs.NewForbiddenError("the question is locked")
+	}
+
+	return nil
+}
+
+// ApplyQuestionModeration changes what the request gives and leaves the rest of the question as it is.
+func ApplyQuestionModeration(question *models.DiscussionQuestion, req dto.DiscussionQuestionModerationRequest) {
+	if req.Hidden != nil {
+		question.Hidden = *req.Hidden
+	}
+	if req.Locked != nil {
+		question.Locked = *req.Locked
+	}
+}
+
+// ApplyAnswerModeration changes what the request gives, an answer pinned again keeps its place in the FAQ.
+func ApplyAnswerModeration(answer *models.DiscussionAnswer, req dto.DiscussionAnswerModerationRequest, now time.Time) {
+	if req.Hidden != nil {
+		answer.Hidden = *req.Hidden
+	}
+	if req.Pinned != nil {
+		if !*req.Pinned {
+			answer.PinnedAt = nil
+		} else if answer.PinnedAt == nil {
+			answer.PinnedAt = &now
+		}
+	}
+}
+
+// ShouldNotifyDiscussionReply tells whether the author of the question hears about the answer,
+// authors are not told about their own replies.
+func ShouldNotifyDiscussionReply(question models.DiscussionQuestion, answer models.DiscussionAnswer, preference models.NotificationPreference) bool {
+	return question.UserID != answer.UserID && preference.DiscussionReplies
+}
+
+func ConvertToDiscussionReplyMail(question models.DiscussionQuestion, answer models.DiscussionAnswer, title string, organizationName string) repository.DiscussionReplyMailConfig {
+	subject := "New reply to your question about " + title
+	if answer.Official {
+		subject = organizationName + " answered your question about " + title
+	}
+
+	return repository.DiscussionReplyMailConfig{
+		ToEmail: question.User.Email,
+		Subject: subject,
+		Body: repository.DiscussionReplyMailBody{
+			AuthorName:       question.User.Name,
+			Title:            title,
+			Question:         question.Body,
+			Answer:           answer.Body,
+			Official:         answer.Official,
+			Path:             DiscussionPath(question.TargetType, question.TargetID),
+			OrganizationName: organizationName,
+		},
+	}
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/dto"
 	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/models"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/pkg/richtext"
 	"github.com/DAF-Bridge/Talent-Atmos-Backend/utils"
 )
 
@@ -15,8 +16,12 @@ type EventService interface {
 	SearchEvents(query dto.SearchQuery, page int, Offset int) (dto.SearchEventResponse, error)
 	GetAllEvents() ([]dto.EventResponses, error)
 	GetAllEventsByOrgID(orgID uint) ([]dto.EventResponses, error)
+	// GetListedEventsByOrgID lists the events of the organization page, without those hidden by moderation
+	GetListedEventsByOrgID(orgID uint) ([]dto.EventResponses, error)
+	// GetEventByID finds a public event, events hidden by moderation and those of suspended organizations are not found
 	GetEventByID(eventID uint) (*dto.EventResponses, error)
 	GetEventByIDwithOrgID(orgID uint, eventID uint) (*dto.EventResponses, error)
+	GetListedEventByIDwithOrgID(orgID uint, eventID uint) (*dto.EventResponses, error)
 	ListAllCategories() (*dto.CategoryListResponse, error)
 	GetEventPaginate(page uint) ([]dto.EventDocumentDTOResponse, error)
 	GetFirst() (*dto.EventResponses, error)
@@ -27,6 +32,8 @@ type EventService interface {
 }
 
 func requestConvertToEvent(orgID uint, reqEvent dto.NewEventRequest, categories []models.Category, contacts []models.ContactChannel) models.Event {
+	contentFormat := richtext.ParseFormat(reqEvent.ContentFormat)
+
 	return models.Event{
 		OrganizationID:  orgID,
 		Name:            reqEvent.Name,
@@ -34,7 +41,10 @@ func requestConvertToEvent(orgID uint, reqEvent dto.NewEventRequest, categories
 		EndDate:         utils.DateOnly{Time: utils.DateParser(reqEvent.EndDate)},
 		StartTime:       utils.TimeOnly{Time: utils.TimeParser(reqEvent.StartTime)},
 		EndTime:         utils.TimeOnly{Time: utils.TimeParser(reqEvent.EndTime)},
+		Timezone:        reqEvent.Timezone,
 		Content:         reqEvent.Content,
+		ContentFormat:   contentFormat,
+		ContentHTML:     richtext.Render(reqEvent.Content, contentFormat),
 		Latitude:        reqEvent.Latitude,
 		Longitude:       reqEvent.Longitude,
 		LocationName:    reqEvent.LocationName,
@@ -47,18 +57,44 @@ func requestConvertToEvent(orgID uint, reqEvent dto.NewEventRequest, categories
 		Status:          reqEvent.Status,
 		Categories:      categories,
 		ContactChannels: contacts,
+		Translations:    requestConvertToEventTranslations(reqEvent.Translations, contentFormat),
 	}
 }
 
-func ConvertToEventResponse(event models.Event) dto.EventResponses {
-	var categories []dto.CategoryResponses
-	for _, category := range event.Categories {
-		categories = append(categories, dto.CategoryResponses{
-			Value: category.ID,
-			Label: category.Name,
+func requestConvertToEventTranslations(reqTranslations []dto.EventTranslationRequest, contentFormat richtext.Format) []models.EventTranslation {
+	var translations []models.EventTranslation
+	for _, t := range reqTranslations {
+		translations = append(translations, models.EventTranslation{
+			Locale:      t.Locale,
+			Name:        t.Name,
+			Content:     t.Content,
+			ContentHTML: richtext.Render(t.Content, contentFormat),
 		})
 	}
 
+	return translations
+}
+
+// ScheduleEvent checks the zone of the event and derives the UTC instants of its local dates and times.
+func ScheduleEvent(event *models.Event) error {
+	if event.Timezone == "" {
+		event.Timezone = utils.DefaultTimezone
+	}
+
+	loc, err := utils.LoadTimezone(event.Timezone)
+	if err != nil {
+		return err
+	}
+
+	start, end, _ := utils.EventInstants(event.StartDate.Time, event.EndDate.Time, event.StartTime.Time, event.EndTime.Time, loc)
+	startAt, endAt := start.UTC(), end.UTC()
+	event.StartAt = &startAt
+	event.EndAt = &endAt
+
+	return nil
+}
+
+func ConvertToEventResponse(event models.Event) dto.EventResponses {
 	var contacts []dto.EventContactChannelsResponses
 	for _, contact := range event.ContactChannels {
 		contacts = append(contacts, dto.EventContactChannelsResponses{
@@ -74,6 +110,26 @@ func ConvertToEventResponse(event models.Event) dto.EventResponses {
 		endDate = event.EndDate.Format("2006-01-02")
 	}
 
+	sessions := make([]dto.EventSessionResponse, 0, len(event.Sessions))
+	for _, session := range event.Sessions {
+		sessions = append(sessions, dto.BuildEventSessionResponse(session))
+	}
+
+	agenda := make([]dto.AgendaItemResponse, 0, len(event.AgendaItems))
+	for _, item := range event.AgendaItems {
+		agenda = append(agenda, dto.BuildAgendaItemResponse(item))
+	}
+
+	speakers := make([]dto.EventSpeakerResponse, 0, len(event.Speakers))
+	for _, speaker := range event.Speakers {
+		speakers = append(speakers, dto.BuildEventSpeakerResponse(speaker))
+	}
+
+	materials := make([]dto.EventMaterialResponse, 0, len(event.Materials))
+	for _, material := range event.Materials {
+		materials = append(materials, dto.BuildEventMaterialResponse(material))
+	}
+
 	return dto.EventResponses{
 		ID:              int(event.ID),
 		OrganizationID:  int(event.OrganizationID),
@@ -83,7 +139,12 @@ func ConvertToEventResponse(event models.Event) dto.EventResponses {
 		EndDate:         endDate,
 		StartTime:       event.StartTime.Format("15:04:05"),
 		EndTime:         event.EndTime.Format("15:04:05"),
+		Timezone:        event.Timezone,
+		StartAt:         utils.FormatUTC(event.StartAt),
+		EndAt:           utils.FormatUTC(event.EndAt),
 		Content:         event.Content,
+		ContentFormat:   string(richtext.ParseFormat(string(event.ContentFormat))),
+		ContentHTML:     richtext.Rendered(event.Content, event.ContentHTML, event.ContentFormat),
 		Latitude:        event.Latitude,
 		Longitude:       event.Longitude,
 		LocationName:    event.LocationName,
@@ -94,20 +155,27 @@ func ConvertToEventResponse(event models.Event) dto.EventResponses {
 		PriceType:       event.PriceType,
 		RegisterLink:    event.RegisterLink,
 		Status:          event.Status,
-		Categories:      categories,
+		Categories:      dto.BuildListCategoryResponses(event.Categories),
 		ContactChannels: contacts,
+		RecurrenceRule:  event.RecurrenceRule,
+		Sessions:        sessions,
+		Agenda:          agenda,
+		Speakers:        speakers,
+		Materials:       materials,
 		Organization:    org,
+		CoHosts:         dto.BuildEventHostResponses(event.CoHosts),
+		Translations:    dto.BuildEventTranslationResponses(event),
 		UpdateAt:        event.UpdatedAt.Format("2006-01-02 15:04:05"),
 	}
 }
 
 func ConvertToEventDocumentResponse(event models.Event) dto.EventDocumentDTOResponse {
-	var Categories []dto.CategoryResponses
-	for _, category := range event.Categories {
-		Categories = append(Categories, dto.CategoryResponses{
-			Value: category.ID,
-			Label: category.Name,
-		})
+	translations := make(map[string]dto.EventTranslationDocument, len(event.Translations))
+	for _, t := range event.Translations {
+		translations[t.Locale] = dto.EventTranslationDocument{
+			Name:    t.Name,
+			Content: richtext.PlainText(richtext.Rendered(t.Content, t.ContentHTML, event.ContentFormat)),
+		}
 	}
 
 	Organization := dto.OrganizationShortDocument{
@@ -124,6 +192,9 @@ func ConvertToEventDocumentResponse(event models.Event) dto.EventDocumentDTOResp
 		EndDate:      event.EndDate.Format("2006-01-02"),
 		StartTime:    event.StartTime.Format("15:04:05"),
 		EndTime:      event.EndTime.Format("15:04:05"),
+		Timezone:     event.Timezone,
+		StartAt:      utils.FormatUTC(event.StartAt),
+		EndAt:        utils.FormatUTC(event.EndAt),
 		Latitude:     event.Latitude,
 		Longitude:    event.Longitude,
 		LocationName: event.LocationName,
@@ -132,8 +203,10 @@ func ConvertToEventDocumentResponse(event models.Event) dto.EventDocumentDTOResp
 		LocationType: event.LocationType,
 		Audience:     event.Audience,
 		Price:        event.PriceType,
-		Categories:   Categories,
+		Categories:   dto.BuildListCategoryResponses(event.Categories),
 		Organization: Organization,
+		CoHosts:      dto.BuildOrganizationShortDocuments(event.CoHosts),
+		Translations: translations,
 		UpdateAt:     event.UpdatedAt.Format("2006-01-02 15:04:05"),
 	}
 
@@ -3,10 +3,12 @@ package service
 import (
 	"context"
 	"mime/multipart"
+	"strings"
 	"time"
 
 	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/dto"
 	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/models"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/pkg/richtext"
 	"github.com/google/uuid"
 	"gorm.io/gorm"
 )
@@ -38,8 +40,12 @@ type OrgOpenJobService interface {
 	NewJob(orgID uint, dto dto.JobRequest) error
 	ListAllJobs() ([]dto.JobResponses, error)
 	GetAllJobsByOrgID(OrgId uint) ([]dto.JobResponses, error)
+	// GetListedJobsByOrgID and GetListedJobByIDwithOrgID serve the organization page,
+	// jobs hidden by moderation and those of suspended organizations are left out
+	GetListedJobsByOrgID(orgID uint) ([]dto.JobResponses, error)
 	GetJobByID(jobID uint) (*dto.JobResponses, error)
 	GetJobByIDwithOrgID(orgID uint, jobID uint) (*dto.JobResponses, error)
+	GetListedJobByIDwithOrgID(orgID uint, jobID uint) (*dto.JobResponses, error)
 	GetJobPaginate(page uint) ([]dto.JobDocumentDTOResponse, error)
 	UpdateJob(orgID uint, jobID uint, dto dto.JobRequest) (*dto.JobResponses, error)
 	UpdateJobPicture(orgID uint, jobID uint, picURL string) error
@@ -54,14 +60,6 @@ type OrgOpenJobService interface {
 }
 
 func ConvertToOrgResponse(org models.Organization) dto.OrganizationResponse {
-	var industries []dto.IndustryResponses
-	for _, industry := range org.Industries {
-		industries = append(industries, dto.IndustryResponses{
-			ID:   industry.ID,
-			Name: industry.Industry,
-		})
-	}
-
 	var contacts []dto.OrganizationContactResponses
 	for _, contact := range org.OrganizationContacts {
 		contacts = append(contacts, dto.OrganizationContactResponses{
@@ -80,23 +78,30 @@ func ConvertToOrgResponse(org models.Organization) dto.OrganizationResponse {
 		HeadLine:            org.HeadLine,
 		Specialty:           org.Specialty,
 		Description:         org.Description,
+		DescriptionFormat:   string(richtext.ParseFormat(string(org.DescriptionFormat))),
+		DescriptionHTML:     richtext.Rendered(org.Description, org.DescriptionHTML, org.DescriptionFormat),
 		Address:             org.Address,
 		Province:            org.Province,
 		Country:             org.Country,
 		Latitude:            org.Latitude,
 		Longitude:           org.Longitude,
 		OrganizationContact: contacts,
-		Industries:          industries,
+		Industries:          dto.BuildListIndustryResponses(org.Industries),
+		Translations:        dto.BuildOrganizationTranslationResponses(org),
 		UpdatedAt:           org.UpdatedAt.Format("2006-01-02 15:04:05"),
 	}
 }
 
 func ConvertToOrgRequest(org dto.OrganizationRequest, contacts []models.OrganizationContact, industries []*models.Industry) models.Organization {
+	descriptionFormat := richtext.ParseFormat(org.DescriptionFormat)
+
 	return models.Organization{
 		Name:                 org.Name,
 		HeadLine:             org.HeadLine,
 		Specialty:            org.Specialty,
 		Description:          org.Description,
+		DescriptionFormat:    descriptionFormat,
+		DescriptionHTML:      richtext.Render(org.Description, descriptionFormat),
 		Address:              org.Address,
 		Province:             org.Province,
 		Country:              org.Country,
@@ -106,10 +111,25 @@ func ConvertToOrgRequest(org dto.OrganizationRequest, contacts []models.Organiza
 		Phone:                org.Phone,
 		OrganizationContacts: contacts,
 		Industries:           industries,
+		Translations:         convertToOrgTranslations(org.Translations, descriptionFormat),
 		Model:                gorm.Model{UpdatedAt: time.Now()},
 	}
 }
 
+func convertToOrgTranslations(reqTranslations []dto.OrganizationTranslationRequest, descriptionFormat richtext.Format) []models.OrganizationTranslation {
+	var translations []models.OrganizationTranslation
+	for _, t := range reqTranslations {
+		translations = append(translations, models.OrganizationTranslation{
+			Locale:          t.Locale,
+			Name:            t.Name,
+			Description:     t.Description,
+			DescriptionHTML: richtext.Render(t.Description, descriptionFormat),
+		})
+	}
+
+	return translations
+}
+
 func convertToOrgContactResponse(contact models.OrganizationContact) dto.OrganizationContactResponses {
 	return dto.OrganizationContactResponses{
 		Media:     string(contact.Media),
@@ -126,14 +146,6 @@ func ConvertToOrgContactRequest(orgID uint, contact dto.OrganizationContactReque
 }
 
 func ConvertToJobResponse(job models.OrgOpenJob) dto.JobResponses {
-	var categories []dto.CategoryResponses
-	for _, category := range job.Categories {
-		categories = append(categories, dto.CategoryResponses{
-			Value: category.ID,
-			Label: category.Name,
-		})
-	}
-
 	var prerequisites []dto.PrerequisiteResponses
 	for _, p := range job.Prerequisites {
 		prerequisites = append(prerequisites, dto.PrerequisiteResponses{
@@ -144,34 +156,52 @@ func ConvertToJobResponse(job models.OrgOpenJob) dto.JobResponses {
 	}
 
 	return dto.JobResponses{
-		ID:             job.ID,
-		JobTitle:       job.Title,
-		PicUrl:         job.PicUrl,
-		Scope:          job.Scope,
-		Prerequisite:   prerequisites,
-		Workplace:      job.Workplace,
-		WorkType:       job.WorkType,
-		CareerStage:    job.CareerStage,
-		Period:         job.Period,
-		Description:    job.Description,
-		Qualifications: job.Qualifications,
-		Quantity:       job.Quantity,
-		Salary:         job.Salary,
-		Province:       job.Province,
-		Country:        job.Country,
-		Status:         job.Status,
-		RegisterLink:   job.RegisterLink,
-		Categories:     categories,
+		ID:                 job.ID,
+		JobTitle:           job.Title,
+		PicUrl:             job.PicUrl,
+		Scope:              job.Scope,
+		Prerequisite:       prerequisites,
+		Workplace:          job.Workplace,
+		WorkType:           job.WorkType,
+		CareerStage:        job.CareerStage,
+		Period:             job.Period,
+		Description:        job.Description,
+		DescriptionHTML:    richtext.Rendered(job.Description, job.DescriptionHTML, job.TextFormat),
+		Qualifications:     job.Qualifications,
+		QualificationsHTML: richtext.Rendered(job.Qualifications, job.QualificationsHTML, job.TextFormat),
+		TextFormat:         string(richtext.ParseFormat(string(job.TextFormat))),
+		Quantity:           job.Quantity,
+		SalaryMin:          job.SalaryMin,
+		SalaryMax:          job.SalaryMax,
+		SalaryCurrency:     job.SalaryCurrency,
+		SalaryPeriod:       job.SalaryPeriod,
+		SalaryNegotiable:   job.SalaryNegotiable,
+		SalaryHidden:       job.SalaryHidden,
+		Province:           job.Province,
+		Country:            job.Country,
+		Status:             job.Status,
+		RegisterLink:       job.RegisterLink,
+		Categories:         dto.BuildListCategoryResponses(job.Categories),
+		Skills:             dto.BuildListDTO(job.Skills, dto.BuildSkillShortResponse),
 		Organization: dto.OrganizationShortResponseWithinJob{
 			ID:     job.Organization.ID,
 			Name:   job.Organization.Name,
 			PicUrl: job.Organization.PicUrl,
 		},
-		UpdatedAt: job.UpdatedAt.Format("2006-01-02 15:04:05"),
+		Translations: dto.BuildJobTranslationResponses(job),
+		UpdatedAt:    job.UpdatedAt.Format("2006-01-02 15:04:05"),
 	}
 }
 
-func ConvertToJobRequest(orgID uint, job dto.JobRequest, categories []models.Category) models.OrgOpenJob {
+// maskHiddenSalary removes the salary amounts of jobs whose organization chose not to publish them.
+func maskHiddenSalary(job *dto.JobResponses) {
+	if job.SalaryHidden {
+		job.SalaryMin = 0
+		job.SalaryMax = 0
+	}
+}
+
+func ConvertToJobRequest(orgID uint, job dto.JobRequest, categories []models.Category, skills []models.Skill) models.OrgOpenJob {
 	var prerequisites []models.Prerequisite
 	for _, p := range job.Prerequisite {
 		prerequisites = append(prerequisites, models.Prerequisite{
@@ -180,28 +210,64 @@ func ConvertToJobRequest(orgID uint, job dto.JobRequest, categories []models.Cat
 		})
 	}
 
+	salaryCurrency := strings.ToUpper(job.SalaryCurrency)
+	if salaryCurrency == "" {
+		salaryCurrency = "THB"
+	}
+	salaryPeriod := job.SalaryPeriod
+	if salaryPeriod == "" {
+		salaryPeriod = models.SalaryPeriodMonthly
+	}
+	textFormat := richtext.ParseFormat(job.TextFormat)
+
 	return models.OrgOpenJob{
-		OrganizationID: orgID,
-		Title:          job.JobTitle,
-		Scope:          job.Scope,
-		Prerequisites:  prerequisites,
-		Workplace:      job.Workplace,
-		WorkType:       job.WorkType,
-		CareerStage:    job.CareerStage,
-		Period:         job.Period,
-		Description:    job.Description,
-		Qualifications: job.Qualifications,
-		Quantity:       job.Quantity,
-		Salary:         job.Salary,
-		Province:       job.Province,
-		Country:        job.Country,
-		RegisterLink:   job.RegisterLink,
-		Status:         job.Status,
-		Categories:     categories,
-		Model:          gorm.Model{UpdatedAt: time.Now()},
+		OrganizationID:     orgID,
+		Title:              job.JobTitle,
+		Scope:              job.Scope,
+		Prerequisites:      prerequisites,
+		Workplace:          job.Workplace,
+		WorkType:           job.WorkType,
+		CareerStage:        job.CareerStage,
+		Period:             job.Period,
+		Description:        job.Description,
+		Qualifications:     job.Qualifications,
+		TextFormat:         textFormat,
+		DescriptionHTML:    richtext.Render(job.Description, textFormat),
+		QualificationsHTML: richtext.Render(job.Qualifications, textFormat),
+		Quantity:           job.Quantity,
+		SalaryMin:          job.SalaryMin,
+		SalaryMax:          job.SalaryMax,
+		SalaryCurrency:     salaryCurrency,
+		SalaryPeriod:       salaryPeriod,
+		SalaryNegotiable:   job.SalaryNegotiable,
+		SalaryHidden:       job.SalaryHidden,
+		Province:           job.Province,
+		Country:            job.Country,
+		RegisterLink:       job.RegisterLink,
+		Status:             job.Status,
+		Categories:         categories,
+		Skills:             skills,
+		Translations:       convertToJobTranslations(job.Translations, textFormat),
+		Model:              gorm.Model{UpdatedAt: time.Now()},
 	}
 }
 
+func convertToJobTranslations(reqTranslations []dto.JobTranslationRequest, textFormat richtext.Format) []models.OrgOpenJobTranslation {
+	var translations []models.OrgOpenJobTranslation
+	for _, t := range reqTranslations {
+		translations = append(translations, models.OrgOpenJobTranslation{
+			Locale:             t.Locale,
+			Title:              t.JobTitle,
+			Description:        t.Description,
+			DescriptionHTML:    richtext.Render(t.Description, textFormat),
+			Qualifications:     t.Qualifications,
+			QualificationsHTML: richtext.Render(t.Qualifications, textFormat),
+		})
+	}
+
+	return translations
+}
+
 func ConvertToPrerequisiteRequest(jobID uint, prerequisite dto.PrerequisiteRequest) models.Prerequisite {
 	return models.Prerequisite{
 		JobID: jobID,
@@ -220,12 +286,12 @@ func ConvertToPrerequisiteResponse(prerequisite models.Prerequisite) dto.Prerequ
 }
 
 func ConvertToJobDocumentDTOResponse(job models.OrgOpenJob) dto.JobDocumentDTOResponse {
-	var Categories []dto.CategoryResponses
-	for _, category := range job.Categories {
-		Categories = append(Categories, dto.CategoryResponses{
-			Value: category.ID,
-			Label: category.Name,
-		})
+	translations := make(map[string]dto.JobTranslationDocument, len(job.Translations))
+	for _, t := range job.Translations {
+		translations[t.Locale] = dto.JobTranslationDocument{
+			Title:       t.Title,
+			Description: richtext.PlainText(richtext.Rendered(t.Description, t.DescriptionHTML, job.TextFormat)),
+		}
 	}
 
 	Organization := dto.OrganizationShortDocument{
@@ -234,18 +300,29 @@ func ConvertToJobDocumentDTOResponse(job models.OrgOpenJob) dto.JobDocumentDTORe
 		PicUrl: job.Organization.PicUrl,
 	}
 
+	if job.SalaryHidden {
+		job.SalaryMin = 0
+		job.SalaryMax = 0
+	}
+
 	return dto.JobDocumentDTOResponse{
-		ID:           job.ID,
-		Title:        job.Title,
-		Description:  job.Description,
-		Workplace:    string(job.Workplace),
-		WorkType:     string(job.WorkType),
-		CareerStage:  string(job.CareerStage),
-		Province:     job.Province,
-		Country:      job.Country,
-		Salary:       job.Salary,
-		Categories:   Categories,
-		Organization: Organization,
-		UpdateAt:     job.UpdatedAt.Format("2006-01-02 15:04:05"),
+		ID:               job.ID,
+		Title:            job.Title,
+		Description:      richtext.PlainText(richtext.Rendered(job.Description, job.DescriptionHTML, job.TextFormat)),
+		Workplace:        string(job.Workplace),
+		WorkType:         string(job.WorkType),
+		CareerStage:      string(job.CareerStage),
+		Province:         job.Province,
+		Country:          job.Country,
+		SalaryMin:        job.SalaryMin,
+		SalaryMax:        job.SalaryMax,
+		SalaryCurrency:   job.SalaryCurrency,
+		SalaryPeriod:     string(job.SalaryPeriod),
+		SalaryNegotiable: job.SalaryNegotiable,
+		SalaryHidden:     job.SalaryHidden,
+		Categories:       dto.BuildListCategoryResponses(job.Categories),
+		Organization:     Organization,
+		Translations:     translations,
+		UpdateAt:         job.UpdatedAt.Format("2006-01-02 15:04:05"),
 	}
 }
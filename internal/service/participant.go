@@ -0,0 +1,139 @@
+package service
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/errs"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/dto"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/models"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/infrastructure/export"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/repository"
+	"github.com/google/uuid"
+)
+
+type ParticipantService interface {
+	ListParticipants(orgID uint, eventID uint, query dto.ParticipantQuery) ([]dto.TicketPurchasedResponse, error)
+	// ExportParticipants writes the filtered registrations in the format of the query and returns its file name
+	ExportParticipants(orgID uint, eventID uint, query dto.ParticipantQuery) (*ParticipantExport, error)
+	// SendAnnouncement mails every registrant of the event in the background, or schedules the announcement
+	SendAnnouncement(orgID uint, eventID uint, req dto.EventAnnouncementRequest) (*dto.EventAnnouncementResponse, error)
+	ListAnnouncements(orgID uint, eventID uint) ([]dto.EventAnnouncementResponse, error)
+	CancelAnnouncement(orgID uint, eventID uint, announcementID uint) error
+	// SendScheduledAnnouncements mails the announcements that are due, it runs on a schedule
+	SendScheduledAnnouncements() error
+	ListPublicParticipants(eventID uint) (*dto.PublicParticipantsResponse, error)
+	UpdateMyVisibility(userID uuid.UUID, eventID uint, req dto.ParticipantVisibilityRequest) error
+}
+
+type ParticipantExport struct {
+	FileName    string
+	ContentType string
+	Data        []byte
+}
+
+var participantExportHeader = []string{
+	"Registration ID", "Name", "Email", "Phone", "Ticket", "Price", "Discount", "Status", "Registered At", "Confirmed At", "Checked In At",
+}
+
+// ConvertToParticipantFilter reads the filters of the query, the check-in state is already validated as true or false.
+func ConvertToParticipantFilter(query dto.ParticipantQuery) models.ParticipantFilter {
+	filter := models.ParticipantFilter{
+		TicketAvailableID: query.TicketID,
+		Status:            models.TicketStatus(query.Status),
+	}
+
+	if query.CheckedIn != "" {
+		checkedIn := query.CheckedIn == "true"
+		filter.CheckedIn = &checkedIn
+	}
+
+	return filter
+}
+
+// ConvertToEventAnnouncement builds the announcement of the request. Without a schedule it is sent right away,
+// a schedule must be ahead of now.
+func ConvertToEventAnnouncement(eventID uint, req dto.EventAnnouncementRequest, now time.Time) (models.EventAnnouncement, error) {
+	announcement := models.EventAnnouncement{
+		EventID:     eventID,
+		Subject:     strings.TrimSpace(req.Subject),
+		Message:     req.Message,
+		ScheduledAt: now,
+		Status:      models.AnnouncementStatusSending,
+	}
+
+	if req.ScheduledAt == "" {
+		return announcement, nil
+	}
+
+	scheduledAt, err := time.Parse(time.RFC3339, req.ScheduledAt)
+	if err != nil {
+		return announcement, errs.NewBadRequestError("scheduledAt must be an RFC 3339 date and time")
+	}
+	if !scheduledAt.After(now) {
+		return announcement, errs.NewBadRequestError("scheduledAt must be in the future")
+	}
+
+	announcement.ScheduledAt = scheduledAt.UTC()
+	announcement.Status = models.AnnouncementStatusScheduled
+	return announcement, nil
+}
+
+// BuildParticipantTable lays the registrations out as the rows of an export, one per ticket.
+func BuildParticipantTable(purchases []models.TicketPurchased) export.Table {
+	table := export.Table{
+		Header: participantExportHeader,
+		Rows:   make([][]string, 0, len(purchases)),
+	}
+
+	for _, purchase := range purchases {
+		checkedInAt := ""
+		if purchase.CheckedInAt != nil {
+			checkedInAt = purchase.CheckedInAt.Format("2006-01-02 15:04:05")
+		}
+
+		table.Rows = append(table.Rows, []string{
+			strconv.FormatUint(uint64(purchase.ID), 10),
+			purchase.Username,
+			purchase.Email,
+			purchase.Phone,
+			purchase.TicketTitle,
+			strconv.FormatFloat(purchase.Price, 'f', 2, 64),
+			strconv.FormatFloat(purchase.DiscountAmount, 'f', 2, 64),
+			string(purchase.Status),
+			purchase.CreatedAt.Format("2006-01-02 15:04:05"),
+			purchase.ConfirmationAt,
+			checkedInAt,
+		})
+	}
+
+	return table
+}
+
+// BuildAnnouncementMails addresses the announcement once to each registrant, a user holding several tickets gets one mail.
+func BuildAnnouncementMails(event models.Event, purchases []models.TicketPurchased, announcement models.EventAnnouncement) []repository.AnnouncementMailConfig {
+	seen := make(map[string]bool, len(purchases))
+	configs := make([]repository.AnnouncementMailConfig, 0, len(purchases))
+	for _, purchase := range purchases {
+		email := strings.ToLower(strings.TrimSpace(purchase.Email))
+		if email == "" || seen[email] {
+			continue
+		}
+		seen[email] = true
+
+		configs = append(configs, repository.AnnouncementMailConfig{
+			ToEmail: purchase.Email,
+			Subject: announcement.Subject,
+			Body: repository.AnnouncementMailBody{
+				AttendeeName:     purchase.Username,
+				EventName:        event.Name,
+				EventID:          event.ID,
+				Message:          announcement.Message,
+				OrganizationName: event.Organization.Name,
+			},
+		})
+	}
+
+	return configs
+}
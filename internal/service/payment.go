@@ -0,0 +1,24 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/dto"
+	"github.com/google/uuid"
+)
+
+// paymentWindow is how long the seat of an unpaid ticket is held before it is released.
+const paymentWindow = 30 * time.Minute
+
+type PaymentService interface {
+	Checkout(ctx context.Context, userID uuid.UUID, purchaseID uint) (*dto.OrderResponse, error)
+	GetMyOrder(userID uuid.UUID, orderID uint) (*dto.OrderResponse, error)
+	GetMyOrderQRCode(userID uuid.UUID, orderID uint) ([]byte, error)
+	HandleWebhook(provider string, body []byte, signature string) error
+	// SimulatePayment pays an order through the fake provider, to run the purchase flow locally
+	SimulatePayment(userID uuid.UUID, orderID uint) (*dto.OrderResponse, error)
+	ListEventOrders(orgID uint, eventID uint) ([]dto.OrderResponse, error)
+	RefundOrder(ctx context.Context, orgID uint, eventID uint, orderID uint) (*dto.OrderResponse, error)
+	ExpirePendingTickets() error
+}
@@ -0,0 +1,104 @@
+package service
+
+import (
+	"context"
+	"mime/multipart"
+	"net/http"
+
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/errs"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/dto"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/models"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/utils"
+)
+
+const maxMaterialSize = 20 << 20 // 20 MB
+
+// materialTypes are the files an event can offer for download, by extension. Office documents
+// are zip archives, their type is given by the extension once the content is known to be a zip.
+var materialTypes = map[string]struct {
+	detected    string
+	contentType string
+}{
+	".pdf":  {"application/pdf", "application/pdf"},
+	".png":  {"image/png", "image/png"},
+	".jpg":  {"image/jpeg", "image/jpeg"},
+	".jpeg": {"image/jpeg", "image/jpeg"},
+	".zip":  {"application/zip", "application/zip"},
+	".docx": {"application/zip", "application/vnd.openxmlformats-officedocument.wordprocessingml.document"},
+	".pptx": {"application/zip", "application/vnd.openxmlformats-officedocument.presentationml.presentation"},
+	".xlsx": {"application/zip", "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"},
+}
+
+type EventProgramService interface {
+	// UpdateAgenda replaces the agenda of the event with the items of the request, in their order
+	UpdateAgenda(orgID uint, eventID uint, req dto.AgendaRequest) ([]dto.AgendaItemResponse, error)
+	ListSpeakers(orgID uint, eventID uint) ([]dto.EventSpeakerResponse, error)
+	CreateSpeaker(orgID uint, eventID uint, req dto.EventSpeakerRequest) (*dto.EventSpeakerResponse, error)
+	UpdateSpeaker(orgID uint, eventID uint, speakerID uint, req dto.EventSpeakerRequest) (*dto.EventSpeakerResponse, error)
+	UploadSpeakerPicture(ctx context.Context, orgID uint, eventID uint, speakerID uint, file multipart.File, fileHeader *multipart.FileHeader) (*dto.EventSpeakerResponse, error)
+	DeleteSpeaker(orgID uint, eventID uint, speakerID uint) error
+	ListMaterials(orgID uint, eventID uint) ([]dto.EventMaterialResponse, error)
+	UploadMaterial(ctx context.Context, orgID uint, eventID uint, title string, file multipart.File, fileHeader *multipart.FileHeader) (*dto.EventMaterialResponse, error)
+	DeleteMaterial(ctx context.Context, orgID uint, eventID uint, materialID uint) error
+}
+
+// detectMaterialContentType checks the file content rather than trusting the extension or the client's header.
+func detectMaterialContentType(data []byte, fileExt string) (string, error) {
+	materialType, ok := materialTypes[fileExt]
+	if !ok {
+		return "", errs.NewBadRequestError("material must be a PDF, image, Office document or zip file")
+	}
+
+	if http.DetectContentType(data) != materialType.detected {
+		return "", errs.NewBadRequestError("material content does not match its file type")
+	}
+
+	return materialType.contentType, nil
+}
+
+// ConvertToAgendaItems builds the agenda from the request. Speakers and sessions are those of the event,
+// an item linking to anything else is rejected.
+func ConvertToAgendaItems(event models.Event, req dto.AgendaRequest, speakers []models.EventSpeaker) ([]models.AgendaItem, error) {
+	speakerByID := make(map[uint]models.EventSpeaker, len(speakers))
+	for _, speaker := range speakers {
+		speakerByID[speaker.ID] = speaker
+	}
+
+	sessionIDs := make(map[uint]bool, len(event.Sessions))
+	for _, session := range event.Sessions {
+		sessionIDs[session.ID] = true
+	}
+
+	items := make([]models.AgendaItem, 0, len(req.Items))
+	for _, itemReq := range req.Items {
+		item := models.AgendaItem{
+			EventID:     event.ID,
+			SessionID:   itemReq.SessionID,
+			StartTime:   utils.TimeOnly{Time: utils.TimeParser(itemReq.StartTime)},
+			EndTime:     utils.TimeOnly{Time: utils.TimeParser(itemReq.EndTime)},
+			Title:       itemReq.Title,
+			Description: itemReq.Description,
+			Speakers:    make([]models.EventSpeaker, 0, len(itemReq.SpeakerIDs)),
+		}
+
+		if item.SessionID != nil && !sessionIDs[*item.SessionID] {
+			return nil, errs.NewBadRequestError("agenda items must belong to sessions of the event")
+		}
+
+		if itemReq.StartTime != "" && itemReq.EndTime != "" && item.EndTime.Before(item.StartTime.Time) {
+			return nil, errs.NewBadRequestError("agenda items must not end before they start")
+		}
+
+		for _, speakerID := range uniqueIDs(itemReq.SpeakerIDs) {
+			speaker, ok := speakerByID[speakerID]
+			if !ok {
+				return nil, errs.NewBadRequestError("agenda speakers must be speakers of the event")
+			}
+			item.Speakers = append(item.Speakers, speaker)
+		}
+
+		items = append(items, item)
+	}
+
+	return items, nil
+}
@@ -0,0 +1,83 @@
+package service
+
+import (
+	"errors"
+	"time"
+
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/errs"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/dto"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/models"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/repository"
+	"github.com/google/uuid"
+)
+
+type PromoCodeService interface {
+	ListPromoCodes(orgID uint, eventID uint) ([]dto.PromoCodeResponse, error)
+	CreatePromoCode(orgID uint, eventID uint, req dto.PromoCodeRequest) (*dto.PromoCodeResponse, error)
+	UpdatePromoCode(orgID uint, eventID uint, promoID uint, req dto.PromoCodeRequest) (*dto.PromoCodeResponse, error)
+	DeletePromoCode(orgID uint, eventID uint, promoID uint) error
+	GetPromoCodeReport(orgID uint, eventID uint) (*dto.PromoCodeReportResponse, error)
+	// QuotePromoCode previews the price of a ticket type with a code, the code is only redeemed on registration
+	QuotePromoCode(userID uuid.UUID, eventID uint, ticketID uint, req dto.PromoCodeQuoteRequest) (*dto.PromoCodeQuoteResponse, error)
+}
+
+// ConvertToPromoCode builds the code of the event from the request, tickets are the requested ticket types of the event.
+func ConvertToPromoCode(eventID uint, req dto.PromoCodeRequest, tickets []models.TicketAvailable) (models.PromoCode, error) {
+	promo := models.PromoCode{
+		EventID:        eventID,
+		Code:           repository.NormalizePromoCode(req.Code),
+		DiscountType:   req.DiscountType,
+		DiscountValue:  req.DiscountValue,
+		Tickets:        tickets,
+		MaxUses:        req.MaxUses,
+		MaxUsesPerUser: req.MaxUsesPerUser,
+		IsActive:       true,
+	}
+
+	if req.IsActive != nil {
+		promo.IsActive = *req.IsActive
+	}
+
+	if promo.DiscountType == models.DiscountPercent && promo.DiscountValue > 100 {
+		return promo, errs.NewBadRequestError("a percentage discount cannot be more than 100")
+	}
+
+	if req.StartsAt != "" {
+		startsAt, err := time.Parse(time.RFC3339, req.StartsAt)
+		if err != nil {
+			return promo, errs.NewBadRequestError("invalid startsAt")
+		}
+		promo.StartsAt = &startsAt
+	}
+
+	if req.ExpiresAt != "" {
+		expiresAt, err := time.Parse(time.RFC3339, req.ExpiresAt)
+		if err != nil {
+			return promo, errs.NewBadRequestError("invalid expiresAt")
+		}
+		promo.ExpiresAt = &expiresAt
+	}
+
+	if promo.StartsAt != nil && promo.ExpiresAt != nil && !promo.ExpiresAt.After(*promo.StartsAt) {
+		return promo, errs.NewBadRequestError("expiresAt must be after startsAt")
+	}
+
+	return promo, nil
+}
+
+// promoCodeError maps the reasons the repository rejects a code to client errors.
+func promoCodeError(err error) (error, bool) {
+	switch {
+	case errors.Is(err, repository.ErrPromoCodeInvalid),
+		errors.Is(err, repository.ErrPromoCodeNotStarted),
+		errors.Is(err, repository.ErrPromoCodeExpired),
+		errors.Is(err, repository.ErrPromoCodeNotApplicable),
+		errors.Is(err, repository.ErrPromoCodeFreeTicket):
+		return errs.NewBadRequestError(err.Error()), true
+	case errors.Is(err, repository.ErrPromoCodeUsedUp),
+		errors.Is(err, repository.ErrPromoCodeUserLimit):
+		return errs.NewConflictError(err.Error()), true
+	}
+
+	return nil, false
+}
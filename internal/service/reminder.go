@@ -0,0 +1,76 @@
+package service
+
+import (
+	"time"
+
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/dto"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/models"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/repository"
+	"github.com/google/uuid"
+)
+
+type ReminderService interface {
+	// SendDueReminders mails the registrants of events starting within a day or within an hour, once each
+	SendDueReminders() error
+}
+
+type NotificationPreferenceService interface {
+	GetMyNotificationPreferences(userID uuid.UUID) (*dto.NotificationPreferenceResponse, error)
+	UpdateMyNotificationPreferences(userID uuid.UUID, req dto.NotificationPreferenceRequest) (*dto.NotificationPreferenceResponse, error)
+}
+
+// ReminderWindow is the range of start times of the events due for the reminder at now. The day before reminder
+// stops an hour before the start, where the hour before reminder takes over.
+func ReminderWindow(kind models.ReminderKind, now time.Time) (time.Time, time.Time) {
+	if kind == models.ReminderHourBefore {
+		return now, now.Add(time.Hour)
+	}
+
+	return now.Add(time.Hour), now.Add(24 * time.Hour)
+}
+
+// ConvertToReminderMail addresses the reminder of a ticket, the event must be loaded with its organization.
+func ConvertToReminderMail(kind models.ReminderKind, purchase models.TicketPurchased, calendarFile []byte, qrCode []byte) repository.ReminderMailConfig {
+	event := purchase.Event
+
+	startsIn := "tomorrow"
+	if kind == models.ReminderHourBefore {
+		startsIn = "in 1 hour"
+	}
+
+	location := event.LocationName
+	if location == "" {
+		location = event.LocationType
+	}
+
+	start, _, allDay := EventTimes(event)
+	eventDate := start.Format("2006-01-02 15:04") + " (" + start.Location().String() + ")"
+	if allDay {
+		eventDate = start.Format("2006-01-02")
+	}
+
+	return repository.ReminderMailConfig{
+		ToEmail: purchase.Email,
+		Subject: event.Name + " starts " + startsIn,
+		Body: repository.ReminderMailBody{
+			AttendeeName:     purchase.Username,
+			EventName:        event.Name,
+			EventID:          event.ID,
+			TicketTitle:      purchase.TicketTitle,
+			EventDate:        eventDate,
+			Location:         location,
+			StartsIn:         startsIn,
+			OrganizationName: event.Organization.Name,
+		},
+		Calendar:     calendarFile,
+		TicketQRCode: qrCode,
+	}
+}
+
+func BuildNotificationPreferenceResponse(preference models.NotificationPreference) dto.NotificationPreferenceResponse {
+	return dto.NotificationPreferenceResponse{
+		EventReminders:     preference.EventReminders,
+		EventAnnouncements: preference.EventAnnouncements,
+		DiscussionReplies:  preference.DiscussionReplies,
+	}
+}
@@ -0,0 +1,196 @@
+package service
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/errs"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/dto"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/models"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/repository"
+	"github.com/google/uuid"
+)
+
+// defaultAutoHideThreshold is the number of pending reports that hides a content until a moderator reviews it
+const defaultAutoHideThreshold = 5
+
+type ReportService interface {
+	Report(userID uuid.UUID, req dto.ContentReportRequest) (*dto.ContentReportResponse, error)
+	// ListQueue lists the contents with pending reports, the most reported first
+	ListQueue(targetType string) ([]dto.ReportedContentResponse, error)
+	GetReportedContent(targetType string, targetID uint) (*dto.ReportedContentDetailResponse, error)
+	// TakeAction resolves the pending reports on the content, its reporters are told the outcome
+	TakeAction(moderatorID uuid.UUID, targetType string, targetID uint, req dto.ModerationActionRequest) (*dto.ModerationLogResponse, error)
+}
+
+// ContentSummary is what moderation shows and mails about a reported content.
+type ContentSummary struct {
+	Title          string
+	Path           string
+	Hidden         bool // Hidden content, suspended organization
+	OrganizationID uint // Organization the content belongs to, 0 for discussion posts
+	OwnerName      string
+	OwnerEmail     string
+}
+
+func ParseReportTarget(value string) (models.ReportTarget, error) {
+	switch targetType := models.ReportTarget(value); targetType {
+	case models.ReportEvent, models.ReportJob, models.ReportOrganization, models.ReportQuestion, models.ReportAnswer:
+		return targetType, nil
+	}
+
+	return "", errs.NewBadRequestError("target type must be one of event, job, organization, question or answer")
+}
+
+// ReportAutoHideThreshold reads the configured threshold, it falls back to the default when unset or invalid.
+func ReportAutoHideThreshold(value string) int {
+	threshold, err := strconv.Atoi(value)
+	if err != nil || threshold < 1 {
+		return defaultAutoHideThreshold
+	}
+
+	return threshold
+}
+
+// ShouldAutoHide hides a visible content once it has enough pending reports, organizations are only suspended by moderators.
+func ShouldAutoHide(targetType models.ReportTarget, summary ContentSummary, pending int64, threshold int) bool {
+	return targetType != models.ReportOrganization && !summary.Hidden && pending >= int64(threshold)
+}
+
+func DescribeContent(content models.ModeratedContent) ContentSummary {
+	switch {
+	case content.Event != nil:
+		return ContentSummary{
+			Title:          content.Event.Name,
+			Path:           fmt.Sprintf("/events/%d", content.Event.ID),
+			Hidden:         content.Event.Hidden,
+			OrganizationID: content.Event.OrganizationID,
+			OwnerName:      content.Event.Organization.Name,
+			OwnerEmail:     content.Event.Organization.Email,
+		}
+	case content.Job != nil:
+		return ContentSummary{
+			Title:          content.Job.Title,
+			Path:           fmt.Sprintf("/jobs/%d", content.Job.ID),
+			Hidden:         content.Job.Hidden,
+			OrganizationID: content.Job.OrganizationID,
+			OwnerName:      content.Job.Organization.Name,
+			OwnerEmail:     content.Job.Organization.Email,
+		}
+	case content.Organization != nil:
+		return ContentSummary{
+			Title:          content.Organization.Name,
+			Path:           fmt.Sprintf("/orgs/%d", content.Organization.ID),
+			Hidden:         content.Organization.Suspended,
+			OrganizationID: content.Organization.ID,
+			OwnerName:      content.Organization.Name,
+			OwnerEmail:     content.Organization.Email,
+		}
+	case content.Question != nil:
+		return ContentSummary{
+			Title:      excerpt(content.Question.Body),
+			Path:       DiscussionPath(content.Question.TargetType, content.Question.TargetID),
+			Hidden:     content.Question.Hidden,
+			OwnerName:  content.Question.User.Name,
+			OwnerEmail: content.Question.User.Email,
+		}
+	case content.Answer != nil:
+		return ContentSummary{
+			Title:      excerpt(content.Answer.Body),
+			Path:       DiscussionPath(content.Answer.Question.TargetType, content.Answer.Question.TargetID),
+			Hidden:     content.Answer.Hidden,
+			OwnerName:  content.Answer.User.Name,
+			OwnerEmail: content.Answer.User.Email,
+		}
+	}
+
+	return ContentSummary{}
+}
+
+// excerpt shortens discussion posts to name them in the queue and in mails.
+func excerpt(body string) string {
+	const maxRunes = 80
+
+	runes := []rune(body)
+	if len(runes) <= maxRunes {
+		return body
+	}
+
+	return string(runes[:maxRunes]) + "…"
+}
+
+// PlanModeration tells what the action changes and how it resolves the pending reports. Dismissing the
+// reports on a content hidden automatically shows it again, as the reports that hid it were unfounded.
+func PlanModeration(action models.ModerationAction, targetType models.ReportTarget, summary ContentSummary, autoHidden bool) (models.ModerationChange, models.ReportStatus, error) {
+	var change models.ModerationChange
+
+	switch action {
+	case models.ActionDismiss:
+		if summary.Hidden && autoHidden {
+			shown := false
+			change.Hidden = &shown
+		}
+		return change, models.ReportDismissed, nil
+	case models.ActionHide:
+		if targetType == models.ReportOrganization {
+			return change, "", errs.NewBadRequestError("organizations are suspended rather than hidden")
+		}
+		hidden := true
+		change.Hidden = &hidden
+		return change, models.ReportActioned, nil
+	case models.ActionSuspend:
+		if summary.OrganizationID == 0 {
+			return change, "", errs.NewBadRequestError("the content does not belong to an organization")
+		}
+		change.SuspendOrganizationID = summary.OrganizationID
+		return change, models.ReportActioned, nil
+	case models.ActionWarn:
+		if summary.OwnerEmail == "" {
+			return change, "", errs.NewBadRequestError("the owner of the content has no email to warn")
+		}
+		return change, models.ReportActioned, nil
+	}
+
+	return change, "", errs.NewBadRequestError("action must be one of dismiss, hide, suspend or warn")
+}
+
+func BuildReportedContentResponse(targetType models.ReportTarget, targetID uint, summary ContentSummary) dto.ReportedContentResponse {
+	return dto.ReportedContentResponse{
+		TargetType: string(targetType),
+		TargetID:   targetID,
+		Title:      summary.Title,
+		Path:       summary.Path,
+		Hidden:     summary.Hidden,
+	}
+}
+
+func ConvertToReportOutcomeMail(report models.ContentReport, summary ContentSummary) repository.ReportOutcomeMailConfig {
+	title := summary.Title
+	if title == "" {
+		// The content was deleted since it was reported
+		title = "the content you reported"
+	}
+
+	return repository.ReportOutcomeMailConfig{
+		ToEmail: report.Reporter.Email,
+		Subject: "Update on your report about " + title,
+		Body: repository.ReportOutcomeMailBody{
+			ReporterName: report.Reporter.Name,
+			Title:        title,
+			Actioned:     report.Status == models.ReportActioned,
+		},
+	}
+}
+
+func ConvertToModerationWarningMail(summary ContentSummary, note string) repository.ModerationWarningMailConfig {
+	return repository.ModerationWarningMailConfig{
+		ToEmail: summary.OwnerEmail,
+		Subject: "Warning about " + summary.Title,
+		Body: repository.ModerationWarningMailBody{
+			OwnerName: summary.OwnerName,
+			Title:     summary.Title,
+			Path:      summary.Path,
+			Note:      note,
+		},
+	}
+}
@@ -0,0 +1,83 @@
+package service
+
+import (
+	"context"
+	"mime/multipart"
+	"strings"
+
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/dto"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/infrastructure/resume"
+	"github.com/google/uuid"
+)
+
+type ResumeService interface {
+	UploadResume(ctx context.Context, userID uuid.UUID, file multipart.File, fileHeader *multipart.FileHeader) (*dto.ResumeUploadResponse, error)
+	ListResumes(userID uuid.UUID) ([]dto.ResumeResponse, error)
+	GetResumeURL(ctx context.Context, userID uuid.UUID, resumeID uint) (*dto.ResumeURLResponse, error)
+	GetResumeSuggestions(userID uuid.UUID, resumeID uint) (*dto.ResumeSuggestionResponse, error)
+	ConfirmResumeSuggestions(userID uuid.UUID, resumeID uint, req dto.ResumeConfirmRequest) error
+	DeleteResume(ctx context.Context, userID uuid.UUID, resumeID uint) error
+}
+
+type JobApplicationService interface {
+	Apply(userID uuid.UUID, jobID uint, req dto.JobApplicationRequest) (*dto.JobApplicationResponse, error)
+	ListMyApplications(userID uuid.UUID) ([]dto.JobApplicationResponse, error)
+	ListJobApplications(orgID uint, jobID uint) ([]dto.JobApplicationResponse, error)
+	GetApplicationResumeURL(ctx context.Context, orgID uint, applicationID uint) (*dto.ResumeURLResponse, error)
+}
+
+const (
+	maxProfileTextLength = 255
+	dateLayout           = "2006-01-02"
+)
+
+// ConvertToResumeSuggestion turns a parsed resume into the free-text profile fields and experience drafts.
+// Catalogue skills are matched separately.
+func ConvertToResumeSuggestion(parsed resume.ParsedResume) dto.ResumeSuggestionResponse {
+	suggestion := dto.ResumeSuggestionResponse{
+		Education:   joinWithinLength(parsed.Education, "; ", maxProfileTextLength),
+		Skill:       joinWithinLength(parsed.Skills, ", ", maxProfileTextLength),
+		Skills:      []dto.SkillShortResponse{},
+		Experiences: []dto.ExperienceSuggestion{},
+	}
+
+	for _, experience := range parsed.Experiences {
+		draft := dto.ExperienceSuggestion{
+			Title:       experience.Title,
+			Description: experience.Description,
+			Currently:   experience.Currently,
+		}
+		if !experience.StartDate.IsZero() {
+			draft.StartDate = experience.StartDate.Format(dateLayout)
+		}
+		if !experience.EndDate.IsZero() {
+			draft.EndDate = experience.EndDate.Format(dateLayout)
+		}
+		suggestion.Experiences = append(suggestion.Experiences, draft)
+	}
+
+	return suggestion
+}
+
+// joinWithinLength joins as many whole values as fit in maxLength characters.
+func joinWithinLength(values []string, sep string, maxLength int) string {
+	var builder strings.Builder
+	length := 0
+	for _, value := range values {
+		added := len([]rune(value))
+		if length > 0 {
+			added += len([]rune(sep))
+		}
+		if length+added > maxLength {
+			break
+		}
+
+		if length > 0 {
+			builder.WriteString(sep)
+		}
+		builder.WriteString(value)
+		length += added
+	}
+
+	return builder.String()
+}
@@ -0,0 +1,160 @@
+package service
+
+import (
+	"time"
+
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/errs"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/dto"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/models"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/infrastructure/calendar"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/utils"
+)
+
+type EventSessionService interface {
+	ListSessions(eventID uint) (*dto.EventSessionsResponse, error)
+	ListEventSessions(orgID uint, eventID uint) (*dto.EventSessionsResponse, error)
+	CreateSession(orgID uint, eventID uint, req dto.EventSessionRequest) (*dto.EventSessionResponse, error)
+	// SetRecurrence replaces the sessions created from the previous rule with those of the new one
+	SetRecurrence(orgID uint, eventID uint, req dto.EventRecurrenceRequest) (*dto.EventSessionsResponse, error)
+	// UpdateSession edits one occurrence, or with the "all" scope every occurrence of the series, and returns the sessions changed
+	UpdateSession(orgID uint, eventID uint, sessionID uint, scope string, req dto.EventSessionRequest) ([]dto.EventSessionResponse, error)
+	DeleteSession(orgID uint, eventID uint, sessionID uint, scope string) error
+}
+
+// ConvertToEventSession builds a session of the event from the request, a session without a location
+// takes the location of the event.
+func ConvertToEventSession(event models.Event, req dto.EventSessionRequest) (models.EventSession, error) {
+	session := models.EventSession{
+		EventID:      event.ID,
+		Title:        req.Title,
+		StartDate:    utils.DateOnly{Time: utils.DateParser(req.StartDate)},
+		EndDate:      utils.DateOnly{Time: utils.DateParser(req.StartDate)},
+		StartTime:    utils.TimeOnly{Time: utils.TimeParser(req.StartTime)},
+		EndTime:      utils.TimeOnly{Time: utils.TimeParser(req.EndTime)},
+		LocationName: req.LocationName,
+		Latitude:     req.Latitude,
+		Longitude:    req.Longitude,
+		Province:     req.Province,
+		LocationType: req.LocationType,
+	}
+
+	if req.EndDate != "" {
+		session.EndDate = utils.DateOnly{Time: utils.DateParser(req.EndDate)}
+		if session.EndDate.Before(session.StartDate.Time) {
+			return session, errs.NewBadRequestError("endDate must not be before startDate")
+		}
+	}
+
+	if session.LocationName == "" {
+		session.LocationName = event.LocationName
+		session.Latitude = event.Latitude
+		session.Longitude = event.Longitude
+		session.Province = event.Province
+	}
+	if session.LocationType == "" {
+		session.LocationType = event.LocationType
+	}
+
+	if err := ScheduleSession(&session, event.Timezone); err != nil {
+		return session, errs.NewBadRequestError(err.Error())
+	}
+
+	return session, nil
+}
+
+// ScheduleSession derives the UTC instants of the local dates and times of a session in the zone of its event.
+func ScheduleSession(session *models.EventSession, timezone string) error {
+	loc, err := utils.LoadTimezone(timezone)
+	if err != nil {
+		return err
+	}
+
+	start, end, _ := utils.EventInstants(session.StartDate.Time, session.EndDate.Time, session.StartTime.Time, session.EndTime.Time, loc)
+	startAt, endAt := start.UTC(), end.UTC()
+	session.StartAt = &startAt
+	session.EndAt = &endAt
+
+	return nil
+}
+
+// ExpandRecurrence creates a session per occurrence of the rule, starting on the start date of the event.
+// Each session takes the start and end time and the location of the event, on the day it occurs.
+func ExpandRecurrence(event models.Event, rule calendar.Rule) ([]models.EventSession, error) {
+	loc, err := utils.LoadTimezone(event.Timezone)
+	if err != nil {
+		return nil, err
+	}
+
+	start := utils.CombineDateTime(event.StartDate.Time, event.StartTime.Time, loc)
+	occurrences, err := rule.Occurrences(start)
+	if err != nil {
+		return nil, err
+	}
+
+	sessions := make([]models.EventSession, 0, len(occurrences))
+	for _, occurrence := range occurrences {
+		recurrenceID := occurrence.UTC()
+		day := utils.DateOnly{Time: time.Date(occurrence.Year(), occurrence.Month(), occurrence.Day(), 0, 0, 0, 0, time.UTC)}
+		session := models.EventSession{
+			EventID:      event.ID,
+			StartDate:    day,
+			EndDate:      day,
+			StartTime:    event.StartTime,
+			EndTime:      event.EndTime,
+			LocationName: event.LocationName,
+			Latitude:     event.Latitude,
+			Longitude:    event.Longitude,
+			Province:     event.Province,
+			LocationType: event.LocationType,
+			RecurrenceID: &recurrenceID,
+		}
+		if err := ScheduleSession(&session, event.Timezone); err != nil {
+			return nil, err
+		}
+		sessions = append(sessions, session)
+	}
+
+	return sessions, nil
+}
+
+// ShiftSession applies an edit of the whole series to one of its sessions. The session moves by as many days
+// as the edited occurrence moved and takes the times, title and location of the edit.
+func ShiftSession(session models.EventSession, edited models.EventSession, original models.EventSession, timezone string) (models.EventSession, error) {
+	days := daysBetween(original.StartDate.Time, edited.StartDate.Time)
+	length := daysBetween(edited.StartDate.Time, edited.EndDate.Time)
+
+	session.StartDate = utils.DateOnly{Time: session.StartDate.AddDate(0, 0, days)}
+	session.EndDate = utils.DateOnly{Time: session.StartDate.AddDate(0, 0, length)}
+	session.StartTime = edited.StartTime
+	session.EndTime = edited.EndTime
+	session.Title = edited.Title
+	session.LocationName = edited.LocationName
+	session.Latitude = edited.Latitude
+	session.Longitude = edited.Longitude
+	session.Province = edited.Province
+	session.LocationType = edited.LocationType
+
+	if err := ScheduleSession(&session, timezone); err != nil {
+		return session, err
+	}
+
+	return session, nil
+}
+
+func daysBetween(from time.Time, to time.Time) int {
+	from = time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, time.UTC)
+	to = time.Date(to.Year(), to.Month(), to.Day(), 0, 0, 0, 0, time.UTC)
+	return int(to.Sub(from).Hours() / 24)
+}
+
+func BuildEventSessionsResponse(rule string, sessions []models.EventSession) dto.EventSessionsResponse {
+	res := dto.EventSessionsResponse{
+		RecurrenceRule: rule,
+		Sessions:       make([]dto.EventSessionResponse, 0, len(sessions)),
+	}
+	for _, session := range sessions {
+		res.Sessions = append(res.Sessions, dto.BuildEventSessionResponse(session))
+	}
+
+	return res
+}
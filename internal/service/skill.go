@@ -0,0 +1,88 @@
+package service
+
+import (
+	"math"
+	"strings"
+
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/dto"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/models"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/utils"
+	"github.com/google/uuid"
+)
+
+type SkillService interface {
+	CreateSkill(req dto.SkillRequest) (*dto.SkillResponse, error)
+	ListSkills() (*dto.SkillListResponse, error)
+	GetSkillByID(skillID uint) (*dto.SkillResponse, error)
+	AutocompleteSkills(query string, limit int) ([]dto.SkillShortResponse, error)
+	UpdateSkill(skillID uint, req dto.SkillRequest) (*dto.SkillResponse, error)
+	DeleteSkill(skillID uint) error
+	GetUserSkills(userID uuid.UUID) ([]dto.SkillShortResponse, error)
+	UpdateUserSkills(userID uuid.UUID, req dto.UserSkillsRequest) ([]dto.SkillShortResponse, error)
+	MatchSkills(userID uuid.UUID, requiredSkills [][]dto.SkillShortResponse) ([]*dto.SkillMatchResponse, error)
+}
+
+func ConvertToSkillModel(req dto.SkillRequest) models.Skill {
+	slug := req.Slug
+	if slug == "" {
+		slug = utils.Slugify(req.Name)
+	}
+
+	isActive := true
+	if req.IsActive != nil {
+		isActive = *req.IsActive
+	}
+
+	var synonyms []models.SkillSynonym
+	for _, synonym := range req.Synonyms {
+		synonym = strings.TrimSpace(synonym)
+		if synonym == "" {
+			continue
+		}
+		synonyms = append(synonyms, models.SkillSynonym{Name: synonym})
+	}
+
+	return models.Skill{
+		Name:     strings.TrimSpace(req.Name),
+		Slug:     slug,
+		ParentID: req.ParentID,
+		Synonyms: synonyms,
+		IsActive: isActive,
+	}
+}
+
+// CandidateSkillSet returns the IDs of the candidate's skills together with their parent skills,
+// so that knowing "Go" also satisfies a job asking for "Programming".
+func CandidateSkillSet(skills []models.Skill) map[uint]bool {
+	skillSet := make(map[uint]bool)
+	for _, skill := range skills {
+		skillSet[skill.ID] = true
+		if skill.ParentID != nil {
+			skillSet[*skill.ParentID] = true
+		}
+	}
+	return skillSet
+}
+
+// ComputeSkillMatch scores how many of the required skills are in the candidate's skill set.
+// It returns nil when the job does not require any skill.
+func ComputeSkillMatch(candidateSkills map[uint]bool, requiredSkills []dto.SkillShortResponse) *dto.SkillMatchResponse {
+	if len(requiredSkills) == 0 {
+		return nil
+	}
+
+	match := &dto.SkillMatchResponse{
+		MatchedSkills: []dto.SkillShortResponse{},
+		MissingSkills: []dto.SkillShortResponse{},
+	}
+	for _, skill := range requiredSkills {
+		if candidateSkills[skill.ID] {
+			match.MatchedSkills = append(match.MatchedSkills, skill)
+		} else {
+			match.MissingSkills = append(match.MissingSkills, skill)
+		}
+	}
+	match.Score = int(math.Round(float64(len(match.MatchedSkills)) * 100 / float64(len(requiredSkills))))
+
+	return match
+}
@@ -0,0 +1,258 @@
+package service
+
+import (
+	"math"
+	"strings"
+
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/errs"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/dto"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/models"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/repository"
+	"github.com/google/uuid"
+)
+
+type SurveyService interface {
+	GetEventSurvey(orgID uint, eventID uint) (*dto.EventSurveyResponse, error)
+	// SaveEventSurvey creates or replaces the survey of the event
+	SaveEventSurvey(orgID uint, eventID uint, req dto.EventSurveyRequest) (*dto.EventSurveyResponse, error)
+	DeleteEventSurvey(orgID uint, eventID uint) error
+	GetSurveyResults(orgID uint, eventID uint) (*dto.SurveyResultsResponse, error)
+	GetOrganizationSurveyResults(orgID uint) (*dto.OrganizationRatingResponse, error)
+	// GetMySurvey is the survey of an event for a participant who was checked in
+	GetMySurvey(userID uuid.UUID, eventID uint) (*dto.ParticipantSurveyResponse, error)
+	SubmitSurvey(userID uuid.UUID, eventID uint, req dto.SurveySubmitRequest) error
+	// GetEventRating and GetOrganizationRating only show ratings the organizers made public
+	GetEventRating(eventID uint) (*dto.EventRatingResponse, error)
+	GetOrganizationRating(orgID uint) (*dto.OrganizationRatingResponse, error)
+	// SendEndedSurveys invites the checked in participants of ended events to their survey, it runs on a schedule
+	SendEndedSurveys() error
+}
+
+// ConvertToEventSurvey builds the survey of the request, choice questions need two options and the others none.
+func ConvertToEventSurvey(eventID uint, req dto.EventSurveyRequest) (models.EventSurvey, error) {
+	survey := models.EventSurvey{
+		EventID:            eventID,
+		Title:              strings.TrimSpace(req.Title),
+		Description:        req.Description,
+		ShowRatingPublicly: req.ShowRatingPublicly,
+		Questions:          make([]models.SurveyQuestion, 0, len(req.Questions)),
+	}
+
+	for i, questionReq := range req.Questions {
+		question := models.SurveyQuestion{
+			Position: i,
+			Type:     models.SurveyQuestionType(questionReq.Type),
+			Prompt:   strings.TrimSpace(questionReq.Prompt),
+			Required: questionReq.Required,
+		}
+
+		if question.Type == models.SurveyQuestionChoice {
+			if len(questionReq.Options) < 2 {
+				return survey, errs.NewBadRequestError("choice questions need at least two options")
+			}
+			for j, label := range questionReq.Options {
+				question.Options = append(question.Options, models.SurveyQuestionOption{Position: j, Label: strings.TrimSpace(label)})
+			}
+		} else if len(questionReq.Options) > 0 {
+			return survey, errs.NewBadRequestError("only choice questions have options")
+		}
+
+		survey.Questions = append(survey.Questions, question)
+	}
+
+	return survey, nil
+}
+
+// ConvertToSurveyResponse checks the answers against the questions of the survey: one answer per question,
+// of the kind the question asks for, and every required question answered.
+func ConvertToSurveyResponse(survey models.EventSurvey, userID uuid.UUID, req dto.SurveySubmitRequest) (models.SurveyResponse, error) {
+	response := models.SurveyResponse{
+		SurveyID: survey.ID,
+		EventID:  survey.EventID,
+		UserID:   userID,
+		Answers:  make([]models.SurveyAnswer, 0, len(req.Answers)),
+	}
+
+	questions := make(map[uint]models.SurveyQuestion, len(survey.Questions))
+	for _, question := range survey.Questions {
+		questions[question.ID] = question
+	}
+
+	answered := make(map[uint]bool, len(req.Answers))
+	for _, answerReq := range req.Answers {
+		question, ok := questions[answerReq.QuestionID]
+		if !ok {
+			return response, errs.NewBadRequestError("answers must be to questions of the survey")
+		}
+		if answered[question.ID] {
+			return response, errs.NewBadRequestError("each question is answered once")
+		}
+
+		answer := models.SurveyAnswer{QuestionID: question.ID}
+		switch question.Type {
+		case models.SurveyQuestionRating:
+			if answerReq.Rating == nil || *answerReq.Rating < models.MinSurveyRating || *answerReq.Rating > models.MaxSurveyRating {
+				return response, errs.NewBadRequestError("ratings are from 1 to 5")
+			}
+			answer.Rating = answerReq.Rating
+		case models.SurveyQuestionChoice:
+			if answerReq.OptionID == nil || !hasOption(question, *answerReq.OptionID) {
+				return response, errs.NewBadRequestError("choose one of the options of the question")
+			}
+			answer.OptionID = answerReq.OptionID
+		default:
+			answer.Text = strings.TrimSpace(answerReq.Text)
+			if answer.Text == "" {
+				// An empty text is no answer
+				continue
+			}
+		}
+
+		answered[question.ID] = true
+		response.Answers = append(response.Answers, answer)
+	}
+
+	for _, question := range survey.Questions {
+		if question.Required && !answered[question.ID] {
+			return response, errs.NewBadRequestError("every required question must be answered")
+		}
+	}
+
+	return response, nil
+}
+
+func hasOption(question models.SurveyQuestion, optionID uint) bool {
+	for _, option := range question.Options {
+		if option.ID == optionID {
+			return true
+		}
+	}
+
+	return false
+}
+
+// BuildSurveyResults sums the answers of each question of the survey.
+func BuildSurveyResults(survey models.EventSurvey, responses []models.SurveyResponse) dto.SurveyResultsResponse {
+	answers := make(map[uint][]models.SurveyAnswer, len(survey.Questions))
+	for _, response := range responses {
+		for _, answer := range response.Answers {
+			answers[answer.QuestionID] = append(answers[answer.QuestionID], answer)
+		}
+	}
+
+	res := dto.SurveyResultsResponse{
+		SurveyID:  survey.ID,
+		EventID:   survey.EventID,
+		Responses: len(responses),
+		Questions: make([]dto.SurveyQuestionResultResponse, 0, len(survey.Questions)),
+	}
+
+	for _, question := range survey.Questions {
+		questionAnswers := answers[question.ID]
+		result := dto.SurveyQuestionResultResponse{
+			ID:       question.ID,
+			Type:     string(question.Type),
+			Prompt:   question.Prompt,
+			Answered: len(questionAnswers),
+		}
+
+		switch question.Type {
+		case models.SurveyQuestionRating:
+			result.RatingCounts = make([]int, models.MaxSurveyRating-models.MinSurveyRating+1)
+			total := 0
+			for _, answer := range questionAnswers {
+				if answer.Rating == nil {
+					continue
+				}
+				result.RatingCounts[*answer.Rating-models.MinSurveyRating]++
+				total += *answer.Rating
+			}
+			if len(questionAnswers) > 0 {
+				result.Average = roundRating(float64(total) / float64(len(questionAnswers)))
+			}
+		case models.SurveyQuestionChoice:
+			counts := make(map[uint]int, len(question.Options))
+			for _, answer := range questionAnswers {
+				if answer.OptionID != nil {
+					counts[*answer.OptionID]++
+				}
+			}
+			result.Options = make([]dto.SurveyOptionResultResponse, 0, len(question.Options))
+			for _, option := range question.Options {
+				result.Options = append(result.Options, dto.SurveyOptionResultResponse{ID: option.ID, Label: option.Label, Count: counts[option.ID]})
+			}
+		default:
+			result.Texts = make([]string, 0, len(questionAnswers))
+			for _, answer := range questionAnswers {
+				result.Texts = append(result.Texts, answer.Text)
+			}
+		}
+
+		res.Questions = append(res.Questions, result)
+	}
+
+	return res
+}
+
+func BuildEventRatingResponse(rating models.SurveyRating) dto.EventRatingResponse {
+	return dto.EventRatingResponse{
+		EventID:   rating.EventID,
+		EventName: rating.EventName,
+		Average:   roundRating(rating.Average),
+		Ratings:   rating.Ratings,
+		Responses: rating.Responses,
+	}
+}
+
+// BuildOrganizationRatingResponse averages the ratings of the events of an organization, an event counts
+// by its number of ratings.
+func BuildOrganizationRatingResponse(orgID uint, ratings []models.SurveyRating) dto.OrganizationRatingResponse {
+	res := dto.OrganizationRatingResponse{
+		OrganizationID: orgID,
+		Events:         make([]dto.EventRatingResponse, 0, len(ratings)),
+	}
+
+	total := 0.0
+	for _, rating := range ratings {
+		res.Ratings += rating.Ratings
+		res.Responses += rating.Responses
+		total += rating.Average * float64(rating.Ratings)
+		res.Events = append(res.Events, BuildEventRatingResponse(rating))
+	}
+	if res.Ratings > 0 {
+		res.Average = roundRating(total / float64(res.Ratings))
+	}
+
+	return res
+}
+
+// BuildSurveyInvitationMails invites each participant checked in to the survey once.
+func BuildSurveyInvitationMails(survey models.EventSurvey, participants []models.EventParticipant) []repository.SurveyInvitationMailConfig {
+	seen := make(map[string]bool, len(participants))
+	configs := make([]repository.SurveyInvitationMailConfig, 0, len(participants))
+	for _, participant := range participants {
+		email := strings.ToLower(strings.TrimSpace(participant.User.Email))
+		if email == "" || seen[email] {
+			continue
+		}
+		seen[email] = true
+
+		configs = append(configs, repository.SurveyInvitationMailConfig{
+			ToEmail: participant.User.Email,
+			Subject: "How was " + survey.Event.Name + "?",
+			Body: repository.SurveyInvitationMailBody{
+				AttendeeName:     participant.User.Name,
+				EventName:        survey.Event.Name,
+				EventID:          survey.EventID,
+				SurveyTitle:      survey.Title,
+				OrganizationName: survey.Event.Organization.Name,
+			},
+		})
+	}
+
+	return configs
+}
+
+func roundRating(average float64) float64 {
+	return math.Round(average*100) / 100
+}
@@ -0,0 +1,16 @@
+package service
+
+import (
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/dto"
+	"github.com/google/uuid"
+)
+
+type TalentService interface {
+	GetVisibility(userID uuid.UUID) (*dto.ProfileVisibilityResponse, error)
+	UpdateVisibility(userID uuid.UUID, req dto.ProfileVisibilityRequest) (*dto.ProfileVisibilityResponse, error)
+	GetPublicProfile(slug string) (*dto.PublicProfileResponse, error)
+	ViewProfileAsOrganization(orgID uint, viewerID uuid.UUID, slug string) (*dto.PublicProfileResponse, error)
+	ListMyProfileViews(userID uuid.UUID) ([]dto.ProfileViewResponse, error)
+	SearchTalents(orgID uint, query dto.SearchTalentQuery, page int, offset int) (dto.SearchTalentResponse, error)
+	SyncTalents() error
+}
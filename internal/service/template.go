@@ -0,0 +1,159 @@
+package service
+
+import (
+	"context"
+
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/dto"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/models"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type OrgTemplateService interface {
+	// DuplicateEvent creates a draft of the organization copied from one of the events it hosts
+	DuplicateEvent(ctx context.Context, orgID uint, eventID uint) (*dto.EventResponses, error)
+	// DuplicateJob creates a draft copied from one of the jobs of the organization
+	DuplicateJob(ctx context.Context, orgID uint, jobID uint) (*dto.JobResponses, error)
+	// SaveTemplate saves an event or a job of the organization as a template, later changes to it do not alter the template
+	SaveTemplate(ctx context.Context, userID uuid.UUID, orgID uint, req dto.OrgTemplateRequest) (*dto.OrgTemplateResponse, error)
+	ListTemplates(orgID uint, kind string) ([]dto.OrgTemplateResponse, error)
+	DeleteTemplate(ctx context.Context, orgID uint, templateID uint) error
+	// CreateDraft starts a new event or job of the organization from one of its templates
+	CreateDraft(ctx context.Context, orgID uint, templateID uint) (*dto.OrgTemplateDraftResponse, error)
+}
+
+// CopyEventDraft copies an event into a new draft with its categories, contact channels, ticket types,
+// speakers, agenda and translations. Sessions, materials, co-hosts and the sales of the event are not copied.
+// The speakers keep their ID so that the agenda items still refer to them, see EventRepository.Duplicate.
+func CopyEventDraft(event models.Event) models.Event {
+	draft := event
+	draft.Model = gorm.Model{}
+	draft.Status = string(models.Draft)
+	draft.Hidden = false
+	draft.OrganizationID = 0
+	draft.Organization = models.Organization{}
+	draft.RecurrenceRule = ""
+	draft.Sessions = nil
+	draft.Materials = nil
+	draft.CoHosts = nil
+	draft.Categories = categoryReferences(event.Categories)
+
+	draft.ContactChannels = make([]models.ContactChannel, 0, len(event.ContactChannels))
+	for _, contact := range event.ContactChannels {
+		draft.ContactChannels = append(draft.ContactChannels, models.ContactChannel{
+			Media:     contact.Media,
+			MediaLink: contact.MediaLink,
+		})
+	}
+
+	draft.TicketAvailable = make([]models.TicketAvailable, 0, len(event.TicketAvailable))
+	for _, ticket := range event.TicketAvailable {
+		draft.TicketAvailable = append(draft.TicketAvailable, models.TicketAvailable{
+			Title:       ticket.Title,
+			Description: ticket.Description,
+			Quantity:    ticket.Quantity,
+			Price:       ticket.Price,
+		})
+	}
+
+	draft.Speakers = make([]models.EventSpeaker, 0, len(event.Speakers))
+	for _, speaker := range event.Speakers {
+		draft.Speakers = append(draft.Speakers, models.EventSpeaker{
+			Model:       gorm.Model{ID: speaker.ID},
+			UserID:      speaker.UserID,
+			Name:        speaker.Name,
+			JobTitle:    speaker.JobTitle,
+			Company:     speaker.Company,
+			Bio:         speaker.Bio,
+			PicUrl:      speaker.PicUrl,
+			ProfileLink: speaker.ProfileLink,
+			Position:    speaker.Position,
+		})
+	}
+
+	// Items of a session become items of the whole event, as the sessions are not copied
+	draft.AgendaItems = make([]models.AgendaItem, 0, len(event.AgendaItems))
+	for _, item := range event.AgendaItems {
+		speakers := make([]models.EventSpeaker, 0, len(item.Speakers))
+		for _, speaker := range item.Speakers {
+			speakers = append(speakers, models.EventSpeaker{Model: gorm.Model{ID: speaker.ID}})
+		}
+
+		draft.AgendaItems = append(draft.AgendaItems, models.AgendaItem{
+			Position:    item.Position,
+			StartTime:   item.StartTime,
+			EndTime:     item.EndTime,
+			Title:       item.Title,
+			Description: item.Description,
+			Speakers:    speakers,
+		})
+	}
+
+	draft.Translations = make([]models.EventTranslation, 0, len(event.Translations))
+	for _, t := range event.Translations {
+		draft.Translations = append(draft.Translations, models.EventTranslation{
+			Locale:      t.Locale,
+			Name:        t.Name,
+			Content:     t.Content,
+			ContentHTML: t.ContentHTML,
+		})
+	}
+
+	return draft
+}
+
+// CopyJobDraft copies a job into a new draft with its prerequisites, categories, skills and translations.
+func CopyJobDraft(job models.OrgOpenJob) models.OrgOpenJob {
+	draft := job
+	draft.Model = gorm.Model{}
+	draft.Status = string(models.JobStatusDraft)
+	draft.Hidden = false
+	draft.OrganizationID = 0
+	draft.Organization = models.Organization{}
+	draft.Categories = categoryReferences(job.Categories)
+
+	draft.Prerequisites = make([]models.Prerequisite, 0, len(job.Prerequisites))
+	for _, prerequisite := range job.Prerequisites {
+		draft.Prerequisites = append(draft.Prerequisites, models.Prerequisite{
+			Title: prerequisite.Title,
+			Link:  prerequisite.Link,
+		})
+	}
+
+	draft.Skills = make([]models.Skill, 0, len(job.Skills))
+	for _, skill := range job.Skills {
+		draft.Skills = append(draft.Skills, models.Skill{Model: gorm.Model{ID: skill.ID}})
+	}
+
+	draft.Translations = make([]models.OrgOpenJobTranslation, 0, len(job.Translations))
+	for _, t := range job.Translations {
+		draft.Translations = append(draft.Translations, models.OrgOpenJobTranslation{
+			Locale:             t.Locale,
+			Title:              t.Title,
+			Description:        t.Description,
+			DescriptionHTML:    t.DescriptionHTML,
+			Qualifications:     t.Qualifications,
+			QualificationsHTML: t.QualificationsHTML,
+		})
+	}
+
+	return draft
+}
+
+func categoryReferences(categories []models.Category) []models.Category {
+	references := make([]models.Category, 0, len(categories))
+	for _, category := range categories {
+		references = append(references, models.Category{Model: gorm.Model{ID: category.ID}})
+	}
+
+	return references
+}
+
+func categoryIDs(categories []models.Category) []uint {
+	ids := make([]uint, 0, len(categories))
+	for _, category := range categories {
+		ids = append(ids, category.ID)
+	}
+
+	return ids
+}
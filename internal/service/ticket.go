@@ -0,0 +1,42 @@
+package service
+
+import (
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/dto"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/models"
+	"github.com/google/uuid"
+)
+
+type TicketService interface {
+	ListEventTickets(eventID uint) ([]dto.TicketAvailableResponse, error)
+	ListTicketTypes(orgID uint, eventID uint) ([]dto.TicketAvailableResponse, error)
+	CreateTicketType(orgID uint, eventID uint, req dto.TicketAvailableRequest) (*dto.TicketAvailableResponse, error)
+	UpdateTicketType(orgID uint, eventID uint, ticketID uint, req dto.TicketAvailableRequest) (*dto.TicketAvailableResponse, error)
+	DeleteTicketType(orgID uint, eventID uint, ticketID uint) error
+	Register(userID uuid.UUID, eventID uint, ticketID uint, req dto.TicketRegisterRequest) (*dto.TicketPurchasedResponse, error)
+	ListMyTickets(userID uuid.UUID) ([]dto.TicketPurchasedResponse, error)
+	GetMyTicket(userID uuid.UUID, purchaseID uint) (*dto.TicketPurchasedResponse, error)
+	CancelMyTicket(userID uuid.UUID, purchaseID uint) error
+	ListEventRegistrations(orgID uint, eventID uint) ([]dto.TicketPurchasedResponse, error)
+	GetMyTicketQRCode(userID uuid.UUID, purchaseID uint) ([]byte, error)
+	CheckIn(orgID uint, eventID uint, req dto.TicketCheckInRequest) (*dto.TicketPurchasedResponse, error)
+	GetCheckInSummary(orgID uint, eventID uint) (*dto.TicketCheckInSummaryResponse, error)
+}
+
+func ConvertToTicketAvailable(eventID uint, req dto.TicketAvailableRequest) models.TicketAvailable {
+	return models.TicketAvailable{
+		Title:       req.Title,
+		Description: req.Description,
+		Quantity:    req.Quantity,
+		Price:       req.Price,
+		EventID:     eventID,
+	}
+}
+
+// InitialTicketStatus confirms free tickets right away, paid tickets wait for their payment.
+func InitialTicketStatus(price float64) models.TicketStatus {
+	if price > 0 {
+		return models.TicketStatusPending
+	}
+
+	return models.TicketStatusConfirmed
+}
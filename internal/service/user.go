@@ -2,9 +2,12 @@ package service
 
 import (
 	"context"
+	"strings"
+	"time"
 
 	"mime/multipart"
 
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/errs"
 	dto "github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/dto"
 	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/models"
 	"github.com/google/uuid"
@@ -21,6 +24,20 @@ type UserService interface {
 	UpdateUserPicture(ctx context.Context, userID uuid.UUID, file multipart.File, fileHeader *multipart.FileHeader) (string, error)
 }
 
+type ProfileService interface {
+	GetMyProfile(userID uuid.UUID) (*dto.ProfileDetailResponse, error)
+	UpdateMyProfile(userID uuid.UUID, req dto.ProfileUpdateRequest) (*dto.ProfileDetailResponse, error)
+}
+
+type ExperienceService interface {
+	ListExperiences(userID uuid.UUID) ([]dto.ExperienceResponse, error)
+	GetExperience(userID uuid.UUID, experienceID uuid.UUID) (*dto.ExperienceResponse, error)
+	CreateExperience(userID uuid.UUID, req dto.ExperienceRequest) (*dto.ExperienceResponse, error)
+	UpdateExperience(userID uuid.UUID, experienceID uuid.UUID, req dto.ExperienceRequest) (*dto.ExperienceResponse, error)
+	UpdateExperiencePicture(ctx context.Context, userID uuid.UUID, experienceID uuid.UUID, file multipart.File, fileHeader *multipart.FileHeader) (string, error)
+	DeleteExperience(userID uuid.UUID, experienceID uuid.UUID) error
+}
+
 type UserPreferenceService interface {
 	CreateUserPreference(userID uuid.UUID, req dto.UserPreferenceRequest) error
 	GetUserPreference(userID uuid.UUID) (dto.UserPreferenceResponse, error)
@@ -79,6 +96,7 @@ func convertToProfileResponse(profile *models.Profile) *dto.ProfileResponses {
 		Language:  profile.Language,
 		PicUrl:    profile.PicUrl,
 		Role:      user.Role,
+		Skills:    dto.BuildListDTO(profile.Skills, dto.BuildSkillShortResponse),
 		UpdateAt:  profile.UpdatedAt.Format("2006-01-02T15:04:05"),
 	}
 }
@@ -214,3 +232,31 @@ func convertToAllEventsAreInteractedByUserResponse(userInteractEvents []models.U
 
 	return eventsAreInteractedByUserResponses
 }
+
+// ConvertToExperienceModel parses the dates of an experience, an ongoing experience has no end date.
+func ConvertToExperienceModel(req dto.ExperienceRequest) (models.Experience, error) {
+	startDate, err := time.Parse(dateLayout, req.StartDate)
+	if err != nil {
+		return models.Experience{}, errs.NewBadRequestError("invalid experience start date")
+	}
+
+	experience := models.Experience{
+		Title:       strings.TrimSpace(req.Title),
+		Description: req.Description,
+		StartDate:   startDate,
+		Currently:   req.Currently,
+	}
+
+	if !req.Currently && req.EndDate != "" {
+		endDate, err := time.Parse(dateLayout, req.EndDate)
+		if err != nil {
+			return models.Experience{}, errs.NewBadRequestError("invalid experience end date")
+		}
+		if endDate.Before(startDate) {
+			return models.Experience{}, errs.NewBadRequestError("experience end date must be after its start date")
+		}
+		experience.EndDate = endDate
+	}
+
+	return experience, nil
+}
@@ -0,0 +1,22 @@
+package service
+
+import (
+	"time"
+
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/dto"
+	"github.com/google/uuid"
+)
+
+// waitlistOfferTTL is how long a freed seat is held for a waitlisted user before it passes to the next one.
+const waitlistOfferTTL = 24 * time.Hour
+
+type WaitlistService interface {
+	JoinWaitlist(userID uuid.UUID, eventID uint, ticketID uint) (*dto.WaitlistEntryResponse, error)
+	ListMyWaitlists(userID uuid.UUID) ([]dto.WaitlistEntryResponse, error)
+	LeaveWaitlist(userID uuid.UUID, entryID uint) error
+	ClaimOffer(userID uuid.UUID, req dto.WaitlistClaimRequest) (*dto.TicketPurchasedResponse, error)
+	ListWaitlist(orgID uint, eventID uint, ticketID uint) ([]dto.WaitlistEntryResponse, error)
+	ReorderWaitlist(orgID uint, eventID uint, ticketID uint, req dto.WaitlistReorderRequest) ([]dto.WaitlistEntryResponse, error)
+	PromoteEntry(orgID uint, eventID uint, ticketID uint, entryID uint) (*dto.WaitlistEntryResponse, error)
+	ExpireOffers() error
+}
@@ -0,0 +1,80 @@
+//go:build integration
+
+package integration_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/errs"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/models"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/infrastructure/payment"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/repository"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/service"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+// assertAppError checks the status the handlers answer with for the error
+func assertAppError(t *testing.T, expected int, err error) {
+	t.Helper()
+
+	appErr, ok := err.(errs.AppError)
+	if assert.True(t, ok, "expected an AppError, got %v", err) {
+		assert.Equal(t, expected, appErr.Code)
+	}
+}
+
+func TestCoHostCannotManageOrganizerOrders(t *testing.T) {
+	// ARRANGE
+	db := openTestDB(t)
+	organizer := createOrganization(t, db)
+	coHost := createOrganization(t, db)
+	event := createEvent(t, db, organizer.ID)
+
+	if err := db.Create(&models.EventCoHost{
+		EventID:        event.ID,
+		OrganizationID: coHost.ID,
+		Status:         models.CoHostAccepted,
+		InvitedBy:      uuid.New(),
+	}).Error; err != nil {
+		t.Fatal(err)
+	}
+
+	eventRepo := repository.NewEventRepository(db)
+	paymentService := service.NewPaymentService(
+		repository.NewOrderRepository(db),
+		repository.NewTicketPurchasedRepository(db),
+		eventRepo,
+		nil,
+		payment.NewFakeProvider("testSecret"),
+	)
+
+	t.Run("TestCoHostListsOrders", func(t *testing.T) {
+		_, err := paymentService.ListEventOrders(coHost.ID, event.ID)
+		assertAppError(t, http.StatusNotFound, err)
+	})
+
+	t.Run("TestCoHostRefundsOrder", func(t *testing.T) {
+		_, err := paymentService.RefundOrder(context.Background(), coHost.ID, event.ID, 1)
+		assertAppError(t, http.StatusNotFound, err)
+	})
+
+	t.Run("TestOrganizerListsOrders", func(t *testing.T) {
+		orders, err := paymentService.ListEventOrders(organizer.ID, event.ID)
+		assert.NoError(t, err)
+		assert.Empty(t, orders)
+	})
+
+	t.Run("TestCoHostEditsEvent", func(t *testing.T) {
+		hosted, err := eventRepo.GetHostedByID(coHost.ID, event.ID)
+		if assert.NoError(t, err) {
+			assert.Equal(t, organizer.ID, hosted.OrganizationID)
+		}
+
+		count, err := eventRepo.CountsByOrgID(coHost.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), count)
+	})
+}
@@ -0,0 +1,62 @@
+//go:build integration
+
+package integration_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/models"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/repository"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func TestModeratedContentLeftOutOfPublicReads(t *testing.T) {
+	// ARRANGE
+	db := openTestDB(t)
+	org := createOrganization(t, db)
+	suspendedOrg := createOrganization(t, db)
+	listed := createEvent(t, db, org.ID)
+	hidden := createEvent(t, db, org.ID)
+	ofSuspended := createEvent(t, db, suspendedOrg.ID)
+
+	assert.NoError(t, db.Model(&models.Event{}).Where("id = ?", hidden.ID).Update("hidden", true).Error)
+	assert.NoError(t, db.Model(&models.Organization{}).Where("id = ?", suspendedOrg.ID).Update("suspended", true).Error)
+
+	eventRepo := repository.NewEventRepository(db)
+
+	t.Run("TestListEvents", func(t *testing.T) {
+		events, err := eventRepo.GetAll()
+		if assert.NoError(t, err) {
+			ids := make([]uint, 0, len(events))
+			for _, event := range events {
+				ids = append(ids, event.ID)
+			}
+			assert.Contains(t, ids, listed.ID)
+			assert.NotContains(t, ids, hidden.ID)
+			assert.NotContains(t, ids, ofSuspended.ID)
+		}
+	})
+
+	t.Run("TestGetEvent", func(t *testing.T) {
+		_, err := eventRepo.GetListedByID(listed.ID)
+		assert.NoError(t, err)
+
+		for _, eventID := range []uint{hidden.ID, ofSuspended.ID} {
+			_, err := eventRepo.GetListedByID(eventID)
+			assert.True(t, errors.Is(err, gorm.ErrRecordNotFound))
+		}
+	})
+
+	t.Run("TestOrganizerStillReadsHiddenEvent", func(t *testing.T) {
+		_, err := eventRepo.GetByIDwithOrgID(org.ID, hidden.ID)
+		assert.NoError(t, err)
+	})
+
+	t.Run("TestSuspension", func(t *testing.T) {
+		suspended, err := repository.NewOrganizationRepository(db).IsSuspended(suspendedOrg.ID)
+		assert.NoError(t, err)
+		assert.True(t, suspended)
+	})
+}
@@ -142,7 +142,10 @@ func TestOrganizationHandlerIntegrationService(t *testing.T) {
 			Description:    "This is a description",
 			Qualifications: "Bachelor's degree in Computer Science",
 			Quantity:       1,
-			Salary:         30000,
+			SalaryMin:      30000,
+			SalaryMax:      30000,
+			SalaryCurrency: "THB",
+			SalaryPeriod:   models.SalaryPeriodMonthly,
 			RegisterLink:   "https://example.com",
 			Province:       "Chiang Mai",
 			Country:        "TH",
@@ -160,7 +163,7 @@ func TestOrganizationHandlerIntegrationService(t *testing.T) {
 		orgRepo := repository.NewOrganizationRepositoryMock()
 		preqRepo := repository.NewPrerequisiteRepositoryMock()
 		jobSrv := service.NewOrgOpenJobService(jobRepo, orgRepo, preqRepo, test.DB_TEST, initializers.ESClient, initializers.S3)
-		jobHandler := handler.NewOrgOpenJobHandler(jobSrv)
+		jobHandler := handler.NewOrgOpenJobHandler(jobSrv, nil)
 
 		// rbac := middleware.NewRBACMiddleware(initializers.Enforcer)
 		// enforceMiddlewareWithOpenJob := rbac.EnforceMiddlewareWithResources("OrganizationOpenJob")
@@ -0,0 +1,132 @@
+//go:build integration
+
+package integration_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/models"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/repository"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func createPromoCode(t *testing.T, db *gorm.DB, eventID uint, maxUses *int, maxUsesPerUser int) models.PromoCode {
+	t.Helper()
+
+	promo := models.PromoCode{
+		EventID:        eventID,
+		Code:           "EARLYBIRD",
+		DiscountType:   models.DiscountPercent,
+		DiscountValue:  20,
+		MaxUses:        maxUses,
+		MaxUsesPerUser: maxUsesPerUser,
+		IsActive:       true,
+	}
+	if err := db.Create(&promo).Error; err != nil {
+		t.Fatalf("failed to create promo code: %v", err)
+	}
+
+	return promo
+}
+
+func countRedemptions(t *testing.T, db *gorm.DB, promoID uint) int64 {
+	t.Helper()
+
+	var count int64
+	if err := db.Model(&models.PromoRedemption{}).
+		Where("promo_code_id = ? AND released_at IS NULL", promoID).
+		Count(&count).Error; err != nil {
+		t.Fatal(err)
+	}
+
+	return count
+}
+
+func TestPromoCodeMaxUsesUnderConcurrentRedemption(t *testing.T) {
+	// ARRANGE
+	db := openTestDB(t)
+	org := createOrganization(t, db)
+	event := createEvent(t, db, org.ID)
+	ticket := createTicket(t, db, event.ID, 10, 500)
+	maxUses := 3
+	promo := createPromoCode(t, db, event.ID, &maxUses, 1)
+
+	purchases := make([]models.TicketPurchased, 0, 8)
+	for i := 0; i < 8; i++ {
+		purchases = append(purchases, models.TicketPurchased{
+			UserID:            createUser(t, db).ID,
+			EventID:           event.ID,
+			TicketAvailableID: ticket.ID,
+			Status:            models.TicketStatusPending,
+		})
+	}
+
+	// ACT
+	results := registerConcurrently(repository.NewTicketPurchasedRepository(db), purchases, "earlybird")
+
+	// ASSERT
+	succeeded, usedUp := countErrors(t, results, repository.ErrPromoCodeUsedUp)
+	assert.Equal(t, maxUses, succeeded)
+	assert.Equal(t, len(purchases)-maxUses, usedUp)
+	assert.Equal(t, int64(maxUses), countRedemptions(t, db, promo.ID))
+
+	redeemed := models.PromoCode{}
+	if assert.NoError(t, db.First(&redeemed, promo.ID).Error) {
+		assert.Equal(t, maxUses, redeemed.UsedCount)
+	}
+
+	// Refused redemptions give their seat back
+	remaining := models.TicketAvailable{}
+	if assert.NoError(t, db.First(&remaining, ticket.ID).Error) {
+		assert.Equal(t, 10-maxUses, remaining.Quantity)
+	}
+}
+
+func TestPromoCodeMaxUsesPerUserUnderConcurrentRedemption(t *testing.T) {
+	// ARRANGE
+	db := openTestDB(t)
+	org := createOrganization(t, db)
+	event := createEvent(t, db, org.ID)
+	user := createUser(t, db)
+	// Different ticket types are serialized only by the promo code lock
+	tickets := []models.TicketAvailable{
+		createTicket(t, db, event.ID, 10, 500),
+		createTicket(t, db, event.ID, 10, 800),
+	}
+	promo := createPromoCode(t, db, event.ID, nil, 1)
+
+	purchases := make([]models.TicketPurchased, 0, 6)
+	for i := 0; i < 6; i++ {
+		purchases = append(purchases, models.TicketPurchased{
+			UserID:            user.ID,
+			EventID:           event.ID,
+			TicketAvailableID: tickets[i%len(tickets)].ID,
+			Status:            models.TicketStatusPending,
+		})
+	}
+
+	// ACT
+	results := registerConcurrently(repository.NewTicketPurchasedRepository(db), purchases, "EARLYBIRD")
+
+	// ASSERT
+	succeeded := 0
+	for _, err := range results {
+		switch {
+		case err == nil:
+			succeeded++
+		// Whichever check the losing registration reaches first refuses it
+		case errors.Is(err, repository.ErrPromoCodeUserLimit), errors.Is(err, repository.ErrAlreadyRegistered):
+		default:
+			t.Errorf("unexpected registration error: %v", err)
+		}
+	}
+	assert.Equal(t, 1, succeeded)
+	assert.Equal(t, int64(1), countRedemptions(t, db, promo.ID))
+
+	redeemed := models.PromoCode{}
+	if assert.NoError(t, db.First(&redeemed, promo.ID).Error) {
+		assert.Equal(t, 1, redeemed.UsedCount)
+	}
+}
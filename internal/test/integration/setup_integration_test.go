@@ -0,0 +1,165 @@
+//go:build integration
+
+package integration_test
+
+import (
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/models"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/utils"
+	"github.com/google/uuid"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+var (
+	migrateOnce sync.Once
+	migrateErr  error
+)
+
+// openTestDB connects to the database of DATABASE_URL_TEST and migrates the tables of users, events, tickets and orders.
+// The tests using it are skipped when the variable is not set.
+func openTestDB(t *testing.T) *gorm.DB {
+	t.Helper()
+
+	dsn := os.Getenv("DATABASE_URL_TEST")
+	if dsn == "" {
+		t.Skip("DATABASE_URL_TEST is not set")
+	}
+
+	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+		// The tests create only the rows they need
+		DisableForeignKeyConstraintWhenMigrating: true,
+	})
+	if err != nil {
+		t.Fatalf("failed to connect to the test database: %v", err)
+	}
+
+	migrateOnce.Do(func() {
+		// Ticket ids are declared with the uint type of the main database, users with its enums
+		for _, statement := range []string{
+			`DO $$ BEGIN CREATE DOMAIN uint AS bigint CHECK (VALUE >= 0);
+				EXCEPTION WHEN duplicate_object THEN NULL; END $$`,
+			`DO $$ BEGIN CREATE TYPE role AS ENUM ('User', 'Admin');
+				EXCEPTION WHEN duplicate_object THEN NULL; END $$`,
+			`DO $$ BEGIN CREATE TYPE provider AS ENUM ('google', 'facebook', 'local');
+				EXCEPTION WHEN duplicate_object THEN NULL; END $$`,
+			`CREATE EXTENSION IF NOT EXISTS "uuid-ossp"`,
+		} {
+			if migrateErr = db.Exec(statement).Error; migrateErr != nil {
+				return
+			}
+		}
+
+		migrateErr = db.AutoMigrate(
+			&models.User{},
+			&models.Organization{}, &models.OrganizationTranslation{},
+			&models.Category{}, &models.CategoryTranslation{},
+			&models.Event{}, &models.EventTranslation{}, &models.EventSession{}, &models.ContactChannel{},
+			&models.EventSpeaker{}, &models.AgendaItem{}, &models.EventMaterial{}, &models.EventCoHost{},
+			&models.TicketAvailable{}, &models.TicketPurchased{}, &models.EventParticipant{},
+			&models.WaitlistEntry{}, &models.Order{}, &models.Payment{},
+			&models.PromoCode{}, &models.PromoRedemption{},
+		)
+	})
+	if migrateErr != nil {
+		t.Fatalf("failed to migrate the test database: %v", migrateErr)
+	}
+
+	return db
+}
+
+// createUser creates a user removed with its tickets and waitlist entries when the test ends
+func createUser(t *testing.T, db *gorm.DB) models.User {
+	t.Helper()
+
+	user := models.User{
+		Name:       "Tester",
+		Email:      uuid.NewString() + "@talentsatmos.com",
+		Provider:   models.ProviderLocal,
+		ProviderID: uuid.NewString(),
+	}
+	if err := db.Create(&user).Error; err != nil {
+		t.Fatalf("failed to create user: %v", err)
+	}
+
+	t.Cleanup(func() {
+		for _, model := range []interface{}{
+			&models.PromoRedemption{}, &models.WaitlistEntry{}, &models.EventParticipant{}, &models.TicketPurchased{},
+		} {
+			db.Unscoped().Where("user_id = ?", user.ID).Delete(model)
+		}
+		db.Unscoped().Delete(&models.User{}, "id = ?", user.ID)
+	})
+
+	return user
+}
+
+// createTicket creates a ticket type of the event, removed with the event
+func createTicket(t *testing.T, db *gorm.DB, eventID uint, quantity int, price float64) models.TicketAvailable {
+	t.Helper()
+
+	ticket := models.TicketAvailable{
+		Title:    "General Admission",
+		Quantity: quantity,
+		Price:    price,
+		EventID:  eventID,
+	}
+	if err := db.Create(&ticket).Error; err != nil {
+		t.Fatalf("failed to create ticket: %v", err)
+	}
+
+	return ticket
+}
+
+// createOrganization creates an organization removed with everything it hosts when the test ends
+func createOrganization(t *testing.T, db *gorm.DB) models.Organization {
+	t.Helper()
+
+	org := models.Organization{
+		Name:  "Talents Atmos",
+		Email: uuid.NewString() + "@talentsatmos.com",
+	}
+	if err := db.Create(&org).Error; err != nil {
+		t.Fatalf("failed to create organization: %v", err)
+	}
+
+	t.Cleanup(func() {
+		db.Unscoped().Delete(&models.Organization{}, org.ID)
+	})
+
+	return org
+}
+
+// createEvent creates an event of the organization next month, removed with its tickets when the test ends
+func createEvent(t *testing.T, db *gorm.DB, orgID uint) models.Event {
+	t.Helper()
+
+	event := models.Event{
+		Name:           "Builds Renewable Energy Summit",
+		StartDate:      utils.DateOnly{Time: time.Now().AddDate(0, 1, 0)},
+		EndDate:        utils.DateOnly{Time: time.Now().AddDate(0, 1, 1)},
+		PriceType:      "free",
+		OrganizationID: orgID,
+	}
+	if err := db.Create(&event).Error; err != nil {
+		t.Fatalf("failed to create event: %v", err)
+	}
+
+	t.Cleanup(func() {
+		for _, model := range []interface{}{
+			&models.PromoRedemption{}, &models.PromoCode{}, &models.Order{}, &models.WaitlistEntry{},
+			&models.EventParticipant{}, &models.TicketPurchased{}, &models.TicketAvailable{}, &models.EventCoHost{},
+		} {
+			db.Unscoped().Where("event_id = ?", event.ID).Delete(model)
+		}
+		db.Unscoped().Delete(&models.Event{}, event.ID)
+	})
+
+	return event
+}
@@ -0,0 +1,135 @@
+//go:build integration
+
+package integration_test
+
+import (
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/models"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/repository"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+// registerConcurrently registers every purchase at the same time and returns the error of each registration
+func registerConcurrently(purchaseRepo models.TicketPurchasedRepository, purchases []models.TicketPurchased, promoCode string) []error {
+	results := make([]error, len(purchases))
+
+	var wg sync.WaitGroup
+	for i := range purchases {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i] = purchaseRepo.Register(&purchases[i], promoCode)
+		}(i)
+	}
+	wg.Wait()
+
+	return results
+}
+
+// countErrors counts the registrations that succeeded and those that failed with target
+func countErrors(t *testing.T, results []error, target error) (succeeded int, failed int) {
+	t.Helper()
+
+	for _, err := range results {
+		switch {
+		case err == nil:
+			succeeded++
+		case errors.Is(err, target):
+			failed++
+		default:
+			t.Errorf("unexpected registration error: %v", err)
+		}
+	}
+
+	return succeeded, failed
+}
+
+func countActivePurchases(t *testing.T, db *gorm.DB, where string, args ...interface{}) int64 {
+	t.Helper()
+
+	var count int64
+	if err := db.Model(&models.TicketPurchased{}).
+		Where("status <> ?", models.TicketStatusCancelled).
+		Where(where, args...).
+		Count(&count).Error; err != nil {
+		t.Fatal(err)
+	}
+
+	return count
+}
+
+func TestRegisterOncePerUser(t *testing.T) {
+	// ARRANGE
+	db := openTestDB(t)
+	org := createOrganization(t, db)
+	event := createEvent(t, db, org.ID)
+	user := createUser(t, db)
+	// Different ticket types are not serialized by the ticket lock
+	tickets := []models.TicketAvailable{
+		createTicket(t, db, event.ID, 10, 0),
+		createTicket(t, db, event.ID, 10, 0),
+	}
+
+	purchases := make([]models.TicketPurchased, 0, 6)
+	for i := 0; i < 6; i++ {
+		purchases = append(purchases, models.TicketPurchased{
+			UserID:            user.ID,
+			EventID:           event.ID,
+			TicketAvailableID: tickets[i%len(tickets)].ID,
+			Status:            models.TicketStatusConfirmed,
+		})
+	}
+
+	// ACT
+	results := registerConcurrently(repository.NewTicketPurchasedRepository(db), purchases, "")
+
+	// ASSERT
+	succeeded, refused := countErrors(t, results, repository.ErrAlreadyRegistered)
+	assert.Equal(t, 1, succeeded)
+	assert.Equal(t, len(purchases)-1, refused)
+	assert.Equal(t, int64(1), countActivePurchases(t, db, "user_id = ? AND event_id = ?", user.ID, event.ID))
+
+	// Only the seat of the registration is taken
+	var seats int64
+	assert.NoError(t, db.Model(&models.TicketAvailable{}).
+		Select("SUM(quantity)").
+		Where("event_id = ?", event.ID).
+		Scan(&seats).Error)
+	assert.Equal(t, int64(19), seats)
+}
+
+func TestRegisterDecrementsSeatsUnderLock(t *testing.T) {
+	// ARRANGE
+	db := openTestDB(t)
+	org := createOrganization(t, db)
+	event := createEvent(t, db, org.ID)
+	ticket := createTicket(t, db, event.ID, 3, 0)
+
+	purchases := make([]models.TicketPurchased, 0, 8)
+	for i := 0; i < 8; i++ {
+		purchases = append(purchases, models.TicketPurchased{
+			UserID:            createUser(t, db).ID,
+			EventID:           event.ID,
+			TicketAvailableID: ticket.ID,
+			Status:            models.TicketStatusConfirmed,
+		})
+	}
+
+	// ACT
+	results := registerConcurrently(repository.NewTicketPurchasedRepository(db), purchases, "")
+
+	// ASSERT
+	succeeded, soldOut := countErrors(t, results, repository.ErrTicketSoldOut)
+	assert.Equal(t, 3, succeeded)
+	assert.Equal(t, 5, soldOut)
+	assert.Equal(t, int64(3), countActivePurchases(t, db, "ticket_available_id = ?", ticket.ID))
+
+	remaining := models.TicketAvailable{}
+	if assert.NoError(t, db.First(&remaining, ticket.ID).Error) {
+		assert.Equal(t, 0, remaining.Quantity)
+	}
+}
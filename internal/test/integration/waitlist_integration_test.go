@@ -0,0 +1,110 @@
+//go:build integration
+
+package integration_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/models"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/repository"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWaitlistOfferExpiry(t *testing.T) {
+	// ARRANGE
+	db := openTestDB(t)
+	org := createOrganization(t, db)
+	event := createEvent(t, db, org.ID)
+	ticket := createTicket(t, db, event.ID, 0, 0)
+	first := createUser(t, db)
+	second := createUser(t, db)
+
+	waitlistRepo := repository.NewWaitlistRepository(db)
+
+	entries := make([]models.WaitlistEntry, 0, 2)
+	for _, user := range []models.User{first, second} {
+		entry := models.WaitlistEntry{TicketAvailableID: ticket.ID, EventID: event.ID, UserID: user.ID}
+		if err := waitlistRepo.Join(&entry); err != nil {
+			t.Fatalf("failed to join the waitlist: %v", err)
+		}
+		entries = append(entries, entry)
+	}
+
+	// The organizer offers a seat to the first entry, the offer has run out by the time it is claimed
+	expired, err := waitlistRepo.Promote(entries[0].ID, time.Now().Add(-time.Minute))
+	if err != nil {
+		t.Fatalf("failed to promote the entry: %v", err)
+	}
+
+	claim := func(token uuid.UUID, userID uuid.UUID) error {
+		return waitlistRepo.Claim(token, userID, &models.TicketPurchased{UserID: userID, Status: models.TicketStatusConfirmed})
+	}
+
+	t.Run("TestClaimExpiredOffer", func(t *testing.T) {
+		err := claim(*expired.OfferToken, first.ID)
+		assert.True(t, errors.Is(err, repository.ErrWaitlistOfferExpired), "got %v", err)
+	})
+
+	var next models.WaitlistEntry
+	t.Run("TestExpireOffers", func(t *testing.T) {
+		now := time.Now()
+		offers, err := waitlistRepo.ExpireOffers(now, now.Add(24*time.Hour))
+		if !assert.NoError(t, err) || !assert.Len(t, offers, 1) {
+			t.FailNow()
+		}
+
+		// The seat passes to the next entry in line
+		next = offers[0]
+		assert.Equal(t, entries[1].ID, next.ID)
+		assert.Equal(t, models.WaitlistStatusOffered, next.Status)
+
+		entry, err := waitlistRepo.GetByID(entries[0].ID)
+		if assert.NoError(t, err) {
+			assert.Equal(t, models.WaitlistStatusExpired, entry.Status)
+		}
+
+		// Offers still running are left alone
+		_, err = waitlistRepo.ExpireOffers(time.Now(), time.Now().Add(24*time.Hour))
+		assert.NoError(t, err)
+
+		entry, err = waitlistRepo.GetByID(next.ID)
+		if assert.NoError(t, err) {
+			assert.Equal(t, models.WaitlistStatusOffered, entry.Status)
+		}
+	})
+
+	t.Run("TestClaimOffer", func(t *testing.T) {
+		if next.OfferToken == nil {
+			t.Skip("no offer was passed on")
+		}
+
+		// Offers are personal
+		err := claim(*next.OfferToken, first.ID)
+		assert.Error(t, err)
+
+		assert.NoError(t, claim(*next.OfferToken, second.ID))
+
+		entry, err := waitlistRepo.GetByID(next.ID)
+		if assert.NoError(t, err) {
+			assert.Equal(t, models.WaitlistStatusClaimed, entry.Status)
+			assert.NotNil(t, entry.TicketPurchasedID)
+		}
+
+		err = claim(*next.OfferToken, second.ID)
+		assert.True(t, errors.Is(err, repository.ErrWaitlistEntryClosed), "got %v", err)
+	})
+
+	t.Run("TestClaimAfterExpiry", func(t *testing.T) {
+		err := claim(*expired.OfferToken, first.ID)
+		assert.True(t, errors.Is(err, repository.ErrWaitlistEntryClosed), "got %v", err)
+
+		// The seat held by the offers was never given back to the quantity
+		remaining := models.TicketAvailable{}
+		if assert.NoError(t, db.First(&remaining, ticket.ID).Error) {
+			assert.Equal(t, 0, remaining.Quantity)
+		}
+	})
+}
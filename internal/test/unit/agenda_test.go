@@ -0,0 +1,97 @@
+//go:build unit
+
+package unit_test
+
+import (
+	"testing"
+
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/dto"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/models"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/service"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func agendaEvent() models.Event {
+	return models.Event{
+		Model:    gorm.Model{ID: 7},
+		Sessions: []models.EventSession{{Model: gorm.Model{ID: 3}, EventID: 7}},
+	}
+}
+
+func agendaSpeakers() []models.EventSpeaker {
+	return []models.EventSpeaker{
+		{Model: gorm.Model{ID: 1}, EventID: 7, Name: "Anda"},
+		{Model: gorm.Model{ID: 2}, EventID: 7, Name: "Krit"},
+	}
+}
+
+func TestConvertToAgendaItems(t *testing.T) {
+	t.Run("KeepsOrderAndSpeakers", func(t *testing.T) {
+		sessionID := uint(3)
+		req := dto.AgendaRequest{Items: []dto.AgendaItemRequest{
+			{Title: "Opening", StartTime: "09:00:00", EndTime: "09:30:00", SpeakerIDs: []uint{2, 1, 2}},
+			{Title: "Workshop", SessionID: &sessionID},
+		}}
+
+		items, err := service.ConvertToAgendaItems(agendaEvent(), req, agendaSpeakers())
+		assert.NoError(t, err)
+		assert.Len(t, items, 2)
+		assert.Equal(t, "Opening", items[0].Title)
+		assert.Equal(t, uint(7), items[0].EventID)
+		assert.Len(t, items[0].Speakers, 2)
+		assert.Equal(t, "Krit", items[0].Speakers[0].Name)
+		assert.Equal(t, "Workshop", items[1].Title)
+		assert.Equal(t, &sessionID, items[1].SessionID)
+		assert.Empty(t, items[1].Speakers)
+	})
+
+	t.Run("RejectsForeignSpeaker", func(t *testing.T) {
+		req := dto.AgendaRequest{Items: []dto.AgendaItemRequest{{Title: "Panel", SpeakerIDs: []uint{9}}}}
+
+		_, err := service.ConvertToAgendaItems(agendaEvent(), req, agendaSpeakers())
+		assert.Error(t, err)
+	})
+
+	t.Run("RejectsForeignSession", func(t *testing.T) {
+		sessionID := uint(4)
+		req := dto.AgendaRequest{Items: []dto.AgendaItemRequest{{Title: "Panel", SessionID: &sessionID}}}
+
+		_, err := service.ConvertToAgendaItems(agendaEvent(), req, agendaSpeakers())
+		assert.Error(t, err)
+	})
+
+	t.Run("RejectsItemEndingBeforeStart", func(t *testing.T) {
+		req := dto.AgendaRequest{Items: []dto.AgendaItemRequest{{Title: "Panel", StartTime: "11:00:00", EndTime: "10:00:00"}}}
+
+		_, err := service.ConvertToAgendaItems(agendaEvent(), req, agendaSpeakers())
+		assert.Error(t, err)
+	})
+}
+
+func TestBuildEventSpeakerResponse(t *testing.T) {
+	userID := uuid.New()
+	speaker := models.EventSpeaker{
+		Model:   gorm.Model{ID: 1},
+		EventID: 7,
+		UserID:  &userID,
+		User:    &models.User{ID: userID, PicUrl: "https://example.com/user.jpg"},
+		Name:    "Anda",
+	}
+
+	res := dto.BuildEventSpeakerResponse(speaker)
+	assert.Equal(t, userID.String(), res.UserID)
+	assert.Equal(t, "https://example.com/user.jpg", res.PicUrl)
+
+	speaker.PicUrl = "https://example.com/speaker.jpg"
+	res = dto.BuildEventSpeakerResponse(speaker)
+	assert.Equal(t, "https://example.com/speaker.jpg", res.PicUrl)
+}
+
+func TestBuildAgendaItemResponseWithoutTimes(t *testing.T) {
+	res := dto.BuildAgendaItemResponse(models.AgendaItem{Title: "Networking"})
+	assert.Empty(t, res.StartTime)
+	assert.Empty(t, res.EndTime)
+	assert.NotNil(t, res.Speakers)
+}
@@ -0,0 +1,91 @@
+//go:build unit
+
+package unit_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/models"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/infrastructure/calendar"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/service"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func calendarTestEvent() models.Event {
+	event := models.Event{
+		Name:         "Builds CMU 2025",
+		StartDate:    utils.DateOnly{Time: utils.DateParser("2025-01-25")},
+		EndDate:      utils.DateOnly{Time: utils.DateParser("2025-01-26")},
+		StartTime:    utils.TimeOnly{Time: utils.TimeParser("09:00:00")},
+		EndTime:      utils.TimeOnly{Time: utils.TimeParser("17:30:00")},
+		LocationName: "Conference Hall, CMU",
+		Status:       string(models.Published),
+		Organization: models.Organization{Name: "DAF Bridge"},
+	}
+	event.ID = 7
+	return event
+}
+
+func TestEventTimes(t *testing.T) {
+	t.Run("TimedEvent", func(t *testing.T) {
+		start, end, allDay := service.EventTimes(calendarTestEvent())
+		assert.False(t, allDay)
+		assert.Equal(t, "2025-01-25T09:00:00+07:00", start.Format(time.RFC3339))
+		assert.Equal(t, "2025-01-26T17:30:00+07:00", end.Format(time.RFC3339))
+	})
+
+	t.Run("AllDayEvent", func(t *testing.T) {
+		event := calendarTestEvent()
+		event.StartTime = utils.TimeOnly{}
+		event.EndTime = utils.TimeOnly{}
+
+		start, end, allDay := service.EventTimes(event)
+		assert.True(t, allDay)
+		assert.Equal(t, "2025-01-25", start.Format("2006-01-02"))
+		// The end of an all day event is exclusive
+		assert.Equal(t, "2025-01-27", end.Format("2006-01-02"))
+	})
+
+	t.Run("MissingEndDate", func(t *testing.T) {
+		event := calendarTestEvent()
+		event.EndDate = utils.DateOnly{}
+		event.EndTime = utils.TimeOnly{Time: utils.TimeParser("08:00:00")}
+
+		start, end, _ := service.EventTimes(event)
+		assert.Equal(t, start.Add(time.Hour), end)
+	})
+}
+
+func TestRenderCalendar(t *testing.T) {
+	event := calendarTestEvent()
+	event.Name = "งานสัมมนาเทคโนโลยีเพื่อความยั่งยืน ครั้งที่ 1; Sustainability Tech Forum"
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	ics := string(calendar.Render("DAF Bridge events", []calendar.Event{service.ConvertToCalendarEvent(event, "https://talent.example.com")}, now))
+
+	assert.True(t, strings.HasPrefix(ics, "BEGIN:VCALENDAR\r\n"))
+	assert.True(t, strings.HasSuffix(ics, "END:VCALENDAR\r\n"))
+	assert.Contains(t, ics, "TZID:Asia/Bangkok\r\n")
+	assert.Contains(t, ics, "DTSTART;TZID=Asia/Bangkok:20250125T090000\r\n")
+	assert.Contains(t, ics, "DTEND;TZID=Asia/Bangkok:20250126T173000\r\n")
+	assert.Contains(t, ics, "UID:event-7@talent.example.com\r\n")
+	assert.Contains(t, ics, "LOCATION:Conference Hall\\, CMU\r\n")
+	assert.Contains(t, ics, "URL:https://talent.example.com/events/7\r\n")
+
+	for _, line := range strings.Split(strings.TrimSuffix(ics, "\r\n"), "\r\n") {
+		assert.LessOrEqual(t, len(line), 75)
+		assert.True(t, utf8.ValidString(line), "folding must not split a character: %q", line)
+	}
+
+	unfolded := strings.ReplaceAll(ics, "\r\n ", "")
+	assert.Contains(t, unfolded, "SUMMARY:งานสัมมนาเทคโนโลยีเพื่อความยั่งยืน ครั้งที่ 1\\; Sustainability Tech Forum\r\n")
+}
+
+func TestBuildCalendarFeedResponse(t *testing.T) {
+	res := service.BuildCalendarFeedResponse("https://api.example.com/calendar/users/abc.ics")
+	assert.Equal(t, "webcal://api.example.com/calendar/users/abc.ics", res.WebcalURL)
+}
@@ -0,0 +1,150 @@
+//go:build unit
+
+package unit_test
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/png"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/dto"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/models"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/infrastructure/certificate"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/infrastructure/resume"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/service"
+	"github.com/stretchr/testify/assert"
+)
+
+func certificateEvent(start string, end string) models.Event {
+	event := models.Event{Name: "Builds CMU 2025", Organization: models.Organization{Name: "DAF Bridge"}}
+	event.StartDate.Time, _ = time.Parse("2006-01-02", start)
+	if end != "" {
+		event.EndDate.Time, _ = time.Parse("2006-01-02", end)
+	}
+	return event
+}
+
+func TestNewCertificateCode(t *testing.T) {
+	format := regexp.MustCompile(`^[A-HJ-NP-Z2-9]{4}-[A-HJ-NP-Z2-9]{4}-[A-HJ-NP-Z2-9]{4}$`)
+
+	seen := map[string]bool{}
+	for i := 0; i < 50; i++ {
+		code, err := service.NewCertificateCode()
+		assert.NoError(t, err)
+		assert.Regexp(t, format, code)
+		assert.False(t, seen[code])
+		seen[code] = true
+	}
+
+	assert.Equal(t, "K7QX-2M9P-VH4D", service.NormalizeCertificateCode(" k7qx-2m9p-vh4d "))
+	assert.Equal(t, "https://talent-atmos.com/certificates/K7QX-2M9P-VH4D", service.CertificateVerifyURL("https://talent-atmos.com/", "K7QX-2M9P-VH4D"))
+}
+
+func TestConvertToCertificateTemplate(t *testing.T) {
+	template := service.ConvertToCertificateTemplate(7, dto.CertificateTemplateRequest{SignatureX: 50, SignatureY: 82})
+	assert.Equal(t, uint(7), template.EventID)
+	assert.Equal(t, float64(20), template.SignatureWidth)
+	assert.Len(t, template.Fields, len(service.DefaultCertificateFields()))
+	for i, field := range template.Fields {
+		assert.Equal(t, i, field.Position)
+		assert.Equal(t, "#1d1d39", field.Color)
+		assert.Equal(t, "center", field.Align)
+	}
+
+	template = service.ConvertToCertificateTemplate(7, dto.CertificateTemplateRequest{
+		SignatureWidth: 30,
+		Fields: []dto.CertificateFieldRequest{
+			{Text: "{name}", X: 10, Y: 40, FontSize: 28, Color: "#ff0000", Align: "left"},
+			{Text: "{event}", X: 50, Y: 50, FontSize: 16},
+		},
+	})
+	assert.Equal(t, float64(30), template.SignatureWidth)
+	assert.Len(t, template.Fields, 2)
+	assert.Equal(t, "left", template.Fields[0].Align)
+	assert.Equal(t, "#ff0000", template.Fields[0].Color)
+	assert.Equal(t, 1, template.Fields[1].Position)
+	assert.Equal(t, "center", template.Fields[1].Align)
+}
+
+func TestFormatCertificateDate(t *testing.T) {
+	assert.Equal(t, "25 January 2025", service.FormatCertificateDate(certificateEvent("2025-01-25", "")))
+	assert.Equal(t, "25 January 2025", service.FormatCertificateDate(certificateEvent("2025-01-25", "2025-01-25")))
+	assert.Equal(t, "24 - 26 January 2025", service.FormatCertificateDate(certificateEvent("2025-01-24", "2025-01-26")))
+	assert.Equal(t, "31 January - 2 February 2025", service.FormatCertificateDate(certificateEvent("2025-01-31", "2025-02-02")))
+	assert.Equal(t, "31 December 2024 - 1 January 2025", service.FormatCertificateDate(certificateEvent("2024-12-31", "2025-01-01")))
+}
+
+func TestBuildCertificatePage(t *testing.T) {
+	template := models.CertificateTemplate{
+		SignatureX:     50,
+		SignatureY:     80,
+		SignatureWidth: 20,
+		Fields: []models.CertificateField{
+			{Text: "{name}", X: 50, Y: 50, FontSize: 30, Color: "#ff0000", Bold: true, Align: "center"},
+			{Text: "{event} by {organization} on {date}", X: 0, Y: 0, FontSize: 12, Color: "bad", Align: "left"},
+		},
+	}
+	signature := &certificate.Image{Width: 200, Height: 100}
+	values := service.ConvertToCertificateValues(certificateEvent("2025-01-25", ""), "Anda Raiwin", "K7QX-2M9P-VH4D", "https://talent-atmos.com")
+
+	page := service.BuildCertificatePage(template, service.CertificateImages{Signature: signature}, values)
+
+	assert.Nil(t, page.Background)
+	if assert.Len(t, page.Images, 1) {
+		width := certificate.PageWidth / 5
+		assert.InDelta(t, width, page.Images[0].Width, 0.001)
+		assert.InDelta(t, certificate.PageWidth/2-width/2, page.Images[0].X, 0.001)
+		assert.InDelta(t, certificate.PageHeight*0.2, page.Images[0].Y, 0.001)
+	}
+
+	if assert.Len(t, page.Texts, 3) {
+		assert.Equal(t, "Anda Raiwin", page.Texts[0].Value)
+		assert.InDelta(t, certificate.PageWidth/2, page.Texts[0].X, 0.001)
+		assert.InDelta(t, certificate.PageHeight/2, page.Texts[0].Y, 0.001)
+		assert.Equal(t, [3]float64{1, 0, 0}, page.Texts[0].Color)
+		assert.True(t, page.Texts[0].Bold)
+
+		assert.Equal(t, "Builds CMU 2025 by DAF Bridge on 25 January 2025", page.Texts[1].Value)
+		assert.InDelta(t, certificate.PageHeight, page.Texts[1].Y, 0.001)
+		assert.Equal(t, certificate.AlignLeft, page.Texts[1].Align)
+		assert.InDelta(t, float64(0x1d)/255, page.Texts[1].Color[0], 0.001)
+
+		assert.Equal(t, "Certificate K7QX-2M9P-VH4D - verify at https://talent-atmos.com/certificates/K7QX-2M9P-VH4D", page.Texts[2].Value)
+	}
+}
+
+func TestRenderCertificatePDF(t *testing.T) {
+	var buffer bytes.Buffer
+	img := image.NewNRGBA(image.Rect(0, 0, 4, 2))
+	for x := 0; x < 4; x++ {
+		img.Set(x, 0, color.NRGBA{R: 200, A: 255})
+		img.Set(x, 1, color.NRGBA{B: 200, A: 128})
+	}
+	assert.NoError(t, png.Encode(&buffer, img))
+
+	signature, err := certificate.LoadImage(buffer.Bytes())
+	assert.NoError(t, err)
+	assert.Equal(t, 4, signature.Width)
+	assert.Equal(t, 2, signature.Height)
+
+	template := service.ConvertToCertificateTemplate(7, dto.CertificateTemplateRequest{SignatureX: 50, SignatureY: 82})
+	values := service.ConvertToCertificateValues(certificateEvent("2025-01-25", ""), "Anda Raiwin", "K7QX-2M9P-VH4D", "https://talent-atmos.com")
+	data, err := certificate.RenderPDF(service.BuildCertificatePage(template, service.CertificateImages{Signature: signature}, values), nil)
+	assert.NoError(t, err)
+	assert.True(t, bytes.HasPrefix(data, []byte("%PDF-")))
+
+	text, err := resume.ExtractText(data, resume.ContentTypePDF)
+	assert.NoError(t, err)
+	assert.Contains(t, text, "Anda Raiwin")
+	assert.Contains(t, text, "Builds CMU 2025")
+	assert.Contains(t, text, "K7QX-2M9P-VH4D")
+}
+
+func TestLoadCertificateImageRejectsOtherFiles(t *testing.T) {
+	_, err := certificate.LoadImage([]byte("%PDF-1.4 not an image"))
+	assert.ErrorIs(t, err, certificate.ErrInvalidImage)
+}
@@ -0,0 +1,105 @@
+//go:build unit
+
+package unit_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/errs"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/dto"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/models"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/service"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/pkg/authorization"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func coHostedEvent() models.Event {
+	return models.Event{
+		Model:          gorm.Model{ID: 7},
+		Name:           "Builds CMU 2025",
+		OrganizationID: 1,
+		Organization:   models.Organization{Model: gorm.Model{ID: 1}, Name: "DAF Bridge"},
+		CoHosts: []models.EventCoHost{
+			{EventID: 7, OrganizationID: 2, Status: models.CoHostAccepted, Organization: models.Organization{Model: gorm.Model{ID: 2}, Name: "CMU Makerspace"}},
+			{EventID: 7, OrganizationID: 3, Status: models.CoHostPending, Organization: models.Organization{Model: gorm.Model{ID: 3}, Name: "Pending Partner"}},
+		},
+	}
+}
+
+func assertAppError(t *testing.T, err error, code int) {
+	appErr, ok := err.(errs.AppError)
+	if assert.True(t, ok, "expected an AppError, got %v", err) {
+		assert.Equal(t, code, appErr.Code)
+	}
+}
+
+func TestCheckCoHostInvite(t *testing.T) {
+	event := coHostedEvent()
+
+	assert.NoError(t, service.CheckCoHostInvite(event, 1, 4))
+	assertAppError(t, service.CheckCoHostInvite(event, 2, 4), http.StatusForbidden)
+	assertAppError(t, service.CheckCoHostInvite(event, 1, 1), http.StatusBadRequest)
+}
+
+func TestCheckCoHostRemoval(t *testing.T) {
+	event := coHostedEvent()
+
+	assert.NoError(t, service.CheckCoHostRemoval(event, 1, 2))
+	assert.NoError(t, service.CheckCoHostRemoval(event, 2, 2))
+	assertAppError(t, service.CheckCoHostRemoval(event, 2, 3), http.StatusForbidden)
+	assertAppError(t, service.CheckCoHostRemoval(event, 2, 1), http.StatusForbidden)
+	assertAppError(t, service.CheckCoHostRemoval(event, 1, 1), http.StatusBadRequest)
+}
+
+func TestEventResponsesListAcceptedCoHosts(t *testing.T) {
+	event := coHostedEvent()
+
+	res := service.ConvertToEventResponse(event)
+	assert.Equal(t, []dto.EventHostResponse{{ID: 2, Name: "CMU Makerspace"}}, res.CoHosts)
+
+	doc := service.ConvertToEventDocumentResponse(event)
+	assert.Equal(t, []dto.OrganizationShortDocument{{ID: 2, Name: "CMU Makerspace"}}, doc.CoHosts)
+
+	event.CoHosts = nil
+	assert.Equal(t, []dto.EventHostResponse{}, service.ConvertToEventResponse(event).CoHosts)
+}
+
+func TestBuildCoHostInvitation(t *testing.T) {
+	event := coHostedEvent()
+	invited := models.Organization{Model: gorm.Model{ID: 3}, Name: "Pending Partner", Email: "partner@example.com"}
+
+	mail := service.ConvertToCoHostInvitationMail(event, invited)
+	assert.Equal(t, "partner@example.com", mail.ToEmail)
+	assert.Equal(t, "DAF Bridge invites you to co-host Builds CMU 2025", mail.Subject)
+	assert.Equal(t, "DAF Bridge", mail.Body.OrganizerName)
+	assert.Equal(t, uint(3), mail.Body.OrganizationID)
+
+	invitation := dto.BuildCoHostInvitationResponse(models.EventCoHost{
+		Model:          gorm.Model{ID: 5},
+		EventID:        7,
+		Event:          event,
+		OrganizationID: 3,
+		Status:         models.CoHostPending,
+	})
+	assert.Equal(t, uint(5), invitation.ID)
+	assert.Equal(t, "Builds CMU 2025", invitation.EventName)
+	assert.Equal(t, "DAF Bridge", invitation.Organizer.Name)
+}
+
+func TestCoHostPermissions(t *testing.T) {
+	allowed := map[string]map[string]bool{}
+	for _, policy := range authorization.GetPermissionsList() {
+		if policy[1] != "EventCoHost" {
+			continue
+		}
+		if allowed[policy[0]] == nil {
+			allowed[policy[0]] = map[string]bool{}
+		}
+		allowed[policy[0]][policy[2]] = true
+	}
+
+	assert.Equal(t, map[string]bool{"read": true}, allowed["moderator"])
+	assert.Equal(t, map[string]bool{"read": true, "invite": true, "accept": true, "remove": true}, allowed["owner"])
+}
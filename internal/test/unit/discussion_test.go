@@ -0,0 +1,145 @@
+//go:build unit
+
+package unit_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/errs"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/dto"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/models"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/repository"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/service"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func boolPtr(b bool) *bool {
+	return &b
+}
+
+func discussionQuestion() models.DiscussionQuestion {
+	return models.DiscussionQuestion{
+		Model:      gorm.Model{ID: 1},
+		TargetType: models.DiscussionEvent,
+		TargetID:   7,
+		UserID:     uuid.MustParse("e2b3c4d5-6f70-4a1b-9c2d-3e4f5a6b7c8d"),
+		User:       models.User{Name: "Somchai", Email: "somchai@example.com"},
+		Body:       "Is parking available at the venue?",
+	}
+}
+
+func TestDiscussionPath(t *testing.T) {
+	assert.Equal(t, "/events/7", service.DiscussionPath(models.DiscussionEvent, 7))
+	assert.Equal(t, "/jobs/3", service.DiscussionPath(models.DiscussionJob, 3))
+}
+
+func TestCheckDiscussionReply(t *testing.T) {
+	question := discussionQuestion()
+	assert.NoError(t, service.CheckDiscussionReply(question, false))
+
+	question.Locked = true
+	assertAppError(t, service.CheckDiscussionReply(question, false), 403)
+	assert.NoError(t, service.CheckDiscussionReply(question, true))
+
+	question.Hidden = true
+	err := service.CheckDiscussionReply(question, false)
+	assertAppError(t, err, 404)
+	assert.Equal(t, "question not found", err.(errs.AppError).Message)
+}
+
+func TestApplyQuestionModeration(t *testing.T) {
+	question := discussionQuestion()
+	service.ApplyQuestionModeration(&question, dto.DiscussionQuestionModerationRequest{Locked: boolPtr(true)})
+	assert.True(t, question.Locked)
+	assert.False(t, question.Hidden)
+
+	service.ApplyQuestionModeration(&question, dto.DiscussionQuestionModerationRequest{Hidden: boolPtr(true), Locked: boolPtr(false)})
+	assert.True(t, question.Hidden)
+	assert.False(t, question.Locked)
+}
+
+func TestApplyAnswerModeration(t *testing.T) {
+	first := time.Date(2025, 1, 20, 3, 0, 0, 0, time.UTC)
+	answer := models.DiscussionAnswer{}
+
+	service.ApplyAnswerModeration(&answer, dto.DiscussionAnswerModerationRequest{Pinned: boolPtr(true)}, first)
+	assert.Equal(t, &first, answer.PinnedAt)
+
+	// Pinning again keeps the place of the answer in the FAQ
+	service.ApplyAnswerModeration(&answer, dto.DiscussionAnswerModerationRequest{Pinned: boolPtr(true), Hidden: boolPtr(true)}, first.Add(time.Hour))
+	assert.Equal(t, &first, answer.PinnedAt)
+	assert.True(t, answer.Hidden)
+
+	service.ApplyAnswerModeration(&answer, dto.DiscussionAnswerModerationRequest{Pinned: boolPtr(false)}, first)
+	assert.Nil(t, answer.PinnedAt)
+	assert.True(t, answer.Hidden)
+}
+
+func TestShouldNotifyDiscussionReply(t *testing.T) {
+	question := discussionQuestion()
+	reply := models.DiscussionAnswer{UserID: uuid.New()}
+
+	assert.True(t, service.ShouldNotifyDiscussionReply(question, reply, models.NotificationPreference{DiscussionReplies: true}))
+	assert.False(t, service.ShouldNotifyDiscussionReply(question, reply, models.NotificationPreference{DiscussionReplies: false}))
+
+	ownReply := models.DiscussionAnswer{UserID: question.UserID}
+	assert.False(t, service.ShouldNotifyDiscussionReply(question, ownReply, models.NotificationPreference{DiscussionReplies: true}))
+}
+
+func TestConvertToDiscussionReplyMail(t *testing.T) {
+	answer := models.DiscussionAnswer{Body: "Yes, the parking of the faculty is open", Official: true}
+
+	config := service.ConvertToDiscussionReplyMail(discussionQuestion(), answer, "Builds CMU 2025", "Builds")
+	assert.Equal(t, repository.DiscussionReplyMailConfig{
+		ToEmail: "somchai@example.com",
+		Subject: "Builds answered your question about Builds CMU 2025",
+		Body: repository.DiscussionReplyMailBody{
+			AuthorName:       "Somchai",
+			Title:            "Builds CMU 2025",
+			Question:         "Is parking available at the venue?",
+			Answer:           "Yes, the parking of the faculty is open",
+			Official:         true,
+			Path:             "/events/7",
+			OrganizationName: "Builds",
+		},
+	}, config)
+
+	answer.Official = false
+	config = service.ConvertToDiscussionReplyMail(discussionQuestion(), answer, "Builds CMU 2025", "Builds")
+	assert.Equal(t, "New reply to your question about Builds CMU 2025", config.Subject)
+}
+
+func TestBuildDiscussionQuestionResponse(t *testing.T) {
+	createdAt := time.Date(2025, 1, 20, 10, 0, 0, 0, time.FixedZone("ICT", 7*60*60))
+	pinnedAt := createdAt.Add(time.Hour)
+
+	question := discussionQuestion()
+	question.CreatedAt = createdAt
+	question.Answers = []models.DiscussionAnswer{{
+		Model:      gorm.Model{ID: 2, CreatedAt: createdAt},
+		QuestionID: 1,
+		User:       models.User{Name: "Builds"},
+		Body:       "Yes",
+		Official:   true,
+		PinnedAt:   &pinnedAt,
+	}}
+
+	res := dto.BuildDiscussionQuestionResponse(question)
+	assert.Equal(t, "Somchai", res.Author.Name)
+	assert.Equal(t, "2025-01-20T03:00:00Z", res.CreatedAt)
+	assert.Equal(t, []dto.DiscussionAnswerResponse{{
+		ID:         2,
+		QuestionID: 1,
+		Author:     dto.DiscussionAuthorResponse{Name: "Builds"},
+		Body:       "Yes",
+		Official:   true,
+		Pinned:     true,
+		CreatedAt:  "2025-01-20T03:00:00Z",
+	}}, res.Answers)
+
+	// Questions without answers list an empty thread
+	assert.Equal(t, []dto.DiscussionAnswerResponse{}, dto.BuildDiscussionQuestionResponse(discussionQuestion()).Answers)
+}
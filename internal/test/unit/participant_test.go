@@ -0,0 +1,112 @@
+//go:build unit
+
+package unit_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/dto"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/models"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/infrastructure/export"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/service"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func TestConvertToParticipantFilter(t *testing.T) {
+	filter := service.ConvertToParticipantFilter(dto.ParticipantQuery{})
+	assert.Nil(t, filter.CheckedIn)
+	assert.Empty(t, filter.Status)
+
+	filter = service.ConvertToParticipantFilter(dto.ParticipantQuery{TicketID: 3, CheckedIn: "false", Status: "confirmed"})
+	assert.Equal(t, uint(3), filter.TicketAvailableID)
+	assert.NotNil(t, filter.CheckedIn)
+	assert.False(t, *filter.CheckedIn)
+	assert.Equal(t, models.TicketStatusConfirmed, filter.Status)
+}
+
+func TestBuildParticipantTable(t *testing.T) {
+	checkedInAt := time.Date(2025, 1, 25, 8, 45, 0, 0, time.UTC)
+	purchases := []models.TicketPurchased{
+		{Model: gorm.Model{ID: 1}, Username: "Anda", Email: "anda@example.com", TicketTitle: "Early Bird", Price: 400, Status: models.TicketStatusConfirmed, CheckedInAt: &checkedInAt},
+		{Model: gorm.Model{ID: 2}, Username: "Krit", Email: "krit@example.com", TicketTitle: "Regular", Status: models.TicketStatusPending},
+	}
+
+	table := service.BuildParticipantTable(purchases)
+	assert.Len(t, table.Rows, 2)
+	assert.Len(t, table.Rows[0], len(table.Header))
+	assert.Equal(t, "400.00", table.Rows[0][5])
+	assert.Equal(t, "2025-01-25 08:45:00", table.Rows[0][10])
+	assert.Equal(t, "", table.Rows[1][10])
+}
+
+func TestBuildAnnouncementMails(t *testing.T) {
+	event := models.Event{Model: gorm.Model{ID: 7}, Name: "Builds CMU 2025"}
+	purchases := []models.TicketPurchased{
+		{Username: "Anda", Email: "anda@example.com"},
+		{Username: "Anda", Email: "Anda@Example.com "},
+		{Username: "Krit", Email: "krit@example.com"},
+		{Username: "No mail"},
+	}
+
+	configs := service.BuildAnnouncementMails(event, purchases, models.EventAnnouncement{Subject: "Venue change", Message: "Room 301"})
+	assert.Len(t, configs, 2)
+	assert.Equal(t, "anda@example.com", configs[0].ToEmail)
+	assert.Equal(t, "krit@example.com", configs[1].ToEmail)
+	assert.Equal(t, uint(7), configs[1].Body.EventID)
+	assert.Equal(t, "Room 301", configs[1].Body.Message)
+}
+
+func TestExportFormats(t *testing.T) {
+	table := export.Table{
+		Header: []string{"Name", "Note"},
+		Rows:   [][]string{{"อันดา", "=1+1"}, {"Krit & co", "<b>"}},
+	}
+
+	t.Run("CSV", func(t *testing.T) {
+		var buf bytes.Buffer
+		assert.NoError(t, export.WriteCSV(&buf, table))
+		assert.Equal(t, "\ufeffName,Note\nอันดา,'=1+1\nKrit & co,<b>\n", buf.String())
+	})
+
+	t.Run("XLSX", func(t *testing.T) {
+		var buf bytes.Buffer
+		assert.NoError(t, export.WriteXLSX(&buf, "Participants", table))
+
+		archive, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+		assert.NoError(t, err)
+
+		files := map[string]string{}
+		for _, file := range archive.File {
+			reader, err := file.Open()
+			assert.NoError(t, err)
+			content, err := io.ReadAll(reader)
+			assert.NoError(t, err)
+			files[file.Name] = string(content)
+		}
+
+		assert.Contains(t, files, "[Content_Types].xml")
+		assert.Contains(t, files["xl/workbook.xml"], `name="Participants"`)
+		sheet := files["xl/worksheets/sheet1.xml"]
+		assert.Contains(t, sheet, `<c r="A2" t="inlineStr"><is><t xml:space="preserve">อันดา</t></is></c>`)
+		assert.Contains(t, sheet, "Krit &amp; co")
+		assert.Contains(t, sheet, "&lt;b&gt;")
+	})
+
+	t.Run("ParseFormat", func(t *testing.T) {
+		format, err := export.ParseFormat("")
+		assert.NoError(t, err)
+		assert.Equal(t, export.CSV, format)
+
+		format, err = export.ParseFormat("XLSX")
+		assert.NoError(t, err)
+		assert.Equal(t, export.XLSXContentType, format.ContentType())
+
+		_, err = export.ParseFormat("pdf")
+		assert.ErrorIs(t, err, export.ErrUnknownFormat)
+	})
+}
@@ -0,0 +1,95 @@
+//go:build unit
+
+package unit_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/dto"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/models"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/infrastructure/payment"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPromptPayPayload(t *testing.T) {
+	t.Run("PhoneNumberWithoutAmount", func(t *testing.T) {
+		payload, err := payment.PromptPayPayload("000-000-0000", 0)
+		assert.NoError(t, err)
+		assert.Equal(t, "00020101021129370016A000000677010111011300660000000005802TH530376463048956", payload)
+	})
+
+	t.Run("PhoneNumberWithAmount", func(t *testing.T) {
+		payload, err := payment.PromptPayPayload("0812345678", 4.22)
+		assert.NoError(t, err)
+		assert.Equal(t, "00020101021229370016A000000677010111011300668123456785802TH530376454044.2263045D49", payload)
+	})
+
+	t.Run("InvalidTarget", func(t *testing.T) {
+		_, err := payment.PromptPayPayload("12345", 100)
+		assert.Error(t, err)
+	})
+}
+
+func TestFakeProviderWebhook(t *testing.T) {
+	provider := payment.NewFakeProvider("secret")
+
+	charge, err := provider.CreateCharge(context.Background(), payment.ChargeRequest{
+		Reference: "ticket-1-1700000000",
+		Amount:    500,
+		Currency:  payment.CurrencyTHB,
+		ExpiresAt: time.Now().Add(30 * time.Minute),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "fake_ticket-1-1700000000", charge.ProviderRef)
+	assert.NotEmpty(t, charge.QRPayload)
+
+	body, signature, err := provider.Simulate(payment.WebhookEvent{
+		ProviderRef: charge.ProviderRef,
+		Status:      payment.WebhookStatusPaid,
+		Amount:      500,
+	})
+	assert.NoError(t, err)
+
+	t.Run("ValidSignature", func(t *testing.T) {
+		event, err := provider.ParseWebhook(body, signature)
+		assert.NoError(t, err)
+		assert.Equal(t, charge.ProviderRef, event.ProviderRef)
+		assert.Equal(t, payment.WebhookStatusPaid, event.Status)
+		assert.Equal(t, 500.0, event.Amount)
+	})
+
+	t.Run("TamperedBody", func(t *testing.T) {
+		tampered := append([]byte{}, body...)
+		tampered[len(tampered)-2] = '9'
+
+		_, err := provider.ParseWebhook(tampered, signature)
+		assert.ErrorIs(t, err, payment.ErrInvalidSignature)
+	})
+
+	t.Run("OtherSecret", func(t *testing.T) {
+		_, err := provider.ParseWebhook(body, payment.SignWebhook("other", body))
+		assert.ErrorIs(t, err, payment.ErrInvalidSignature)
+	})
+}
+
+func TestBuildOrderResponse(t *testing.T) {
+	order := models.Order{
+		Amount: 500,
+		Status: models.OrderStatusPending,
+		Payments: []models.Payment{
+			{Provider: "fake", ProviderRef: "fake_ticket-1-2", Status: models.PaymentStatusPending},
+			{Provider: "fake", ProviderRef: "fake_ticket-1-1", Status: models.PaymentStatusFailed},
+		},
+	}
+
+	res := dto.BuildOrderResponse(order)
+	assert.Equal(t, 500.0, res.Amount)
+	if assert.NotNil(t, res.Payment) {
+		assert.Equal(t, "fake_ticket-1-2", res.Payment.ProviderRef)
+	}
+
+	res = dto.BuildOrderResponse(models.Order{})
+	assert.Nil(t, res.Payment)
+}
@@ -0,0 +1,140 @@
+//go:build unit
+
+package unit_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/dto"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/models"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/repository"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/service"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConvertToExperienceModel(t *testing.T) {
+	t.Run("PastRole", func(t *testing.T) {
+		experience, err := service.ConvertToExperienceModel(dto.ExperienceRequest{
+			Title:     " Software Engineer ",
+			StartDate: "2022-06-01",
+			EndDate:   "2024-05-31",
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "Software Engineer", experience.Title)
+		assert.Equal(t, time.Date(2024, time.May, 31, 0, 0, 0, 0, time.UTC), experience.EndDate)
+	})
+
+	t.Run("CurrentRoleHasNoEndDate", func(t *testing.T) {
+		experience, err := service.ConvertToExperienceModel(dto.ExperienceRequest{
+			Title:     "Software Engineer",
+			StartDate: "2022-06-01",
+			EndDate:   "2024-05-31",
+			Currently: true,
+		})
+
+		assert.NoError(t, err)
+		assert.True(t, experience.EndDate.IsZero())
+
+		res := dto.BuildExperienceResponse(experience)
+		assert.Equal(t, "2022-06-01", res.StartDate)
+		assert.Empty(t, res.EndDate)
+	})
+
+	t.Run("EndBeforeStart", func(t *testing.T) {
+		_, err := service.ConvertToExperienceModel(dto.ExperienceRequest{
+			Title:     "Software Engineer",
+			StartDate: "2022-06-01",
+			EndDate:   "2021-06-01",
+		})
+
+		assert.Error(t, err)
+	})
+}
+
+func TestBuildPublicProfileResponse(t *testing.T) {
+	slug := "anda-raiwin"
+	profile := models.Profile{
+		FirstName:   "Anda",
+		Email:       "andaraiwin@gmail.com",
+		Phone:       "08123456789",
+		PublicSlug:  &slug,
+		IsPublic:    true,
+		HideEmail:   true,
+		HidePhone:   false,
+		Experiences: []models.Experience{{Title: "Software Engineer"}},
+	}
+
+	t.Run("HiddenFieldsAreLeftOut", func(t *testing.T) {
+		res := dto.BuildPublicProfileResponse(profile)
+
+		assert.Equal(t, "anda-raiwin", res.Slug)
+		assert.Empty(t, res.Email)
+		assert.Equal(t, "08123456789", res.Phone)
+		assert.Len(t, res.Experiences, 1)
+		assert.NotNil(t, res.Skills)
+	})
+
+	t.Run("HiddenExperiences", func(t *testing.T) {
+		hidden := profile
+		hidden.HideExperiences = true
+
+		res := dto.BuildPublicProfileResponse(hidden)
+		assert.Empty(t, res.Experiences)
+	})
+}
+
+// publicProfiles serves one public profile and records the views of it
+type publicProfiles struct {
+	profile models.Profile
+	views   []models.ProfileView
+}
+
+func (r *publicProfiles) Create(profile *models.Profile) error {
+	return nil
+}
+
+func (r *publicProfiles) Update(profile *models.Profile) error {
+	return nil
+}
+
+func (r *publicProfiles) GetByUserID(userID uuid.UUID) (*models.Profile, error) {
+	return &r.profile, nil
+}
+
+func (r *publicProfiles) GetPublicBySlug(slug string) (*models.Profile, error) {
+	return &r.profile, nil
+}
+
+func (r *publicProfiles) GetAllPublic() ([]models.Profile, error) {
+	return []models.Profile{r.profile}, nil
+}
+
+func (r *publicProfiles) UpdateVisibility(profile *models.Profile) error {
+	return nil
+}
+
+func (r *publicProfiles) CreateView(view *models.ProfileView) error {
+	r.views = append(r.views, *view)
+	return nil
+}
+
+func (r *publicProfiles) ListViewsByUserID(userID uuid.UUID) ([]models.ProfileView, error) {
+	return r.views, nil
+}
+
+func TestViewProfileAsOrganization(t *testing.T) {
+	slug := "anda-raiwin"
+	profiles := &publicProfiles{profile: models.Profile{FirstName: "Anda", PublicSlug: &slug, IsPublic: true}}
+
+	// The organization of the mock has not been approved
+	talentService := service.NewTalentService(profiles, repository.NewOrganizationRepositoryMock(), nil, nil)
+
+	_, err := talentService.ViewProfileAsOrganization(1, uuid.New(), slug)
+
+	assertAppError(t, err, http.StatusForbidden)
+	assert.Empty(t, profiles.views)
+}
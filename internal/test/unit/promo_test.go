@@ -0,0 +1,73 @@
+//go:build unit
+
+package unit_test
+
+import (
+	"testing"
+
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/dto"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/models"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/repository"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/service"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPromoDiscount(t *testing.T) {
+	assert.Equal(t, 100.0, repository.PromoDiscount(models.DiscountPercent, 20, 500))
+	assert.Equal(t, 33.33, repository.PromoDiscount(models.DiscountPercent, 33.333, 100))
+	assert.Equal(t, 150.0, repository.PromoDiscount(models.DiscountFixed, 150, 500))
+	// A discount never makes the price negative
+	assert.Equal(t, 300.0, repository.PromoDiscount(models.DiscountFixed, 500, 300))
+	assert.Equal(t, 300.0, repository.PromoDiscount(models.DiscountPercent, 100, 300))
+}
+
+func TestNormalizePromoCode(t *testing.T) {
+	assert.Equal(t, "EARLYBIRD", repository.NormalizePromoCode("  earlyBird "))
+}
+
+func TestConvertToPromoCode(t *testing.T) {
+	t.Run("Valid", func(t *testing.T) {
+		promo, err := service.ConvertToPromoCode(1, dto.PromoCodeRequest{
+			Code:          "earlybird",
+			DiscountType:  models.DiscountPercent,
+			DiscountValue: 20,
+			StartsAt:      "2025-01-01T00:00:00+07:00",
+			ExpiresAt:     "2025-01-20T23:59:59+07:00",
+		}, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, "EARLYBIRD", promo.Code)
+		assert.True(t, promo.IsActive)
+		assert.NotNil(t, promo.StartsAt)
+		assert.NotNil(t, promo.ExpiresAt)
+	})
+
+	t.Run("PercentOverHundred", func(t *testing.T) {
+		_, err := service.ConvertToPromoCode(1, dto.PromoCodeRequest{Code: "HALF", DiscountType: models.DiscountPercent, DiscountValue: 150}, nil)
+		assert.Error(t, err)
+	})
+
+	t.Run("ExpiresBeforeStart", func(t *testing.T) {
+		_, err := service.ConvertToPromoCode(1, dto.PromoCodeRequest{
+			Code:          "LATE",
+			DiscountType:  models.DiscountFixed,
+			DiscountValue: 100,
+			StartsAt:      "2025-01-20T00:00:00+07:00",
+			ExpiresAt:     "2025-01-01T00:00:00+07:00",
+		}, nil)
+		assert.Error(t, err)
+	})
+}
+
+func TestBuildPromoCodeReportResponse(t *testing.T) {
+	promos := []models.PromoCode{{Code: "EARLYBIRD"}, {Code: "UNUSED"}}
+	promos[0].ID = 1
+	promos[1].ID = 2
+	usages := []models.PromoCodeUsage{{PromoCodeID: 1, Redemptions: 2, Released: 1, TotalDiscount: 200, Revenue: 800}}
+
+	res := dto.BuildPromoCodeReportResponse(promos, usages, nil)
+	assert.Len(t, res.Codes, 2)
+	assert.Equal(t, int64(2), res.Codes[0].Redemptions)
+	assert.Equal(t, 800.0, res.Codes[0].Revenue)
+	assert.Equal(t, int64(0), res.Codes[1].Redemptions)
+	assert.NotNil(t, res.Redemptions)
+}
@@ -0,0 +1,77 @@
+//go:build unit
+
+package unit_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/dto"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/models"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/service"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/utils"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func TestReminderWindow(t *testing.T) {
+	now := time.Date(2025, 1, 24, 9, 0, 0, 0, time.UTC)
+
+	from, to := service.ReminderWindow(models.ReminderDayBefore, now)
+	assert.Equal(t, now.Add(time.Hour), from)
+	assert.Equal(t, now.Add(24*time.Hour), to)
+
+	from, to = service.ReminderWindow(models.ReminderHourBefore, now)
+	assert.Equal(t, now, from)
+	assert.Equal(t, now.Add(time.Hour), to)
+}
+
+func TestConvertToReminderMail(t *testing.T) {
+	event := models.Event{
+		Model:        gorm.Model{ID: 7},
+		Name:         "Builds CMU 2025",
+		StartDate:    utils.DateOnly{Time: time.Date(2025, 1, 25, 0, 0, 0, 0, time.UTC)},
+		EndDate:      utils.DateOnly{Time: time.Date(2025, 1, 25, 0, 0, 0, 0, time.UTC)},
+		StartTime:    utils.TimeOnly{Time: time.Date(0, 1, 1, 9, 30, 0, 0, time.UTC)},
+		EndTime:      utils.TimeOnly{Time: time.Date(0, 1, 1, 17, 0, 0, 0, time.UTC)},
+		Timezone:     "Asia/Bangkok",
+		LocationName: "CMU Art Center",
+		Organization: models.Organization{Name: "DAF Bridge"},
+	}
+	purchase := models.TicketPurchased{Username: "Anda", Email: "anda@example.com", TicketTitle: "Early Bird", Event: event}
+
+	config := service.ConvertToReminderMail(models.ReminderHourBefore, purchase, []byte("BEGIN:VCALENDAR"), []byte("png"))
+	assert.Equal(t, "anda@example.com", config.ToEmail)
+	assert.Equal(t, "Builds CMU 2025 starts in 1 hour", config.Subject)
+	assert.Equal(t, "2025-01-25 09:30 (Asia/Bangkok)", config.Body.EventDate)
+	assert.Equal(t, "CMU Art Center", config.Body.Location)
+	assert.Equal(t, "DAF Bridge", config.Body.OrganizationName)
+	assert.Equal(t, []byte("png"), config.TicketQRCode)
+
+	config = service.ConvertToReminderMail(models.ReminderDayBefore, purchase, nil, nil)
+	assert.Equal(t, "tomorrow", config.Body.StartsIn)
+}
+
+func TestConvertToEventAnnouncement(t *testing.T) {
+	now := time.Date(2025, 1, 24, 9, 0, 0, 0, time.UTC)
+
+	t.Run("SentNow", func(t *testing.T) {
+		announcement, err := service.ConvertToEventAnnouncement(7, dto.EventAnnouncementRequest{Subject: " Venue change ", Message: "Room 301"}, now)
+		assert.NoError(t, err)
+		assert.Equal(t, models.AnnouncementStatusSending, announcement.Status)
+		assert.Equal(t, "Venue change", announcement.Subject)
+		assert.Equal(t, now, announcement.ScheduledAt)
+	})
+
+	t.Run("Scheduled", func(t *testing.T) {
+		announcement, err := service.ConvertToEventAnnouncement(7, dto.EventAnnouncementRequest{Subject: "Doors open", Message: "See you", ScheduledAt: "2025-01-25T08:00:00+07:00"}, now)
+		assert.NoError(t, err)
+		assert.Equal(t, models.AnnouncementStatusScheduled, announcement.Status)
+		assert.Equal(t, time.Date(2025, 1, 25, 1, 0, 0, 0, time.UTC), announcement.ScheduledAt)
+	})
+
+	t.Run("ScheduledInThePast", func(t *testing.T) {
+		_, err := service.ConvertToEventAnnouncement(7, dto.EventAnnouncementRequest{Subject: "Late", Message: "Too late", ScheduledAt: "2025-01-24T15:00:00+07:00"}, now)
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,165 @@
+//go:build unit
+
+package unit_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/models"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/service"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func TestParseReportTarget(t *testing.T) {
+	for _, value := range []string{"event", "job", "organization", "question", "answer"} {
+		targetType, err := service.ParseReportTarget(value)
+		assert.NoError(t, err)
+		assert.Equal(t, models.ReportTarget(value), targetType)
+	}
+
+	_, err := service.ParseReportTarget("profile")
+	assertAppError(t, err, 400)
+}
+
+func TestReportAutoHideThreshold(t *testing.T) {
+	assert.Equal(t, 3, service.ReportAutoHideThreshold("3"))
+	assert.Equal(t, 5, service.ReportAutoHideThreshold(""))
+	assert.Equal(t, 5, service.ReportAutoHideThreshold("0"))
+	assert.Equal(t, 5, service.ReportAutoHideThreshold("many"))
+}
+
+func TestShouldAutoHide(t *testing.T) {
+	visible := service.ContentSummary{}
+	assert.False(t, service.ShouldAutoHide(models.ReportEvent, visible, 2, 3))
+	assert.True(t, service.ShouldAutoHide(models.ReportEvent, visible, 3, 3))
+	assert.True(t, service.ShouldAutoHide(models.ReportAnswer, visible, 4, 3))
+
+	// Hidden content is not hidden again, organizations are only suspended by moderators
+	assert.False(t, service.ShouldAutoHide(models.ReportEvent, service.ContentSummary{Hidden: true}, 3, 3))
+	assert.False(t, service.ShouldAutoHide(models.ReportOrganization, visible, 10, 3))
+}
+
+func TestDescribeContent(t *testing.T) {
+	org := models.Organization{Model: gorm.Model{ID: 1}, Name: "Builds", Email: "builds@example.com"}
+
+	event := service.DescribeContent(models.ModeratedContent{Event: &models.Event{
+		Model:          gorm.Model{ID: 7},
+		Name:           "Builds CMU 2025",
+		Hidden:         true,
+		OrganizationID: 1,
+		Organization:   org,
+	}})
+	assert.Equal(t, service.ContentSummary{
+		Title:          "Builds CMU 2025",
+		Path:           "/events/7",
+		Hidden:         true,
+		OrganizationID: 1,
+		OwnerName:      "Builds",
+		OwnerEmail:     "builds@example.com",
+	}, event)
+
+	suspended := org
+	suspended.Suspended = true
+	organization := service.DescribeContent(models.ModeratedContent{Organization: &suspended})
+	assert.Equal(t, "/orgs/1", organization.Path)
+	assert.True(t, organization.Hidden)
+	assert.Equal(t, uint(1), organization.OrganizationID)
+
+	answer := service.DescribeContent(models.ModeratedContent{Answer: &models.DiscussionAnswer{
+		Body:     strings.Repeat("ก", 100),
+		User:     models.User{Name: "Somchai", Email: "somchai@example.com"},
+		Question: models.DiscussionQuestion{TargetType: models.DiscussionJob, TargetID: 3},
+	}})
+	assert.Equal(t, strings.Repeat("ก", 80)+"…", answer.Title)
+	assert.Equal(t, "/jobs/3", answer.Path)
+	assert.Zero(t, answer.OrganizationID)
+	assert.Equal(t, "somchai@example.com", answer.OwnerEmail)
+}
+
+func TestPlanModeration(t *testing.T) {
+	event := service.ContentSummary{Title: "Builds CMU 2025", Path: "/events/7", OrganizationID: 1, OwnerEmail: "builds@example.com"}
+
+	change, status, err := service.PlanModeration(models.ActionHide, models.ReportEvent, event, false)
+	assert.NoError(t, err)
+	assert.Equal(t, models.ReportActioned, status)
+	if assert.NotNil(t, change.Hidden) {
+		assert.True(t, *change.Hidden)
+	}
+
+	change, status, err = service.PlanModeration(models.ActionSuspend, models.ReportEvent, event, false)
+	assert.NoError(t, err)
+	assert.Equal(t, models.ReportActioned, status)
+	assert.Equal(t, uint(1), change.SuspendOrganizationID)
+	assert.Nil(t, change.Hidden)
+
+	change, status, err = service.PlanModeration(models.ActionWarn, models.ReportEvent, event, false)
+	assert.NoError(t, err)
+	assert.Equal(t, models.ReportActioned, status)
+	assert.Equal(t, models.ModerationChange{}, change)
+
+	change, status, err = service.PlanModeration(models.ActionDismiss, models.ReportEvent, event, false)
+	assert.NoError(t, err)
+	assert.Equal(t, models.ReportDismissed, status)
+	assert.Equal(t, models.ModerationChange{}, change)
+
+	// Unfounded reports that hid the content automatically show it again
+	hidden := event
+	hidden.Hidden = true
+	change, _, err = service.PlanModeration(models.ActionDismiss, models.ReportEvent, hidden, true)
+	assert.NoError(t, err)
+	if assert.NotNil(t, change.Hidden) {
+		assert.False(t, *change.Hidden)
+	}
+
+	// Content hidden by a moderator stays hidden
+	change, _, err = service.PlanModeration(models.ActionDismiss, models.ReportEvent, hidden, false)
+	assert.NoError(t, err)
+	assert.Nil(t, change.Hidden)
+
+	_, _, err = service.PlanModeration(models.ActionHide, models.ReportOrganization, event, false)
+	assertAppError(t, err, 400)
+
+	_, _, err = service.PlanModeration(models.ActionSuspend, models.ReportQuestion, service.ContentSummary{Path: "/events/7"}, false)
+	assertAppError(t, err, 400)
+
+	_, _, err = service.PlanModeration(models.ActionAutoHide, models.ReportEvent, event, false)
+	assertAppError(t, err, 400)
+}
+
+func TestConvertToReportOutcomeMail(t *testing.T) {
+	report := models.ContentReport{
+		Reporter: models.User{Name: "Somchai", Email: "somchai@example.com"},
+		Status:   models.ReportActioned,
+	}
+
+	config := service.ConvertToReportOutcomeMail(report, service.ContentSummary{Title: "Builds CMU 2025"})
+	assert.Equal(t, "somchai@example.com", config.ToEmail)
+	assert.Equal(t, "Update on your report about Builds CMU 2025", config.Subject)
+	assert.True(t, config.Body.Actioned)
+
+	report.Status = models.ReportDismissed
+	config = service.ConvertToReportOutcomeMail(report, service.ContentSummary{})
+	assert.Equal(t, "the content you reported", config.Body.Title)
+	assert.False(t, config.Body.Actioned)
+}
+
+func TestConvertToModerationWarningMail(t *testing.T) {
+	summary := service.ContentSummary{Title: "Builds CMU 2025", Path: "/events/7", OwnerName: "Builds", OwnerEmail: "builds@example.com"}
+
+	config := service.ConvertToModerationWarningMail(summary, "Misleading ticket prices")
+	assert.Equal(t, "builds@example.com", config.ToEmail)
+	assert.Equal(t, "Warning about Builds CMU 2025", config.Subject)
+	assert.Equal(t, "/events/7", config.Body.Path)
+	assert.Equal(t, "Misleading ticket prices", config.Body.Note)
+}
+
+func TestCopyDraftsAreNotHidden(t *testing.T) {
+	event := templateEvent()
+	event.Hidden = true
+	assert.False(t, service.CopyEventDraft(event).Hidden)
+
+	job := models.OrgOpenJob{Title: "Software Engineer", Hidden: true}
+	assert.False(t, service.CopyJobDraft(job).Hidden)
+}
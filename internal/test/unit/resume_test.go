@@ -0,0 +1,86 @@
+//go:build unit
+
+package unit_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/infrastructure/resume"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseResume(t *testing.T) {
+	text := `Anda Raiwin
+andaraiwin@gmail.com
+
+Education
+B.Eng. Computer Engineering, Chiang Mai University
+
+Skills
+Programming: Go, Python
+- PostgreSQL | Docker, go
+
+Work Experience
+Software Engineer | DAF Bridge Jan 2022 - Present
+Built the job board backend
+Intern
+Jun 2564 - Aug 2564
+Maintained the CI/CD pipelines
+
+Languages
+Thai, English`
+
+	parsed := resume.Parse(text)
+
+	assert.Equal(t, []string{"B.Eng. Computer Engineering, Chiang Mai University"}, parsed.Education)
+	assert.Equal(t, []string{"Go", "Python", "PostgreSQL", "Docker"}, parsed.Skills)
+
+	if assert.Len(t, parsed.Experiences, 2) {
+		current := parsed.Experiences[0]
+		assert.Equal(t, "Software Engineer | DAF Bridge", current.Title)
+		assert.Equal(t, "Built the job board backend", current.Description)
+		assert.Equal(t, time.Date(2022, time.January, 1, 0, 0, 0, 0, time.UTC), current.StartDate)
+		assert.True(t, current.Currently)
+
+		// the title is on the line above the dates and the years are in the Buddhist era
+		internship := parsed.Experiences[1]
+		assert.Equal(t, "Intern", internship.Title)
+		assert.Equal(t, "Maintained the CI/CD pipelines", internship.Description)
+		assert.Equal(t, time.Date(2021, time.June, 1, 0, 0, 0, 0, time.UTC), internship.StartDate)
+		assert.Equal(t, time.Date(2021, time.August, 1, 0, 0, 0, 0, time.UTC), internship.EndDate)
+		assert.False(t, internship.Currently)
+	}
+}
+
+func TestExtractDOCXText(t *testing.T) {
+	buffer := bytes.NewBuffer(nil)
+	archive := zip.NewWriter(buffer)
+	document, _ := archive.Create("word/document.xml")
+	document.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?>
+<w:document xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main"><w:body>
+<w:p><w:r><w:t>Skills</w:t></w:r></w:p>
+<w:p><w:r><w:t>Go,</w:t></w:r><w:r><w:t xml:space="preserve"> Docker</w:t></w:r></w:p>
+</w:body></w:document>`))
+	archive.Close()
+
+	assert.True(t, resume.IsDOCX(buffer.Bytes()))
+
+	text, err := resume.ExtractText(buffer.Bytes(), resume.ContentTypeDOCX)
+	assert.NoError(t, err)
+	assert.Equal(t, "Skills\nGo, Docker\n", text)
+	assert.Equal(t, []string{"Go", "Docker"}, resume.Parse(text).Skills)
+}
+
+func TestExtractDOCXTextRefusesOversizedDocument(t *testing.T) {
+	buffer := bytes.NewBuffer(nil)
+	archive := zip.NewWriter(buffer)
+	document, _ := archive.Create("word/document.xml")
+	document.Write(bytes.Repeat([]byte("<w:p/>"), 4<<20))
+	archive.Close()
+
+	_, err := resume.ExtractText(buffer.Bytes(), resume.ContentTypeDOCX)
+	assert.Error(t, err)
+}
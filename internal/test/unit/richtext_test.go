@@ -0,0 +1,153 @@
+//go:build unit
+
+package unit_test
+
+import (
+	"testing"
+
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/dto"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/models"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/service"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/pkg/richtext"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSanitizeKeepsAllowedMarkup(t *testing.T) {
+	source := `<h2>Agenda</h2><p>Join <strong>us</strong> at <a href="https://example.com/register" title="Register">the venue</a></p><ul><li>Talks</li></ul><img src="https://example.com/poster.png" alt="Poster">`
+
+	assert.Equal(t,
+		`<h2>Agenda</h2><p>Join <strong>us</strong> at <a href="https://example.com/register" title="Register" rel="nofollow noopener noreferrer">the venue</a></p><ul><li>Talks</li></ul><img src="https://example.com/poster.png" alt="Poster" />`,
+		richtext.Sanitize(source))
+}
+
+func TestSanitizeRemovesScripts(t *testing.T) {
+	tests := map[string]struct {
+		source   string
+		expected string
+	}{
+		"script":            {`<p>Hi</p><script>alert(1)</script>`, `<p>Hi</p>`},
+		"uppercase script":  {`<SCRIPT>alert(1)</SCRIPT>ok`, `ok`},
+		"style":             {`<style>body{display:none}</style><p>Hi</p>`, `<p>Hi</p>`},
+		"iframe":            {`<iframe src="https://evil.example"></iframe>ok`, `ok`},
+		"svg":               {`<svg><script>alert(1)</script><circle onload="alert(1)"/></svg>ok`, `ok`},
+		"event handler":     {`<p onclick="alert(1)">Hi</p>`, `<p>Hi</p>`},
+		"image handler":     {`<img src=x onerror=alert(1)>`, `<img src="x" />`},
+		"unknown tag":       {`<div class="x"><span>Hi</span></div>`, `Hi`},
+		"comment":           {`<!-- <script>alert(1)</script> -->ok`, `ok`},
+		"escaped text":      {`1 &lt; 2 & "quotes"`, `1 &lt; 2 &amp; &#34;quotes&#34;`},
+		"stray close":       {`</p>Hi</strong>`, `Hi`},
+		"unclosed":          {`<p><strong>Hi`, `<p><strong>Hi</strong></p>`},
+		"misnested":         {`<p><em>Hi</p>`, `<p><em>Hi</em></p>`},
+		"attribute escaped": {`<a title='x" onclick="alert(1)'>Hi</a>`, `<a title="x&#34; onclick=&#34;alert(1)" rel="nofollow noopener noreferrer">Hi</a>`},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, richtext.Sanitize(tt.source))
+		})
+	}
+}
+
+func TestSanitizeRemovesUnsafeAddresses(t *testing.T) {
+	tests := map[string]struct {
+		source   string
+		expected string
+	}{
+		"javascript":         {`<a href="javascript:alert(1)">x</a>`, `<a rel="nofollow noopener noreferrer">x</a>`},
+		"mixed case":         {`<a href="JaVaScRiPt:alert(1)">x</a>`, `<a rel="nofollow noopener noreferrer">x</a>`},
+		"encoded tab":        {`<a href="java&#09;script:alert(1)">x</a>`, `<a rel="nofollow noopener noreferrer">x</a>`},
+		"leading space":      {`<a href=" javascript:alert(1)">x</a>`, `<a rel="nofollow noopener noreferrer">x</a>`},
+		"data":               {`<a href="data:text/html,<script>alert(1)</script>">x</a>`, `<a rel="nofollow noopener noreferrer">x</a>`},
+		"protocol relative":  {`<a href="//evil.example">x</a>`, `<a rel="nofollow noopener noreferrer">x</a>`},
+		"backslash":          {`<a href="/\evil.example">x</a>`, `<a rel="nofollow noopener noreferrer">x</a>`},
+		"mailto image":       {`<img src="mailto:a@example.com">`, `<img />`},
+		"mailto link":        {`<a href="mailto:a@example.com">x</a>`, `<a href="mailto:a@example.com" rel="nofollow noopener noreferrer">x</a>`},
+		"relative link":      {`<a href="/events/1">x</a>`, `<a href="/events/1" rel="nofollow noopener noreferrer">x</a>`},
+		"query kept escaped": {`<a href="https://example.com/?a=1&b=2">x</a>`, `<a href="https://example.com/?a=1&amp;b=2" rel="nofollow noopener noreferrer">x</a>`},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, richtext.Sanitize(tt.source))
+		})
+	}
+}
+
+func TestMarkdown(t *testing.T) {
+	source := "# Builds CMU\n\nA **free** workshop for *students*,  \nsee [the schedule](https://example.com/schedule).\n\n- Talks\n- `go test`\n\n1. Register\n2. Come\n\n> Bring a laptop\n\n```\n<b>code</b>\n```\n\n---"
+
+	assert.Equal(t, "<h1>Builds CMU</h1>\n"+
+		"<p>A <strong>free</strong> workshop for <em>students</em>,<br />\nsee <a href=\"https://example.com/schedule\">the schedule</a>.</p>\n"+
+		"<ul>\n<li>Talks</li>\n<li><code>go test</code></li>\n</ul>\n"+
+		"<ol>\n<li>Register</li>\n<li>Come</li>\n</ol>\n"+
+		"<blockquote>\n<p>Bring a laptop</p>\n</blockquote>\n"+
+		"<pre><code>&lt;b&gt;code&lt;/b&gt;</code></pre>\n"+
+		"<hr />\n",
+		richtext.Markdown(source))
+}
+
+func TestMarkdownSpans(t *testing.T) {
+	assert.Equal(t, "<p>snake_case and <em>italic</em> and <del>gone</del></p>\n", richtext.Markdown("snake_case and _italic_ and ~~gone~~"))
+	assert.Equal(t, "<p>an `unclosed code span</p>\n", richtext.Markdown("an `unclosed code span"))
+	assert.Equal(t, "<h2>C#</h2>\n", richtext.Markdown("## C#"))
+}
+
+func TestRenderMarkdownEscapesHTML(t *testing.T) {
+	rendered := richtext.Render("Hi <script>alert(1)</script> [x](javascript:void)", richtext.FormatMarkdown)
+
+	assert.Equal(t, "<p>Hi &lt;script&gt;alert(1)&lt;/script&gt; <a rel=\"nofollow noopener noreferrer\">x</a></p>\n", rendered)
+}
+
+func TestParseFormat(t *testing.T) {
+	assert.Equal(t, richtext.FormatMarkdown, richtext.ParseFormat("markdown"))
+	assert.Equal(t, richtext.FormatHTML, richtext.ParseFormat("html"))
+	assert.Equal(t, richtext.FormatHTML, richtext.ParseFormat(""))
+}
+
+func TestRenderedFallsBackToSource(t *testing.T) {
+	assert.Equal(t, "<p>stored</p>", richtext.Rendered("**source**", "<p>stored</p>", richtext.FormatMarkdown))
+	assert.Equal(t, "<p>legacy</p>", richtext.Rendered(`<p onclick="x">legacy</p>`, "", richtext.FormatHTML))
+	assert.Empty(t, richtext.Rendered("", "", richtext.FormatHTML))
+}
+
+func TestPlainText(t *testing.T) {
+	html := "<h1>Builds   CMU</h1><p>A <strong>free</strong> workshop &amp; talks</p><ul><li>Go</li><li>Rust</li></ul><script>alert(1)</script>"
+
+	assert.Equal(t, "Builds CMU\nA free workshop & talks\nGo\nRust", richtext.PlainText(html))
+}
+
+func TestConvertToJobRequestRendersTexts(t *testing.T) {
+	job := service.ConvertToJobRequest(1, dto.JobRequest{
+		Description:    "We **build** things<script>alert(1)</script>",
+		Qualifications: "- Go",
+		TextFormat:     "markdown",
+	}, nil, nil)
+
+	assert.Equal(t, richtext.FormatMarkdown, job.TextFormat)
+	assert.Equal(t, "We **build** things<script>alert(1)</script>", job.Description)
+	assert.Equal(t, "<p>We <strong>build</strong> things&lt;script&gt;alert(1)&lt;/script&gt;</p>\n", job.DescriptionHTML)
+	assert.Equal(t, "<ul>\n<li>Go</li>\n</ul>\n", job.QualificationsHTML)
+
+	res := service.ConvertToJobResponse(job)
+	assert.Equal(t, "markdown", res.TextFormat)
+	assert.Equal(t, job.DescriptionHTML, res.DescriptionHTML)
+
+	document := service.ConvertToJobDocumentDTOResponse(job)
+	assert.Equal(t, "We build things<script>alert(1)</script>", document.Description)
+}
+
+func TestConvertToOrgRequestSanitizesDescription(t *testing.T) {
+	org := service.ConvertToOrgRequest(dto.OrganizationRequest{Description: `<p onmouseover="alert(1)">Hello</p>`}, nil, nil)
+
+	assert.Equal(t, richtext.FormatHTML, org.DescriptionFormat)
+	assert.Equal(t, "<p>Hello</p>", org.DescriptionHTML)
+}
+
+func TestConvertToEventResponseRendersLegacyContent(t *testing.T) {
+	event := models.Event{Content: `<p>Hello<img src=x onerror=alert(1)></p>`}
+
+	res := service.ConvertToEventResponse(event)
+	assert.Equal(t, "html", res.ContentFormat)
+	assert.Equal(t, `<p>Hello<img src="x" /></p>`, res.ContentHTML)
+	assert.Equal(t, event.Content, res.Content)
+}
@@ -0,0 +1,62 @@
+//go:build unit
+
+package unit_test
+
+import (
+	"testing"
+
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSalaryToMonthlyTHB(t *testing.T) {
+	t.Run("MonthlyTHBIsUnchanged", func(t *testing.T) {
+		monthly, ok := utils.SalaryToMonthlyTHB(30000, "THB", "monthly")
+
+		assert.True(t, ok)
+		assert.Equal(t, 30000.0, monthly)
+	})
+
+	t.Run("YearlyIsDividedByTwelve", func(t *testing.T) {
+		monthly, ok := utils.SalaryToMonthlyTHB(360000, "thb", "yearly")
+
+		assert.True(t, ok)
+		assert.Equal(t, 30000.0, monthly)
+	})
+
+	t.Run("HourlyUsesFullTimeHours", func(t *testing.T) {
+		monthly, ok := utils.SalaryToMonthlyTHB(300, "THB", "hourly")
+
+		assert.True(t, ok)
+		assert.InDelta(t, 52000.0, monthly, 0.01)
+	})
+
+	t.Run("UnknownCurrencyIsRejected", func(t *testing.T) {
+		_, ok := utils.SalaryToMonthlyTHB(1000, "XYZ", "monthly")
+
+		assert.False(t, ok)
+	})
+}
+
+func TestJobRequestSalaryCurrency(t *testing.T) {
+	tests := map[string]struct {
+		currency string
+		valid    bool
+	}{
+		"default":     {"", true},
+		"supported":   {"USD", true},
+		"lowercase":   {"eur", true},
+		"unsupported": {"XYZ", false},
+		"no rate":     {"CHF", false},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			errors := utils.ValidateStruct(struct {
+				SalaryCurrency string `validate:"omitempty,salary_currency"`
+			}{tt.currency})
+
+			assert.Equal(t, tt.valid, len(errors) == 0)
+		})
+	}
+}
@@ -0,0 +1,175 @@
+//go:build unit
+
+package unit_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	searchsync "github.com/DAF-Bridge/Talent-Atmos-Backend/internal/infrastructure/sync"
+	"github.com/opensearch-project/opensearch-go"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeCluster answers the index management requests of EnsureSearchIndices
+type fakeCluster struct {
+	mu      sync.Mutex
+	indices map[string]bool
+	aliases map[string]string
+	plugins []string
+	created map[string]map[string]interface{}
+	copied  []string
+}
+
+func newFakeCluster(indices ...string) *fakeCluster {
+	cluster := &fakeCluster{
+		indices: map[string]bool{},
+		aliases: map[string]string{},
+		created: map[string]map[string]interface{}{},
+	}
+	for _, index := range indices {
+		cluster.indices[index] = true
+	}
+
+	return cluster
+}
+
+func (f *fakeCluster) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+	path := strings.TrimPrefix(r.URL.Path, "/")
+
+	switch {
+	case path == "":
+		w.Write([]byte(`{"version":{"number":"2.11.0","distribution":"opensearch"}}`))
+	case path == "_cat/plugins":
+		plugins := make([]map[string]string, 0, len(f.plugins))
+		for _, plugin := range f.plugins {
+			plugins = append(plugins, map[string]string{"component": plugin})
+		}
+		json.NewEncoder(w).Encode(plugins)
+	case strings.HasPrefix(path, "_alias/"):
+		alias := strings.TrimPrefix(path, "_alias/")
+		index, ok := f.aliases[alias]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{}`))
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{index: map[string]interface{}{"aliases": map[string]interface{}{alias: map[string]interface{}{}}}})
+	case path == "_reindex":
+		var body struct {
+			Source struct{ Index string } `json:"source"`
+			Dest   struct{ Index string } `json:"dest"`
+		}
+		json.NewDecoder(r.Body).Decode(&body)
+		f.copied = append(f.copied, body.Source.Index+">"+body.Dest.Index)
+		w.Write([]byte(`{}`))
+	case path == "_aliases":
+		var body struct {
+			Actions []map[string]map[string]string `json:"actions"`
+		}
+		json.NewDecoder(r.Body).Decode(&body)
+		for _, action := range body.Actions {
+			if add, ok := action["add"]; ok {
+				f.aliases[add["alias"]] = add["index"]
+			}
+			if remove, ok := action["remove_index"]; ok {
+				delete(f.indices, remove["index"])
+			}
+		}
+		w.Write([]byte(`{"acknowledged":true}`))
+	case r.Method == http.MethodHead:
+		if _, ok := f.aliases[path]; !ok && !f.indices[path] {
+			w.WriteHeader(http.StatusNotFound)
+		}
+	case r.Method == http.MethodPut:
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		f.indices[path] = true
+		f.created[path] = body
+		w.Write([]byte(`{"acknowledged":true}`))
+	default:
+		w.WriteHeader(http.StatusBadRequest)
+	}
+}
+
+func ensureIndices(t *testing.T, cluster *fakeCluster) {
+	server := httptest.NewServer(cluster)
+	t.Cleanup(server.Close)
+
+	client, err := opensearch.NewClient(opensearch.Config{Addresses: []string{server.URL}})
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.NoError(t, searchsync.EnsureSearchIndices(client))
+}
+
+// lookup follows the keys down nested JSON objects
+func lookup(value interface{}, keys ...string) interface{} {
+	for _, key := range keys {
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		value = object[key]
+	}
+
+	return value
+}
+
+func TestEnsureSearchIndicesReplacesUnversionedIndices(t *testing.T) {
+	cluster := newFakeCluster("events", "jobs")
+	ensureIndices(t, cluster)
+
+	assert.Equal(t, map[string]string{"events": "events_v1", "jobs": "jobs_v1", "organizations": "organizations_v1"}, cluster.aliases)
+	assert.Equal(t, []string{"events>events_v1", "jobs>jobs_v1"}, cluster.copied)
+	assert.Equal(t, map[string]bool{"events_v1": true, "jobs_v1": true, "organizations_v1": true}, cluster.indices)
+}
+
+func TestEnsureSearchIndicesKeepsCurrentVersion(t *testing.T) {
+	cluster := newFakeCluster("events_v1", "jobs_v1", "organizations_v1")
+	cluster.aliases = map[string]string{"events": "events_v1", "jobs": "jobs_v1", "organizations": "organizations_v1"}
+	ensureIndices(t, cluster)
+
+	assert.Empty(t, cluster.created)
+	assert.Empty(t, cluster.copied)
+}
+
+func TestEnsureSearchIndicesAnalyzers(t *testing.T) {
+	cluster := newFakeCluster()
+	ensureIndices(t, cluster)
+
+	events := cluster.created["events_v1"]
+	assert.Equal(t, "thai", lookup(events, "settings", "analysis", "analyzer", "thai_text", "tokenizer"))
+	assert.Equal(t, "standard", lookup(events, "settings", "analysis", "analyzer", "english_text", "tokenizer"))
+	assert.Contains(t, lookup(events, "settings", "analysis", "filter", "search_synonyms", "synonyms"), "workshop, เวิร์กช็อป, เวิร์คช็อป, อบรม")
+
+	properties := lookup(events, "mappings", "properties")
+	assert.Equal(t, "thai_text", lookup(properties, "name", "analyzer"))
+	assert.Equal(t, "english_text", lookup(properties, "name", "fields", "en", "analyzer"))
+	assert.Equal(t, "thai_text", lookup(properties, "translations", "properties", "th", "properties", "content", "analyzer"))
+	assert.Equal(t, "english_text", lookup(properties, "translations", "properties", "en", "properties", "content", "analyzer"))
+
+	jobs := lookup(cluster.created["jobs_v1"], "mappings", "properties")
+	assert.Equal(t, "thai_text", lookup(jobs, "title", "analyzer"))
+	assert.Equal(t, "double", lookup(jobs, "salaryMinMonthlyThb", "type"))
+
+	organizations := lookup(cluster.created["organizations_v1"], "mappings", "properties")
+	assert.Equal(t, "english_text", lookup(organizations, "translations", "properties", "en", "properties", "description", "analyzer"))
+}
+
+func TestEnsureSearchIndicesUsesICUTokenizer(t *testing.T) {
+	cluster := newFakeCluster()
+	cluster.plugins = []string{"analysis-icu"}
+	ensureIndices(t, cluster)
+
+	assert.Equal(t, "icu_tokenizer", lookup(cluster.created["jobs_v1"], "settings", "analysis", "analyzer", "thai_text", "tokenizer"))
+}
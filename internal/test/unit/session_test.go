@@ -0,0 +1,165 @@
+//go:build unit
+
+package unit_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/dto"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/models"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/infrastructure/calendar"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/service"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func occurrenceDates(occurrences []time.Time) []string {
+	dates := make([]string, 0, len(occurrences))
+	for _, occurrence := range occurrences {
+		dates = append(dates, occurrence.Format("2006-01-02 15:04"))
+	}
+	return dates
+}
+
+func TestParseRule(t *testing.T) {
+	t.Run("RoundTrip", func(t *testing.T) {
+		rule, err := calendar.ParseRule("RRULE:FREQ=WEEKLY;INTERVAL=2;BYDAY=TU,TH;COUNT=8")
+		assert.NoError(t, err)
+		assert.Equal(t, calendar.Weekly, rule.Freq)
+		assert.Equal(t, []time.Weekday{time.Tuesday, time.Thursday}, rule.ByDay)
+		assert.Equal(t, "FREQ=WEEKLY;INTERVAL=2;BYDAY=TU,TH;COUNT=8", rule.String())
+	})
+
+	t.Run("Rejected", func(t *testing.T) {
+		for _, s := range []string{
+			"",
+			"FREQ=WEEKLY",
+			"FREQ=YEARLY;COUNT=3",
+			"FREQ=DAILY;COUNT=3;UNTIL=20250301",
+			"FREQ=DAILY;BYDAY=MO;COUNT=3",
+			"FREQ=WEEKLY;BYDAY=XX;COUNT=3",
+			"FREQ=DAILY;COUNT=1000",
+			"FREQ=DAILY;BYHOUR=9;COUNT=3",
+		} {
+			_, err := calendar.ParseRule(s)
+			assert.Error(t, err, s)
+		}
+	})
+}
+
+func TestRuleOccurrences(t *testing.T) {
+	bangkok, _ := utils.LoadTimezone("Asia/Bangkok")
+	berlin, _ := utils.LoadTimezone("Europe/Berlin")
+
+	t.Run("WeeklyOnDays", func(t *testing.T) {
+		rule, _ := calendar.ParseRule("FREQ=WEEKLY;BYDAY=TU,TH;COUNT=4")
+		// Wednesday, the Tuesday of the first week is before the start
+		occurrences, err := rule.Occurrences(time.Date(2025, 1, 22, 9, 0, 0, 0, bangkok))
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"2025-01-23 09:00", "2025-01-28 09:00", "2025-01-30 09:00", "2025-02-04 09:00"}, occurrenceDates(occurrences))
+	})
+
+	t.Run("DailyUntilDate", func(t *testing.T) {
+		rule, _ := calendar.ParseRule("FREQ=DAILY;INTERVAL=2;UNTIL=20250131")
+		occurrences, err := rule.Occurrences(time.Date(2025, 1, 25, 18, 30, 0, 0, bangkok))
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"2025-01-25 18:30", "2025-01-27 18:30", "2025-01-29 18:30", "2025-01-31 18:30"}, occurrenceDates(occurrences))
+	})
+
+	t.Run("MonthlySkipsMissingDays", func(t *testing.T) {
+		rule, _ := calendar.ParseRule("FREQ=MONTHLY;COUNT=3")
+		occurrences, err := rule.Occurrences(time.Date(2025, 1, 31, 10, 0, 0, 0, bangkok))
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"2025-01-31 10:00", "2025-03-31 10:00", "2025-05-31 10:00"}, occurrenceDates(occurrences))
+	})
+
+	t.Run("KeepsWallClockAcrossDaylightSavingTime", func(t *testing.T) {
+		rule, _ := calendar.ParseRule("FREQ=WEEKLY;COUNT=2")
+		occurrences, err := rule.Occurrences(time.Date(2025, 3, 27, 9, 0, 0, 0, berlin))
+		assert.NoError(t, err)
+		assert.Equal(t, "2025-03-27T08:00:00Z", occurrences[0].UTC().Format(time.RFC3339))
+		assert.Equal(t, "2025-04-03T07:00:00Z", occurrences[1].UTC().Format(time.RFC3339))
+	})
+}
+
+func sessionTestEvent() models.Event {
+	event := models.Event{
+		StartDate:    utils.DateOnly{Time: utils.DateParser("2025-01-21")},
+		EndDate:      utils.DateOnly{Time: utils.DateParser("2025-03-11")},
+		StartTime:    utils.TimeOnly{Time: utils.TimeParser("18:00:00")},
+		EndTime:      utils.TimeOnly{Time: utils.TimeParser("20:00:00")},
+		Timezone:     "Asia/Bangkok",
+		LocationName: "builds CMU",
+		Latitude:     18.7883,
+		Longitude:    98.9853,
+		LocationType: string(models.Onsite),
+	}
+	event.ID = 3
+	return event
+}
+
+func TestExpandRecurrence(t *testing.T) {
+	rule, _ := calendar.ParseRule("FREQ=WEEKLY;COUNT=3")
+	sessions, err := service.ExpandRecurrence(sessionTestEvent(), rule)
+	assert.NoError(t, err)
+	assert.Len(t, sessions, 3)
+
+	last := sessions[2]
+	assert.Equal(t, uint(3), last.EventID)
+	assert.Equal(t, "2025-02-04", last.StartDate.Format("2006-01-02"))
+	assert.Equal(t, "2025-02-04", last.EndDate.Format("2006-01-02"))
+	assert.Equal(t, "2025-02-04T11:00:00Z", utils.FormatUTC(last.StartAt))
+	assert.Equal(t, "2025-02-04T13:00:00Z", utils.FormatUTC(last.EndAt))
+	assert.Equal(t, "builds CMU", last.LocationName)
+	assert.Equal(t, "2025-02-04T11:00:00Z", utils.FormatUTC(last.RecurrenceID))
+}
+
+func TestConvertToEventSession(t *testing.T) {
+	event := sessionTestEvent()
+
+	t.Run("TakesTheEventLocation", func(t *testing.T) {
+		session, err := service.ConvertToEventSession(event, dto.EventSessionRequest{
+			StartDate: "2025-01-25",
+			StartTime: "09:00:00",
+			EndTime:   "16:00:00",
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, "builds CMU", session.LocationName)
+		assert.Equal(t, 98.9853, session.Longitude)
+		assert.Equal(t, "2025-01-25T02:00:00Z", utils.FormatUTC(session.StartAt))
+		assert.Nil(t, session.RecurrenceID)
+	})
+
+	t.Run("EndBeforeStart", func(t *testing.T) {
+		_, err := service.ConvertToEventSession(event, dto.EventSessionRequest{
+			StartDate: "2025-01-25",
+			EndDate:   "2025-01-24",
+			StartTime: "09:00:00",
+			EndTime:   "16:00:00",
+		})
+		assert.Error(t, err)
+	})
+}
+
+func TestShiftSession(t *testing.T) {
+	event := sessionTestEvent()
+	rule, _ := calendar.ParseRule("FREQ=WEEKLY;COUNT=3")
+	sessions, _ := service.ExpandRecurrence(event, rule)
+
+	// The second Tuesday moves to Wednesday at 19:00 in another room, the whole series follows
+	edited, err := service.ConvertToEventSession(event, dto.EventSessionRequest{
+		StartDate:    "2025-01-29",
+		StartTime:    "19:00:00",
+		EndTime:      "21:00:00",
+		LocationName: "Punspace",
+	})
+	assert.NoError(t, err)
+
+	shifted, err := service.ShiftSession(sessions[2], edited, sessions[1], event.Timezone)
+	assert.NoError(t, err)
+	assert.Equal(t, "2025-02-05", shifted.StartDate.Format("2006-01-02"))
+	assert.Equal(t, "2025-02-05T12:00:00Z", utils.FormatUTC(shifted.StartAt))
+	assert.Equal(t, "Punspace", shifted.LocationName)
+	assert.Equal(t, sessions[2].RecurrenceID, shifted.RecurrenceID)
+}
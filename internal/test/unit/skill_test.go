@@ -0,0 +1,46 @@
+//go:build unit
+
+package unit_test
+
+import (
+	"testing"
+
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/dto"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/models"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/service"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/utils"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func TestSkillMatch(t *testing.T) {
+	programmingID := uint(1)
+	candidate := service.CandidateSkillSet([]models.Skill{
+		{Model: gorm.Model{ID: 2}, Name: "Go", ParentID: &programmingID},
+		{Model: gorm.Model{ID: 3}, Name: "SQL"},
+	})
+
+	t.Run("ScoreRequiredSkills", func(t *testing.T) {
+		required := []dto.SkillShortResponse{
+			{ID: 1, Name: "Programming"},
+			{ID: 3, Name: "SQL"},
+			{ID: 4, Name: "Docker"},
+		}
+
+		match := service.ComputeSkillMatch(candidate, required)
+
+		assert.Equal(t, 67, match.Score)
+		assert.Equal(t, []dto.SkillShortResponse{required[0], required[1]}, match.MatchedSkills)
+		assert.Equal(t, []dto.SkillShortResponse{required[2]}, match.MissingSkills)
+	})
+
+	t.Run("NoRequiredSkills", func(t *testing.T) {
+		assert.Nil(t, service.ComputeSkillMatch(candidate, nil))
+	})
+}
+
+func TestSlugify(t *testing.T) {
+	assert.Equal(t, "machine-learning", utils.Slugify("  Machine Learning "))
+	assert.Equal(t, "cplusplus", utils.Slugify("C++"))
+	assert.Equal(t, "csharp-net", utils.Slugify("C# / .NET"))
+}
@@ -0,0 +1,129 @@
+//go:build unit
+
+package unit_test
+
+import (
+	"testing"
+
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/dto"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/models"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/service"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func intPtr(v int) *int    { return &v }
+func uintPtr(v uint) *uint { return &v }
+
+func testSurvey() models.EventSurvey {
+	return models.EventSurvey{
+		Model:   gorm.Model{ID: 3},
+		EventID: 7,
+		Title:   "How was Builds CMU 2025?",
+		Questions: []models.SurveyQuestion{
+			{Model: gorm.Model{ID: 1}, Type: models.SurveyQuestionRating, Prompt: "Overall", Required: true},
+			{Model: gorm.Model{ID: 2}, Type: models.SurveyQuestionChoice, Prompt: "Best session", Options: []models.SurveyQuestionOption{
+				{Model: gorm.Model{ID: 10}, Label: "Keynote"},
+				{Model: gorm.Model{ID: 11}, Label: "Workshop"},
+			}},
+			{Model: gorm.Model{ID: 3}, Type: models.SurveyQuestionText, Prompt: "Anything else?"},
+		},
+	}
+}
+
+func TestConvertToEventSurvey(t *testing.T) {
+	survey, err := service.ConvertToEventSurvey(7, dto.EventSurveyRequest{
+		Title: " Feedback ",
+		Questions: []dto.SurveyQuestionRequest{
+			{Type: "rating", Prompt: "Overall", Required: true},
+			{Type: "choice", Prompt: "Best session", Options: []string{"Keynote", "Workshop"}},
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "Feedback", survey.Title)
+	assert.Len(t, survey.Questions, 2)
+	assert.Equal(t, 1, survey.Questions[1].Position)
+	assert.Equal(t, "Workshop", survey.Questions[1].Options[1].Label)
+
+	_, err = service.ConvertToEventSurvey(7, dto.EventSurveyRequest{Questions: []dto.SurveyQuestionRequest{{Type: "choice", Options: []string{"Only"}}}})
+	assert.Error(t, err)
+
+	_, err = service.ConvertToEventSurvey(7, dto.EventSurveyRequest{Questions: []dto.SurveyQuestionRequest{{Type: "text", Options: []string{"A", "B"}}}})
+	assert.Error(t, err)
+}
+
+func TestConvertToSurveyResponse(t *testing.T) {
+	survey := testSurvey()
+	userID := uuid.New()
+
+	response, err := service.ConvertToSurveyResponse(survey, userID, dto.SurveySubmitRequest{Answers: []dto.SurveyAnswerRequest{
+		{QuestionID: 1, Rating: intPtr(4)},
+		{QuestionID: 2, OptionID: uintPtr(11)},
+		{QuestionID: 3, Text: "  "},
+	}})
+	assert.NoError(t, err)
+	assert.Equal(t, uint(3), response.SurveyID)
+	assert.Equal(t, uint(7), response.EventID)
+	assert.Len(t, response.Answers, 2)
+
+	cases := map[string][]dto.SurveyAnswerRequest{
+		"required question missing": {{QuestionID: 2, OptionID: uintPtr(10)}},
+		"rating out of range":       {{QuestionID: 1, Rating: intPtr(6)}},
+		"option of another":         {{QuestionID: 1, Rating: intPtr(5)}, {QuestionID: 2, OptionID: uintPtr(99)}},
+		"unknown question":          {{QuestionID: 1, Rating: intPtr(5)}, {QuestionID: 42, Text: "Hi"}},
+		"answered twice":            {{QuestionID: 1, Rating: intPtr(5)}, {QuestionID: 1, Rating: intPtr(3)}},
+	}
+	for name, answers := range cases {
+		_, err := service.ConvertToSurveyResponse(survey, userID, dto.SurveySubmitRequest{Answers: answers})
+		assert.Error(t, err, name)
+	}
+}
+
+func TestBuildSurveyResults(t *testing.T) {
+	responses := []models.SurveyResponse{
+		{Answers: []models.SurveyAnswer{{QuestionID: 1, Rating: intPtr(5)}, {QuestionID: 2, OptionID: uintPtr(11)}, {QuestionID: 3, Text: "Great"}}},
+		{Answers: []models.SurveyAnswer{{QuestionID: 1, Rating: intPtr(4)}, {QuestionID: 2, OptionID: uintPtr(11)}}},
+		{Answers: []models.SurveyAnswer{{QuestionID: 1, Rating: intPtr(4)}}},
+	}
+
+	results := service.BuildSurveyResults(testSurvey(), responses)
+	assert.Equal(t, 3, results.Responses)
+	assert.Len(t, results.Questions, 3)
+	assert.Equal(t, 4.33, results.Questions[0].Average)
+	assert.Equal(t, []int{0, 0, 0, 2, 1}, results.Questions[0].RatingCounts)
+	assert.Equal(t, 0, results.Questions[1].Options[0].Count)
+	assert.Equal(t, 2, results.Questions[1].Options[1].Count)
+	assert.Equal(t, []string{"Great"}, results.Questions[2].Texts)
+}
+
+func TestBuildOrganizationRatingResponse(t *testing.T) {
+	rating := service.BuildOrganizationRatingResponse(1, []models.SurveyRating{
+		{EventID: 7, Ratings: 3, Responses: 3, Average: 5},
+		{EventID: 8, Ratings: 1, Responses: 2, Average: 1},
+	})
+	assert.Equal(t, int64(4), rating.Ratings)
+	assert.Equal(t, int64(5), rating.Responses)
+	assert.Equal(t, 4.0, rating.Average)
+	assert.Len(t, rating.Events, 2)
+
+	rating = service.BuildOrganizationRatingResponse(1, nil)
+	assert.Equal(t, 0.0, rating.Average)
+	assert.NotNil(t, rating.Events)
+}
+
+func TestBuildSurveyInvitationMails(t *testing.T) {
+	survey := testSurvey()
+	survey.Event = models.Event{Model: gorm.Model{ID: 7}, Name: "Builds CMU 2025", Organization: models.Organization{Name: "DAF Bridge"}}
+	participants := []models.EventParticipant{
+		{User: models.User{Name: "Anda", Email: "anda@example.com"}},
+		{User: models.User{Name: "Anda", Email: "ANDA@example.com"}},
+		{User: models.User{Name: "Krit", Email: "krit@example.com"}},
+	}
+
+	configs := service.BuildSurveyInvitationMails(survey, participants)
+	assert.Len(t, configs, 2)
+	assert.Equal(t, "How was Builds CMU 2025?", configs[0].Subject)
+	assert.Equal(t, uint(7), configs[1].Body.EventID)
+	assert.Equal(t, "DAF Bridge", configs[1].Body.OrganizationName)
+}
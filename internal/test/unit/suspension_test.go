@@ -0,0 +1,66 @@
+//go:build unit
+
+package unit_test
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/middleware"
+	"github.com/casbin/casbin/v2"
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+)
+
+type suspendedOrganizations map[uint]bool
+
+func (s suspendedOrganizations) IsSuspended(orgID uint) (bool, error) {
+	return s[orgID], nil
+}
+
+func TestRBACMiddlewareRefusesChangesOfSuspendedOrganizations(t *testing.T) {
+	enforcer, err := casbin.NewEnforcer("../../../pkg/authorization/rbac_model.conf")
+	if !assert.NoError(t, err) {
+		return
+	}
+	for _, domain := range []string{"1", "2"} {
+		_, err = enforcer.AddGroupingPolicy("member", "owner", domain)
+		assert.NoError(t, err)
+	}
+	for _, act := range []string{"read", "update"} {
+		_, err = enforcer.AddPolicy("owner", "Event", act, "allow")
+		assert.NoError(t, err)
+	}
+
+	rbac := middleware.NewRBACMiddleware(enforcer, suspendedOrganizations{2: true})
+	enforce := rbac.EnforceMiddlewareWithResources("Event")
+
+	app := fiber.New()
+	org := app.Group("/orgs/:orgID/events", func(c *fiber.Ctx) error {
+		c.Locals("user", jwt.MapClaims{"user_id": "member"})
+		return c.Next()
+	})
+	org.Get("/", enforce("read"), func(c *fiber.Ctx) error { return c.SendStatus(fiber.StatusOK) })
+	org.Put("/", enforce("update"), func(c *fiber.Ctx) error { return c.SendStatus(fiber.StatusOK) })
+
+	tests := map[string]struct {
+		method   string
+		path     string
+		expected int
+	}{
+		"read":                   {fiber.MethodGet, "/orgs/1/events", fiber.StatusOK},
+		"update":                 {fiber.MethodPut, "/orgs/1/events", fiber.StatusOK},
+		"read while suspended":   {fiber.MethodGet, "/orgs/2/events", fiber.StatusOK},
+		"update while suspended": {fiber.MethodPut, "/orgs/2/events", fiber.StatusForbidden},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			res, err := app.Test(httptest.NewRequest(tt.method, tt.path, nil))
+			if assert.NoError(t, err) {
+				assert.Equal(t, tt.expected, res.StatusCode)
+			}
+		})
+	}
+}
@@ -0,0 +1,154 @@
+//go:build unit
+
+package unit_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/dto"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/models"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/service"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/pkg/authorization"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/utils"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func templateEvent() models.Event {
+	event := coHostedEvent()
+	event.Status = string(models.Published)
+	event.PicUrl = "https://bucket.s3.ap-southeast-1.amazonaws.com/organizations/1/pictures/events/7.png"
+	event.StartDate = utils.DateOnly{Time: time.Date(2025, 1, 25, 0, 0, 0, 0, time.UTC)}
+	event.StartTime = utils.TimeOnly{Time: time.Date(0, 1, 1, 9, 0, 0, 0, time.UTC)}
+	event.EndTime = utils.TimeOnly{Time: time.Date(0, 1, 1, 17, 0, 0, 0, time.UTC)}
+	event.RecurrenceRule = "FREQ=WEEKLY;COUNT=4"
+	event.Categories = []models.Category{{Model: gorm.Model{ID: 3}, Name: "Workshop"}}
+	event.ContactChannels = []models.ContactChannel{{Model: gorm.Model{ID: 4}, EventID: 7, Media: "facebook", MediaLink: "https://facebook.com/builds"}}
+	event.TicketAvailable = []models.TicketAvailable{{Model: gorm.Model{ID: 5}, EventID: 7, Title: "Early bird", Quantity: 50, Price: 100}}
+	event.Sessions = []models.EventSession{{Model: gorm.Model{ID: 6}, EventID: 7}}
+	event.Materials = []models.EventMaterial{{Model: gorm.Model{ID: 8}, EventID: 7, Title: "Slides"}}
+	event.Speakers = []models.EventSpeaker{{Model: gorm.Model{ID: 9}, EventID: 7, Name: "Somchai", PicUrl: "https://example.com/somchai.png", Position: 1}}
+	event.AgendaItems = []models.AgendaItem{{
+		Model:     gorm.Model{ID: 10},
+		EventID:   7,
+		SessionID: uintPtr(6),
+		Title:     "Keynote",
+		Position:  1,
+		StartTime: utils.TimeOnly{Time: time.Date(0, 1, 1, 9, 0, 0, 0, time.UTC)},
+		EndTime:   utils.TimeOnly{Time: time.Date(0, 1, 1, 10, 0, 0, 0, time.UTC)},
+		Speakers:  []models.EventSpeaker{{Model: gorm.Model{ID: 9}, Name: "Somchai"}},
+	}}
+
+	return event
+}
+
+func TestCopyEventDraft(t *testing.T) {
+	event := templateEvent()
+
+	draft := service.CopyEventDraft(event)
+	assert.Zero(t, draft.ID)
+	assert.Zero(t, draft.OrganizationID)
+	assert.Equal(t, string(models.Draft), draft.Status)
+	assert.Equal(t, event.Name, draft.Name)
+	assert.Equal(t, event.PicUrl, draft.PicUrl)
+	assert.Empty(t, draft.RecurrenceRule)
+	assert.Empty(t, draft.Sessions)
+	assert.Empty(t, draft.Materials)
+	assert.Empty(t, draft.CoHosts)
+
+	assert.Equal(t, []models.Category{{Model: gorm.Model{ID: 3}}}, draft.Categories)
+	assert.Equal(t, []models.ContactChannel{{Media: "facebook", MediaLink: "https://facebook.com/builds"}}, draft.ContactChannels)
+	assert.Equal(t, []models.TicketAvailable{{Title: "Early bird", Quantity: 50, Price: 100}}, draft.TicketAvailable)
+
+	// Speakers keep their ID for the agenda items to refer to them
+	if assert.Len(t, draft.Speakers, 1) {
+		assert.Equal(t, uint(9), draft.Speakers[0].ID)
+		assert.Zero(t, draft.Speakers[0].EventID)
+		assert.Equal(t, "https://example.com/somchai.png", draft.Speakers[0].PicUrl)
+	}
+	if assert.Len(t, draft.AgendaItems, 1) {
+		item := draft.AgendaItems[0]
+		assert.Zero(t, item.ID)
+		assert.Zero(t, item.EventID)
+		assert.Nil(t, item.SessionID)
+		assert.Equal(t, "Keynote", item.Title)
+		assert.Equal(t, []models.EventSpeaker{{Model: gorm.Model{ID: 9}}}, item.Speakers)
+	}
+
+	// The source is left untouched
+	assert.Equal(t, uint(7), event.ID)
+	assert.Equal(t, uint(4), event.ContactChannels[0].ID)
+}
+
+func TestCopyJobDraft(t *testing.T) {
+	job := models.OrgOpenJob{
+		Model:          gorm.Model{ID: 2},
+		OrganizationID: 1,
+		Title:          "Software Engineer",
+		Status:         string(models.JobStatusPublished),
+		SalaryMin:      25000,
+		Prerequisites:  []models.Prerequisite{{Model: gorm.Model{ID: 3}, JobID: 2, Title: "Portfolio", Link: "https://example.com"}},
+		Categories:     []models.Category{{Model: gorm.Model{ID: 4}, Name: "Technology"}},
+		Skills:         []models.Skill{{Model: gorm.Model{ID: 5}, Name: "Go"}},
+	}
+
+	draft := service.CopyJobDraft(job)
+	assert.Zero(t, draft.ID)
+	assert.Zero(t, draft.OrganizationID)
+	assert.Equal(t, string(models.JobStatusDraft), draft.Status)
+	assert.Equal(t, "Software Engineer", draft.Title)
+	assert.Equal(t, float64(25000), draft.SalaryMin)
+	assert.Equal(t, []models.Prerequisite{{Title: "Portfolio", Link: "https://example.com"}}, draft.Prerequisites)
+	assert.Equal(t, []models.Category{{Model: gorm.Model{ID: 4}}}, draft.Categories)
+	assert.Equal(t, []models.Skill{{Model: gorm.Model{ID: 5}}}, draft.Skills)
+}
+
+func TestEventTemplateContentRoundTrip(t *testing.T) {
+	draft := service.CopyEventDraft(templateEvent())
+
+	data, err := json.Marshal(draft)
+	assert.NoError(t, err)
+
+	var saved models.Event
+	assert.NoError(t, json.Unmarshal(data, &saved))
+	assert.Equal(t, draft, service.CopyEventDraft(saved))
+}
+
+func TestBuildOrgTemplateResponse(t *testing.T) {
+	createdAt := time.Date(2025, 1, 20, 10, 0, 0, 0, time.FixedZone("ICT", 7*60*60))
+
+	res := dto.BuildOrgTemplateResponse(models.OrgTemplate{
+		Model:  gorm.Model{ID: 1, CreatedAt: createdAt},
+		Kind:   models.EventTemplate,
+		Name:   "Monthly meetup",
+		Title:  "Builds CMU 2025",
+		PicUrl: "https://example.com/picture.png",
+	})
+	assert.Equal(t, dto.OrgTemplateResponse{
+		ID:        1,
+		Kind:      "event",
+		Name:      "Monthly meetup",
+		Title:     "Builds CMU 2025",
+		PicUrl:    "https://example.com/picture.png",
+		CreatedAt: "2025-01-20T03:00:00Z",
+	}, res)
+}
+
+func TestOrgTemplatePermissions(t *testing.T) {
+	allowed := map[string]map[string]bool{}
+	for _, policy := range authorization.GetPermissionsList() {
+		if policy[1] != "OrganizationTemplate" {
+			continue
+		}
+		if allowed[policy[0]] == nil {
+			allowed[policy[0]] = map[string]bool{}
+		}
+		allowed[policy[0]][policy[2]] = true
+	}
+
+	expected := map[string]bool{"read": true, "create": true, "delete": true}
+	assert.Equal(t, expected, allowed["moderator"])
+	assert.Equal(t, expected, allowed["owner"])
+}
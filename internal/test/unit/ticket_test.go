@@ -0,0 +1,96 @@
+//go:build unit
+
+package unit_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/dto"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/models"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/infrastructure/ticket"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/service"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInitialTicketStatus(t *testing.T) {
+	assert.Equal(t, models.TicketStatusConfirmed, service.InitialTicketStatus(0))
+	assert.Equal(t, models.TicketStatusPending, service.InitialTicketStatus(500))
+}
+
+func TestBuildTicketAvailableResponse(t *testing.T) {
+	t.Run("FreeTicket", func(t *testing.T) {
+		ticket := service.ConvertToTicketAvailable(1, dto.TicketAvailableRequest{Title: "General", Quantity: 10})
+
+		res := dto.BuildTicketAvailableResponse(ticket)
+		assert.Equal(t, uint(1), res.EventID)
+		assert.True(t, res.IsFree)
+		assert.False(t, res.SoldOut)
+	})
+
+	t.Run("SoldOutPaidTicket", func(t *testing.T) {
+		ticket := service.ConvertToTicketAvailable(1, dto.TicketAvailableRequest{Title: "VIP", Quantity: 0, Price: 1500})
+
+		res := dto.BuildTicketAvailableResponse(ticket)
+		assert.False(t, res.IsFree)
+		assert.True(t, res.SoldOut)
+	})
+}
+
+func TestTicketQRSigner(t *testing.T) {
+	signer := ticket.NewQRSigner("secret")
+
+	payload, err := signer.NewPayload(7)
+	assert.NoError(t, err)
+
+	t.Run("ValidPayload", func(t *testing.T) {
+		eventID, err := signer.Verify(payload)
+		assert.NoError(t, err)
+		assert.Equal(t, uint(7), eventID)
+	})
+
+	t.Run("PayloadsAreNotGuessable", func(t *testing.T) {
+		other, err := signer.NewPayload(7)
+		assert.NoError(t, err)
+		assert.NotEqual(t, payload, other)
+	})
+
+	t.Run("TamperedEvent", func(t *testing.T) {
+		tampered := strings.Replace(payload, "TA1.7.", "TA1.8.", 1)
+		_, err := signer.Verify(tampered)
+		assert.ErrorIs(t, err, ticket.ErrInvalidQRCode)
+	})
+
+	t.Run("OtherSecret", func(t *testing.T) {
+		_, err := ticket.NewQRSigner("other").Verify(payload)
+		assert.ErrorIs(t, err, ticket.ErrInvalidQRCode)
+	})
+
+	t.Run("RenderPNG", func(t *testing.T) {
+		png, err := ticket.RenderPNG(payload)
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("\x89PNG"), png[:4])
+	})
+}
+
+func TestBuildWaitlistEntryResponse(t *testing.T) {
+	t.Run("Waiting", func(t *testing.T) {
+		res := dto.BuildWaitlistEntryResponse(models.WaitlistEntry{Position: 2, Status: models.WaitlistStatusWaiting})
+		assert.Equal(t, 2, res.Position)
+		assert.Empty(t, res.OfferExpiresAt)
+	})
+
+	t.Run("Offered", func(t *testing.T) {
+		offeredAt := time.Date(2024, time.November, 29, 8, 0, 0, 0, time.UTC)
+		expiresAt := offeredAt.Add(24 * time.Hour)
+
+		res := dto.BuildWaitlistEntryResponse(models.WaitlistEntry{
+			Status:         models.WaitlistStatusOffered,
+			OfferedAt:      &offeredAt,
+			OfferExpiresAt: &expiresAt,
+		})
+		assert.Equal(t, "offered", res.Status)
+		assert.Equal(t, "2024-11-30 08:00:00", res.OfferExpiresAt)
+	})
+}
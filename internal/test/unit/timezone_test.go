@@ -0,0 +1,103 @@
+//go:build unit
+
+package unit_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/service"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoadTimezone(t *testing.T) {
+	t.Run("DefaultWhenEmpty", func(t *testing.T) {
+		loc, err := utils.LoadTimezone("")
+		assert.NoError(t, err)
+		assert.Equal(t, utils.DefaultTimezone, loc.String())
+	})
+
+	t.Run("IANAName", func(t *testing.T) {
+		loc, err := utils.LoadTimezone("Europe/Berlin")
+		assert.NoError(t, err)
+		assert.Equal(t, "Europe/Berlin", loc.String())
+	})
+
+	t.Run("RejectsLocalAndUnknown", func(t *testing.T) {
+		_, err := utils.LoadTimezone("Local")
+		assert.Error(t, err)
+
+		_, err = utils.LoadTimezone("Mars/Olympus_Mons")
+		assert.Error(t, err)
+	})
+}
+
+func TestEventInstants(t *testing.T) {
+	berlin, _ := utils.LoadTimezone("Europe/Berlin")
+
+	t.Run("FollowsDaylightSavingTime", func(t *testing.T) {
+		start, end, allDay := utils.EventInstants(utils.DateParser("2025-03-29"), utils.DateParser("2025-03-30"),
+			utils.TimeParser("09:00:00"), utils.TimeParser("09:00:00"), berlin)
+		assert.False(t, allDay)
+		assert.Equal(t, "2025-03-29T08:00:00Z", start.UTC().Format(time.RFC3339))
+		assert.Equal(t, "2025-03-30T07:00:00Z", end.UTC().Format(time.RFC3339))
+	})
+
+	t.Run("AllDay", func(t *testing.T) {
+		start, end, allDay := utils.EventInstants(utils.DateParser("2025-01-25"), utils.DateParser("2025-01-26"),
+			utils.TimeParser("00:00:00"), utils.TimeParser("00:00:00"), berlin)
+		assert.True(t, allDay)
+		assert.Equal(t, "2025-01-25T00:00:00+01:00", start.Format(time.RFC3339))
+		assert.Equal(t, "2025-01-27T00:00:00+01:00", end.Format(time.RFC3339))
+	})
+
+	t.Run("InvertedEndLastsAnHour", func(t *testing.T) {
+		start, end, _ := utils.EventInstants(utils.DateParser("2025-01-25"), utils.DateParser("2025-01-25"),
+			utils.TimeParser("17:00:00"), utils.TimeParser("09:00:00"), berlin)
+		assert.Equal(t, time.Hour, end.Sub(start))
+	})
+}
+
+func TestScheduleEvent(t *testing.T) {
+	t.Run("DefaultZone", func(t *testing.T) {
+		event := calendarTestEvent()
+		assert.NoError(t, service.ScheduleEvent(&event))
+		assert.Equal(t, utils.DefaultTimezone, event.Timezone)
+		assert.Equal(t, "2025-01-25T02:00:00Z", utils.FormatUTC(event.StartAt))
+		assert.Equal(t, "2025-01-26T10:30:00Z", utils.FormatUTC(event.EndAt))
+	})
+
+	t.Run("InvalidZone", func(t *testing.T) {
+		event := calendarTestEvent()
+		event.Timezone = "Nowhere/City"
+		assert.Error(t, service.ScheduleEvent(&event))
+		assert.Nil(t, event.StartAt)
+	})
+
+	t.Run("ResponseCarriesLocalAndUTC", func(t *testing.T) {
+		event := calendarTestEvent()
+		event.Timezone = "Europe/Berlin"
+		assert.NoError(t, service.ScheduleEvent(&event))
+
+		res := service.ConvertToEventResponse(event)
+		assert.Equal(t, "09:00:00", res.StartTime)
+		assert.Equal(t, "Europe/Berlin", res.Timezone)
+		assert.Equal(t, "2025-01-25T08:00:00Z", res.StartAt)
+	})
+}
+
+func TestGetDateRangeInZone(t *testing.T) {
+	tokyo, _ := utils.LoadTimezone("Asia/Tokyo")
+	start, end := utils.GetDateRange("today", tokyo)
+
+	now := time.Now().In(tokyo)
+	assert.Equal(t, tokyo, start.Location())
+	assert.Equal(t, now.Day(), start.Day())
+	assert.Equal(t, 0, start.Hour())
+	assert.True(t, end.After(now) || end.Equal(now))
+
+	start, end = utils.GetDateRange("", tokyo)
+	assert.True(t, start.IsZero())
+	assert.True(t, end.IsZero())
+}
@@ -0,0 +1,181 @@
+//go:build unit
+
+package unit_test
+
+import (
+	"io"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/dto"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/models"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/service"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/pkg/richtext"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/utils"
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func TestGetLocaleFromFiberCtx(t *testing.T) {
+	app := fiber.New()
+	app.Get("/", func(c *fiber.Ctx) error {
+		return c.SendString(utils.GetLocaleFromFiberCtx(c))
+	})
+
+	tests := map[string]struct {
+		header   string
+		expected string
+	}{
+		"none":         {"", "th"},
+		"english":      {"en", "en"},
+		"region":       {"en-US,en;q=0.9", "en"},
+		"preferred":    {"en;q=0.5,th", "th"},
+		"unsupported":  {"fr-FR,de", "th"},
+		"second match": {"fr-FR,en;q=0.8", "en"},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			req := httptest.NewRequest("GET", "/", nil)
+			if tt.header != "" {
+				req.Header.Set(fiber.HeaderAcceptLanguage, tt.header)
+			}
+
+			res, err := app.Test(req)
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			body, _ := io.ReadAll(res.Body)
+			assert.Equal(t, tt.expected, string(body))
+			assert.Contains(t, res.Header.Get(fiber.HeaderVary), fiber.HeaderAcceptLanguage)
+		})
+	}
+}
+
+func translatedEvent() models.Event {
+	return models.Event{
+		Model:         gorm.Model{ID: 1},
+		Name:          "งานสัมมนา ESG",
+		Content:       "**สวัสดี**",
+		ContentFormat: richtext.FormatMarkdown,
+		Organization: models.Organization{
+			Name:         "บิลด์ส",
+			Translations: []models.OrganizationTranslation{{Locale: "en", Name: "Builds"}},
+		},
+		Categories: []models.Category{{
+			Model:        gorm.Model{ID: 3},
+			Name:         "forum",
+			Translations: []models.CategoryTranslation{{Locale: "th", Name: "เวทีเสวนา"}},
+		}},
+		Translations: []models.EventTranslation{{Locale: "en", Name: "ESG Seminar", Content: "**Hello**"}},
+	}
+}
+
+func TestEventResponseLocalize(t *testing.T) {
+	res := service.ConvertToEventResponse(translatedEvent())
+	assert.Equal(t, "<p><strong>Hello</strong></p>\n", res.Translations["en"].ContentHTML)
+
+	res.Localize("en")
+	assert.Equal(t, "ESG Seminar", res.Name)
+	assert.Equal(t, "**Hello**", res.Content)
+	assert.Equal(t, "<p><strong>Hello</strong></p>\n", res.ContentHTML)
+	assert.Equal(t, "Builds", res.Organization.Name)
+	// Categories without an English label keep the authored one
+	assert.Equal(t, "forum", res.Categories[0].Label)
+}
+
+func TestEventResponseLocalizeFallsBack(t *testing.T) {
+	event := translatedEvent()
+	event.Translations[0].Content = ""
+
+	res := service.ConvertToEventResponse(event)
+	res.Localize("en")
+	assert.Equal(t, "ESG Seminar", res.Name)
+	assert.Equal(t, "**สวัสดี**", res.Content)
+	assert.Equal(t, "<p><strong>สวัสดี</strong></p>\n", res.ContentHTML)
+
+	res = service.ConvertToEventResponse(translatedEvent())
+	res.Localize("th")
+	assert.Equal(t, "งานสัมมนา ESG", res.Name)
+	assert.Equal(t, "บิลด์ส", res.Organization.Name)
+	assert.Equal(t, "เวทีเสวนา", res.Categories[0].Label)
+}
+
+func TestEventDocumentResponseLocalize(t *testing.T) {
+	res := service.ConvertToEventDocumentResponse(translatedEvent())
+	assert.Equal(t, "Hello", res.Translations["en"].Content)
+
+	res.Localize("en")
+	assert.Equal(t, "ESG Seminar", res.Name)
+}
+
+func TestConvertToJobRequestTranslations(t *testing.T) {
+	job := service.ConvertToJobRequest(1, dto.JobRequest{
+		JobTitle:    "วิศวกรซอฟต์แวร์",
+		Description: "เขียน **Go**",
+		TextFormat:  "markdown",
+		Translations: []dto.JobTranslationRequest{
+			{Locale: "en", JobTitle: "Software Engineer", Description: "Write **Go**", Qualifications: "- Go"},
+		},
+	}, nil, nil)
+
+	if assert.Len(t, job.Translations, 1) {
+		assert.Equal(t, "<p>Write <strong>Go</strong></p>\n", job.Translations[0].DescriptionHTML)
+		assert.Equal(t, "<ul>\n<li>Go</li>\n</ul>\n", job.Translations[0].QualificationsHTML)
+	}
+
+	res := service.ConvertToJobResponse(job)
+	res.Localize("en")
+	assert.Equal(t, "Software Engineer", res.JobTitle)
+	assert.Equal(t, "<p>Write <strong>Go</strong></p>\n", res.DescriptionHTML)
+	assert.Equal(t, "<ul>\n<li>Go</li>\n</ul>\n", res.QualificationsHTML)
+
+	document := service.ConvertToJobDocumentDTOResponse(job)
+	document.Localize("en")
+	assert.Equal(t, "Software Engineer", document.Title)
+	assert.Equal(t, "Write Go", document.Description)
+}
+
+func TestConvertToOrgRequestTranslations(t *testing.T) {
+	org := service.ConvertToOrgRequest(dto.OrganizationRequest{
+		Name:         "บิลด์ส",
+		Description:  "<p>สวัสดี</p>",
+		Translations: []dto.OrganizationTranslationRequest{{Locale: "en", Name: "Builds", Description: `<p onclick="x">Hello</p>`}},
+	}, nil, []*models.Industry{{
+		Model:        gorm.Model{ID: 2},
+		Industry:     "Environment",
+		Translations: []models.IndustryTranslation{{Locale: "th", Name: "สิ่งแวดล้อม"}},
+	}})
+
+	res := service.ConvertToOrgResponse(org)
+	assert.Equal(t, map[string]string{"th": "สิ่งแวดล้อม"}, res.Industries[0].Translations)
+
+	res.Localize("en")
+	assert.Equal(t, "Builds", res.Name)
+	assert.Equal(t, "<p>Hello</p>", res.DescriptionHTML)
+	assert.Equal(t, "Environment", res.Industries[0].Name)
+
+	res = service.ConvertToOrgResponse(org)
+	res.Localize("th")
+	assert.Equal(t, "บิลด์ส", res.Name)
+	assert.Equal(t, "สิ่งแวดล้อม", res.Industries[0].Name)
+}
+
+func TestCopyDraftTranslations(t *testing.T) {
+	event := models.Event{Translations: []models.EventTranslation{{Model: gorm.Model{ID: 4}, EventID: 1, Locale: "en", Name: "ESG Seminar"}}}
+	assert.Equal(t, []models.EventTranslation{{Locale: "en", Name: "ESG Seminar"}}, service.CopyEventDraft(event).Translations)
+
+	job := models.OrgOpenJob{Translations: []models.OrgOpenJobTranslation{{Model: gorm.Model{ID: 5}, JobID: 2, Locale: "en", Title: "Software Engineer"}}}
+	assert.Equal(t, []models.OrgOpenJobTranslation{{Locale: "en", Title: "Software Engineer"}}, service.CopyJobDraft(job).Translations)
+}
+
+func TestBuildCategoryResponseWithoutTranslations(t *testing.T) {
+	res := dto.BuildCategoryResponse(models.Category{Model: gorm.Model{ID: 1}, Name: "all"})
+	assert.Nil(t, res.Translations)
+
+	categories := []dto.CategoryResponses{res}
+	dto.LocalizeCategories(categories, "th")
+	assert.Equal(t, "all", categories[0].Label)
+}
@@ -56,3 +56,29 @@ func AuthMiddleware(jwtSecret string) fiber.Handler {
 		return c.Next()
 	}
 }
+
+// OptionalAuthMiddleware sets the user information in the context when a valid token is present,
+// but lets anonymous requests through so public routes can personalize their responses.
+func OptionalAuthMiddleware(jwtSecret string) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		tokenString := strings.TrimPrefix(c.Get("Authorization"), "Bearer ")
+		if tokenString == "" {
+			tokenString = c.Cookies("authToken")
+		}
+		if tokenString == "" {
+			return c.Next()
+		}
+
+		token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
+			if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+				return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+			}
+			return []byte(jwtSecret), nil
+		})
+		if err == nil && token.Valid {
+			c.Locals("user", token.Claims.(jwt.MapClaims))
+		}
+
+		return c.Next()
+	}
+}
@@ -8,12 +8,20 @@ import (
 	"github.com/golang-jwt/jwt/v5"
 )
 
+// OrganizationSuspension tells whether moderation suspended an organization
+type OrganizationSuspension interface {
+	IsSuspended(orgID uint) (bool, error)
+}
+
 type RBACMiddleware struct {
-	enforcer casbin.IEnforcer
+	enforcer   casbin.IEnforcer
+	suspension OrganizationSuspension
 }
 
-func NewRBACMiddleware(enforcer casbin.IEnforcer) *RBACMiddleware {
-	return &RBACMiddleware{enforcer: enforcer}
+// NewRBACMiddleware authorizes the members of organizations, those of a suspended organization
+// keep reading its resources but every other action is refused
+func NewRBACMiddleware(enforcer casbin.IEnforcer, suspension OrganizationSuspension) *RBACMiddleware {
+	return &RBACMiddleware{enforcer: enforcer, suspension: suspension}
 }
 
 func (r *RBACMiddleware) EnforceMiddleware(resources string, act string) fiber.Handler {
@@ -52,6 +60,17 @@ func (r *RBACMiddleware) EnforceMiddleware(resources string, act string) fiber.H
 			return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": "You are not authorized"})
 
 		}
+
+		if act != "read" && r.suspension != nil {
+			suspended, err := r.suspension.IsSuspended(uint(orgID))
+			if err != nil {
+				return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "Error occurred when authorizing user"})
+			}
+			if suspended {
+				return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": "organization is suspended"})
+			}
+		}
+
 		return c.Next()
 	}
 }
@@ -61,3 +80,29 @@ func (r *RBACMiddleware) EnforceMiddlewareWithResources(resources string) func(a
 		return r.EnforceMiddleware(resources, act)
 	}
 }
+
+// EnforceSystemAdminMiddleware only lets through users in the "System Admin" group (g2),
+// for platform wide resources that do not belong to an organization.
+func (r *RBACMiddleware) EnforceSystemAdminMiddleware() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		userData, ok := c.Locals("user").(jwt.MapClaims)
+		if !ok {
+			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+		}
+
+		sub, ok := userData["user_id"].(string)
+		if !ok {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid user_id uuid"})
+		}
+
+		ok, err := r.enforcer.HasNamedGroupingPolicy("g2", sub, "System Admin")
+		if err != nil {
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "Error occurred when authorizing user"})
+		}
+		if !ok {
+			return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": "You are not authorized"})
+		}
+
+		return c.Next()
+	}
+}
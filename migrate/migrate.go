@@ -5,7 +5,10 @@ import (
 
 	"github.com/DAF-Bridge/Talent-Atmos-Backend/initializers"
 	"github.com/DAF-Bridge/Talent-Atmos-Backend/internal/domain/models"
-	// "github.com/DAF-Bridge/Talent-Atmos-Backend/utils"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/pkg/richtext"
+	"github.com/DAF-Bridge/Talent-Atmos-Backend/utils"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 func init() {
@@ -48,6 +51,120 @@ func main() {
 		log.Fatal(err)
 	}
 
+	if err := migrateJobSalaryRange(initializers.DB); err != nil {
+		log.Fatal(err)
+	}
+
+	// Skills catalogue, linked to profiles (profile_skill) and jobs (job_skill)
+	if err := initializers.DB.AutoMigrate(&models.Skill{}, &models.SkillSynonym{}, &models.Profile{}, &models.OrgOpenJob{}); err != nil {
+		log.Fatal(err)
+	}
+
+	// Public profile settings and the log of organizations viewing them
+	if err := initializers.DB.AutoMigrate(&models.Profile{}, &models.ProfileView{}); err != nil {
+		log.Fatal(err)
+	}
+
+	// Resume versions and the job applications they are attached to
+	if err := initializers.DB.AutoMigrate(&models.Resume{}, &models.JobApplication{}); err != nil {
+		log.Fatal(err)
+	}
+
+	// Ticket types, registrations and the attendees they create
+	if err := initializers.DB.AutoMigrate(&models.TicketAvailable{}, &models.TicketPurchased{}, &models.EventParticipant{}); err != nil {
+		log.Fatal(err)
+	}
+
+	// Ticket QR codes and attendance
+	if err := initializers.DB.AutoMigrate(&models.TicketPurchased{}, &models.EventParticipant{}); err != nil {
+		log.Fatal(err)
+	}
+
+	// Waitlists of sold out ticket types
+	if err := initializers.DB.AutoMigrate(&models.WaitlistEntry{}); err != nil {
+		log.Fatal(err)
+	}
+
+	// Orders of paid tickets and their payment attempts
+	if err := initializers.DB.AutoMigrate(&models.Order{}, &models.Payment{}); err != nil {
+		log.Fatal(err)
+	}
+
+	// Promo codes, their redemptions and the discount kept on tickets
+	if err := initializers.DB.AutoMigrate(&models.TicketPurchased{}, &models.PromoCode{}, &models.PromoRedemption{}); err != nil {
+		log.Fatal(err)
+	}
+
+	// One active registration per user and event
+	if err := initializers.DB.AutoMigrate(&models.TicketPurchased{}); err != nil {
+		log.Fatal(err)
+	}
+
+	// Saved events and personal calendar feeds
+	if err := initializers.DB.AutoMigrate(&models.SavedEvent{}, &models.CalendarFeed{}); err != nil {
+		log.Fatal(err)
+	}
+
+	// Event timezones and the UTC instants derived from the local dates and times
+	if err := migrateEventSchedule(initializers.DB); err != nil {
+		log.Fatal(err)
+	}
+
+	// Sessions of multi-session programs and the recurrence rule of events
+	if err := initializers.DB.AutoMigrate(&models.Event{}, &models.EventSession{}); err != nil {
+		log.Fatal(err)
+	}
+
+	// Agendas, speakers and downloadable materials of events
+	if err := initializers.DB.AutoMigrate(&models.EventSpeaker{}, &models.AgendaItem{}, &models.EventMaterial{}); err != nil {
+		log.Fatal(err)
+	}
+
+	// Event reminders, scheduled announcements and the notification preferences they respect
+	if err := initializers.DB.AutoMigrate(&models.NotificationPreference{}, &models.EventReminder{}, &models.EventAnnouncement{}); err != nil {
+		log.Fatal(err)
+	}
+
+	// Post-event feedback surveys and their responses
+	if err := initializers.DB.AutoMigrate(&models.EventSurvey{}, &models.SurveyQuestion{}, &models.SurveyQuestionOption{}, &models.SurveyResponse{}, &models.SurveyAnswer{}); err != nil {
+		log.Fatal(err)
+	}
+
+	// Participation certificate templates and the certificates issued
+	if err := initializers.DB.AutoMigrate(&models.CertificateTemplate{}, &models.CertificateField{}, &models.Certificate{}); err != nil {
+		log.Fatal(err)
+	}
+
+	// Partner organizations co-hosting events
+	if err := initializers.DB.AutoMigrate(&models.EventCoHost{}); err != nil {
+		log.Fatal(err)
+	}
+
+	// Event and job templates of organizations
+	if err := initializers.DB.AutoMigrate(&models.OrgTemplate{}); err != nil {
+		log.Fatal(err)
+	}
+
+	// Questions and answers about events and jobs
+	if err := initializers.DB.AutoMigrate(&models.DiscussionQuestion{}, &models.DiscussionAnswer{}); err != nil {
+		log.Fatal(err)
+	}
+
+	// Content reports, the actions of moderators and the content they hide or suspend
+	if err := initializers.DB.AutoMigrate(&models.ContentReport{}, &models.ModerationLog{}, &models.Event{}, &models.OrgOpenJob{}, &models.Organization{}); err != nil {
+		log.Fatal(err)
+	}
+
+	// Formats and sanitized renderings of event contents, organization descriptions and job texts
+	if err := migrateRichText(initializers.DB); err != nil {
+		log.Fatal(err)
+	}
+
+	// Thai and English translations of events, jobs, organizations, categories and industries
+	if err := migrateTranslations(initializers.DB); err != nil {
+		log.Fatal(err)
+	}
+
 	//initializers.DB.AutoMigrate(&models.User{})
 	//initializers.DB.AutoMigrate(&models.Organization{})
 	// initializers.DB.AutoMigrate(&models.OrganizationContact{})
@@ -156,3 +273,171 @@ func main() {
 	// initializers.DB.Migrator().DropColumn(&models.Event{}, "head_line")
 
 }
+
+// migrateJobSalaryRange replaces the single salary column of org_open_jobs with a salary range.
+// Existing salaries were entered as monthly THB amounts, so they become a fixed range in that period and currency.
+func migrateJobSalaryRange(db *gorm.DB) error {
+	return db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.AutoMigrate(&models.OrgOpenJob{}); err != nil {
+			return err
+		}
+
+		if !tx.Migrator().HasColumn(&models.OrgOpenJob{}, "salary") {
+			return nil
+		}
+
+		if err := tx.Exec(`UPDATE org_open_jobs
+			SET salary_min = salary, salary_max = salary, salary_currency = 'THB', salary_period = 'monthly'
+			WHERE salary IS NOT NULL`).Error; err != nil {
+			return err
+		}
+
+		return tx.Migrator().DropColumn(&models.OrgOpenJob{}, "salary")
+	})
+}
+
+// migrateEventSchedule adds the timezone of events and fills the UTC start and end of existing rows.
+// Events created before the column existed were all entered in Asia/Bangkok, the column default.
+func migrateEventSchedule(db *gorm.DB) error {
+	if err := db.AutoMigrate(&models.Event{}); err != nil {
+		return err
+	}
+
+	var events []models.Event
+	return db.Unscoped().Where("start_at IS NULL").FindInBatches(&events, 200, func(tx *gorm.DB, batch int) error {
+		for _, event := range events {
+			loc, err := utils.LoadTimezone(event.Timezone)
+			if err != nil {
+				return err
+			}
+
+			start, end, _ := utils.EventInstants(event.StartDate.Time, event.EndDate.Time, event.StartTime.Time, event.EndTime.Time, loc)
+			if err := tx.Model(&models.Event{}).Unscoped().Where("id = ?", event.ID).
+				UpdateColumns(map[string]interface{}{"start_at": start.UTC(), "end_at": end.UTC()}).Error; err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}).Error
+}
+
+// migrateRichText adds the format and rendered columns of rich text and renders the existing rows.
+// Texts written before were all sent as HTML, the column default.
+func migrateRichText(db *gorm.DB) error {
+	if err := db.AutoMigrate(&models.Event{}, &models.Organization{}, &models.OrgOpenJob{}); err != nil {
+		return err
+	}
+
+	var events []models.Event
+	if err := db.Unscoped().Where("content <> '' AND (content_html IS NULL OR content_html = '')").FindInBatches(&events, 200, func(tx *gorm.DB, batch int) error {
+		for _, event := range events {
+			if err := tx.Model(&models.Event{}).Unscoped().Where("id = ?", event.ID).
+				UpdateColumn("content_html", richtext.Render(event.Content, event.ContentFormat)).Error; err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}).Error; err != nil {
+		return err
+	}
+
+	var orgs []models.Organization
+	if err := db.Unscoped().Where("description <> '' AND (description_html IS NULL OR description_html = '')").FindInBatches(&orgs, 200, func(tx *gorm.DB, batch int) error {
+		for _, org := range orgs {
+			if err := tx.Model(&models.Organization{}).Unscoped().Where("id = ?", org.ID).
+				UpdateColumn("description_html", richtext.Render(org.Description, org.DescriptionFormat)).Error; err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}).Error; err != nil {
+		return err
+	}
+
+	var jobs []models.OrgOpenJob
+	return db.Unscoped().Where("(description <> '' AND (description_html IS NULL OR description_html = '')) OR (qualifications <> '' AND (qualifications_html IS NULL OR qualifications_html = ''))").FindInBatches(&jobs, 200, func(tx *gorm.DB, batch int) error {
+		for _, job := range jobs {
+			if err := tx.Model(&models.OrgOpenJob{}).Unscoped().Where("id = ?", job.ID).
+				UpdateColumns(map[string]interface{}{
+					"description_html":    richtext.Render(job.Description, job.TextFormat),
+					"qualifications_html": richtext.Render(job.Qualifications, job.TextFormat),
+				}).Error; err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}).Error
+}
+
+// thaiCategoryNames are the Thai labels of the seeded categories, by slug
+var thaiCategoryNames = map[string]string{
+	"all":             "ทั้งหมด",
+	"conference":      "การประชุม",
+	"incubation":      "บ่มเพาะธุรกิจ",
+	"networking":      "สร้างเครือข่าย",
+	"forum":           "เวทีเสวนา",
+	"exhibition":      "นิทรรศการ",
+	"competition":     "การแข่งขัน",
+	"workshop":        "เวิร์กช็อป",
+	"campaign":        "แคมเปญ",
+	"esg":             "ESG",
+	"esg-environment": "สิ่งแวดล้อม",
+	"esg-social":      "สังคม",
+	"esg-governance":  "ธรรมาภิบาล",
+}
+
+// thaiIndustryNames are the Thai names of the seeded industries
+var thaiIndustryNames = map[string]string{
+	"Environment": "สิ่งแวดล้อม",
+	"Social":      "สังคม",
+	"Governance":  "ธรรมาภิบาล",
+}
+
+// migrateTranslations creates the translation tables and seeds the Thai labels of the categories and
+// industries, the labels translated by hand since are kept.
+func migrateTranslations(db *gorm.DB) error {
+	if err := db.AutoMigrate(&models.EventTranslation{}, &models.OrgOpenJobTranslation{}, &models.OrganizationTranslation{},
+		&models.CategoryTranslation{}, &models.IndustryTranslation{}); err != nil {
+		return err
+	}
+
+	var categories []models.Category
+	if err := db.Find(&categories).Error; err != nil {
+		return err
+	}
+
+	for _, category := range categories {
+		name, ok := thaiCategoryNames[category.Slug]
+		if !ok {
+			continue
+		}
+
+		translation := models.CategoryTranslation{CategoryID: category.ID, Locale: utils.LocaleThai, Name: name}
+		if err := db.Clauses(clause.OnConflict{DoNothing: true}).Create(&translation).Error; err != nil {
+			return err
+		}
+	}
+
+	var industries []models.Industry
+	if err := db.Find(&industries).Error; err != nil {
+		return err
+	}
+
+	for _, industry := range industries {
+		name, ok := thaiIndustryNames[industry.Industry]
+		if !ok {
+			continue
+		}
+
+		translation := models.IndustryTranslation{IndustryID: industry.ID, Locale: utils.LocaleThai, Name: name}
+		if err := db.Clauses(clause.OnConflict{DoNothing: true}).Create(&translation).Error; err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -9,11 +9,13 @@ var permissionsList [][]string
 func init() {
 	allRole = []string{"moderator", "owner", "system_admin"}
 	moderatorPermissionsMap := map[string][]string{
-		"Event":               {"delete", "update", "create", "read"},
-		"Organization":        {"update", "read"},
-		"OrganizationContact": {"delete", "update", "create", "read"},
-		"OrganizationOpenJob": {"delete", "update", "create", "read"},
-		"Role":                {"read"},
+		"Event":                {"delete", "update", "create", "read"},
+		"Organization":         {"update", "read"},
+		"OrganizationContact":  {"delete", "update", "create", "read"},
+		"OrganizationOpenJob":  {"delete", "update", "create", "read"},
+		"Role":                 {"read"},
+		"EventCoHost":          {"read"},
+		"OrganizationTemplate": {"delete", "create", "read"},
 	}
 	moderatorPermissionsList := createCasbinPermissionsList("moderator", moderatorPermissionsMap)
 	permissionsList = append(permissionsList, moderatorPermissionsList...)
@@ -21,6 +23,8 @@ func init() {
 	ownerPermissionsMap := map[string][]string{
 		"Organization": {"delete"},
 		"Role":         {"remove", "edit", "invite", "read"},
+		// Only owners bind their organization to the events of another
+		"EventCoHost": {"invite", "accept", "remove"},
 	}
 	mergeMapSlice(ownerPermissionsMap, moderatorPermissionsMap)
 	ownerPermissionsList := createCasbinPermissionsList("owner", ownerPermissionsMap)
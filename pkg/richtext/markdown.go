@@ -0,0 +1,145 @@
+package richtext
+
+import (
+	"html"
+	"regexp"
+	"strings"
+)
+
+var (
+	headingPattern    = regexp.MustCompile(`^(#{1,6})\s+(.*?)(?:\s+#+)?\s*$`)
+	unorderedPattern  = regexp.MustCompile(`^\s*[-*+]\s+(.*)$`)
+	orderedPattern    = regexp.MustCompile(`^\s*\d+[.)]\s+(.*)$`)
+	rulePattern       = regexp.MustCompile(`^\s*(?:(?:-\s*){3,}|(?:\*\s*){3,}|(?:_\s*){3,})$`)
+	linkPattern       = regexp.MustCompile(`\[([^\]]+)\]\(([^)\s]+)\)`)
+	strongPattern     = regexp.MustCompile(`\*\*([^*]+)\*\*|__([^_]+)__`)
+	emphasisPattern   = regexp.MustCompile(`\*([^*]+)\*`)
+	underscorePattern = regexp.MustCompile(`(^|[^\w])_([^_]+)_([^\w]|$)`)
+	strikePattern     = regexp.MustCompile(`~~([^~]+)~~`)
+	blockquotePattern = regexp.MustCompile(`^\s*>\s?(.*)$`)
+	codeFencePattern  = regexp.MustCompile("^\\s*```")
+	hardBreakPattern  = regexp.MustCompile(` {2,}$`)
+)
+
+const codeSpanDelimiter = "`"
+
+// Markdown renders the common Markdown blocks: headings, paragraphs, lists, block quotes, fenced code and
+// rules, with links, code, bold, italic and strikethrough inside them. Raw HTML is escaped rather than
+// passed through, the result still goes through Sanitize for the addresses of the links.
+func Markdown(source string) string {
+	lines := strings.Split(strings.ReplaceAll(source, "\r\n", "\n"), "\n")
+
+	var b strings.Builder
+	renderBlocks(&b, lines)
+
+	return b.String()
+}
+
+func renderBlocks(b *strings.Builder, lines []string) {
+	var paragraph []string
+	endParagraph := func() {
+		if len(paragraph) == 0 {
+			return
+		}
+
+		b.WriteString("<p>")
+		for i, line := range paragraph {
+			if i > 0 {
+				b.WriteString("\n")
+			}
+			b.WriteString(inline(strings.TrimSpace(line)))
+			if i < len(paragraph)-1 && hardBreakPattern.MatchString(line) {
+				b.WriteString("<br />")
+			}
+		}
+		b.WriteString("</p>\n")
+		paragraph = nil
+	}
+
+	for i := 0; i < len(lines); i++ {
+		line := lines[i]
+
+		switch {
+		case strings.TrimSpace(line) == "":
+			endParagraph()
+		case codeFencePattern.MatchString(line):
+			endParagraph()
+
+			var code []string
+			for i++; i < len(lines) && !codeFencePattern.MatchString(lines[i]); i++ {
+				code = append(code, lines[i])
+			}
+			b.WriteString("<pre><code>" + html.EscapeString(strings.Join(code, "\n")) + "</code></pre>\n")
+		case headingPattern.MatchString(line):
+			endParagraph()
+
+			match := headingPattern.FindStringSubmatch(line)
+			level := string(rune('0' + len(match[1])))
+			b.WriteString("<h" + level + ">" + inline(match[2]) + "</h" + level + ">\n")
+		case rulePattern.MatchString(line):
+			endParagraph()
+			b.WriteString("<hr />\n")
+		case blockquotePattern.MatchString(line):
+			endParagraph()
+
+			var quote []string
+			for ; i < len(lines) && blockquotePattern.MatchString(lines[i]); i++ {
+				quote = append(quote, blockquotePattern.FindStringSubmatch(lines[i])[1])
+			}
+			i--
+
+			b.WriteString("<blockquote>\n")
+			renderBlocks(b, quote)
+			b.WriteString("</blockquote>\n")
+		case unorderedPattern.MatchString(line), orderedPattern.MatchString(line):
+			endParagraph()
+
+			pattern, tag := unorderedPattern, "ul"
+			if !unorderedPattern.MatchString(line) {
+				pattern, tag = orderedPattern, "ol"
+			}
+
+			b.WriteString("<" + tag + ">\n")
+			for ; i < len(lines) && pattern.MatchString(lines[i]); i++ {
+				b.WriteString("<li>" + inline(pattern.FindStringSubmatch(lines[i])[1]) + "</li>\n")
+			}
+			i--
+			b.WriteString("</" + tag + ">\n")
+		default:
+			paragraph = append(paragraph, line)
+		}
+	}
+	endParagraph()
+}
+
+// inline renders the spans of a line, code spans are kept verbatim.
+func inline(text string) string {
+	parts := strings.Split(text, codeSpanDelimiter)
+
+	var b strings.Builder
+	for i, part := range parts {
+		// An odd number of parts has every code span closed, the last delimiter is literal otherwise
+		inCode := i%2 == 1 && (len(parts)%2 == 1 || i < len(parts)-1)
+		switch {
+		case inCode:
+			b.WriteString("<code>" + html.EscapeString(part) + "</code>")
+		case i%2 == 1:
+			b.WriteString(codeSpanDelimiter + spans(part))
+		default:
+			b.WriteString(spans(part))
+		}
+	}
+
+	return b.String()
+}
+
+func spans(text string) string {
+	text = html.EscapeString(text)
+	text = linkPattern.ReplaceAllString(text, `<a href="$2">$1</a>`)
+	text = strongPattern.ReplaceAllString(text, `<strong>$1$2</strong>`)
+	text = emphasisPattern.ReplaceAllString(text, `<em>$1</em>`)
+	text = underscorePattern.ReplaceAllString(text, `$1<em>$2</em>$3`)
+	text = strikePattern.ReplaceAllString(text, `<del>$1</del>`)
+
+	return text
+}
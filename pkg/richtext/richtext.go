@@ -0,0 +1,38 @@
+// Package richtext turns the rich text authored on the platform into HTML that is safe to show, and into
+// plain text for search.
+package richtext
+
+type Format string
+
+const (
+	FormatHTML     Format = "html"     // A restricted subset of HTML
+	FormatMarkdown Format = "markdown" // Markdown without raw HTML
+)
+
+// ParseFormat reads the format of a source, HTML when empty or unknown as it is what the frontend sent so far.
+func ParseFormat(value string) Format {
+	if Format(value) == FormatMarkdown {
+		return FormatMarkdown
+	}
+
+	return FormatHTML
+}
+
+// Render converts the source to sanitized HTML.
+func Render(source string, format Format) string {
+	if format == FormatMarkdown {
+		return Sanitize(Markdown(source))
+	}
+
+	return Sanitize(source)
+}
+
+// Rendered returns the rendered form stored with the source, the source is rendered for the rows saved
+// before it was stored.
+func Rendered(source string, rendered string, format Format) string {
+	if rendered == "" && source != "" {
+		return Render(source, format)
+	}
+
+	return rendered
+}
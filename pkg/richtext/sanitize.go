@@ -0,0 +1,280 @@
+package richtext
+
+import (
+	"net/url"
+	"strings"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// allowedTags maps the tags kept by Sanitize to the attributes kept on them, anything else is dropped.
+var allowedTags = map[atom.Atom][]string{
+	atom.P:          nil,
+	atom.Br:         nil,
+	atom.Hr:         nil,
+	atom.Strong:     nil,
+	atom.B:          nil,
+	atom.Em:         nil,
+	atom.I:          nil,
+	atom.U:          nil,
+	atom.S:          nil,
+	atom.Del:        nil,
+	atom.Sub:        nil,
+	atom.Sup:        nil,
+	atom.H1:         nil,
+	atom.H2:         nil,
+	atom.H3:         nil,
+	atom.H4:         nil,
+	atom.H5:         nil,
+	atom.H6:         nil,
+	atom.Ul:         nil,
+	atom.Ol:         nil,
+	atom.Li:         nil,
+	atom.Blockquote: nil,
+	atom.Code:       nil,
+	atom.Pre:        nil,
+	atom.A:          {"href", "title"},
+	atom.Img:        {"src", "alt", "title"},
+}
+
+// droppedTags are removed together with their content.
+var droppedTags = map[atom.Atom]bool{
+	atom.Script:    true,
+	atom.Style:     true,
+	atom.Iframe:    true,
+	atom.Object:    true,
+	atom.Embed:     true,
+	atom.Noscript:  true,
+	atom.Noembed:   true,
+	atom.Noframes:  true,
+	atom.Template:  true,
+	atom.Textarea:  true,
+	atom.Title:     true,
+	atom.Select:    true,
+	atom.Svg:       true,
+	atom.Math:      true,
+	atom.Plaintext: true,
+	atom.Xmp:       true,
+}
+
+var voidTags = map[atom.Atom]bool{
+	atom.Br:  true,
+	atom.Hr:  true,
+	atom.Img: true,
+}
+
+// blockTags start a new line in plain text.
+var blockTags = map[atom.Atom]bool{
+	atom.P:          true,
+	atom.Br:         true,
+	atom.Hr:         true,
+	atom.Div:        true,
+	atom.H1:         true,
+	atom.H2:         true,
+	atom.H3:         true,
+	atom.H4:         true,
+	atom.H5:         true,
+	atom.H6:         true,
+	atom.Ul:         true,
+	atom.Ol:         true,
+	atom.Li:         true,
+	atom.Blockquote: true,
+	atom.Pre:        true,
+	atom.Tr:         true,
+	atom.Table:      true,
+}
+
+// Sanitize keeps the allow-listed tags and attributes of the HTML and escapes its text, links may only
+// point to http, https and mailto addresses. Tags left open are closed so the result can be embedded.
+func Sanitize(source string) string {
+	var b strings.Builder
+	var open []atom.Atom
+	skip := skipper{}
+
+	tokenizer := html.NewTokenizer(strings.NewReader(source))
+	for {
+		tokenType := tokenizer.Next()
+		if tokenType == html.ErrorToken {
+			// The end of the source, the tokenizer reads from memory so there is no other error
+			break
+		}
+
+		token := tokenizer.Token()
+		if skip.skipping(tokenType, token) {
+			continue
+		}
+
+		switch tokenType {
+		case html.TextToken:
+			b.WriteString(html.EscapeString(token.Data))
+		case html.StartTagToken, html.SelfClosingTagToken:
+			attrs, ok := allowedTags[token.DataAtom]
+			if !ok {
+				continue
+			}
+
+			writeStartTag(&b, token, attrs)
+			if voidTags[token.DataAtom] {
+				continue
+			}
+			if tokenType == html.SelfClosingTagToken {
+				b.WriteString("</" + token.DataAtom.String() + ">")
+				continue
+			}
+			open = append(open, token.DataAtom)
+		case html.EndTagToken:
+			// Only the tags still open are closed, along with those opened inside them
+			for i := len(open) - 1; i >= 0; i-- {
+				if open[i] != token.DataAtom {
+					continue
+				}
+				for j := len(open) - 1; j >= i; j-- {
+					b.WriteString("</" + open[j].String() + ">")
+				}
+				open = open[:i]
+				break
+			}
+		}
+	}
+
+	for i := len(open) - 1; i >= 0; i-- {
+		b.WriteString("</" + open[i].String() + ">")
+	}
+
+	return b.String()
+}
+
+// PlainText extracts the text of the HTML for search, blocks are separated by new lines.
+func PlainText(source string) string {
+	var lines []string
+	var line strings.Builder
+	skip := skipper{}
+
+	flush := func() {
+		if text := strings.Join(strings.Fields(line.String()), " "); text != "" {
+			lines = append(lines, text)
+		}
+		line.Reset()
+	}
+
+	tokenizer := html.NewTokenizer(strings.NewReader(source))
+	for {
+		tokenType := tokenizer.Next()
+		if tokenType == html.ErrorToken {
+			break
+		}
+
+		token := tokenizer.Token()
+		if skip.skipping(tokenType, token) {
+			continue
+		}
+
+		switch tokenType {
+		case html.TextToken:
+			line.WriteString(token.Data)
+		case html.StartTagToken, html.EndTagToken, html.SelfClosingTagToken:
+			if blockTags[token.DataAtom] {
+				flush()
+			} else {
+				line.WriteString(" ")
+			}
+		}
+	}
+	flush()
+
+	return strings.Join(lines, "\n")
+}
+
+// skipper tracks the dropped tag being skipped, tags of the same name may be nested in it.
+type skipper struct {
+	tag   atom.Atom
+	depth int
+}
+
+func (s *skipper) skipping(tokenType html.TokenType, token html.Token) bool {
+	if s.depth == 0 {
+		if tokenType == html.StartTagToken && droppedTags[token.DataAtom] {
+			s.tag, s.depth = token.DataAtom, 1
+			return true
+		}
+		// A self closing dropped tag has no content to skip
+		return tokenType == html.SelfClosingTagToken && droppedTags[token.DataAtom]
+	}
+
+	if token.DataAtom == s.tag {
+		switch tokenType {
+		case html.StartTagToken:
+			s.depth++
+		case html.EndTagToken:
+			s.depth--
+		}
+	}
+
+	return true
+}
+
+func writeStartTag(b *strings.Builder, token html.Token, allowed []string) {
+	b.WriteString("<" + token.DataAtom.String())
+
+	for _, attr := range token.Attr {
+		if attr.Namespace != "" || !contains(allowed, attr.Key) {
+			continue
+		}
+
+		value := attr.Val
+		if attr.Key == "href" || attr.Key == "src" {
+			var ok bool
+			if value, ok = safeURL(value, attr.Key == "href"); !ok {
+				continue
+			}
+		}
+
+		b.WriteString(" " + attr.Key + `="` + html.EscapeString(value) + `"`)
+	}
+
+	if token.DataAtom == atom.A {
+		b.WriteString(` rel="nofollow noopener noreferrer"`)
+	}
+
+	if voidTags[token.DataAtom] {
+		b.WriteString(" />")
+		return
+	}
+	b.WriteString(">")
+}
+
+// safeURL accepts http and https addresses and relative paths, and mailto addresses for links.
+func safeURL(value string, link bool) (string, bool) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return "", false
+	}
+
+	parsed, err := url.Parse(value)
+	if err != nil {
+		return "", false
+	}
+
+	switch parsed.Scheme {
+	case "http", "https":
+		return value, true
+	case "mailto":
+		return value, link
+	case "":
+		// Browsers read protocol relative addresses, with slashes or backslashes, as any host
+		return value, parsed.Host == "" && !strings.HasPrefix(value, "//") && !strings.Contains(value, `\`)
+	}
+
+	return "", false
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
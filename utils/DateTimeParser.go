@@ -134,8 +134,9 @@ func (d *DateOnly) Scan(value interface{}) error {
 
 // GetDateRange Searching Service Utils
 // GetDateRange converts a predefined date range string into a start and end time.
-func GetDateRange(dateRange string) (start time.Time, end time.Time) {
-	now := time.Now()
+// Days and weeks are those of the caller's zone, not of the server.
+func GetDateRange(dateRange string, loc *time.Location) (start time.Time, end time.Time) {
+	now := time.Now().In(loc)
 
 	switch dateRange {
 	case "today":
@@ -0,0 +1,23 @@
+package utils
+
+import "github.com/gofiber/fiber/v2"
+
+const (
+	LocaleThai    = "th"
+	LocaleEnglish = "en"
+)
+
+// SupportedLocales are the locales texts are translated to, the first one is the default.
+var SupportedLocales = []string{LocaleThai, LocaleEnglish}
+
+// GetLocaleFromFiberCtx picks the supported locale the caller prefers in Accept-Language, callers
+// that send none or only unsupported ones get the default locale. The response varies with the header.
+func GetLocaleFromFiberCtx(c *fiber.Ctx) string {
+	c.Vary(fiber.HeaderAcceptLanguage)
+
+	if locale := c.AcceptsLanguages(SupportedLocales...); locale != "" {
+		return locale
+	}
+
+	return SupportedLocales[0]
+}
@@ -0,0 +1,56 @@
+package utils
+
+import "strings"
+
+// hoursPerMonth is the average number of working hours in a month (40 hours * 52 weeks / 12 months).
+const hoursPerMonth = 40.0 * 52.0 / 12.0
+
+// thbExchangeRates holds approximate THB values of one unit of each supported currency.
+// They are only used to normalize salaries for range filtering, not for display.
+var thbExchangeRates = map[string]float64{
+	"THB": 1,
+	"USD": 35,
+	"EUR": 38,
+	"GBP": 44,
+	"JPY": 0.23,
+	"CNY": 4.8,
+	"SGD": 26,
+	"MYR": 7.5,
+	"LAK": 0.0016,
+	"MMK": 0.017,
+	"KHR": 0.0086,
+	"VND": 0.0014,
+}
+
+// IsSupportedSalaryCurrency reports whether salaries in the ISO 4217 currency can be converted to THB.
+func IsSupportedSalaryCurrency(currency string) bool {
+	_, ok := thbExchangeRates[strings.ToUpper(currency)]
+	return ok
+}
+
+// SalaryToMonthlyTHB converts an amount paid per period ("hourly", "monthly" or "yearly")
+// in the given ISO 4217 currency to its monthly THB equivalent.
+// It returns false if the currency or the period is not supported.
+func SalaryToMonthlyTHB(amount float64, currency string, period string) (float64, bool) {
+	if currency == "" {
+		currency = "THB"
+	}
+	rate, ok := thbExchangeRates[strings.ToUpper(currency)]
+	if !ok {
+		return 0, false
+	}
+
+	var monthly float64
+	switch period {
+	case "hourly":
+		monthly = amount * hoursPerMonth
+	case "monthly", "":
+		monthly = amount
+	case "yearly":
+		monthly = amount / 12
+	default:
+		return 0, false
+	}
+
+	return monthly * rate, true
+}
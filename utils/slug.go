@@ -0,0 +1,33 @@
+package utils
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Slugify lowercases s and joins its words with dashes, keeping non-latin letters (e.g. Thai) as they are.
+func Slugify(s string) string {
+	var builder strings.Builder
+	dash := false
+	for _, r := range strings.ToLower(strings.TrimSpace(s)) {
+		switch {
+		case unicode.IsLetter(r) || unicode.IsDigit(r) || unicode.IsMark(r):
+			builder.WriteRune(r)
+			dash = false
+		// keep names such as "C++" and "C#" distinguishable from "C"
+		case r == '+':
+			builder.WriteString("plus")
+			dash = false
+		case r == '#':
+			builder.WriteString("sharp")
+			dash = false
+		default:
+			if !dash && builder.Len() > 0 {
+				builder.WriteRune('-')
+				dash = true
+			}
+		}
+	}
+
+	return strings.TrimSuffix(builder.String(), "-")
+}
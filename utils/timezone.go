@@ -0,0 +1,71 @@
+package utils
+
+import (
+	"fmt"
+	"time"
+
+	// The zone database is embedded so that zones resolve the same in a bare container
+	_ "time/tzdata"
+)
+
+// DefaultTimezone is the zone of events created before they carried one, and of callers that send none.
+const DefaultTimezone = "Asia/Bangkok"
+
+// LoadTimezone resolves an IANA zone name, an empty name is the default zone.
+// "Local" is rejected since it would depend on the server.
+func LoadTimezone(name string) (*time.Location, error) {
+	if name == "" {
+		name = DefaultTimezone
+	}
+
+	if name == "Local" {
+		return nil, fmt.Errorf("invalid timezone: %s", name)
+	}
+
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, fmt.Errorf("invalid timezone: %s", name)
+	}
+
+	return loc, nil
+}
+
+// CombineDateTime places a stored date and wall clock time in the zone.
+func CombineDateTime(date time.Time, clock time.Time, loc *time.Location) time.Time {
+	return time.Date(date.Year(), date.Month(), date.Day(), clock.Hour(), clock.Minute(), clock.Second(), 0, loc)
+}
+
+// EventInstants turns the local dates and times of an event into instants. An event without times
+// lasts whole days and its end is midnight after the last day. A missing or inverted end lasts an hour.
+func EventInstants(startDate, endDate, startTime, endTime time.Time, loc *time.Location) (start time.Time, end time.Time, allDay bool) {
+	if endDate.IsZero() || endDate.Before(startDate) {
+		endDate = startDate
+	}
+
+	if isMidnight(startTime) && isMidnight(endTime) {
+		start = CombineDateTime(startDate, time.Time{}, loc)
+		end = CombineDateTime(endDate, time.Time{}, loc).AddDate(0, 0, 1)
+		return start, end, true
+	}
+
+	start = CombineDateTime(startDate, startTime, loc)
+	end = CombineDateTime(endDate, endTime, loc)
+	if !end.After(start) {
+		end = start.Add(time.Hour)
+	}
+
+	return start, end, false
+}
+
+func isMidnight(t time.Time) bool {
+	return t.Hour() == 0 && t.Minute() == 0 && t.Second() == 0
+}
+
+// FormatUTC writes an instant as RFC 3339 in UTC, a missing instant is empty.
+func FormatUTC(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+
+	return t.UTC().Format(time.RFC3339)
+}
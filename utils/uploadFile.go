@@ -39,3 +39,35 @@ func UploadBackgroundImage(c *fiber.Ctx) (multipart.File, *multipart.FileHeader,
 
 	return file, fileHeader, nil
 }
+
+func UploadResume(c *fiber.Ctx) (multipart.File, *multipart.FileHeader, error) {
+	fileHeader, err := c.FormFile("resume")
+	if err != nil {
+		logs.Error(err)
+		return nil, nil, errs.NewBadRequestError("Failed to get resume from form")
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		logs.Error(err)
+		return nil, nil, errs.NewUnexpectedError()
+	}
+
+	return file, fileHeader, nil
+}
+
+func UploadMaterial(c *fiber.Ctx) (multipart.File, *multipart.FileHeader, error) {
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		logs.Error(err)
+		return nil, nil, errs.NewBadRequestError("Failed to get file from form")
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		logs.Error(err)
+		return nil, nil, errs.NewUnexpectedError()
+	}
+
+	return file, fileHeader, nil
+}
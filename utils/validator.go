@@ -15,6 +15,15 @@ import (
 
 var validate = validator.New()
 
+func init() {
+	// Job salaries are stored only in currencies with a THB rate, so the salary filter can compare them
+	if err := validate.RegisterValidation("salary_currency", func(fl validator.FieldLevel) bool {
+		return IsSupportedSalaryCurrency(fl.Field().String())
+	}); err != nil {
+		panic(err)
+	}
+}
+
 func ValidateStruct(data interface{}) []types.ValidationError {
 	var validationErrors []types.ValidationError
 